@@ -2,11 +2,19 @@ package main
 
 import (
 	"context"
+	"flag"
+	"io"
 	"log"
+	"os"
+	"strings"
 
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/client/internal/agent"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/client/internal/history"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/client/internal/message"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/client/internal/ui"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/codec"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/recording"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -16,9 +24,69 @@ func runTea(p *tea.Program, userInputCh chan string) error {
 	return err
 }
 
+// replayEvents feeds every event from r into p, in order, until the trace
+// is exhausted or ctx is canceled. Events that don't produce a visible
+// message (message.NewMessage returning nil) are dropped, same as the
+// live-agent path.
+func replayEvents(ctx context.Context, p *tea.Program, r *recording.Replayer) error {
+	out := make(chan events.Event)
+	go func() {
+		for event := range out {
+			if msg := message.NewMessage(event); msg != nil {
+				p.Send(msg)
+			}
+		}
+	}()
+	return r.Replay(ctx, out)
+}
+
 func main() {
+	pipeStdin := flag.Bool("pipe-stdin", false, "read an initial prompt from stdin and send it as the first message")
+	resume := flag.Bool("resume", false, "restore the previous session's message history on startup")
+	replayFile := flag.String("replay", "", "replay a recorded SSE trace file instead of contacting a live server")
+	replaySpeedup := flag.Float64("replay-speedup", 1, "scale factor for replay timing (2 = twice as fast, 0.5 = half as fast)")
+	flag.Parse()
+
+	historyStore, err := history.Open(agent.DefaultSessionID)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer historyStore.Close()
+
 	userInputCh := make(chan string)
-	p := tea.NewProgram(ui.InitialModel(userInputCh), tea.WithAltScreen())
+	model := ui.InitialModel(userInputCh, historyStore)
+	if *resume {
+		records, err := history.Load(agent.DefaultSessionID)
+		if err != nil {
+			log.Fatal(err)
+		}
+		model.LoadHistory(records)
+	}
+
+	p := tea.NewProgram(model, tea.WithAltScreen())
+
+	if *replayFile != "" {
+		f, err := os.Open(*replayFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+
+		replayer, err := recording.NewReplayer(codec.NewJSONCodec(), f, *replaySpeedup)
+		if err != nil {
+			log.Fatal(err)
+		}
+		go func() {
+			if err := replayEvents(context.Background(), p, replayer); err != nil {
+				p.Send(ui.ErrorMsg{Err: err, Fatal: true})
+			}
+		}()
+
+		if teaErr := runTea(p, userInputCh); teaErr != nil {
+			log.Fatal(teaErr)
+		}
+		return
+	}
 
 	sendUserInput := func(msg *message.Message) {
 		p.Send(msg)
@@ -26,13 +94,25 @@ func main() {
 	go func() {
 
 		for msg := range userInputCh {
-			err := agent.Chat(context.Background(), msg, agent.DefaultEndpoint(), sendUserInput)
+			err := agent.Chat(context.Background(), msg, agent.DefaultEndpoint(), sendUserInput, agent.ChatOptions{})
 			if err != nil {
-				log.Fatal(err)
+				p.Send(ui.ErrorMsg{Err: err, Fatal: true})
 			}
 		}
 	}()
 
+	if *pipeStdin {
+		go func() {
+			data, err := io.ReadAll(os.Stdin)
+			if err != nil {
+				return
+			}
+			if prompt := strings.TrimSpace(string(data)); prompt != "" {
+				p.Send(ui.InitialPromptMsg(prompt))
+			}
+		}()
+	}
+
 	teaErr := runTea(p, userInputCh)
 	if teaErr != nil {
 		log.Fatal(teaErr)
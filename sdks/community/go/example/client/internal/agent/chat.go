@@ -6,9 +6,9 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/client/internal/event"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/client/internal/message"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/client/sse"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
 	"github.com/sirupsen/logrus"
 )
 
@@ -16,7 +16,27 @@ func DefaultEndpoint() string {
 	return "http://localhost:8000/agentic"
 }
 
-func Chat(ctx context.Context, inputMsg string, endpoint string, send func(msg *message.Message)) error {
+// DefaultSessionID names the thread Chat posts to and, correspondingly,
+// the history file the client TUI persists its transcript under.
+const DefaultSessionID = "test-session-1755371887"
+
+// ChatOptions configures a single Chat call.
+type ChatOptions struct {
+	// PerEventDeadline, if non-zero, is passed through to
+	// sse.StreamOptions.PerEventDeadline, bounding how long Chat waits on
+	// any single event (e.g. a stalled tool-call delta) before giving up on
+	// the stream - without requiring the caller to cancel ctx itself.
+	PerEventDeadline time.Duration
+
+	// ValidationMode controls how a lifecycle violation in the decoded
+	// event stream (e.g. ToolCallArgs before ToolCallStart) is handled: the
+	// zero value, events.ValidationModeStrict, ends the run on the first
+	// violation, while events.ValidationModeBestEffort renders it as a
+	// RunErrorEvent and keeps the run going.
+	ValidationMode events.ValidationMode
+}
+
+func Chat(ctx context.Context, inputMsg string, endpoint string, send func(msg *message.Message), opts ChatOptions) error {
 	logger := logrus.New()
 	logger.SetLevel(logrus.FatalLevel)
 	sseConfig := sse.Config{
@@ -34,7 +54,7 @@ func Chat(ctx context.Context, inputMsg string, endpoint string, send func(msg *
 		client.Close()
 	}()
 
-	sessionID := "test-session-1755371887"
+	sessionID := DefaultSessionID
 	runID := "run-1755744865857245000"
 
 	payload := map[string]interface{}{
@@ -53,17 +73,26 @@ func Chat(ctx context.Context, inputMsg string, endpoint string, send func(msg *
 		"forwardedProps": map[string]interface{}{},
 	}
 
-	// Start the SSE stream
+	// Start the SSE stream. Resumable lets transient network failures
+	// reconnect with Last-Event-ID replay instead of ending the run, so the
+	// loop below only needs to react to reconnectEvents for user-visible
+	// status, not to bail out on the first error.
+	reconnectEvents := make(chan sse.ReconnectEvent, 4)
 	var err error
 	frames, errorCh, err := client.Stream(sse.StreamOptions{
-		Context: ctx,
-		Payload: payload,
+		Context:          ctx,
+		Payload:          payload,
+		PerEventDeadline: opts.PerEventDeadline,
+		Resumable:        true,
+		Events:           reconnectEvents,
 	})
 
 	if err != nil {
 		return errors.New("Failed to establish SSE connection")
 	}
 
+	validator := events.NewValidator(opts.ValidationMode)
+
 	// Parse SSE events
 	for {
 		select {
@@ -72,16 +101,38 @@ func Chat(ctx context.Context, inputMsg string, endpoint string, send func(msg *
 				return nil
 			}
 
-			rawEvent, err := event.Parse(frame.Data)
+			// client.Codec() resolves to whatever wire format the server
+			// actually responded with (JSON, protobuf, ...), negotiated by
+			// client.Stream via the Accept header - so this loop doesn't
+			// assume JSON the way a direct event.Parse call would.
+			rawEvent, err := client.Codec().Decode(frame.Data)
 			if err != nil {
 				return fmt.Errorf("failed to process SSE event %w", err)
 			}
-			currMsg := message.NewMessage(rawEvent)
+
+			validEvent, err := validator.Validate(rawEvent)
+			if err != nil {
+				return fmt.Errorf("event lifecycle violation: %w", err)
+			}
+
+			currMsg := message.NewMessage(validEvent)
 			if currMsg == nil {
 				return fmt.Errorf("failed to parse message %w", err)
 			}
 			send(currMsg)
 
+		case evt := <-reconnectEvents:
+			switch evt.Kind {
+			case sse.GaveUp:
+				return fmt.Errorf("SSE stream reconnect attempts exhausted: %w", evt.Err)
+			default:
+				logger.WithFields(logrus.Fields{
+					"kind":    evt.Kind,
+					"attempt": evt.Attempt,
+					"err":     evt.Err,
+				}).Info("SSE reconnect event")
+			}
+
 		case err, ok := <-errorCh:
 			if !ok {
 				break
@@ -0,0 +1,154 @@
+// Package history persists the client TUI's message transcript to disk as
+// JSONL, so a session survives a restart when the user asks to resume it.
+package history
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Record is the on-disk shape of one transcript entry. It mirrors
+// ui.UIMessage's fields rather than importing that package directly, so
+// history has no dependency on the UI layer that writes to it.
+type Record struct {
+	Role      string    `json:"role"`
+	Content   string    `json:"content"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Dir returns the directory session history files live under:
+// $XDG_STATE_HOME/ag-ui/history, falling back to ~/.local/state/ag-ui/history
+// if XDG_STATE_HOME is unset.
+func Dir() (string, error) {
+	base := os.Getenv("XDG_STATE_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("history: resolve home directory: %w", err)
+		}
+		base = filepath.Join(home, ".local", "state")
+	}
+	return filepath.Join(base, "ag-ui", "history"), nil
+}
+
+// sessionPath returns the JSONL path for a given session name.
+func sessionPath(session string) (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, session+".jsonl"), nil
+}
+
+// Store appends Records to a single session's JSONL file as they happen,
+// so a crash loses at most the in-flight write rather than the whole
+// transcript.
+type Store struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// Open opens (creating if necessary) the history file for session,
+// positioned for appending.
+func Open(session string) (*Store, error) {
+	path, err := sessionPath(session)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("history: create history directory: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("history: open %s: %w", path, err)
+	}
+	return &Store{file: f}, nil
+}
+
+// Append writes r as the next line of the session's history file.
+func (s *Store) Append(r Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("history: marshal record: %w", err)
+	}
+	data = append(data, '\n')
+	if _, err := s.file.Write(data); err != nil {
+		return fmt.Errorf("history: append to %s: %w", s.file.Name(), err)
+	}
+	return nil
+}
+
+// Close closes the underlying history file.
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// Load reads back every Record previously appended to session's history
+// file. A session with no history file yet returns (nil, nil).
+func Load(session string) ([]Record, error) {
+	path, err := sessionPath(session)
+	if err != nil {
+		return nil, err
+	}
+	records, err := LoadRecordsFromFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return records, err
+}
+
+// LoadRecordsFromFile reads Records from an arbitrary JSONL path, used by
+// the /load command to restore a transcript saved elsewhere by /save.
+func LoadRecordsFromFile(path string) ([]Record, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []Record
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var r Record
+		if err := json.Unmarshal(line, &r); err != nil {
+			return nil, fmt.Errorf("history: parse %s: %w", path, err)
+		}
+		records = append(records, r)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("history: read %s: %w", path, err)
+	}
+	return records, nil
+}
+
+// SaveRecordsToFile writes records to an arbitrary JSONL path, used by the
+// /save command to export the current transcript.
+func SaveRecordsToFile(path string, records []Record) error {
+	var buf bytes.Buffer
+	for _, r := range records {
+		data, err := json.Marshal(r)
+		if err != nil {
+			return fmt.Errorf("history: marshal record: %w", err)
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("history: write %s: %w", path, err)
+	}
+	return nil
+}
@@ -5,19 +5,35 @@ import (
 	"fmt"
 
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/state"
 	"github.com/charmbracelet/lipgloss"
 )
 
 var serverStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("21"))
 
+// DefaultStore accumulates the StateSnapshotEvent/StateDeltaEvent stream
+// into a single thread state, so a StateDeltaEvent can be rendered as the
+// operations it actually applied instead of a detached dump of the raw
+// patch.
+var DefaultStore = state.NewStore()
+
 type Message struct {
 	contents []string
+	// stateVersion is the DefaultStore.Version() that resulted from this
+	// message, or 0 if the message didn't touch state.
+	stateVersion int
 }
 
 func (m *Message) Strings() []string {
 	return m.contents
 }
 
+// StateVersion returns the DefaultStore version this message produced, or 0
+// if it isn't a state event.
+func (m *Message) StateVersion() int {
+	return m.stateVersion
+}
+
 func NewMessage(event events.Event) *Message {
 	return getMessageFromEvent(event)
 }
@@ -123,31 +139,34 @@ func getMessageFromEvent(event events.Event) *Message {
 		if !ok {
 			return nil
 		}
-		var contents []string
-		if snapshot.Snapshot != nil {
-			jsonData, err := json.Marshal(snapshot.Snapshot)
-			if err != nil {
-				fmt.Println("Error marshaling JSON:", err)
-				return nil
-			}
-			contents = append(contents, string(jsonData))
-
+		if err := DefaultStore.Reconcile(snapshot.Snapshot); err != nil {
+			fmt.Println("Error reconciling state snapshot:", err)
+			return nil
 		}
+		content := fmt.Sprintf("%s state reconciled (version %d)", serverStyle.Render("Server:"), DefaultStore.Version())
 		return &Message{
-			contents: contents,
+			contents:     []string{content},
+			stateVersion: DefaultStore.Version(),
 		}
 	case events.EventTypeStateDelta:
 		delta, ok := event.(*events.StateDeltaEvent)
 		if !ok {
 			return nil
 		}
-		var contents []string
-		for _, op := range delta.Delta {
-			currOp := fmt.Sprintf("%s Operation: %s, Path: %s, Value: %s", serverStyle.Render("Server:"), op.Op, op.Path, op.Value)
-			contents = append(contents, currOp)
+		log, err := DefaultStore.ApplyDelta(delta.Delta)
+		if err != nil {
+			content := fmt.Sprintf("%s state delta rejected: %s", serverStyle.Render("Server:"), err)
+			return &Message{
+				contents: []string{content},
+			}
+		}
+		contents := make([]string, 0, len(log))
+		for _, line := range log {
+			contents = append(contents, fmt.Sprintf("%s %s", serverStyle.Render("Server:"), line))
 		}
 		return &Message{
-			contents: contents,
+			contents:     contents,
+			stateVersion: DefaultStore.Version(),
 		}
 	case events.EventTypeMessagesSnapshot:
 		snapshot, ok := event.(*events.MessagesSnapshotEvent)
@@ -0,0 +1,212 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/client/internal/history"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// defaultCommandRegistry builds the registry of built-in slash commands a
+// fresh Model starts with.
+func defaultCommandRegistry() *CommandRegistry {
+	r := NewCommandRegistry()
+
+	r.Register(Command{
+		Name:        "clear",
+		Description: "Clear the visible transcript (history on disk is untouched)",
+		Handler: func(ctx CommandContext) tea.Cmd {
+			ctx.Model.messages = nil
+			ctx.Model.selectedMsgIndex = -1
+			ctx.Model.updateViewportContent()
+			return nil
+		},
+	})
+
+	r.Register(Command{
+		Name:        "save",
+		Args:        []string{"<path>"},
+		Description: "Save the current transcript to a JSONL file",
+		Handler: func(ctx CommandContext) tea.Cmd {
+			if len(ctx.Args) == 0 {
+				ctx.Model.appendSystemMessage("Usage: /save <path>")
+				return nil
+			}
+			path := ctx.Args[0]
+			if err := history.SaveRecordsToFile(path, ctx.Model.historyRecords()); err != nil {
+				ctx.Model.appendSystemMessage(fmt.Sprintf("/save failed: %s", err))
+				return nil
+			}
+			ctx.Model.appendSystemMessage(fmt.Sprintf("Saved transcript to %s", path))
+			return nil
+		},
+	})
+
+	r.Register(Command{
+		Name:        "load",
+		Args:        []string{"<path>"},
+		Description: "Replace the current transcript with one saved by /save",
+		Handler: func(ctx CommandContext) tea.Cmd {
+			if len(ctx.Args) == 0 {
+				ctx.Model.appendSystemMessage("Usage: /load <path>")
+				return nil
+			}
+			path := ctx.Args[0]
+			records, err := history.LoadRecordsFromFile(path)
+			if err != nil {
+				ctx.Model.appendSystemMessage(fmt.Sprintf("/load failed: %s", err))
+				return nil
+			}
+			ctx.Model.messages = nil
+			ctx.Model.LoadHistory(records)
+			ctx.Model.updateViewportContent()
+			ctx.Model.appendSystemMessage(fmt.Sprintf("Loaded %d messages from %s", len(records), path))
+			return nil
+		},
+	})
+
+	r.Register(Command{
+		Name:        "reconnect",
+		Description: "Reset the waiting state so the next message opens a new connection",
+		Handler: func(ctx CommandContext) tea.Cmd {
+			// Each submitted message already opens its own connection
+			// (agent.Chat), so there's no persistent stream to tear down -
+			// this just clears a stuck waitingForResp after a dropped
+			// connection so the textarea accepts input again.
+			ctx.Model.waitingForResp = false
+			ctx.Model.appendSystemMessage("Ready to send on a fresh connection")
+			return nil
+		},
+	})
+
+	r.Register(Command{
+		Name:        "export",
+		Args:        []string{"md|json"},
+		Description: "Export the transcript as Markdown or JSON to a timestamped file",
+		Handler: func(ctx CommandContext) tea.Cmd {
+			format := "md"
+			if len(ctx.Args) > 0 {
+				format = ctx.Args[0]
+			}
+			path, err := ctx.Model.exportTranscript(format)
+			if err != nil {
+				ctx.Model.appendSystemMessage(fmt.Sprintf("/export failed: %s", err))
+				return nil
+			}
+			ctx.Model.appendSystemMessage(fmt.Sprintf("Exported transcript to %s", path))
+			return nil
+		},
+	})
+
+	r.Register(Command{
+		Name:        "search",
+		Args:        []string{"<query>"},
+		Description: "Jump to the most recent message containing query",
+		Handler: func(ctx CommandContext) tea.Cmd {
+			if len(ctx.Args) == 0 {
+				ctx.Model.appendSystemMessage("Usage: /search <query>")
+				return nil
+			}
+			query := strings.Join(ctx.Args, " ")
+			index, ok := ctx.Model.searchMessages(query)
+			if !ok {
+				ctx.Model.appendSystemMessage(fmt.Sprintf("No match for %q", query))
+				return nil
+			}
+			ctx.Model.selectedMsgIndex = index
+			ctx.Model.jumpToSelected()
+			return nil
+		},
+	})
+
+	r.Register(Command{
+		Name:        "help",
+		Description: "List available commands",
+		Handler: func(ctx CommandContext) tea.Cmd {
+			var b strings.Builder
+			b.WriteString("Available commands:")
+			for _, name := range ctx.Model.commands.Names() {
+				cmd, _ := ctx.Model.commands.Get(name)
+				usage := "/" + cmd.Name
+				if len(cmd.Args) > 0 {
+					usage += " " + strings.Join(cmd.Args, " ")
+				}
+				b.WriteString(fmt.Sprintf("\n  %-20s %s", usage, cmd.Description))
+			}
+			ctx.Model.appendSystemMessage(b.String())
+			return nil
+		},
+	})
+
+	return r
+}
+
+// historyRecords converts the in-memory transcript to history.Records, for
+// /save and /export.
+func (m *Model) historyRecords() []history.Record {
+	records := make([]history.Record, len(m.messages))
+	for i, msg := range m.messages {
+		records[i] = history.Record{Role: msg.Role, Content: msg.Content, Timestamp: msg.Timestamp}
+	}
+	return records
+}
+
+// searchMessages returns the index of the most recent message whose
+// content contains query (case-insensitive).
+func (m *Model) searchMessages(query string) (int, bool) {
+	needle := strings.ToLower(query)
+	for i := len(m.messages) - 1; i >= 0; i-- {
+		if strings.Contains(strings.ToLower(m.messages[i].Content), needle) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// exportTranscript writes the current transcript as Markdown or JSON to a
+// timestamped file in the history directory's exports subdirectory,
+// returning the path written.
+func (m *Model) exportTranscript(format string) (string, error) {
+	dir, err := history.Dir()
+	if err != nil {
+		return "", err
+	}
+	dir = filepath.Join(dir, "exports")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("create export directory: %w", err)
+	}
+
+	stamp := time.Now().Format("20060102-150405")
+
+	switch format {
+	case "json":
+		path := filepath.Join(dir, fmt.Sprintf("ag-ui-export-%s.json", stamp))
+		data, err := json.MarshalIndent(m.historyRecords(), "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("marshal transcript: %w", err)
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			return "", fmt.Errorf("write %s: %w", path, err)
+		}
+		return path, nil
+
+	case "md":
+		path := filepath.Join(dir, fmt.Sprintf("ag-ui-export-%s.md", stamp))
+		var b strings.Builder
+		for _, msg := range m.messages {
+			b.WriteString(fmt.Sprintf("### %s (%s)\n\n%s\n\n", msg.Role, msg.Timestamp.Format("15:04:05"), msg.Content))
+		}
+		if err := os.WriteFile(path, []byte(b.String()), 0o644); err != nil {
+			return "", fmt.Errorf("write %s: %w", path, err)
+		}
+		return path, nil
+
+	default:
+		return "", fmt.Errorf("unknown export format %q (want md or json)", format)
+	}
+}
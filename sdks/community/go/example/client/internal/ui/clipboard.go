@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/atotto/clipboard"
+)
+
+// Clipboard abstracts the OS clipboard so ErrorWindow and Model can be
+// exercised in headless tests with a fake, without pulling in a real
+// clipboard library (which typically needs an X11/Wayland/pbcopy backend
+// unavailable in CI).
+type Clipboard interface {
+	Read() (string, error)
+	Write(text string) error
+}
+
+// systemClipboard is the default Clipboard, backed by atotto/clipboard. Its
+// Write falls back to an OSC 52 terminal escape sequence when the host has
+// no clipboard utility (e.g. a bare SSH session), so copying still reaches
+// the user's local machine through their terminal emulator.
+type systemClipboard struct{}
+
+func (systemClipboard) Read() (string, error) {
+	return clipboard.ReadAll()
+}
+
+func (systemClipboard) Write(text string) error {
+	if err := clipboard.WriteAll(text); err != nil {
+		writeClipboardOSC52(text)
+		return nil
+	}
+	return nil
+}
+
+// DefaultClipboard is the Clipboard used by InitialModel.
+var DefaultClipboard Clipboard = systemClipboard{}
+
+// writeClipboardOSC52 copies text to the system clipboard using the OSC 52
+// terminal escape sequence, which most modern terminal emulators (and SSH
+// sessions through them) honor without needing a platform-specific
+// clipboard library.
+func writeClipboardOSC52(text string) {
+	encoded := base64.StdEncoding.EncodeToString([]byte(text))
+	fmt.Fprintf(os.Stdout, "\x1b]52;c;%s\x07", encoded)
+}
@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// CommandContext carries what a slash command handler needs to act on
+// Model, without the handler reaching into unexported fields directly.
+type CommandContext struct {
+	Args  []string
+	Model *Model
+}
+
+// CommandHandler executes a parsed slash command and returns the tea.Cmd
+// (if any) Model.Update should run as a result.
+type CommandHandler func(ctx CommandContext) tea.Cmd
+
+// Command describes one slash command: its handler and the completion
+// hints shown while typing its arguments.
+type Command struct {
+	Name        string
+	Args        []string // suggested argument forms, e.g. "<path>", shown in order as Tab is pressed
+	Description string
+	Handler     CommandHandler
+}
+
+// CommandRegistry maps command names (without the leading "/") to their
+// Command definition, and drives Tab completion in the textarea.
+type CommandRegistry struct {
+	commands map[string]Command
+	order    []string
+}
+
+// NewCommandRegistry creates an empty registry.
+func NewCommandRegistry() *CommandRegistry {
+	return &CommandRegistry{commands: make(map[string]Command)}
+}
+
+// Register adds cmd to the registry, or replaces an existing command of
+// the same name in place.
+func (r *CommandRegistry) Register(cmd Command) {
+	if _, exists := r.commands[cmd.Name]; !exists {
+		r.order = append(r.order, cmd.Name)
+	}
+	r.commands[cmd.Name] = cmd
+}
+
+// Get looks up a command by name.
+func (r *CommandRegistry) Get(name string) (Command, bool) {
+	cmd, ok := r.commands[name]
+	return cmd, ok
+}
+
+// Names returns every registered command name, in registration order.
+func (r *CommandRegistry) Names() []string {
+	names := make([]string, len(r.order))
+	copy(names, r.order)
+	return names
+}
+
+// Complete returns the registered command names starting with prefix, for
+// Tab completion on the command name itself.
+func (r *CommandRegistry) Complete(prefix string) []string {
+	var matches []string
+	for _, name := range r.order {
+		if strings.HasPrefix(name, prefix) {
+			matches = append(matches, name)
+		}
+	}
+	return matches
+}
+
+// Dispatch parses line (the full textarea value, starting with "/") and
+// runs the matching command. ok is false if line doesn't name a
+// registered command, in which case cmd is nil and the caller is expected
+// to report the unrecognized command itself.
+func (r *CommandRegistry) Dispatch(line string, m *Model) (cmd tea.Cmd, ok bool) {
+	fields := strings.Fields(strings.TrimPrefix(line, "/"))
+	if len(fields) == 0 {
+		return nil, false
+	}
+
+	def, ok := r.commands[fields[0]]
+	if !ok {
+		return nil, false
+	}
+	return def.Handler(CommandContext{Args: fields[1:], Model: m}), true
+}
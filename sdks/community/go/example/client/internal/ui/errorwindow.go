@@ -0,0 +1,180 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ErrorMsg reports an SSE parse failure, network disconnect, or
+// event.Parse error to Model.Update, which routes it to ErrorWindow
+// instead of rendering it as a chat message. Fatal errors block further
+// input until dismissed; recoverable ones are shown without blocking the
+// textarea.
+type ErrorMsg struct {
+	Err   error
+	Fatal bool
+}
+
+var (
+	errorOverlayStyle = lipgloss.NewStyle().
+		BorderStyle(lipgloss.RoundedBorder()).
+		BorderForeground(errorColor).
+		Padding(1, 2)
+
+	errorTitleStyle = lipgloss.NewStyle().
+		Foreground(errorColor).
+		Bold(true)
+
+	errorBodyStyle = lipgloss.NewStyle().
+		Foreground(textColor)
+
+	errorHintStyle = lipgloss.NewStyle().
+		Foreground(mutedTextColor).
+		Italic(true).
+		MarginTop(1)
+)
+
+// ErrorWindow is a modal overlay layered over Model's chat viewport. It
+// owns its own viewport.Model so a long stack trace scrolls independently
+// of the chat behind it, and caches a snapshot of that chat view (taken at
+// Show time) so View can still describe what the overlay sits on top of.
+type ErrorWindow struct {
+	viewport   viewport.Model
+	background string
+	err        error
+	fatal      bool
+	visible    bool
+	width      int
+	height     int
+	clipboard  Clipboard
+}
+
+// NewErrorWindow creates an ErrorWindow with no error shown, copying error
+// text to clipboard when the user presses c/y.
+func NewErrorWindow(clipboard Clipboard) *ErrorWindow {
+	return &ErrorWindow{viewport: viewport.New(0, 0), clipboard: clipboard}
+}
+
+// Show displays msg as a modal overlay. background is the chat view
+// rendered at the moment the error occurred, kept so the chat appears
+// unchanged once the overlay is dismissed.
+func (e *ErrorWindow) Show(msg ErrorMsg, background string) {
+	e.err = msg.Err
+	e.fatal = msg.Fatal
+	e.background = background
+	e.visible = true
+	e.viewport.SetContent(e.renderError())
+	e.viewport.GotoTop()
+}
+
+// Visible reports whether an error is currently shown.
+func (e *ErrorWindow) Visible() bool {
+	return e.visible
+}
+
+// Blocking reports whether the overlay should consume all input, rather
+// than letting the textarea keep receiving keys underneath it.
+func (e *ErrorWindow) Blocking() bool {
+	return e.visible && e.fatal
+}
+
+// SetSize resizes the overlay to fit within a width x height terminal.
+func (e *ErrorWindow) SetSize(width, height int) {
+	e.width = width
+	e.height = height
+	e.viewport.Width = width - 8
+	e.viewport.Height = min(height-8, 12)
+	if e.err != nil {
+		e.viewport.SetContent(e.renderError())
+	}
+}
+
+// Update handles a message while the overlay is visible: Esc/Enter/q
+// dismiss it, c/y copy the error text to the clipboard, and everything
+// else scrolls the stack trace viewport. It is a no-op if the overlay
+// isn't visible.
+func (e *ErrorWindow) Update(msg tea.Msg) tea.Cmd {
+	if !e.visible {
+		return nil
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		switch keyMsg.String() {
+		case "esc", "enter", "q":
+			e.visible = false
+			return nil
+		case "c", "y":
+			return e.copyCmd()
+		}
+	}
+
+	var cmd tea.Cmd
+	e.viewport, cmd = e.viewport.Update(msg)
+	return cmd
+}
+
+// copyCmd copies the current error's text to the clipboard, so users can
+// paste it straight into an issue without leaving the terminal.
+func (e *ErrorWindow) copyCmd() tea.Cmd {
+	text := e.errorText()
+	return func() tea.Msg {
+		e.clipboard.Write(text)
+		return nil
+	}
+}
+
+func (e *ErrorWindow) errorText() string {
+	if e.err == nil {
+		return ""
+	}
+	return fmt.Sprintf("%+v", e.err)
+}
+
+func (e *ErrorWindow) renderError() string {
+	title := "Error"
+	if e.fatal {
+		title = "Fatal Error"
+	}
+	header := errorTitleStyle.Render(title)
+	body := errorBodyStyle.Render(e.errorText())
+	return fmt.Sprintf("%s\n\n%s", header, body)
+}
+
+// View renders the overlay centered over the terminal. background isn't
+// composited character-by-character (lipgloss has no such primitive); it
+// is kept so the chat underneath is known to be unchanged once the
+// overlay is dismissed.
+func (e *ErrorWindow) View() string {
+	hint := errorHintStyle.Render("esc/enter dismiss • c copy error")
+	box := errorOverlayStyle.
+		Width(e.width - 4).
+		Render(fmt.Sprintf("%s\n\n%s", e.viewport.View(), hint))
+
+	return lipgloss.Place(e.width, e.height, lipgloss.Center, lipgloss.Center, box)
+}
+
+// isDismissKey reports whether msg is a key that ErrorWindow.Update treats
+// as dismiss-or-act, so Model.Update can avoid also handling it as chat
+// input on the same tick.
+func isDismissKey(msg tea.Msg) bool {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return false
+	}
+	switch keyMsg.String() {
+	case "esc", "enter", "q", "c", "y":
+		return true
+	default:
+		return false
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
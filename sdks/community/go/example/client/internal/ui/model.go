@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/client/internal/history"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/client/internal/message"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textarea"
@@ -31,10 +32,14 @@ func (m UIMessage) String() string {
 	var roleStyle lipgloss.Style
 	var rolePrefix string
 
-	if m.Role == "user" {
+	switch m.Role {
+	case "user":
 		roleStyle = UserLabelStyle
 		rolePrefix = "You"
-	} else {
+	case "system":
+		roleStyle = SystemLabelStyle
+		rolePrefix = "System"
+	default:
 		roleStyle = AssistantLabelStyle
 		rolePrefix = "Assistant"
 	}
@@ -46,41 +51,84 @@ func (m UIMessage) String() string {
 	return fmt.Sprintf("%s\n%s", header, content)
 }
 
+// InitialPromptMsg carries a one-shot initial prompt (from --pipe-stdin)
+// that Model.Update should submit exactly as if the user had typed it and
+// pressed Enter.
+type InitialPromptMsg string
+
 type Model struct {
-	messages       []UIMessage
-	viewport       viewport.Model
-	textarea       textarea.Model
-	userInput      chan string
-	ready          bool
-	waitingForResp bool
-	typingDots     int
+	messages         []UIMessage
+	viewport         viewport.Model
+	textarea         textarea.Model
+	userInput        chan string
+	ready            bool
+	waitingForResp   bool
+	typingDots       int
+	errorWindow      *ErrorWindow
+	clipboard        Clipboard
+	selectedMsgIndex int
+	commands         *CommandRegistry
+	history          *history.Store
 }
 
-func (m *Model) updateViewportContent() {
-	if len(m.messages) == 0 {
-		// Show splash screen when no messages
-		m.viewport.SetContent(getSplashScreen(m.viewport.Width, m.viewport.Height))
-		return
-	}
+// buildViewportContent renders every message into the viewport's content
+// string, and reports the line offset each message starts at so callers
+// that need to scroll to a specific message (jumpToSelected) don't have to
+// re-measure lipgloss heights themselves.
+func (m *Model) buildViewportContent() (content string, messageLineOffsets []int) {
+	var b strings.Builder
+	line := 0
+	offsets := make([]int, len(m.messages))
 
-	var content strings.Builder
 	for i, msg := range m.messages {
 		if i > 0 {
-			content.WriteString("\n\n")
+			b.WriteString("\n\n")
+			line += 2
+		}
+		offsets[i] = line
+
+		block := msg.String()
+		if !m.textarea.Focused() && i == m.selectedMsgIndex {
+			block = selectedMessageStyle.Render(block)
 		}
-		content.WriteString(msg.String())
+		b.WriteString(block)
+		line += lipgloss.Height(block)
 	}
 
-	// Add typing indicator if waiting for response
 	if m.waitingForResp {
-		content.WriteString("\n\n")
-		content.WriteString(getTypingIndicator(m.typingDots))
+		b.WriteString("\n\n")
+		b.WriteString(getTypingIndicator(m.typingDots))
 	}
 
-	m.viewport.SetContent(content.String())
+	return b.String(), offsets
+}
+
+func (m *Model) updateViewportContent() {
+	if len(m.messages) == 0 {
+		// Show splash screen when no messages
+		m.viewport.SetContent(getSplashScreen(m.viewport.Width, m.viewport.Height))
+		return
+	}
+
+	content, _ := m.buildViewportContent()
+	m.viewport.SetContent(content)
 	m.viewport.GotoBottom()
 }
 
+// jumpToSelected re-renders the viewport and scrolls it so selectedMsgIndex
+// is visible, instead of always snapping to the bottom - used by the j/k
+// selection bindings and by /search.
+func (m *Model) jumpToSelected() {
+	if len(m.messages) == 0 {
+		return
+	}
+	content, offsets := m.buildViewportContent()
+	m.viewport.SetContent(content)
+	if m.selectedMsgIndex >= 0 && m.selectedMsgIndex < len(offsets) {
+		m.viewport.YOffset = offsets[m.selectedMsgIndex]
+	}
+}
+
 func getTextarea() textarea.Model {
 	ta := textarea.New()
 	ta.Placeholder = "Send a message..."
@@ -104,7 +152,10 @@ func getTextarea() textarea.Model {
 	return ta
 }
 
-func InitialModel(userInput chan string) *Model {
+// InitialModel creates the chat Model. historyStore, if non-nil, receives
+// every message appended during the session (see persistMessage); pass nil
+// to run without persistence.
+func InitialModel(userInput chan string, historyStore *history.Store) *Model {
 	vp := viewport.New(80, 20)
 	vp.KeyMap = viewport.KeyMap{
 		Up:       key.NewBinding(key.WithKeys("up", "k")),
@@ -114,11 +165,94 @@ func InitialModel(userInput chan string) *Model {
 	}
 
 	return &Model{
-		viewport:  vp,
-		textarea:  getTextarea(),
-		userInput: userInput,
-		messages:  []UIMessage{},
+		viewport:         vp,
+		textarea:         getTextarea(),
+		userInput:        userInput,
+		messages:         []UIMessage{},
+		errorWindow:      NewErrorWindow(DefaultClipboard),
+		clipboard:        DefaultClipboard,
+		selectedMsgIndex: -1,
+		commands:         defaultCommandRegistry(),
+		history:          historyStore,
+	}
+}
+
+// LoadHistory seeds Model with previously-persisted messages, so --resume
+// can restore a prior session's transcript before the first frame renders.
+// It does not itself write back to historyStore - the records are already
+// on disk.
+func (m *Model) LoadHistory(records []history.Record) {
+	for _, r := range records {
+		m.messages = append(m.messages, UIMessage{Role: r.Role, Content: r.Content, Timestamp: r.Timestamp})
+	}
+	if len(m.messages) > 0 {
+		m.selectedMsgIndex = len(m.messages) - 1
+	}
+}
+
+// persistMessage appends msg to the history store, if one is configured.
+// A write failure is surfaced as a non-blocking error overlay rather than
+// silently dropped or crashing the session.
+func (m *Model) persistMessage(msg UIMessage) {
+	if m.history == nil {
+		return
+	}
+	if err := m.history.Append(history.Record{Role: msg.Role, Content: msg.Content, Timestamp: msg.Timestamp}); err != nil {
+		m.errorWindow.Show(ErrorMsg{Err: err, Fatal: false}, m.View())
+	}
+}
+
+// appendSystemMessage adds a locally-generated notice (command output,
+// errors) to the transcript, rendered with SystemLabelStyle rather than as
+// a real assistant reply.
+func (m *Model) appendSystemMessage(content string) {
+	msg := NewUIMessage("system", content)
+	m.messages = append(m.messages, msg)
+	m.selectedMsgIndex = len(m.messages) - 1
+	m.persistMessage(msg)
+	m.updateViewportContent()
+}
+
+// completeCommand handles Tab while the textarea holds a "/"-prefixed
+// value: it completes the command name if exactly one match remains, or
+// otherwise inserts the next argument hint the registry has for it.
+func (m *Model) completeCommand() {
+	value := m.textarea.Value()
+	hasTrailingSpace := strings.HasSuffix(value, " ")
+	fields := strings.Fields(strings.TrimPrefix(value, "/"))
+	if len(fields) == 0 {
+		return
+	}
+
+	if len(fields) == 1 && !hasTrailingSpace {
+		matches := m.commands.Complete(fields[0])
+		if len(matches) != 1 {
+			return
+		}
+		m.textarea.SetValue("/" + matches[0] + " ")
+		return
+	}
+
+	cmd, ok := m.commands.Get(fields[0])
+	if !ok || len(cmd.Args) == 0 {
+		return
 	}
+
+	argIndex := len(fields) - 1
+	if hasTrailingSpace {
+		argIndex = len(fields)
+	}
+	if argIndex >= len(cmd.Args) {
+		return
+	}
+	hint := cmd.Args[argIndex]
+
+	if hasTrailingSpace {
+		m.textarea.SetValue(value + hint)
+		return
+	}
+	fields[len(fields)-1] = hint
+	m.textarea.SetValue("/" + cmd.Name + " " + strings.Join(fields[1:], " "))
 }
 
 func (m *Model) Init() tea.Cmd {
@@ -126,6 +260,26 @@ func (m *Model) Init() tea.Cmd {
 }
 
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if wsMsg, ok := msg.(tea.WindowSizeMsg); ok {
+		m.errorWindow.SetSize(wsMsg.Width, wsMsg.Height)
+	}
+
+	if errMsg, ok := msg.(ErrorMsg); ok {
+		m.errorWindow.Show(errMsg, m.View())
+		return m, nil
+	}
+
+	if m.errorWindow.Visible() {
+		wasBlocking := m.errorWindow.Blocking()
+		errCmd := m.errorWindow.Update(msg)
+		if wasBlocking || isDismissKey(msg) {
+			// A blocking overlay consumes every message. A non-blocking one
+			// still consumes its own dismiss/copy keys, so the keypress that
+			// closed it doesn't also fall through to the textarea below.
+			return m, errCmd
+		}
+	}
+
 	var (
 		tiCmd tea.Cmd
 		vpCmd tea.Cmd
@@ -173,16 +327,41 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		case tea.KeyEnter:
 			if m.textarea.Focused() && m.textarea.Value() != "" && !m.waitingForResp {
+				value := m.textarea.Value()
+
+				if strings.HasPrefix(value, "/") {
+					m.textarea.Reset()
+					cmd, ok := m.commands.Dispatch(value, m)
+					if !ok {
+						m.appendSystemMessage(fmt.Sprintf("Unknown command: %s (try /help)", value))
+						return m, nil
+					}
+					return m, cmd
+				}
+
 				// Send the message
-				m.userInput <- m.textarea.Value()
+				m.userInput <- value
 
 				// Add to messages
-				uiMsg := NewUIMessage("user", m.textarea.Value())
+				uiMsg := NewUIMessage("user", value)
 				m.messages = append(m.messages, uiMsg)
+				m.selectedMsgIndex = len(m.messages) - 1
+				m.persistMessage(uiMsg)
 				m.updateViewportContent()
 				m.textarea.Reset()
 				m.waitingForResp = true
 			}
+		case tea.KeyTab:
+			if m.textarea.Focused() && strings.HasPrefix(m.textarea.Value(), "/") {
+				m.completeCommand()
+				return m, nil
+			}
+		case tea.KeyCtrlV:
+			if m.textarea.Focused() {
+				if text, err := m.clipboard.Read(); err == nil {
+					m.textarea.InsertString(text)
+				}
+			}
 		default:
 			if !m.textarea.Focused() {
 				switch msg.String() {
@@ -190,6 +369,20 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					// Enter input mode
 					m.textarea.Focus()
 					return m, textarea.Blink
+				case "j":
+					if m.selectedMsgIndex < len(m.messages)-1 {
+						m.selectedMsgIndex++
+						m.jumpToSelected()
+					}
+				case "k":
+					if m.selectedMsgIndex > 0 {
+						m.selectedMsgIndex--
+						m.jumpToSelected()
+					}
+				case "y":
+					m.yankSelectedMessage()
+				case "Y":
+					m.yankLastAssistantResponse()
 				}
 			}
 		}
@@ -199,9 +392,23 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		for _, currMsg := range msg.Strings() {
 			uiMsg := NewUIMessage("assistant", currMsg)
 			m.messages = append(m.messages, uiMsg)
+			m.persistMessage(uiMsg)
 		}
+		m.selectedMsgIndex = len(m.messages) - 1
 		m.updateViewportContent()
 
+	case InitialPromptMsg:
+		if string(msg) != "" && !m.waitingForResp {
+			m.userInput <- string(msg)
+
+			uiMsg := NewUIMessage("user", string(msg))
+			m.messages = append(m.messages, uiMsg)
+			m.selectedMsgIndex = len(m.messages) - 1
+			m.persistMessage(uiMsg)
+			m.updateViewportContent()
+			m.waitingForResp = true
+		}
+
 	case tickMsg:
 		m.typingDots++
 		if m.waitingForResp {
@@ -214,11 +421,35 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(tiCmd, vpCmd)
 }
 
+// yankSelectedMessage copies the message at selectedMsgIndex (tracked by
+// the j/k normal-mode bindings) to the clipboard.
+func (m *Model) yankSelectedMessage() {
+	if m.selectedMsgIndex < 0 || m.selectedMsgIndex >= len(m.messages) {
+		return
+	}
+	m.clipboard.Write(m.messages[m.selectedMsgIndex].Content)
+}
+
+// yankLastAssistantResponse copies the most recent assistant message to
+// the clipboard, regardless of where selectedMsgIndex currently points.
+func (m *Model) yankLastAssistantResponse() {
+	for i := len(m.messages) - 1; i >= 0; i-- {
+		if m.messages[i].Role == "assistant" {
+			m.clipboard.Write(m.messages[i].Content)
+			return
+		}
+	}
+}
+
 func (m *Model) textareaView() string {
 	return InputContainerStyle.Render(m.textarea.View())
 }
 
 func (m *Model) View() string {
+	if m.ready && m.errorWindow.Visible() {
+		return m.errorWindow.View()
+	}
+
 	if !m.ready {
 		initMsg := lipgloss.NewStyle().
 			Foreground(primaryColor).
@@ -259,7 +490,10 @@ func (m *Model) View() string {
 	helpItems := []string{
 		HelpKeyStyle.Render("i/a") + " " + HelpDescStyle.Render("input mode"),
 		HelpKeyStyle.Render("Esc") + " " + HelpDescStyle.Render("normal mode"),
-		HelpKeyStyle.Render("Enter") + " " + HelpDescStyle.Render("send"),
+		HelpKeyStyle.Render("Enter") + " " + HelpDescStyle.Render("send / run /command"),
+		HelpKeyStyle.Render("j/k") + " " + HelpDescStyle.Render("select message"),
+		HelpKeyStyle.Render("y/Y") + " " + HelpDescStyle.Render("yank / yank last reply"),
+		HelpKeyStyle.Render("Ctrl+V") + " " + HelpDescStyle.Render("paste"),
 		HelpKeyStyle.Render("Ctrl+C") + " " + HelpDescStyle.Render("quit"),
 	}
 	help := HelpStyle.Render(strings.Join(helpItems, " • "))
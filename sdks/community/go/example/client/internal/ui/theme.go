@@ -53,6 +53,10 @@ var (
 		Foreground(secondaryColor).
 		Bold(true)
 
+	SystemLabelStyle = lipgloss.NewStyle().
+		Foreground(warningColor).
+		Bold(true)
+
 	MessageContentStyle = lipgloss.NewStyle().
 		Foreground(textColor).
 		PaddingLeft(2)
@@ -61,6 +65,14 @@ var (
 		Foreground(mutedTextColor).
 		Italic(true)
 
+	// selectedMessageStyle marks the message the j/k index currently
+	// points at, so a yank (y) copies something visibly identifiable.
+	selectedMessageStyle = lipgloss.NewStyle().
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderLeft(true).
+		BorderForeground(accentColor).
+		PaddingLeft(1)
+
 	// Input styles
 	InputContainerStyle = lipgloss.NewStyle().
 		BorderStyle(lipgloss.RoundedBorder()).
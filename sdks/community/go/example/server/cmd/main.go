@@ -10,14 +10,36 @@ import (
 	"time"
 
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/server/internal/config"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/server/internal/grpcserver"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/server/internal/mcp"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/server/internal/middleware"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/server/internal/routes"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/observability/log"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/state"
+	grpctransport "github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/transport/grpc"
 	"github.com/gofiber/fiber/v3"
 	"github.com/gofiber/fiber/v3/middleware/cors"
 	"github.com/gofiber/fiber/v3/middleware/requestid"
 	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
+// newZapLogger builds the zap.Logger backing log.FromContext, at the same
+// level as the logrus logger used for startup/lifecycle logging. cfg.LogLevel
+// is already one of zap's own level names (debug, info, warn, error), having
+// been validated against config.ValidLogLevels.
+func newZapLogger(cfg *config.Config) (*zap.Logger, error) {
+	var level zapcore.Level
+	if err := level.UnmarshalText([]byte(cfg.LogLevel)); err != nil {
+		level = zapcore.InfoLevel
+	}
+
+	zapCfg := zap.NewProductionConfig()
+	zapCfg.Level = zap.NewAtomicLevelAt(level)
+	return zapCfg.Build()
+}
+
 func newErrorHandler() fiber.ErrorHandler {
 	return func(c fiber.Ctx, err error) error {
 		code := fiber.StatusInternalServerError
@@ -26,11 +48,8 @@ func newErrorHandler() fiber.ErrorHandler {
 			code = ferr.Code
 		}
 
-		entry := logrus.NewEntry(logrus.StandardLogger())
-		entry.WithFields(logrus.Fields{
-			"error":  err.Error(),
-			"status": code,
-		}).Error("Request error")
+		appLogger := log.FromContext(middleware.ContextFromFiber(c, context.Background()))
+		appLogger.Errorw("Request error", "error", err.Error(), "status", code)
 
 		return c.Status(code).JSON(fiber.Map{
 			"error":   true,
@@ -39,7 +58,7 @@ func newErrorHandler() fiber.ErrorHandler {
 	}
 }
 
-func registerRoutes(app *fiber.App, cfg *config.Config) {
+func registerRoutes(app *fiber.App, cfg *config.Config, replay *state.ReplayRegistry, stateStore *state.Store) {
 
 	// Basic info route
 	app.Get("/", func(c fiber.Ctx) error {
@@ -56,7 +75,8 @@ func registerRoutes(app *fiber.App, cfg *config.Config) {
 	}
 
 	// Feature routes
-	app.Post("/agentic", routes.AgenticHandler(cfg))
+	app.Post("/agentic", routes.AgenticHandler(cfg, replay, stateStore))
+	app.Post("/agentic/approvals", routes.ApprovalHandler())
 }
 
 func logConfig(logger *logrus.Logger, cfg *config.Config) {
@@ -70,10 +90,12 @@ func logConfig(logger *logrus.Logger, cfg *config.Config) {
 		"sse_keepalive":         cfg.SSEKeepAlive,
 		"cors_enabled":          cfg.CORSEnabled,
 		"streaming_chunk_delay": cfg.StreamingChunkDelay,
+		"enable_grpc":           cfg.EnableGRPC,
+		"grpc_port":             cfg.GRPCPort,
 	}).Info("Server configuration loaded")
 }
 
-func createApp(cfg *config.Config, logger *logrus.Logger) *fiber.App {
+func createApp(cfg *config.Config, logger *logrus.Logger, replay *state.ReplayRegistry, stateStore *state.Store) *fiber.App {
 	app := fiber.New(fiber.Config{
 		AppName:      "AG-UI Example Server",
 		ReadTimeout:  cfg.ReadTimeout,
@@ -83,6 +105,7 @@ func createApp(cfg *config.Config, logger *logrus.Logger) *fiber.App {
 
 	// Middleware
 	app.Use(requestid.New())
+	app.Use(middleware.RequestLogging())
 
 	// CORS
 	if cfg.CORSEnabled {
@@ -102,7 +125,7 @@ func createApp(cfg *config.Config, logger *logrus.Logger) *fiber.App {
 	//}))
 
 	// Routes
-	registerRoutes(app, cfg)
+	registerRoutes(app, cfg, replay, stateStore)
 
 	return app
 }
@@ -121,7 +144,24 @@ func main() {
 	// Log the effective configuration
 	logConfig(logger, cfg)
 
-	app := createApp(cfg, logger)
+	// Back log.FromContext with a real zap logger so correlated request
+	// logs (newErrorHandler, routes.*) go somewhere; logrus above remains
+	// the logger for startup/lifecycle events, which have no request to
+	// correlate against.
+	zapLogger, err := newZapLogger(cfg)
+	if err != nil {
+		logger.WithError(err).Error("Failed to initialize structured logger")
+		os.Exit(1)
+	}
+	defer zapLogger.Sync() //nolint:errcheck
+	log.SetBase(zapLogger)
+
+	// Shared across the HTTP/SSE and gRPC transports so a client observes
+	// one history per thread regardless of which it reconnects through.
+	stateStore := state.NewStore()
+	replay := state.NewReplayRegistry(cfg.SSEReplayBufferSize, cfg.SSEReplayTTL)
+
+	app := createApp(cfg, logger, replay, stateStore)
 
 	// Start server in a goroutine
 	serverAddr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
@@ -150,6 +190,20 @@ func main() {
 		}
 	}()
 
+	// Start the gRPC Agentic transport in a goroutine, sharing stateStore
+	// and replay with the Fiber routes above.
+	var grpcServer *grpctransport.Server
+	if cfg.EnableGRPC {
+		grpcServer = grpctransport.NewServer(cfg.GRPCPort, replay, grpcserver.NewRunHandler(stateStore))
+		go func() {
+			logger.WithField("port", cfg.GRPCPort).Info("Starting gRPC server")
+			if err := grpcServer.Start(); err != nil {
+				logger.WithError(err).Error("gRPC server failed to start")
+				os.Exit(1)
+			}
+		}()
+	}
+
 	// Wait for interrupt signal to gracefully shutdown the server
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -166,6 +220,12 @@ func main() {
 		logger.WithError(err).Error("MCP server shutdown error")
 	}
 
+	if grpcServer != nil {
+		if err := grpcServer.Shutdown(ctx); err != nil {
+			logger.WithError(err).Error("gRPC server shutdown error")
+		}
+	}
+
 	if err = app.ShutdownWithContext(ctx); err != nil {
 		logger.WithError(err).Error("Server shutdown error")
 		os.Exit(1)
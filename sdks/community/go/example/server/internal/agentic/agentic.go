@@ -5,50 +5,132 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"strconv"
+	"time"
 
+	agentprofiles "github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/server/internal/agents"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/server/internal/mcp"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/observability/log"
 	"github.com/tmc/langchaingo/agents"
 	"github.com/tmc/langchaingo/chains"
 	"github.com/tmc/langchaingo/llms/anthropic"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/sse"
 	langchaingoTools "github.com/tmc/langchaingo/tools"
 )
 
+const defaultModel = "claude-3-haiku-20240307"
+const defaultMaxIterations = 50
+
+// stateSnapshotInterval is how often CallLLM emits a fresh
+// events.StateSnapshotEvent for a run with a WithStateStore configured, so
+// a client reconnecting mid-run can reconcile without replaying every
+// delta since the start of the run.
+const stateSnapshotInterval = 30 * time.Second
+
 // reminder is a reminder for the AI to output in our expected format.
 //
 //go:embed data/reminder.md
 var reminder string
 
-func CallLLM(ctx context.Context, input string, tools []langchaingoTools.Tool, returnChan chan<- string) error {
-	// adapter for the mcp server defined in sdks/community/go/example/server/internal/mcp
-	// this mcp server starts with the Fiber server in sdks/community/go/example/server/cmd/main.go
-	adapter, err := mcp.NewAdapter(fmt.Sprintf("http://127.0.0.1:%d/mcp", mcp.DefaultPort))
+// CallLLM runs one agent turn. ref, if it names a Profile registered with
+// agentprofiles, scopes the run to that agent's own toolbox, system
+// prompt, and default LLM parameters instead of exposing everything the
+// MCP server advertises; the zero AgentRef ("") runs with every tool the
+// caller passed in plus everything the MCP server advertises, as before
+// agent profiles existed.
+func CallLLM(ctx context.Context, input string, tools []langchaingoTools.Tool, returnChan chan<- string, ref agentprofiles.AgentRef, opts ...HandlerOption) error {
+	profile, hasProfile := agentprofiles.Lookup(ref)
+
+	model := defaultModel
+	maxIterations := defaultMaxIterations
+	if hasProfile {
+		if profile.Params.Model != "" {
+			model = profile.Params.Model
+		}
+		if profile.Params.MaxIterations > 0 {
+			maxIterations = profile.Params.MaxIterations
+		}
+		opts = append(opts, WithAgentName(profile.Name))
+	}
 
+	llm, err := anthropic.New(anthropic.WithModel(model))
 	if err != nil {
-		return fmt.Errorf("new mcp adapter: %w", err)
+		return fmt.Errorf("failed to create LLM client: %w", err)
 	}
 
-	_, err = adapter.Tools()
-	if err != nil {
-		return fmt.Errorf("append tools: %w", err)
+	opts = append(opts, WithModel(model))
+	handler := NewHandler(returnChan, opts...)
+
+	// mcpClients defaults to a single connection to the mcp server defined
+	// in sdks/community/go/example/server/internal/mcp, which starts with
+	// the Fiber server in sdks/community/go/example/server/cmd/main.go.
+	// WithMCPClients replaces this with one or more alternate connections
+	// (or a *mcp.MultiAdapter fanning out across several).
+	mcpClients := handler.mcpClients
+	if len(mcpClients) == 0 {
+		adapter, err := mcp.NewAdapter(fmt.Sprintf("http://127.0.0.1:%d/mcp", mcp.DefaultPort))
+		if err != nil {
+			return fmt.Errorf("new mcp adapter: %w", err)
+		}
+		mcpClients = []mcp.MCPClient{adapter}
 	}
 
-	llm, err := anthropic.New(anthropic.WithModel("claude-3-haiku-20240307"))
-	if err != nil {
-		return fmt.Errorf("failed to create LLM client: %w", err)
+	var adapterTools []langchaingoTools.Tool
+	for _, client := range mcpClients {
+		clientTools, err := client.Tools()
+		if err != nil {
+			return fmt.Errorf("tools from mcp client: %w", err)
+		}
+		adapterTools = append(adapterTools, clientTools...)
 	}
 
+	if handler.stateStore != nil {
+		go func() {
+			ticker := time.NewTicker(stateSnapshotInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					handler.EmitStateSnapshot()
+				}
+			}
+		}()
+	}
+
+	availableTools := append(append([]langchaingoTools.Tool{}, tools...), adapterTools...)
+	if hasProfile {
+		availableTools = agentprofiles.ScopeTools(availableTools, profile)
+	}
+	if handler.toolFilter != nil {
+		availableTools = FilterTools(availableTools, handler.toolFilter)
+	}
+	availableTools = append(availableTools, &handoffTool{handler: handler})
+
+	// Surface a failing call as a RunErrorEvent instead of aborting the
+	// chain, gate every tool - caller-supplied and MCP-backed alike - so a
+	// denied call never reaches its underlying implementation, and trace
+	// every call so it shows up as a child span of this run in tracing.
+	allTools := TraceTools(GateTools(SurfaceToolErrors(availableTools, handler), handler))
+
 	agent := agents.NewOneShotAgent(llm,
-		tools,
-		agents.WithMaxIterations(50))
+		allTools,
+		agents.WithMaxIterations(maxIterations))
+
+	executor := agents.NewExecutor(agent, agents.WithCallbacksHandler(handler))
 
-	executor := agents.NewExecutor(agent, agents.WithCallbacksHandler(NewHandler(returnChan)))
+	systemPrompt := reminder
+	if hasProfile && profile.SystemPrompt != "" {
+		systemPrompt = profile.SystemPrompt + "\n" + reminder
+	}
 
 	inputMap := make(map[string]any)
-	inputMap["input"] = input + "\n" + reminder
+	inputMap["input"] = input + "\n" + systemPrompt
 
 	result, err := chains.Call(ctx, executor, inputMap)
 	if err != nil {
@@ -66,7 +148,12 @@ func CallLLM(ctx context.Context, input string, tools []langchaingoTools.Tool, r
 	return nil
 }
 
-func ProcessInput(ctx context.Context, w *bufio.Writer, sseWriter *sse.SSEWriter, input string) error {
+// ProcessInput drives one agent turn and streams its events to w via
+// sseWriter. When replayBuf is non-nil, every event is first recorded in
+// it under a fresh sequence ID and written with that ID as the SSE frame's
+// "id:" field, so a later reconnect with a matching Last-Event-ID can
+// resume from replayBuf instead of missing everything emitted in between.
+func ProcessInput(ctx context.Context, w *bufio.Writer, sseWriter *sse.SSEWriter, replayBuf *events.ReplayBuffer, input string, ref agentprofiles.AgentRef, opts ...HandlerOption) error {
 	resultChan := make(chan string)
 	g, groupCtx := errgroup.WithContext(ctx)
 
@@ -78,9 +165,28 @@ func ProcessInput(ctx context.Context, w *bufio.Writer, sseWriter *sse.SSEWriter
 					return nil
 				}
 
+				if replayBuf != nil {
+					if parsed, err := events.EventFromJSON([]byte(result)); err == nil {
+						seq := replayBuf.Add(parsed)
+						spanCtx, span := log.StartSpan(ctx, "sse.write_event",
+							"event.type", string(parsed.Type()),
+							"event.bytes", encoding.GetOptimalBufferSizeForEvent(parsed),
+						)
+						err := sseWriter.WriteEventWithID(spanCtx, w, parsed, strconv.FormatInt(seq, 10))
+						span.End()
+						if err != nil {
+							return fmt.Errorf("failed to write event: %w", err)
+						}
+						continue
+					}
+				}
+
 				// All messages from the handler should now be proper JSON events
 				// WriteBytes will format them as SSE frames with "data: " prefix
-				if err := sseWriter.WriteBytes(ctx, w, []byte(result)); err != nil {
+				spanCtx, span := log.StartSpan(ctx, "sse.write_bytes", "event.bytes", len(result))
+				err := sseWriter.WriteBytes(spanCtx, w, []byte(result))
+				span.End()
+				if err != nil {
 					return fmt.Errorf("failed to write event: %w", err)
 				}
 			case <-ctx.Done():
@@ -90,7 +196,7 @@ func ProcessInput(ctx context.Context, w *bufio.Writer, sseWriter *sse.SSEWriter
 	})
 
 	g.Go(func() error {
-		callLLMErr := CallLLM(groupCtx, input, nil, resultChan)
+		callLLMErr := CallLLM(groupCtx, input, nil, resultChan, ref, opts...)
 		close(resultChan)
 		return callLLMErr
 	})
@@ -3,10 +3,12 @@ package agentic
 import (
 	"context"
 	_ "embed"
+	"encoding/json"
 	"os"
 	"testing"
 
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/server/internal/mcp"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/sync/errgroup"
 )
@@ -53,7 +55,7 @@ func TestToolCalls(t *testing.T) {
 	})
 
 	g.Go(func() error {
-		callErr := CallLLM(groupCtx, languages_prompt, nil, resultChan)
+		callErr := CallLLM(groupCtx, languages_prompt, nil, resultChan, "")
 		close(resultChan)
 		return callErr
 	})
@@ -64,3 +66,78 @@ func TestToolCalls(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, results)
 }
+
+// Verifies a ToolCallApprovalRequested event is raised for every tool call
+// under ApprovalAlwaysAsk, and that denying it keeps the real MCP tool
+// (languageChoiceHandler, whose result carries "option1") from ever running.
+func TestToolCallDeniedNeverReachesMCP(t *testing.T) {
+	if os.Getenv("RUN_INTEGRATION_TESTS") != "true" {
+		t.Skip("Skipping integration test")
+	}
+	mcpServer, err := mcp.NewServer(mcp.DefaultPort)
+	require.NoError(t, err)
+	go func() {
+		mcpErr := mcpServer.Start()
+		if mcpErr != nil {
+			require.NoError(t, mcpErr)
+		}
+	}()
+
+	ctx := context.Background()
+	resultChan := make(chan string)
+	g, groupCtx := errgroup.WithContext(ctx)
+	var results []string
+
+	g.Go(func() error {
+		for {
+			select {
+			case result := <-resultChan:
+				if result == "" {
+					return nil
+				}
+				results = append(results, result)
+				denyIfApprovalRequest(result)
+			case <-groupCtx.Done():
+				return groupCtx.Err()
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	})
+
+	g.Go(func() error {
+		callErr := CallLLM(groupCtx, languages_prompt, nil, resultChan, "", WithApprovalPolicy(ApprovalPolicy{Mode: ApprovalAlwaysAsk}))
+		close(resultChan)
+		return callErr
+	})
+
+	if err = g.Wait(); err != nil {
+		require.NoError(t, err)
+	}
+	require.NoError(t, err)
+	require.NotEmpty(t, results)
+
+	for _, result := range results {
+		assert.NotContains(t, result, "option1", "a denied tool call should never reach languageChoiceHandler")
+	}
+}
+
+// denyIfApprovalRequest denies, by toolCallId, every ToolCallApprovalRequested
+// custom event found in result.
+func denyIfApprovalRequest(result string) {
+	var event struct {
+		Type string         `json:"type"`
+		Name string         `json:"name"`
+		Value map[string]any `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(result), &event); err != nil || event.Name != "ToolCallApprovalRequested" {
+		return
+	}
+
+	toolCallID, _ := event.Value["toolCallId"].(string)
+	ResolveApproval(ApprovalDecision{
+		ToolCallID: toolCallID,
+		Approved:   false,
+		Reason:     "denied by test",
+	})
+}
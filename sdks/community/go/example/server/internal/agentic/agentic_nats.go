@@ -0,0 +1,74 @@
+package agentic
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strconv"
+
+	agentprofiles "github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/server/internal/agents"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/natsbinding"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/sse"
+	"golang.org/x/sync/errgroup"
+)
+
+// ProcessInputNATS drives one agent turn exactly like ProcessInput, but
+// additionally republishes every event through pub, so the same run can be
+// consumed both by the HTTP/SSE client reading w and by any NATS/JetStream
+// subscriber on pub's subject - e.g. a durable consumer that reconnects
+// mid-run and replays from where it left off. A publish failure is logged
+// via the returned error only after the SSE stream has finished; it never
+// interrupts delivery to the HTTP client.
+func ProcessInputNATS(ctx context.Context, w *bufio.Writer, sseWriter *sse.SSEWriter, pub *natsbinding.NATSStreamPublisher, replayBuf *events.ReplayBuffer, input string, ref agentprofiles.AgentRef, opts ...HandlerOption) error {
+	resultChan := make(chan string)
+	g, groupCtx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		var publishErr error
+		for {
+			select {
+			case result := <-resultChan:
+				if result == "" {
+					return publishErr
+				}
+
+				parsed, parseErr := events.EventFromJSON([]byte(result))
+				if parseErr != nil {
+					// All messages from the handler should now be proper JSON
+					// events; fall back to writing the raw bytes to the SSE
+					// client only, since there's no typed event to publish.
+					if err := sseWriter.WriteBytes(ctx, w, []byte(result)); err != nil {
+						return fmt.Errorf("failed to write event: %w", err)
+					}
+					continue
+				}
+
+				if replayBuf != nil {
+					seq := replayBuf.Add(parsed)
+					if err := sseWriter.WriteEventWithID(ctx, w, parsed, strconv.FormatInt(seq, 10)); err != nil {
+						return fmt.Errorf("failed to write event: %w", err)
+					}
+				} else if err := sseWriter.WriteBytes(ctx, w, []byte(result)); err != nil {
+					return fmt.Errorf("failed to write event: %w", err)
+				}
+
+				if pub != nil && publishErr == nil {
+					if err := pub.WriteEvent(ctx, parsed); err != nil {
+						publishErr = fmt.Errorf("failed to publish event to NATS: %w", err)
+					}
+				}
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	})
+
+	g.Go(func() error {
+		callLLMErr := CallLLM(groupCtx, input, nil, resultChan, ref, opts...)
+		close(resultChan)
+		return callLLMErr
+	})
+
+	return g.Wait()
+}
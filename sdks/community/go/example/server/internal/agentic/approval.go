@@ -0,0 +1,280 @@
+package agentic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/observability/log"
+	langchaingoTools "github.com/tmc/langchaingo/tools"
+)
+
+// ApprovalMode selects how an ApprovalPolicy decides whether a tool call
+// needs the frontend's sign-off before it runs.
+type ApprovalMode int
+
+const (
+	// ApprovalAlwaysAllow runs every tool call without asking. This is the
+	// zero value, so a Handler with no ApprovalPolicy configured behaves
+	// exactly as it did before approval gating existed.
+	ApprovalAlwaysAllow ApprovalMode = iota
+	// ApprovalAlwaysAsk asks for every tool call, regardless of name.
+	ApprovalAlwaysAsk
+	// ApprovalAllowList runs tools named in AllowList without asking, and
+	// asks about every other tool.
+	ApprovalAllowList
+	// ApprovalDenyList rejects tools named in DenyList without asking, and
+	// runs every other tool without asking.
+	ApprovalDenyList
+)
+
+// ApprovalPolicy decides, per tool name, whether a call may run
+// immediately, must be rejected outright, or needs the frontend to weigh
+// in via a ToolCallApprovalRequested event.
+type ApprovalPolicy struct {
+	Mode      ApprovalMode
+	AllowList []string
+	DenyList  []string
+}
+
+type approvalOutcome int
+
+const (
+	approvalAllow approvalOutcome = iota
+	approvalDeny
+	approvalAsk
+)
+
+func (p ApprovalPolicy) decide(toolName string) approvalOutcome {
+	switch p.Mode {
+	case ApprovalAlwaysAsk:
+		return approvalAsk
+	case ApprovalAllowList:
+		if containsName(p.AllowList, toolName) {
+			return approvalAllow
+		}
+		return approvalAsk
+	case ApprovalDenyList:
+		if containsName(p.DenyList, toolName) {
+			return approvalDeny
+		}
+		return approvalAllow
+	default:
+		return approvalAllow
+	}
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// HandlerOption configures a Handler at construction time.
+type HandlerOption func(*Handler)
+
+// WithApprovalPolicy configures the ApprovalPolicy a Handler consults
+// before letting a tool call through.
+func WithApprovalPolicy(policy ApprovalPolicy) HandlerOption {
+	return func(h *Handler) {
+		h.approvalPolicy = policy
+	}
+}
+
+// WithAgentName configures the agent profile name a Handler reports on its
+// StepStarted events, and that a handoff_to tool call replaces.
+func WithAgentName(agentName string) HandlerOption {
+	return func(h *Handler) {
+		h.agentName = agentName
+	}
+}
+
+// ApprovalDecision is the frontend's response to a ToolCallApprovalRequested
+// event, delivered to the Handler blocked on it via ResolveApproval.
+type ApprovalDecision struct {
+	ToolCallID string `json:"tool_call_id"`
+	Approved   bool   `json:"approved"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// pendingApprovals maps a toolCallID awaiting a decision in awaitApproval to
+// the channel ResolveApproval delivers it on. It's package-level rather than
+// a Handler field because the frontend's approval endpoint only knows the
+// toolCallID from the event it saw, not which Handler emitted it.
+var pendingApprovals sync.Map // map[string]chan ApprovalDecision
+
+// approvalResults records the outcome of every awaitApproval call by
+// toolCallID, so approvalGatedTool.Call can look up an already-resolved
+// decision without a channel or callback of its own.
+var approvalResults sync.Map // map[string]ApprovalDecision
+
+// ResolveApproval delivers decision to whichever Handler is blocked in
+// awaitApproval for decision.ToolCallID. It reports whether a call was
+// actually waiting on that ID.
+func ResolveApproval(decision ApprovalDecision) bool {
+	v, ok := pendingApprovals.Load(decision.ToolCallID)
+	if !ok {
+		return false
+	}
+	ch := v.(chan ApprovalDecision)
+	select {
+	case ch <- decision:
+	default:
+	}
+	return true
+}
+
+// awaitApproval applies h.approvalPolicy to toolName and, if it requires
+// asking, emits a ToolCallApprovalRequested custom event carrying toolName
+// and the parsed input, then blocks until ResolveApproval delivers a
+// decision or ctx is canceled. The resolved decision is recorded in
+// approvalResults for approvalGatedTool to consult, and a denial is
+// followed by a synthesized ToolCallResult explaining why.
+func (h *Handler) awaitApproval(ctx context.Context, toolCallID, toolName, input string) {
+	decision := ApprovalDecision{ToolCallID: toolCallID, Approved: true}
+
+	switch h.approvalPolicy.decide(toolName) {
+	case approvalDeny:
+		decision = ApprovalDecision{ToolCallID: toolCallID, Approved: false, Reason: "rejected by approval policy"}
+
+	case approvalAsk:
+		ch := make(chan ApprovalDecision, 1)
+		pendingApprovals.Store(toolCallID, ch)
+		defer pendingApprovals.Delete(toolCallID)
+
+		var args any = input
+		if err := json.Unmarshal([]byte(input), &args); err != nil {
+			args = input
+		}
+
+		requestEvent := events.NewCustomEvent("ToolCallApprovalRequested", events.WithValue(map[string]any{
+			"toolCallId": toolCallID,
+			"toolName":   toolName,
+			"arguments":  args,
+		}))
+		if jsonData, err := requestEvent.ToJSON(); err == nil {
+			h.returnChan <- string(jsonData)
+		}
+
+		select {
+		case decision = <-ch:
+		case <-ctx.Done():
+			decision = ApprovalDecision{ToolCallID: toolCallID, Approved: false, Reason: "run canceled before approval"}
+		}
+	}
+
+	approvalResults.Store(toolCallID, decision)
+
+	if !decision.Approved {
+		h.sendDenial(toolCallID, decision.Reason)
+	}
+}
+
+// sendDenial ends toolCallID's tool call and reports reason as its result,
+// the same event shape a real tool's output would take, so a denied call
+// looks to the rest of the stream like any other completed one.
+func (h *Handler) sendDenial(toolCallID, reason string) {
+	toolEndEvent := events.NewToolCallEndEvent(toolCallID)
+	if jsonData, err := toolEndEvent.ToJSON(); err == nil {
+		h.returnChan <- string(jsonData)
+	}
+
+	resultMessageID := events.GenerateMessageID()
+	resultEvent := events.NewToolCallResultEvent(resultMessageID, toolCallID, "Tool call denied: "+reason)
+	if jsonData, err := resultEvent.ToJSON(); err == nil {
+		h.returnChan <- string(jsonData)
+	}
+}
+
+// approvalGatedTool wraps a langchaingoTools.Tool so Call short-circuits
+// with the rejection reason instead of running the underlying tool, if
+// awaitApproval already recorded a denial for this call's toolCallID.
+type approvalGatedTool struct {
+	langchaingoTools.Tool
+	handler *Handler
+}
+
+// GateTools wraps every tool in tools so that a call handler's
+// ApprovalPolicy denies never reaches the underlying tool - including MCP
+// tools served through mcp.Adapter.Tools().
+func GateTools(tools []langchaingoTools.Tool, handler *Handler) []langchaingoTools.Tool {
+	gated := make([]langchaingoTools.Tool, len(tools))
+	for i, tool := range tools {
+		gated[i] = &approvalGatedTool{Tool: tool, handler: handler}
+	}
+	return gated
+}
+
+func (t *approvalGatedTool) Call(ctx context.Context, input string) (string, error) {
+	if v, ok := approvalResults.Load(t.handler.toolCallID); ok {
+		if decision := v.(ApprovalDecision); !decision.Approved {
+			return "Tool call denied: " + decision.Reason, nil
+		}
+	}
+	return t.Tool.Call(ctx, input)
+}
+
+// tracingTool wraps a langchaingoTools.Tool so every Call is a child
+// log.Span of whatever span ctx carries, named after the tool - so a tool
+// invocation launched from CallLLM shows up nested under the run's trace
+// instead of as an unattributed delay.
+type tracingTool struct {
+	langchaingoTools.Tool
+}
+
+// TraceTools wraps every tool in tools so its Call is spanned; compose with
+// GateTools by calling TraceTools first so the approval gate's short-circuit
+// is still captured inside the span.
+func TraceTools(tools []langchaingoTools.Tool) []langchaingoTools.Tool {
+	traced := make([]langchaingoTools.Tool, len(tools))
+	for i, tool := range tools {
+		traced[i] = &tracingTool{Tool: tool}
+	}
+	return traced
+}
+
+func (t *tracingTool) Call(ctx context.Context, input string) (string, error) {
+	spanCtx, span := log.StartSpan(ctx, "tool.call", "tool.name", t.Tool.Name())
+	defer span.End()
+	return t.Tool.Call(spanCtx, input)
+}
+
+// errorSurfacingTool wraps a langchaingoTools.Tool so a failed Call emits a
+// RunErrorEvent on the run's stream and hands the LLM the failure as the
+// tool's observation text instead of returning an error, so one failing
+// tool call - including an MCP server that dropped mid-call - surfaces to
+// the client without aborting the rest of the agent chain.
+type errorSurfacingTool struct {
+	langchaingoTools.Tool
+	handler *Handler
+}
+
+// SurfaceToolErrors wraps every tool in tools with errorSurfacingTool. It
+// should wrap the raw tools before GateTools/TraceTools do, so a denied or
+// traced call still reaches it on failure.
+func SurfaceToolErrors(tools []langchaingoTools.Tool, handler *Handler) []langchaingoTools.Tool {
+	wrapped := make([]langchaingoTools.Tool, len(tools))
+	for i, tool := range tools {
+		wrapped[i] = &errorSurfacingTool{Tool: tool, handler: handler}
+	}
+	return wrapped
+}
+
+func (t *errorSurfacingTool) Call(ctx context.Context, input string) (string, error) {
+	result, err := t.Tool.Call(ctx, input)
+	if err == nil {
+		return result, nil
+	}
+
+	message := fmt.Sprintf("tool %q failed: %v", t.Tool.Name(), err)
+	errEvent := events.NewRunErrorEvent(message, events.WithRunID(t.handler.runID))
+	if jsonData, jsonErr := errEvent.ToJSON(); jsonErr == nil {
+		t.handler.returnChan <- string(jsonData)
+	}
+	return "error: " + message, nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/server/internal/mcp"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/state"
 	"github.com/tmc/langchaingo/llms"
 	"github.com/tmc/langchaingo/schema"
 )
@@ -17,14 +19,65 @@ type Handler struct {
 	messageID  string
 	toolCallID string
 	stepID     string
+
+	// approvalPolicy gates tool calls raised through HandleToolStart and
+	// HandleAgentAction; see awaitApproval.
+	approvalPolicy ApprovalPolicy
+
+	// agentName identifies the agent profile driving this run, if any; see
+	// WithAgentName and handoff.
+	agentName string
+
+	// model is the LLM model name attached to every events.UsageEvent this
+	// run emits; see WithModel.
+	model string
+
+	// promptTokens, completionTokens, and totalTokens accumulate usage
+	// across every LLM round-trip in the run, via addUsage.
+	promptTokens     int
+	completionTokens int
+	totalTokens      int
+
+	// finishReason is the most recent StopReason seen from the LLM,
+	// applied to the run's final RunFinishedEvent.
+	finishReason string
+
+	// onRunSummary, if set, is invoked once in HandleAgentFinish with the
+	// run's cumulative usage; see WithRunSummary.
+	onRunSummary func(RunSummary)
+
+	// toolCallAccumulator buffers in-flight tool calls streamed via
+	// HandleStreamingFunc, keyed by their index in the stream; see
+	// handleToolCallChunk and finalizeToolCalls.
+	toolCallAccumulator map[uint32]*partialToolCall
+
+	// stateStore, if set via WithStateStore, receives every JSON Patch
+	// batch EmitStateDelta applies, keyed by threadID, and backs
+	// EmitStateSnapshot.
+	stateStore *state.Store
+
+	// mcpClients, if set via WithMCPClients, are the MCP server
+	// connections CallLLM aggregates tools from, in place of its default
+	// single connection to the example server's own embedded MCP server.
+	mcpClients []mcp.MCPClient
+
+	// toolFilter, if set via WithToolFilter, drops any tool - caller-
+	// supplied or MCP-backed - this run shouldn't expose to the LLM.
+	toolFilter ToolFilter
 }
 
-func NewHandler(returnChan chan<- string) *Handler {
-	return &Handler{
+func NewHandler(returnChan chan<- string, options ...HandlerOption) *Handler {
+	h := &Handler{
 		returnChan: returnChan,
 		threadID:   events.GenerateThreadID(),
 		runID:      events.GenerateRunID(),
 	}
+
+	for _, opt := range options {
+		opt(h)
+	}
+
+	return h
 }
 
 func (h *Handler) HandleText(ctx context.Context, text string) {
@@ -87,6 +140,25 @@ func (h *Handler) HandleLLMGenerateContentEnd(ctx context.Context, res *llms.Con
 		}
 	}
 
+	// Extract and accumulate this round-trip's token usage, and emit it as
+	// its own event so a UI can show cost incrementally rather than only
+	// once the whole run finishes.
+	promptTokens, completionTokens, totalTokens := h.addUsage(res)
+	usageEvent := events.NewUsageEvent(h.threadID, h.runID,
+		events.WithUsageMessageID(h.messageID),
+		events.WithUsageModel(h.model),
+		events.WithTokenCounts(promptTokens, completionTokens, totalTokens),
+	)
+	if jsonData, err := usageEvent.ToJSON(); err == nil {
+		h.returnChan <- string(jsonData)
+	}
+
+	// Argument fragments stop arriving once the model has finished
+	// requesting tool calls; close out everything buffered so far.
+	if h.finishReason == "tool_calls" {
+		h.finalizeToolCalls()
+	}
+
 	// Reset message ID for next interaction
 	h.messageID = ""
 }
@@ -112,8 +184,9 @@ func (h *Handler) HandleChainStart(ctx context.Context, inputs map[string]any) {
 	// Generate step ID for this chain execution
 	h.stepID = events.GenerateStepID()
 
-	// Send step started event with step name
-	stepStartedEvent := events.NewStepStartedEvent(h.stepID)
+	// Send step started event with step name, tagged with the active agent
+	// so a UI can display which agent is running this step.
+	stepStartedEvent := events.NewStepStartedEventWithOptions(h.stepID, events.WithAgentName(h.agentName))
 	if jsonData, err := stepStartedEvent.ToJSON(); err == nil {
 		h.returnChan <- string(jsonData)
 	}
@@ -172,6 +245,10 @@ func (h *Handler) HandleToolStart(ctx context.Context, input string) {
 	if jsonData, err := toolArgsEvent.ToJSON(); err == nil {
 		h.returnChan <- string(jsonData)
 	}
+
+	// Gate the call on h.approvalPolicy before the executor invokes the
+	// underlying tool; see awaitApproval.
+	h.awaitApproval(ctx, h.toolCallID, toolName, input)
 }
 
 func (h *Handler) HandleToolEnd(ctx context.Context, output string) {
@@ -233,6 +310,10 @@ func (h *Handler) HandleAgentAction(ctx context.Context, action schema.AgentActi
 	if jsonData, err := toolArgsEvent.ToJSON(); err == nil {
 		h.returnChan <- string(jsonData)
 	}
+
+	// Gate the call on h.approvalPolicy before the executor invokes the
+	// underlying tool; see awaitApproval.
+	h.awaitApproval(ctx, h.toolCallID, action.Tool, action.ToolInput)
 }
 
 func (h *Handler) HandleAgentFinish(ctx context.Context, finish schema.AgentFinish) {
@@ -256,11 +337,23 @@ func (h *Handler) HandleAgentFinish(ctx context.Context, finish schema.AgentFini
 		}
 	}
 
-	// Send run finished event
-	runFinishedEvent := events.NewRunFinishedEvent(h.threadID, h.runID)
+	// Send run finished event, carrying the last generation's finish reason
+	runFinishedEvent := events.NewRunFinishedEventWithOptions(h.threadID, h.runID, events.WithFinishReason(h.finishReason))
 	if jsonData, err := runFinishedEvent.ToJSON(); err == nil {
 		h.returnChan <- string(jsonData)
 	}
+
+	if h.onRunSummary != nil {
+		h.onRunSummary(RunSummary{
+			ThreadID:         h.threadID,
+			RunID:            h.runID,
+			Model:            h.model,
+			PromptTokens:     h.promptTokens,
+			CompletionTokens: h.completionTokens,
+			TotalTokens:      h.totalTokens,
+			FinishReason:     h.finishReason,
+		})
+	}
 }
 
 func (h *Handler) HandleRetrieverStart(ctx context.Context, query string) {
@@ -289,6 +382,14 @@ func (h *Handler) HandleRetrieverEnd(ctx context.Context, query string, document
 }
 
 func (h *Handler) HandleStreamingFunc(ctx context.Context, chunk []byte) {
+	// Tool call arguments stream as their own OpenAI-style "tool_calls"
+	// delta chunks, not plain text; buffer and forward those separately so
+	// a client can reconstruct the full arguments JSON as it arrives
+	// instead of waiting for one blob at the end.
+	if h.handleToolCallChunk(chunk) {
+		return
+	}
+
 	// Handle streaming content chunks
 	if h.messageID == "" {
 		h.messageID = events.GenerateMessageID()
@@ -0,0 +1,51 @@
+package agentic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// handoffTool is a built-in tool, named after the lmcli "handoff_to"
+// convention, that lets the LLM end the current agent's run and switch
+// control to a different agent profile mid-conversation. Its Call never
+// reaches a real backend - it only asks handler to switch agents.
+type handoffTool struct {
+	handler *Handler
+}
+
+func (t *handoffTool) Name() string { return "handoff_to" }
+
+func (t *handoffTool) Description() string {
+	return "Hand off the conversation to a different agent by name when the current agent can't help further. Input is the target agent's name."
+}
+
+func (t *handoffTool) Call(ctx context.Context, input string) (string, error) {
+	target := strings.TrimSpace(input)
+	if target == "" {
+		return "", fmt.Errorf("handoff_to: target agent name is required")
+	}
+	t.handler.handoff(target)
+	return fmt.Sprintf("handed off to agent %q", target), nil
+}
+
+// handoff closes h's current run and opens a fresh one under agentName,
+// linking the two via ParentRunID so a UI or trace viewer can follow a
+// multi-agent conversation across the switch.
+func (h *Handler) handoff(agentName string) {
+	runFinishedEvent := events.NewRunFinishedEvent(h.threadID, h.runID)
+	if jsonData, err := runFinishedEvent.ToJSON(); err == nil {
+		h.returnChan <- string(jsonData)
+	}
+
+	previousRunID := h.runID
+	h.runID = events.GenerateRunID()
+	h.agentName = agentName
+
+	runStartedEvent := events.NewRunStartedEventWithOptions(h.threadID, h.runID, events.WithParentRunID(previousRunID))
+	if jsonData, err := runStartedEvent.ToJSON(); err == nil {
+		h.returnChan <- string(jsonData)
+	}
+}
@@ -0,0 +1,55 @@
+package agentic
+
+import (
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/server/internal/mcp"
+	langchaingoTools "github.com/tmc/langchaingo/tools"
+)
+
+// WithMCPClients configures the MCP server connections CallLLM aggregates
+// tools from, replacing its default of one connection to the example
+// server's own embedded MCP server. Passing a *mcp.MultiAdapter lets a run
+// fan out across several upstream servers with its own dedup/TTL/backoff
+// behavior; passing multiple simple clients has CallLLM aggregate them
+// itself; passing a fake lets a test exercise CallLLM without a live MCP
+// server.
+func WithMCPClients(clients ...mcp.MCPClient) HandlerOption {
+	return func(h *Handler) {
+		h.mcpClients = clients
+	}
+}
+
+// ToolFilter reports whether a tool named name should be exposed to the
+// LLM for this run. CallLLM applies it, if set via WithToolFilter, after
+// assembling every caller-supplied and MCP-backed tool, so it allow- or
+// deny-lists across both sources uniformly.
+type ToolFilter func(name string) bool
+
+// AllowListFilter returns a ToolFilter that keeps only the named tools.
+func AllowListFilter(names ...string) ToolFilter {
+	return func(name string) bool { return containsName(names, name) }
+}
+
+// DenyListFilter returns a ToolFilter that drops the named tools and keeps
+// everything else.
+func DenyListFilter(names ...string) ToolFilter {
+	return func(name string) bool { return !containsName(names, name) }
+}
+
+// WithToolFilter configures the ToolFilter CallLLM applies to the run's
+// assembled tool list before handing it to the agent.
+func WithToolFilter(filter ToolFilter) HandlerOption {
+	return func(h *Handler) {
+		h.toolFilter = filter
+	}
+}
+
+// FilterTools keeps only the tools in tools that filter allows.
+func FilterTools(tools []langchaingoTools.Tool, filter ToolFilter) []langchaingoTools.Tool {
+	filtered := make([]langchaingoTools.Tool, 0, len(tools))
+	for _, tool := range tools {
+		if filter(tool.Name()) {
+			filtered = append(filtered, tool)
+		}
+	}
+	return filtered
+}
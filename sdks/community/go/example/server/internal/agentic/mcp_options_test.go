@@ -0,0 +1,86 @@
+package agentic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	langchaingoTools "github.com/tmc/langchaingo/tools"
+)
+
+// fakeTool is a minimal langchaingoTools.Tool whose Call either returns a
+// canned result or a canned error, for exercising tool-wrapping decorators
+// without a real langchaingo tool.
+type fakeTool struct {
+	name string
+	err  error
+}
+
+func (t *fakeTool) Name() string        { return t.name }
+func (t *fakeTool) Description() string { return "fake tool for tests" }
+func (t *fakeTool) Call(ctx context.Context, input string) (string, error) {
+	if t.err != nil {
+		return "", t.err
+	}
+	return "ok", nil
+}
+
+func TestFilterToolsKeepsOnlyAllowedTools(t *testing.T) {
+	tools := []langchaingoTools.Tool{&fakeTool{name: "a"}, &fakeTool{name: "b"}, &fakeTool{name: "c"}}
+	filtered := FilterTools(tools, AllowListFilter("a", "c"))
+
+	if len(filtered) != 2 || filtered[0].Name() != "a" || filtered[1].Name() != "c" {
+		t.Fatalf("expected [a c], got %v", names(filtered))
+	}
+}
+
+func TestFilterToolsDenyListDropsNamedTools(t *testing.T) {
+	tools := []langchaingoTools.Tool{&fakeTool{name: "a"}, &fakeTool{name: "b"}}
+	filtered := FilterTools(tools, DenyListFilter("b"))
+
+	if len(filtered) != 1 || filtered[0].Name() != "a" {
+		t.Fatalf("expected [a], got %v", names(filtered))
+	}
+}
+
+func TestErrorSurfacingToolEmitsRunErrorEventInsteadOfFailing(t *testing.T) {
+	returnChan := make(chan string, 4)
+	handler := NewHandler(returnChan)
+
+	wrapped := SurfaceToolErrors([]langchaingoTools.Tool{&fakeTool{name: "flaky", err: errors.New("boom")}}, handler)[0]
+
+	result, err := wrapped.Call(context.Background(), "{}")
+	if err != nil {
+		t.Fatalf("expected no error from the wrapper, got %v", err)
+	}
+	if result == "" {
+		t.Fatal("expected a non-empty observation describing the failure")
+	}
+	close(returnChan)
+
+	var sawRunError bool
+	for raw := range returnChan {
+		var envelope struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal([]byte(raw), &envelope); err != nil {
+			t.Fatalf("unmarshal envelope: %v", err)
+		}
+		if events.EventType(envelope.Type) == events.EventTypeRunError {
+			sawRunError = true
+		}
+	}
+	if !sawRunError {
+		t.Error("expected a RunErrorEvent on returnChan")
+	}
+}
+
+func names(tools []langchaingoTools.Tool) []string {
+	out := make([]string, len(tools))
+	for i, tool := range tools {
+		out[i] = tool.Name()
+	}
+	return out
+}
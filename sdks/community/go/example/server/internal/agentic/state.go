@@ -0,0 +1,48 @@
+package agentic
+
+import (
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/state"
+)
+
+// WithStateStore attaches a state.Store the Handler applies every
+// EmitStateDelta call to, keyed by the run's thread ID, so the client's
+// StateDeltaEvent stream and the server's own copy of state never diverge.
+func WithStateStore(store *state.Store) HandlerOption {
+	return func(h *Handler) {
+		h.stateStore = store
+	}
+}
+
+// EmitStateDelta applies delta to this run's document in the configured
+// state.Store, if any, and emits the resulting events.StateDeltaEvent over
+// the same channel every other Handle* method uses, so JSON Patch ops a
+// tool produces are both persisted server-side and forwarded to the client
+// in one call.
+func (h *Handler) EmitStateDelta(delta []events.JSONPatchOperation) error {
+	if h.stateStore != nil {
+		if _, err := h.stateStore.Apply(h.threadID, delta); err != nil {
+			return err
+		}
+	}
+
+	deltaEvent := events.NewStateDeltaEvent(delta)
+	if jsonData, err := deltaEvent.ToJSON(); err == nil {
+		h.returnChan <- string(jsonData)
+	}
+	return nil
+}
+
+// EmitStateSnapshot sends the configured state.Store's current document
+// for this run's thread as a events.StateSnapshotEvent, letting a client
+// that reconnects mid-run reconcile instead of replaying every delta.
+func (h *Handler) EmitStateSnapshot() {
+	if h.stateStore == nil {
+		return
+	}
+
+	snapshotEvent := events.NewStateSnapshotEvent(h.stateStore.Snapshot(h.threadID))
+	if jsonData, err := snapshotEvent.ToJSON(); err == nil {
+		h.returnChan <- string(jsonData)
+	}
+}
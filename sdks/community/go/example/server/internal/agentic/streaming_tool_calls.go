@@ -0,0 +1,94 @@
+package agentic
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// toolCallDelta is one element of the "tool_calls" array in an OpenAI-style
+// streamed chunk: a partial update to the tool call at Index, identified by
+// ID/Function.Name on its first occurrence and carrying only an argument
+// fragment on every subsequent one.
+type toolCallDelta struct {
+	Index    uint32 `json:"index"`
+	ID       string `json:"id,omitempty"`
+	Function struct {
+		Name      string `json:"name,omitempty"`
+		Arguments string `json:"arguments,omitempty"`
+	} `json:"function,omitempty"`
+}
+
+// toolCallDeltaChunk is the shape of a streamed chunk carrying tool call
+// deltas rather than plain text.
+type toolCallDeltaChunk struct {
+	ToolCalls []toolCallDelta `json:"tool_calls"`
+}
+
+// partialToolCall accumulates one tool call's streamed fragments, keyed by
+// its index in the toolCallAccumulator map, until the generation's finish
+// reason is "tool_calls".
+type partialToolCall struct {
+	id   string
+	args strings.Builder
+}
+
+// handleToolCallChunk tries to parse chunk as an OpenAI-style tool_calls
+// delta payload. If it is one, it buffers the deltas and emits the matching
+// ToolCallStart/ToolCallArgs events and reports true. Otherwise it reports
+// false so the caller can fall back to plain text streaming.
+func (h *Handler) handleToolCallChunk(chunk []byte) bool {
+	var payload toolCallDeltaChunk
+	if err := json.Unmarshal(chunk, &payload); err != nil || len(payload.ToolCalls) == 0 {
+		return false
+	}
+
+	if h.toolCallAccumulator == nil {
+		h.toolCallAccumulator = make(map[uint32]*partialToolCall)
+	}
+
+	for _, delta := range payload.ToolCalls {
+		call, started := h.toolCallAccumulator[delta.Index]
+		if !started {
+			call = &partialToolCall{id: delta.ID}
+			if call.id == "" {
+				call.id = events.GenerateToolCallID()
+			}
+			h.toolCallAccumulator[delta.Index] = call
+
+			toolStartEvent := events.NewToolCallStartEvent(call.id, delta.Function.Name)
+			if jsonData, err := toolStartEvent.ToJSON(); err == nil {
+				h.returnChan <- string(jsonData)
+			}
+		}
+
+		if delta.Function.Arguments == "" {
+			continue
+		}
+
+		call.args.WriteString(delta.Function.Arguments)
+
+		toolArgsEvent := events.NewToolCallArgsEvent(call.id, delta.Function.Arguments)
+		if jsonData, err := toolArgsEvent.ToJSON(); err == nil {
+			h.returnChan <- string(jsonData)
+		}
+	}
+
+	return true
+}
+
+// finalizeToolCalls emits a ToolCallEnd for every tool call buffered by
+// handleToolCallChunk and clears the accumulator. Called once the
+// generation's finish reason is "tool_calls", per the OpenAI streaming
+// convention that argument fragments stop arriving at that point.
+func (h *Handler) finalizeToolCalls() {
+	for _, call := range h.toolCallAccumulator {
+		toolEndEvent := events.NewToolCallEndEvent(call.id)
+		if jsonData, err := toolEndEvent.ToJSON(); err == nil {
+			h.returnChan <- string(jsonData)
+		}
+	}
+
+	h.toolCallAccumulator = nil
+}
@@ -0,0 +1,76 @@
+package agentic
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/llms"
+)
+
+// TestStreamingToolCallDeltasReconstructFullArguments feeds a fake
+// streaming provider's tool_calls delta chunks through HandleStreamingFunc
+// and verifies a client can reconstruct the complete arguments JSON from
+// the resulting ToolCallArgs delta sequence, bookended by exactly one
+// ToolCallStart and one ToolCallEnd.
+func TestStreamingToolCallDeltasReconstructFullArguments(t *testing.T) {
+	returnChan := make(chan string, 32)
+	handler := NewHandler(returnChan)
+	ctx := context.Background()
+
+	chunks := []string{
+		`{"tool_calls":[{"index":0,"id":"call_1","function":{"name":"get_weather","arguments":""}}]}`,
+		`{"tool_calls":[{"index":0,"function":{"arguments":"{\"loc"}}]}`,
+		`{"tool_calls":[{"index":0,"function":{"arguments":"ation\":\"par"}}]}`,
+		`{"tool_calls":[{"index":0,"function":{"arguments":"is\"}"}}]}`,
+	}
+	for _, chunk := range chunks {
+		handler.HandleStreamingFunc(ctx, []byte(chunk))
+	}
+
+	handler.HandleLLMGenerateContentEnd(ctx, &llms.ContentResponse{
+		Choices: []*llms.ContentChoice{
+			{StopReason: "tool_calls"},
+		},
+	})
+	close(returnChan)
+
+	var startCount, endCount int
+	var toolCallID string
+	var argsBuilder string
+
+	for raw := range returnChan {
+		var envelope struct {
+			Type string `json:"type"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(raw), &envelope))
+
+		switch events.EventType(envelope.Type) {
+		case events.EventTypeToolCallStart:
+			var e events.ToolCallStartEvent
+			require.NoError(t, json.Unmarshal([]byte(raw), &e))
+			startCount++
+			toolCallID = e.ToolCallID
+			require.Equal(t, "get_weather", e.ToolCallName)
+		case events.EventTypeToolCallArgs:
+			var e events.ToolCallArgsEvent
+			require.NoError(t, json.Unmarshal([]byte(raw), &e))
+			require.Equal(t, toolCallID, e.ToolCallID)
+			argsBuilder += e.Delta
+		case events.EventTypeToolCallEnd:
+			var e events.ToolCallEndEvent
+			require.NoError(t, json.Unmarshal([]byte(raw), &e))
+			require.Equal(t, toolCallID, e.ToolCallID)
+			endCount++
+		}
+	}
+
+	require.Equal(t, 1, startCount)
+	require.Equal(t, 1, endCount)
+
+	var reconstructed map[string]any
+	require.NoError(t, json.Unmarshal([]byte(argsBuilder), &reconstructed))
+	require.Equal(t, "paris", reconstructed["location"])
+}
@@ -0,0 +1,78 @@
+package agentic
+
+import "github.com/tmc/langchaingo/llms"
+
+// RunSummary is delivered once, at the end of a run, to the callback
+// registered with WithRunSummary, so an integrator can bill or meter a run
+// without parsing events.UsageEvent/events.RunFinishedEvent out of the
+// stream itself.
+type RunSummary struct {
+	ThreadID         string
+	RunID            string
+	Model            string
+	PromptTokens     int
+	CompletionTokens int
+	TotalTokens      int
+	FinishReason     string
+}
+
+// WithModel records the LLM model name used for this run, so it can be
+// attached to every events.UsageEvent the run emits.
+func WithModel(model string) HandlerOption {
+	return func(h *Handler) {
+		h.model = model
+	}
+}
+
+// WithRunSummary registers a callback invoked once, in HandleAgentFinish,
+// with the run's cumulative token usage and final finish reason.
+func WithRunSummary(onSummary func(RunSummary)) HandlerOption {
+	return func(h *Handler) {
+		h.onRunSummary = onSummary
+	}
+}
+
+// addUsage extracts per-choice GenerationInfo (prompt/completion/total
+// tokens) and StopReason from res, accumulates the token counts into the
+// run's running totals, and records the finish reason for the final
+// RunFinishedEvent. It returns the deltas so the caller can emit a
+// per-round-trip events.UsageEvent.
+func (h *Handler) addUsage(res *llms.ContentResponse) (promptTokens, completionTokens, totalTokens int) {
+	if res == nil || len(res.Choices) == 0 {
+		return 0, 0, 0
+	}
+
+	choice := res.Choices[0]
+	if choice.StopReason != "" {
+		h.finishReason = choice.StopReason
+	}
+
+	promptTokens = intFromGenerationInfo(choice.GenerationInfo, "PromptTokens")
+	completionTokens = intFromGenerationInfo(choice.GenerationInfo, "CompletionTokens")
+	totalTokens = intFromGenerationInfo(choice.GenerationInfo, "TotalTokens")
+
+	h.promptTokens += promptTokens
+	h.completionTokens += completionTokens
+	h.totalTokens += totalTokens
+
+	return promptTokens, completionTokens, totalTokens
+}
+
+// intFromGenerationInfo reads an int-valued key out of a GenerationInfo map,
+// tolerating the int/int64/float64 shapes different llms providers use.
+func intFromGenerationInfo(info map[string]any, key string) int {
+	if info == nil {
+		return 0
+	}
+
+	switch v := info[key].(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,85 @@
+// Package agents models the "agent profile" concept used to scope a single
+// CallLLM run to a named agent: its system prompt, the subset of available
+// tools it's allowed to call, and its default LLM request parameters. A
+// profile is registered once and referenced by name (AgentRef) from call
+// sites, the same shape as pkg/encoding/codec's Registry/Register/Lookup.
+package agents
+
+import "sync"
+
+// Profile is an agent profile: a name, a system prompt (typically loaded
+// from an embedded markdown file alongside whatever registers it), the
+// subset of tool names it's allowed to call, and the default LLM request
+// parameters to use while it's active.
+type Profile struct {
+	Name         string
+	SystemPrompt string
+	ToolNames    []string
+	Params       Params
+}
+
+// Params holds the LLM request parameters a Profile applies by default.
+type Params struct {
+	Model         string
+	Temperature   float64
+	MaxIterations int
+}
+
+// AgentRef names a Profile registered with a Registry. The zero value ("")
+// never resolves, so a caller that doesn't care about agent profiles can
+// pass it through unchanged.
+type AgentRef string
+
+// Registry maps an AgentRef to the Profile it names.
+//
+// A Registry is safe for concurrent use.
+type Registry struct {
+	mu       sync.RWMutex
+	profiles map[AgentRef]Profile
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{profiles: make(map[AgentRef]Profile)}
+}
+
+// Register adds profile to the registry under AgentRef(profile.Name),
+// overwriting any existing registration for that name.
+func (r *Registry) Register(profile Profile) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.profiles[AgentRef(profile.Name)] = profile
+}
+
+// Lookup returns the Profile registered for ref.
+func (r *Registry) Lookup(ref AgentRef) (Profile, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p, ok := r.profiles[ref]
+	return p, ok
+}
+
+// Refs returns every registered AgentRef.
+func (r *Registry) Refs() []AgentRef {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	refs := make([]AgentRef, 0, len(r.profiles))
+	for ref := range r.profiles {
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+// DefaultRegistry is the Registry Lookup and Register operate on when a
+// call site doesn't need a private Registry of its own.
+var DefaultRegistry = NewRegistry()
+
+// Register adds profile to DefaultRegistry.
+func Register(profile Profile) {
+	DefaultRegistry.Register(profile)
+}
+
+// Lookup returns the Profile registered on DefaultRegistry for ref.
+func Lookup(ref AgentRef) (Profile, bool) {
+	return DefaultRegistry.Lookup(ref)
+}
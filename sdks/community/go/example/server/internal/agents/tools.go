@@ -0,0 +1,26 @@
+package agents
+
+import langchaingoTools "github.com/tmc/langchaingo/tools"
+
+// ScopeTools returns the subset of tools named in profile.ToolNames, in
+// that order, skipping any name profile.ToolNames lists that tools doesn't
+// contain. An empty ToolNames leaves tools unscoped, so a Profile that
+// doesn't curate a toolbox still gets everything the caller passed in.
+func ScopeTools(tools []langchaingoTools.Tool, profile Profile) []langchaingoTools.Tool {
+	if len(profile.ToolNames) == 0 {
+		return tools
+	}
+
+	byName := make(map[string]langchaingoTools.Tool, len(tools))
+	for _, tool := range tools {
+		byName[tool.Name()] = tool
+	}
+
+	scoped := make([]langchaingoTools.Tool, 0, len(profile.ToolNames))
+	for _, name := range profile.ToolNames {
+		if tool, ok := byName[name]; ok {
+			scoped = append(scoped, tool)
+		}
+	}
+	return scoped
+}
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// DefaultClientIPHeaders lists the headers ClientIP consults, in order,
+// once a request's RemoteAddr is confirmed to be a trusted proxy.
+var DefaultClientIPHeaders = []string{"X-Forwarded-For", "X-Real-IP"}
+
+// isBogonIP reports whether ip is a private, loopback, link-local, or
+// otherwise non-routable address - the kind of value a spoofed forwarding
+// header might carry that should never be trusted as a real client IP.
+func isBogonIP(ip net.IP) bool {
+	return ip.IsPrivate() ||
+		ip.IsLoopback() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsUnspecified() ||
+		ip.IsMulticast()
+}
+
+// trustedProxyNets parses c.TrustedProxies into net.IPNets, ignoring
+// entries already rejected by Validate.
+func (c *Config) trustedProxyNets() []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(c.TrustedProxies))
+	for _, cidr := range c.TrustedProxies {
+		if _, ipNet, err := net.ParseCIDR(cidr); err == nil {
+			nets = append(nets, ipNet)
+		}
+	}
+	return nets
+}
+
+// isTrustedProxy reports whether ip falls inside any configured trusted
+// proxy CIDR.
+func (c *Config) isTrustedProxy(ip net.IP) bool {
+	for _, ipNet := range c.trustedProxyNets() {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP resolves the real client address for r. It trusts the
+// configured ClientIPHeaders only when r.RemoteAddr falls inside a
+// TrustedProxies CIDR; otherwise - or if no header yields a usable address
+// - it falls back to RemoteAddr. With ForwardedHeaderStrict set, private
+// and bogon addresses found in a header are skipped rather than returned,
+// so a spoofed "X-Forwarded-For: 10.0.0.1" from an untrusted peer can't
+// masquerade as an internal client.
+func (c *Config) ClientIP(r *http.Request) net.IP {
+	remoteIP := remoteAddrIP(r.RemoteAddr)
+	if remoteIP == nil || !c.isTrustedProxy(remoteIP) {
+		return remoteIP
+	}
+
+	headers := c.ClientIPHeaders
+	if len(headers) == 0 {
+		headers = DefaultClientIPHeaders
+	}
+
+	for _, header := range headers {
+		value := r.Header.Get(header)
+		if value == "" {
+			continue
+		}
+		for _, candidate := range strings.Split(value, ",") {
+			ip := net.ParseIP(strings.TrimSpace(candidate))
+			if ip == nil {
+				continue
+			}
+			if c.ForwardedHeaderStrict && isBogonIP(ip) {
+				continue
+			}
+			return ip
+		}
+	}
+
+	return remoteIP
+}
+
+// remoteAddrIP extracts the IP portion of an http.Request.RemoteAddr
+// ("host:port"), falling back to parsing addr whole in case it has no port.
+func remoteAddrIP(addr string) net.IP {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	return net.ParseIP(host)
+}
+
+// validateTrustedProxies rejects malformed CIDRs and CIDRs whose ranges
+// overlap, since an overlapping pair makes trust depend on parse order
+// rather than anything the operator intended.
+func validateTrustedProxies(cidrs []string) error {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid trusted proxy CIDR %q: %w", cidr, err)
+		}
+		for _, existing := range nets {
+			if existing.Contains(ipNet.IP) || ipNet.Contains(existing.IP) {
+				return fmt.Errorf("trusted proxy CIDR %q overlaps with %q", cidr, existing.String())
+			}
+		}
+		nets = append(nets, ipNet)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package config
+
+import (
+	"net/http"
+	"testing"
+)
+
+func newClientIPConfig(trustedProxies []string, strict bool) *Config {
+	c := New()
+	c.TrustedProxies = trustedProxies
+	c.ForwardedHeaderStrict = strict
+	return c
+}
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		strict         bool
+		remoteAddr     string
+		headers        map[string]string
+		want           string
+	}{
+		{
+			name:       "no trusted proxies falls back to remote addr",
+			remoteAddr: "203.0.113.10:12345",
+			headers:    map[string]string{"X-Forwarded-For": "198.51.100.20"},
+			want:       "203.0.113.10",
+		},
+		{
+			name:           "trusted proxy header is honored",
+			trustedProxies: []string{"10.0.0.0/8"},
+			remoteAddr:     "10.1.2.3:443",
+			headers:        map[string]string{"X-Forwarded-For": "198.51.100.20"},
+			want:           "198.51.100.20",
+		},
+		{
+			name:           "untrusted peer spoofing the header is ignored",
+			trustedProxies: []string{"10.0.0.0/8"},
+			remoteAddr:     "203.0.113.10:12345",
+			headers:        map[string]string{"X-Forwarded-For": "198.51.100.20"},
+			want:           "203.0.113.10",
+		},
+		{
+			name:           "strict mode skips a bogon entry and falls back to remote addr",
+			trustedProxies: []string{"10.0.0.0/8"},
+			strict:         true,
+			remoteAddr:     "10.1.2.3:443",
+			headers:        map[string]string{"X-Forwarded-For": "10.0.0.99"},
+			want:           "10.1.2.3",
+		},
+		{
+			name:           "non-strict mode accepts a private address from a trusted proxy",
+			trustedProxies: []string{"10.0.0.0/8"},
+			strict:         false,
+			remoteAddr:     "10.1.2.3:443",
+			headers:        map[string]string{"X-Forwarded-For": "10.0.0.99"},
+			want:           "10.0.0.99",
+		},
+		{
+			name:           "first header takes precedence over the second",
+			trustedProxies: []string{"10.0.0.0/8"},
+			remoteAddr:     "10.1.2.3:443",
+			headers:        map[string]string{"X-Forwarded-For": "198.51.100.20", "X-Real-IP": "198.51.100.21"},
+			want:           "198.51.100.20",
+		},
+		{
+			name:           "falls through to the second header if the first is absent",
+			trustedProxies: []string{"10.0.0.0/8"},
+			remoteAddr:     "10.1.2.3:443",
+			headers:        map[string]string{"X-Real-IP": "198.51.100.21"},
+			want:           "198.51.100.21",
+		},
+		{
+			name:           "comma-separated forwarded-for uses the first entry",
+			trustedProxies: []string{"10.0.0.0/8"},
+			remoteAddr:     "10.1.2.3:443",
+			headers:        map[string]string{"X-Forwarded-For": "198.51.100.20, 10.1.2.3"},
+			want:           "198.51.100.20",
+		},
+		{
+			name:           "malformed header entries are skipped",
+			trustedProxies: []string{"10.0.0.0/8"},
+			remoteAddr:     "10.1.2.3:443",
+			headers:        map[string]string{"X-Forwarded-For": "not-an-ip, 198.51.100.20"},
+			want:           "198.51.100.20",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newClientIPConfig(tt.trustedProxies, tt.strict)
+			r := &http.Request{RemoteAddr: tt.remoteAddr, Header: http.Header{}}
+			for k, v := range tt.headers {
+				r.Header.Set(k, v)
+			}
+
+			got := cfg.ClientIP(r)
+			if got == nil || got.String() != tt.want {
+				t.Errorf("ClientIP() = %v, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateTrustedProxies(t *testing.T) {
+	tests := []struct {
+		name    string
+		cidrs   []string
+		wantErr bool
+	}{
+		{name: "empty list is valid"},
+		{name: "valid non-overlapping CIDRs", cidrs: []string{"10.0.0.0/8", "192.168.0.0/16"}},
+		{name: "malformed CIDR", cidrs: []string{"not-a-cidr"}, wantErr: true},
+		{name: "overlapping CIDRs", cidrs: []string{"10.0.0.0/8", "10.1.0.0/16"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTrustedProxies(tt.cidrs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateTrustedProxies(%v) error = %v, wantErr %v", tt.cidrs, err, tt.wantErr)
+			}
+		})
+	}
+}
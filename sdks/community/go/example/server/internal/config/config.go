@@ -34,6 +34,34 @@ type Config struct {
 
 	// Streaming settings
 	StreamingChunkDelay time.Duration
+
+	// SSEReplayBufferSize bounds how many events each thread's replay
+	// buffer retains for Last-Event-ID resumption (0 for unbounded).
+	SSEReplayBufferSize int
+	// SSEReplayTTL bounds how long a buffered event stays eligible for
+	// replay after being emitted (0 disables TTL-based eviction).
+	SSEReplayTTL time.Duration
+
+	// gRPC transport settings
+	EnableGRPC bool
+	GRPCPort   int
+
+	// TrustedProxies lists the CIDRs a reverse proxy may connect from.
+	// ClientIP only consults ClientIPHeaders when the request's RemoteAddr
+	// falls inside one of these ranges.
+	TrustedProxies []string
+	// ClientIPHeaders lists the headers ClientIP checks, in order, once a
+	// request's peer is a trusted proxy.
+	ClientIPHeaders []string
+	// ForwardedHeaderStrict, when true, makes ClientIP skip private and
+	// bogon addresses found in a forwarding header instead of returning
+	// them, so a spoofed header can't claim an internal-looking address.
+	ForwardedHeaderStrict bool
+
+	// RecordDir, if non-empty, captures every live SSE session's events to
+	// a JSONL trace file in this directory, for later replay against
+	// pkg/recording.Replayer. Recording is disabled when empty.
+	RecordDir string
 }
 
 // envVar defines an environment variable handler
@@ -67,6 +95,10 @@ const (
 	DefaultWriteTimeout        = 30 * time.Second
 	DefaultSSEKeepAlive        = 15 * time.Second
 	DefaultStreamingChunkDelay = 200 * time.Millisecond
+	DefaultSSEReplayBufferSize = 256
+	DefaultSSEReplayTTL        = 5 * time.Minute
+	DefaultEnableGRPC          = true
+	DefaultGRPCPort            = 50051
 )
 
 // Default CORS allowed origins
@@ -83,16 +115,22 @@ var ValidLogLevels = map[string]slog.Level{
 // New creates a new Config with default values
 func New() *Config {
 	return &Config{
-		Host:                DefaultHost,
-		Port:                DefaultPort,
-		LogLevel:            DefaultLogLevel,
-		EnableSSE:           DefaultEnableSSE,
-		ReadTimeout:         DefaultReadTimeout,
-		WriteTimeout:        DefaultWriteTimeout,
-		SSEKeepAlive:        DefaultSSEKeepAlive,
-		CORSEnabled:         true,
-		CORSAllowedOrigins:  DefaultCORSAllowedOrigins,
-		StreamingChunkDelay: DefaultStreamingChunkDelay,
+		Host:                  DefaultHost,
+		Port:                  DefaultPort,
+		LogLevel:              DefaultLogLevel,
+		EnableSSE:             DefaultEnableSSE,
+		ReadTimeout:           DefaultReadTimeout,
+		WriteTimeout:          DefaultWriteTimeout,
+		SSEKeepAlive:          DefaultSSEKeepAlive,
+		CORSEnabled:           true,
+		CORSAllowedOrigins:    DefaultCORSAllowedOrigins,
+		StreamingChunkDelay:   DefaultStreamingChunkDelay,
+		SSEReplayBufferSize:   DefaultSSEReplayBufferSize,
+		SSEReplayTTL:          DefaultSSEReplayTTL,
+		EnableGRPC:            DefaultEnableGRPC,
+		GRPCPort:              DefaultGRPCPort,
+		ClientIPHeaders:       DefaultClientIPHeaders,
+		ForwardedHeaderStrict: true,
 	}
 }
 
@@ -144,6 +182,22 @@ func (c *Config) Validate() error {
 		errs = append(errs, fmt.Errorf("streaming chunk delay must be non-negative, got %v", c.StreamingChunkDelay))
 	}
 
+	if c.SSEReplayBufferSize < 0 {
+		errs = append(errs, fmt.Errorf("SSE replay buffer size must be non-negative, got %d", c.SSEReplayBufferSize))
+	}
+
+	if c.SSEReplayTTL < 0 {
+		errs = append(errs, fmt.Errorf("SSE replay TTL must be non-negative, got %v", c.SSEReplayTTL))
+	}
+
+	if c.GRPCPort < 1 || c.GRPCPort > 65535 {
+		errs = append(errs, fmt.Errorf("gRPC port must be between 1 and 65535, got %d", c.GRPCPort))
+	}
+
+	if err := validateTrustedProxies(c.TrustedProxies); err != nil {
+		errs = append(errs, err)
+	}
+
 	if len(errs) > 0 {
 		return errors.Join(errs...)
 	}
@@ -163,16 +217,27 @@ func (c *Config) GetLogLevel() slog.Level {
 // LoadFromFlags loads configuration from command line flags with precedence over env vars
 func (c *Config) LoadFromFlags() error {
 	var (
-		host         = flag.String("host", c.Host, "Server host address")
-		port         = flag.Int("port", c.Port, "Server port (1-65535)")
-		logLevel     = flag.String("log-level", c.LogLevel, "Log level (debug, info, warn, error)")
-		enableSSE    = flag.Bool("enable-sse", c.EnableSSE, "Enable Server-Sent Events")
-		readTimeout  = flag.Duration("read-timeout", c.ReadTimeout, "Read timeout duration")
-		writeTimeout = flag.Duration("write-timeout", c.WriteTimeout, "Write timeout duration")
-		sseKeepAlive = flag.Duration("sse-keepalive", c.SSEKeepAlive, "SSE keep-alive duration")
-		corsEnabled  = flag.Bool("cors-enabled", c.CORSEnabled, "Enable CORS")
+		host                = flag.String("host", c.Host, "Server host address")
+		port                = flag.Int("port", c.Port, "Server port (1-65535)")
+		logLevel            = flag.String("log-level", c.LogLevel, "Log level (debug, info, warn, error)")
+		enableSSE           = flag.Bool("enable-sse", c.EnableSSE, "Enable Server-Sent Events")
+		readTimeout         = flag.Duration("read-timeout", c.ReadTimeout, "Read timeout duration")
+		writeTimeout        = flag.Duration("write-timeout", c.WriteTimeout, "Write timeout duration")
+		sseKeepAlive        = flag.Duration("sse-keepalive", c.SSEKeepAlive, "SSE keep-alive duration")
+		corsEnabled         = flag.Bool("cors-enabled", c.CORSEnabled, "Enable CORS")
+		sseReplayBufferSize = flag.Int("sse-replay-buffer-size", c.SSEReplayBufferSize, "Per-thread SSE replay buffer size (0 for unbounded)")
+		sseReplayTTL        = flag.Duration("sse-replay-ttl", c.SSEReplayTTL, "How long a buffered SSE event stays eligible for replay (0 disables TTL eviction)")
+		enableGRPC          = flag.Bool("enable-grpc", c.EnableGRPC, "Enable the gRPC Agentic streaming transport")
+		grpcPort            = flag.Int("grpc-port", c.GRPCPort, "gRPC server port (1-65535)")
+		recordDir           = flag.String("record", c.RecordDir, "Directory to record captured SSE sessions as JSONL traces (disabled if empty)")
 	)
 
+	// Registered so --help documents it and flag.Parse doesn't reject it;
+	// its value is unused here because LoadConfig must resolve and apply
+	// the config file *before* flags are parsed, to keep flags > env > file
+	// precedence. See resolveConfigPath.
+	_ = flag.String("config", "", "Path to a YAML or TOML config file (env: AGUI_CONFIG)")
+
 	flag.Parse()
 
 	// Apply flag values with precedence over env vars
@@ -184,16 +249,28 @@ func (c *Config) LoadFromFlags() error {
 	c.WriteTimeout = *writeTimeout
 	c.SSEKeepAlive = *sseKeepAlive
 	c.CORSEnabled = *corsEnabled
+	c.SSEReplayBufferSize = *sseReplayBufferSize
+	c.SSEReplayTTL = *sseReplayTTL
+	c.EnableGRPC = *enableGRPC
+	c.GRPCPort = *grpcPort
+	c.RecordDir = *recordDir
 
 	return nil
 }
 
-// LoadConfig creates and loads configuration with proper precedence: flags > env > defaults
+// LoadConfig creates and loads configuration with proper precedence: flags > env > file > defaults
 func LoadConfig() (*Config, error) {
 	// Start with defaults
 	config := New()
 
-	// Load environment variables (override defaults)
+	// Load a config file, if one is named by AGUI_CONFIG or --config (overrides defaults)
+	if path := resolveConfigPath(); path != "" {
+		if err := config.LoadFromFile(path); err != nil {
+			return nil, fmt.Errorf("failed to load config file: %w", err)
+		}
+	}
+
+	// Load environment variables (override the config file and defaults)
 	if err := config.LoadFromEnv(); err != nil {
 		return nil, fmt.Errorf("failed to load environment variables: %w", err)
 	}
@@ -223,5 +300,9 @@ func (c *Config) LogSafeConfig(logger *slog.Logger) {
 		"sse_keepalive", c.SSEKeepAlive,
 		"cors_enabled", c.CORSEnabled,
 		"streaming_chunk_delay", c.StreamingChunkDelay,
+		"sse_replay_buffer_size", c.SSEReplayBufferSize,
+		"sse_replay_ttl", c.SSEReplayTTL,
+		"enable_grpc", c.EnableGRPC,
+		"grpc_port", c.GRPCPort,
 	)
 }
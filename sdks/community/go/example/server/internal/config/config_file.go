@@ -0,0 +1,144 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// fileConfig mirrors Config's fields for file-based loading. Every field is
+// a pointer (or, for CORSAllowedOrigins, left as a nil-able slice) so
+// LoadFromFile can tell an explicitly-set value apart from one simply
+// absent from the file, and durations are strings (e.g. "30s") since
+// neither YAML nor TOML has a native time.Duration type.
+type fileConfig struct {
+	Host                *string  `yaml:"host" toml:"host"`
+	Port                *int     `yaml:"port" toml:"port"`
+	LogLevel            *string  `yaml:"log_level" toml:"log_level"`
+	EnableSSE           *bool    `yaml:"enable_sse" toml:"enable_sse"`
+	ReadTimeout         *string  `yaml:"read_timeout" toml:"read_timeout"`
+	WriteTimeout        *string  `yaml:"write_timeout" toml:"write_timeout"`
+	SSEKeepAlive        *string  `yaml:"sse_keepalive" toml:"sse_keepalive"`
+	CORSEnabled         *bool    `yaml:"cors_enabled" toml:"cors_enabled"`
+	CORSAllowedOrigins  []string `yaml:"cors_allowed_origins" toml:"cors_allowed_origins"`
+	StreamingChunkDelay *string  `yaml:"streaming_chunk_delay" toml:"streaming_chunk_delay"`
+	SSEReplayBufferSize *int     `yaml:"sse_replay_buffer_size" toml:"sse_replay_buffer_size"`
+	SSEReplayTTL        *string  `yaml:"sse_replay_ttl" toml:"sse_replay_ttl"`
+	EnableGRPC          *bool    `yaml:"enable_grpc" toml:"enable_grpc"`
+	GRPCPort            *int     `yaml:"grpc_port" toml:"grpc_port"`
+}
+
+// LoadFromFile reads path (a .yaml/.yml or .toml file, detected by
+// extension) and applies whichever fields it sets onto c. Fields the file
+// omits are left untouched, so a config file only needs to mention the
+// settings it wants to override. Call this before LoadFromEnv/
+// LoadFromFlags so flags and env vars keep precedence over the file, per
+// LoadConfig.
+func (c *Config) LoadFromFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config: read %s: %w", path, err)
+	}
+
+	var fc fileConfig
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &fc); err != nil {
+			return fmt.Errorf("config: parse YAML %s: %w", path, err)
+		}
+	case ".toml":
+		if err := toml.Unmarshal(data, &fc); err != nil {
+			return fmt.Errorf("config: parse TOML %s: %w", path, err)
+		}
+	default:
+		return fmt.Errorf("config: unsupported config file extension %q (expected .yaml, .yml, or .toml)", ext)
+	}
+
+	return c.applyFileConfig(&fc)
+}
+
+// applyFileConfig merges fc's explicitly-set fields onto c, parsing its
+// string-encoded durations.
+func (c *Config) applyFileConfig(fc *fileConfig) error {
+	if fc.Host != nil {
+		c.Host = *fc.Host
+	}
+	if fc.Port != nil {
+		c.Port = *fc.Port
+	}
+	if fc.LogLevel != nil {
+		c.LogLevel = strings.ToLower(*fc.LogLevel)
+	}
+	if fc.EnableSSE != nil {
+		c.EnableSSE = *fc.EnableSSE
+	}
+	if fc.CORSEnabled != nil {
+		c.CORSEnabled = *fc.CORSEnabled
+	}
+	if fc.CORSAllowedOrigins != nil {
+		c.CORSAllowedOrigins = fc.CORSAllowedOrigins
+	}
+	if fc.SSEReplayBufferSize != nil {
+		c.SSEReplayBufferSize = *fc.SSEReplayBufferSize
+	}
+	if fc.EnableGRPC != nil {
+		c.EnableGRPC = *fc.EnableGRPC
+	}
+	if fc.GRPCPort != nil {
+		c.GRPCPort = *fc.GRPCPort
+	}
+
+	durations := []struct {
+		raw  *string
+		dst  *time.Duration
+		name string
+	}{
+		{fc.ReadTimeout, &c.ReadTimeout, "read_timeout"},
+		{fc.WriteTimeout, &c.WriteTimeout, "write_timeout"},
+		{fc.SSEKeepAlive, &c.SSEKeepAlive, "sse_keepalive"},
+		{fc.StreamingChunkDelay, &c.StreamingChunkDelay, "streaming_chunk_delay"},
+		{fc.SSEReplayTTL, &c.SSEReplayTTL, "sse_replay_ttl"},
+	}
+	for _, d := range durations {
+		if d.raw == nil {
+			continue
+		}
+		parsed, err := time.ParseDuration(*d.raw)
+		if err != nil {
+			return fmt.Errorf("config: invalid %s %q: %w", d.name, *d.raw, err)
+		}
+		*d.dst = parsed
+	}
+
+	return nil
+}
+
+// resolveConfigPath returns the config file path from AGUI_CONFIG or a
+// --config/-config flag, checked ahead of LoadFromFlags's flag.Parse call
+// so LoadConfig can apply the file before env vars and flags (which must
+// retain precedence over it).
+func resolveConfigPath() string {
+	if path := os.Getenv("AGUI_CONFIG"); path != "" {
+		return path
+	}
+
+	args := os.Args[1:]
+	for i, arg := range args {
+		switch {
+		case arg == "--config" || arg == "-config":
+			if i+1 < len(args) {
+				return args[i+1]
+			}
+		case strings.HasPrefix(arg, "--config="):
+			return strings.TrimPrefix(arg, "--config=")
+		case strings.HasPrefix(arg, "-config="):
+			return strings.TrimPrefix(arg, "-config=")
+		}
+	}
+	return ""
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Watch re-reads path whenever it changes on disk and calls onChange with
+// the resulting Config, as long as it passes Validate. It never mutates c
+// itself — callers elsewhere may be reading c's fields concurrently (e.g.
+// request handlers, LogSafeConfig) — instead it tracks its own in-memory
+// copy and hands each accepted update to onChange.
+//
+// Host and Port are immutable at runtime: if a reload changes either, Watch
+// logs a warning that a restart is required and keeps the running values,
+// surfacing everything else that did change.
+//
+// Watch blocks until ctx is done or the underlying watcher fails to start,
+// so callers should run it in its own goroutine.
+func (c *Config) Watch(ctx context.Context, path string, onChange func(*Config)) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		return err
+	}
+
+	current := *c
+	target := filepath.Clean(path)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if filepath.Clean(event.Name) != target {
+				continue
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+
+			next := current
+			if err := next.LoadFromFile(path); err != nil {
+				slog.Default().Warn("config: reload failed, keeping previous config", "error", err)
+				continue
+			}
+			if err := next.Validate(); err != nil {
+				slog.Default().Warn("config: reloaded config is invalid, keeping previous config", "error", err)
+				continue
+			}
+
+			if next.Host != current.Host || next.Port != current.Port {
+				slog.Default().Warn("config: host/port changed on disk but require a restart to take effect",
+					"host", next.Host, "port", next.Port)
+				next.Host = current.Host
+				next.Port = current.Port
+			}
+
+			current = next
+			onChange(&current)
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			slog.Default().Warn("config: watcher error", "error", err)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Package grpcserver adapts the same agent logic the Fiber/SSE transport
+// drives through agentic.ProcessInput to pkg/transport/grpc.RunHandler, so
+// the gRPC Agentic RPC runs identical agent behavior instead of a separate
+// implementation.
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/server/internal/agentic"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/state"
+	grpctransport "github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/transport/grpc"
+	"golang.org/x/sync/errgroup"
+)
+
+// NewRunHandler builds a grpctransport.RunHandler that runs msg.Content
+// through agentic.CallLLM, decoding its JSON event strings back into
+// events.Event and forwarding them to sink. store, if given the same
+// instance passed to routes.AgenticHandler, lets this run's periodic state
+// snapshots reach both transports' shared state.
+func NewRunHandler(store *state.Store) grpctransport.RunHandler {
+	return func(ctx context.Context, msg grpctransport.ClientMessage, sink chan<- events.Event) error {
+		resultChan := make(chan string)
+		g, groupCtx := errgroup.WithContext(ctx)
+
+		g.Go(func() error {
+			for {
+				select {
+				case result, ok := <-resultChan:
+					if !ok {
+						return nil
+					}
+					event, err := events.EventFromJSON([]byte(result))
+					if err != nil {
+						return fmt.Errorf("grpcserver: decode agent event: %w", err)
+					}
+					select {
+					case sink <- event:
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		})
+
+		g.Go(func() error {
+			err := agentic.CallLLM(groupCtx, msg.Content, nil, resultChan, "", agentic.WithStateStore(store))
+			close(resultChan)
+			return err
+		})
+
+		return g.Wait()
+	}
+}
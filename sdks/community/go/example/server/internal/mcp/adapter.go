@@ -2,6 +2,8 @@ package mcp
 
 import (
 	"fmt"
+	"net/url"
+	"path/filepath"
 	"time"
 
 	mcpadapter "github.com/i2y/langchaingo-mcp-adapter"
@@ -10,43 +12,131 @@ import (
 	langchaingoTools "github.com/tmc/langchaingo/tools"
 )
 
-type Adapter struct {
-	adapter   *mcpadapter.MCPAdapter
+// serverConn is one upstream MCP server Adapter talks to. name namespaces
+// that server's tools in Tools() so two servers can each expose a tool of
+// the same name without colliding.
+type serverConn struct {
+	name      string
 	mcpClient *client.Client
+	adapter   *mcpadapter.MCPAdapter
+}
+
+// Adapter aggregates one or more upstream MCP servers - local (stdio) or
+// remote (SSE, streamable HTTP) - behind a single Tools() call, so an agent
+// run can mix e.g. local filesystem tools with a remote HTTP MCP server.
+type Adapter struct {
+	servers []*serverConn
 }
 
-func NewAdapter(endpoint string) (*Adapter, error) {
-	httpTransport, err := getTransport(endpoint)
+// NewAdapter connects to each endpoint and returns an Adapter exposing all
+// of their tools together. Each endpoint's URL scheme selects its
+// transport:
+//
+//   - "http"/"https": streamable HTTP, e.g. "http://127.0.0.1:8080/mcp"
+//   - "sse":           Server-Sent Events, e.g. "sse://127.0.0.1:8080/events"
+//   - "stdio":         a local subprocess, e.g. "stdio:///usr/local/bin/fs-server?arg=--root=/tmp"
+//
+// stdio commands take their arguments from repeated "arg" query parameters,
+// in order.
+func NewAdapter(endpoints ...string) (*Adapter, error) {
+	servers := make([]*serverConn, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		conn, err := newServerConn(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		servers = append(servers, conn)
+	}
+	return &Adapter{servers: servers}, nil
+}
+
+func newServerConn(endpoint string) (*serverConn, error) {
+	t, name, err := getTransport(endpoint)
 	if err != nil {
 		return nil, err
 	}
-	mcpClient := client.NewClient(httpTransport)
+
+	mcpClient := client.NewClient(t)
 
 	adapter, err := mcpadapter.New(mcpClient, mcpadapter.WithToolTimeout(30*time.Second))
 	if err != nil {
 		return nil, fmt.Errorf("new mcp adapter: %w", err)
 	}
-	return &Adapter{
-		adapter:   adapter,
-		mcpClient: mcpClient,
-	}, nil
+
+	return &serverConn{name: name, mcpClient: mcpClient, adapter: adapter}, nil
 }
 
+// Close tears down every underlying client, returning the first error
+// encountered while still attempting to close the rest.
 func (a *Adapter) Close() error {
-	return a.mcpClient.Close()
+	var firstErr error
+	for _, s := range a.servers {
+		if err := s.mcpClient.Close(); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("close %s: %w", s.name, err)
+		}
+	}
+	return firstErr
 }
 
+// Tools returns every tool from every server this Adapter was constructed
+// with, each renamed "server:tool" to avoid collisions between servers.
 func (a *Adapter) Tools() ([]langchaingoTools.Tool, error) {
-	return a.adapter.Tools()
+	var allTools []langchaingoTools.Tool
+	for _, s := range a.servers {
+		tools, err := s.adapter.Tools()
+		if err != nil {
+			return nil, fmt.Errorf("tools from %s: %w", s.name, err)
+		}
+		for _, tool := range tools {
+			allTools = append(allTools, &namespacedTool{Tool: tool, prefix: s.name})
+		}
+	}
+	return allTools, nil
+}
+
+// namespacedTool wraps a tool from a specific upstream MCP server,
+// prefixing its name so tools from different servers never collide.
+type namespacedTool struct {
+	langchaingoTools.Tool
+	prefix string
+}
+
+func (t *namespacedTool) Name() string {
+	return t.prefix + ":" + t.Tool.Name()
 }
 
-func getTransport(endpoint string) (transport.Interface, error) {
-	httpTransport, err := transport.NewStreamableHTTP(
-		endpoint, // Replace with your MCP server URL
-		// You can add HTTP-specific options here like headers, OAuth, etc.
-	)
+// getTransport builds the transport.Interface endpoint's scheme calls for,
+// along with the server name to namespace its tools under.
+func getTransport(endpoint string) (transport.Interface, string, error) {
+	u, err := url.Parse(endpoint)
 	if err != nil {
-		return nil, fmt.Errorf("create transport: %w", err)
+		return nil, "", fmt.Errorf("parse endpoint %q: %w", endpoint, err)
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		httpTransport, err := transport.NewStreamableHTTP(endpoint)
+		if err != nil {
+			return nil, "", fmt.Errorf("create http transport: %w", err)
+		}
+		return httpTransport, u.Host, nil
+
+	case "sse":
+		sseTransport, err := transport.NewSSE("http://" + u.Host + u.Path)
+		if err != nil {
+			return nil, "", fmt.Errorf("create sse transport: %w", err)
+		}
+		return sseTransport, u.Host, nil
+
+	case "stdio":
+		command := u.Path
+		if command == "" {
+			command = u.Opaque
+		}
+		stdioTransport := transport.NewStdio(command, nil, u.Query()["arg"]...)
+		return stdioTransport, filepath.Base(command), nil
+
+	default:
+		return nil, "", fmt.Errorf("unsupported mcp transport scheme %q", u.Scheme)
 	}
-	return httpTransport, nil
 }
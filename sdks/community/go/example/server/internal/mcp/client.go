@@ -0,0 +1,21 @@
+package mcp
+
+import (
+	langchaingoTools "github.com/tmc/langchaingo/tools"
+)
+
+// MCPClient is anything that can report the tools it exposes to the
+// agent, whether that's a direct connection to one upstream server
+// (*Adapter) or an aggregation across several (*MultiAdapter). CallLLM
+// depends on this interface rather than constructing an *Adapter itself,
+// so a test can inject a fake and an alternate deployment can supply its
+// own transport without touching agentic.CallLLM.
+type MCPClient interface {
+	// Tools returns every tool this client currently exposes.
+	Tools() ([]langchaingoTools.Tool, error)
+}
+
+var (
+	_ MCPClient = (*Adapter)(nil)
+	_ MCPClient = (*MultiAdapter)(nil)
+)
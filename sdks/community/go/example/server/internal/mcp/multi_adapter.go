@@ -0,0 +1,279 @@
+package mcp
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	langchaingoTools "github.com/tmc/langchaingo/tools"
+)
+
+// DedupeStrategy controls how MultiAdapter resolves two upstream servers
+// exposing a tool of the same name.
+type DedupeStrategy int
+
+const (
+	// DedupeFirstWins keeps whichever server's tool was seen first (in
+	// endpoint registration order) and silently drops the rest. This is
+	// the default.
+	DedupeFirstWins DedupeStrategy = iota
+	// DedupePrefixAll namespaces every tool as "server:tool", exactly like
+	// Adapter.Tools does, so same-named tools from different servers never
+	// collide in the first place.
+	DedupePrefixAll
+	// DedupePrefixOnCollision leaves tool names bare unless two servers
+	// expose the same name, in which case every server sharing that name
+	// (not just the later one) is namespaced "server:tool" so none of them
+	// is silently dropped.
+	DedupePrefixOnCollision
+)
+
+// MultiAdapterOption configures a MultiAdapter at construction time.
+type MultiAdapterOption func(*MultiAdapter)
+
+// WithDedupeStrategy sets how MultiAdapter resolves same-named tools from
+// different servers. The default is DedupeFirstWins.
+func WithDedupeStrategy(strategy DedupeStrategy) MultiAdapterOption {
+	return func(m *MultiAdapter) {
+		m.dedupe = strategy
+	}
+}
+
+// WithCatalogTTL sets how long MultiAdapter caches its aggregated tool
+// catalog before refreshing it from every upstream server on the next
+// Tools call. The default, 0, disables caching: every Tools call refreshes.
+func WithCatalogTTL(ttl time.Duration) MultiAdapterOption {
+	return func(m *MultiAdapter) {
+		m.ttl = ttl
+	}
+}
+
+// WithReconnectBackoff sets the initial and maximum delay MultiAdapter
+// waits before retrying a server whose last connection attempt failed,
+// doubling the delay after each consecutive failure up to max. The
+// defaults are 1s initial, 30s max.
+func WithReconnectBackoff(initial, maxDelay time.Duration) MultiAdapterOption {
+	return func(m *MultiAdapter) {
+		m.initialBackoff = initial
+		m.maxBackoff = maxDelay
+	}
+}
+
+// managedServer is one upstream MCP server MultiAdapter aggregates, plus
+// the reconnection state tools() uses to decide whether to retry it on a
+// given call to MultiAdapter.Tools.
+type managedServer struct {
+	endpoint string
+	name     string
+
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+
+	mu        sync.Mutex
+	conn      *serverConn // nil until the first successful connect
+	backoff   time.Duration
+	nextRetry time.Time
+}
+
+// MultiAdapter aggregates tool catalogs from several upstream MCP servers
+// behind a single Tools call, deduplicating same-named tools per its
+// DedupeStrategy, caching the aggregated catalog for WithCatalogTTL, and
+// reconnecting a server that dropped with exponential backoff rather than
+// failing every subsequent Tools call until it's restarted.
+type MultiAdapter struct {
+	servers []*managedServer
+
+	dedupe         DedupeStrategy
+	ttl            time.Duration
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+
+	cacheMu  sync.Mutex
+	cache    []langchaingoTools.Tool
+	cachedAt time.Time
+}
+
+// NewMultiAdapter builds a MultiAdapter over endpoints, in the same
+// endpoint URL format NewAdapter accepts. Unlike NewAdapter, a connection
+// failure at construction time doesn't fail the whole call - each server
+// is dialed lazily (and redialed on failure, with backoff) the first time
+// Tools needs it, so one misconfigured or temporarily-down server doesn't
+// prevent the others from serving tools.
+func NewMultiAdapter(endpoints []string, opts ...MultiAdapterOption) (*MultiAdapter, error) {
+	m := &MultiAdapter{
+		initialBackoff: time.Second,
+		maxBackoff:     30 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	for _, endpoint := range endpoints {
+		_, name, err := getTransport(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		m.servers = append(m.servers, &managedServer{
+			endpoint:       endpoint,
+			name:           name,
+			initialBackoff: m.initialBackoff,
+			maxBackoff:     m.maxBackoff,
+		})
+	}
+
+	return m, nil
+}
+
+// Close tears down every server this MultiAdapter has successfully
+// connected to, returning the first error encountered while still
+// attempting to close the rest.
+func (m *MultiAdapter) Close() error {
+	var firstErr error
+	for _, s := range m.servers {
+		s.mu.Lock()
+		conn := s.conn
+		s.mu.Unlock()
+		if conn == nil {
+			continue
+		}
+		if err := conn.mcpClient.Close(); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("close %s: %w", s.name, err)
+		}
+	}
+	return firstErr
+}
+
+// serverTools pairs one server's name with the tool catalog it returned,
+// so dedupeTools can namespace a tool by the server it came from.
+type serverTools struct {
+	name  string
+	tools []langchaingoTools.Tool
+}
+
+// Tools returns the aggregated, deduplicated tool catalog across every
+// server that's currently reachable, refreshing it if the cached catalog
+// is older than the configured TTL (or caching is disabled). A server
+// that's unreachable this round - whether never yet connected or still
+// within its reconnect backoff window - is silently omitted rather than
+// failing the whole call; its tools reappear once it's reachable again.
+func (m *MultiAdapter) Tools() ([]langchaingoTools.Tool, error) {
+	m.cacheMu.Lock()
+	if m.ttl > 0 && m.cache != nil && time.Since(m.cachedAt) < m.ttl {
+		cached := m.cache
+		m.cacheMu.Unlock()
+		return cached, nil
+	}
+	m.cacheMu.Unlock()
+
+	var perServer []serverTools
+	for _, s := range m.servers {
+		tools, err := s.tools()
+		if err != nil {
+			continue
+		}
+		perServer = append(perServer, serverTools{name: s.name, tools: tools})
+	}
+
+	merged := m.dedupeTools(perServer)
+
+	m.cacheMu.Lock()
+	m.cache = merged
+	m.cachedAt = time.Now()
+	m.cacheMu.Unlock()
+
+	return merged, nil
+}
+
+// dedupeTools applies m.dedupe across perServer's catalogs.
+func (m *MultiAdapter) dedupeTools(perServer []serverTools) []langchaingoTools.Tool {
+	if m.dedupe == DedupePrefixAll {
+		var out []langchaingoTools.Tool
+		for _, s := range perServer {
+			for _, tool := range s.tools {
+				out = append(out, &namespacedTool{Tool: tool, prefix: s.name})
+			}
+		}
+		return out
+	}
+
+	// Count occurrences of each name up front so DedupePrefixOnCollision
+	// can tell a genuine collision from a tool only one server exposes.
+	count := make(map[string]int)
+	for _, s := range perServer {
+		for _, tool := range s.tools {
+			count[tool.Name()]++
+		}
+	}
+
+	seen := make(map[string]bool)
+	var out []langchaingoTools.Tool
+	for _, s := range perServer {
+		for _, tool := range s.tools {
+			name := tool.Name()
+			switch m.dedupe {
+			case DedupePrefixOnCollision:
+				if count[name] > 1 {
+					out = append(out, &namespacedTool{Tool: tool, prefix: s.name})
+					continue
+				}
+				out = append(out, tool)
+			default: // DedupeFirstWins
+				if seen[name] {
+					continue
+				}
+				seen[name] = true
+				out = append(out, tool)
+			}
+		}
+	}
+	return out
+}
+
+// tools returns s's current tool catalog, (re)connecting first if s has no
+// live connection or its last attempt failed and the backoff window since
+// then has elapsed. It returns an error without touching s's backoff state
+// if s is still within that window, so callers can skip it for this round
+// without repeatedly paying a dial timeout.
+func (s *managedServer) tools() ([]langchaingoTools.Tool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		if !s.nextRetry.IsZero() && time.Now().Before(s.nextRetry) {
+			return nil, fmt.Errorf("%s: still backing off until %s", s.name, s.nextRetry)
+		}
+		conn, err := newServerConn(s.endpoint)
+		if err != nil {
+			s.recordFailureLocked()
+			return nil, fmt.Errorf("connect %s: %w", s.name, err)
+		}
+		s.conn = conn
+		s.backoff = 0
+		s.nextRetry = time.Time{}
+	}
+
+	tools, err := s.conn.adapter.Tools()
+	if err != nil {
+		// The connection is presumed dead; drop it so the next call
+		// redials instead of repeatedly querying a broken client.
+		s.conn = nil
+		s.recordFailureLocked()
+		return nil, fmt.Errorf("tools from %s: %w", s.name, err)
+	}
+	return tools, nil
+}
+
+// recordFailureLocked bumps s's exponential backoff after a failed
+// (re)connect or Tools call, capped at s.maxBackoff. Callers must hold
+// s.mu.
+func (s *managedServer) recordFailureLocked() {
+	if s.backoff == 0 {
+		s.backoff = s.initialBackoff
+	} else {
+		s.backoff *= 2
+		if s.backoff > s.maxBackoff {
+			s.backoff = s.maxBackoff
+		}
+	}
+	s.nextRetry = time.Now().Add(s.backoff)
+}
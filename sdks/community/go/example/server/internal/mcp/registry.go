@@ -0,0 +1,168 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/tools"
+)
+
+// ToolHandler invokes one registered tool; the same signature mcp-go's
+// server.ToolHandlerFunc expects.
+type ToolHandler func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)
+
+// ToolRegistry lets callers register MCP tools at runtime instead of the
+// fixed set NewServer wires up at construction. Every tool registered this
+// way is also bridged into the AG-UI event stream; see toolRegistry.
+type ToolRegistry interface {
+	// Register adds name to the MCP server with the given schema and
+	// handler. It returns an error if name is already registered.
+	Register(name string, schema mcp.Tool, handler ToolHandler) error
+	// RegisterDefinition is like Register, but derives the MCP schema from
+	// a pkg/tools.Definition and validates incoming arguments against it
+	// before handler runs.
+	RegisterDefinition(def tools.Definition, handler ToolHandler) error
+	// Unregister removes name. It is a no-op if name was never registered.
+	Unregister(name string)
+	// List returns the schema of every currently registered tool.
+	List() []mcp.Tool
+}
+
+// toolRegistry is ToolRegistry's only implementation, backing Server.
+type toolRegistry struct {
+	mu      sync.RWMutex
+	mcp     *server.MCPServer
+	tools   map[string]mcp.Tool
+	emitter events.Emitter
+}
+
+func newToolRegistry(mcpServer *server.MCPServer, emitter events.Emitter) *toolRegistry {
+	return &toolRegistry{
+		mcp:     mcpServer,
+		tools:   make(map[string]mcp.Tool),
+		emitter: emitter,
+	}
+}
+
+func (r *toolRegistry) Register(name string, schema mcp.Tool, handler ToolHandler) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.tools[name]; exists {
+		return fmt.Errorf("mcp: tool %q already registered", name)
+	}
+
+	r.tools[name] = schema
+	r.mcp.AddTool(schema, server.ToolHandlerFunc(r.bridgeHandler(name, handler)))
+	return nil
+}
+
+func (r *toolRegistry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.tools[name]; !exists {
+		return
+	}
+	delete(r.tools, name)
+	r.mcp.DeleteTools(name)
+}
+
+func (r *toolRegistry) List() []mcp.Tool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tools := make([]mcp.Tool, 0, len(r.tools))
+	for _, tool := range r.tools {
+		tools = append(tools, tool)
+	}
+	return tools
+}
+
+// bridgeHandler wraps handler so every invocation emits a
+// ToolCallStart/ToolCallArgs pair before running it and a ToolCallEnd
+// after, regardless of outcome, so an AG-UI front-end renders an
+// MCP-driven tool call the same way it renders a model-issued one. If the
+// registry has no emitter configured, handler runs unwrapped.
+func (r *toolRegistry) bridgeHandler(name string, handler ToolHandler) ToolHandler {
+	if r.emitter == nil {
+		return handler
+	}
+
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		toolCallID := events.GenerateToolCallID()
+
+		if err := r.emitter.Emit(events.NewToolCallStartEvent(toolCallID, name)); err != nil {
+			return nil, fmt.Errorf("mcp: emit ToolCallStart for %q: %w", name, err)
+		}
+
+		argsJSON, err := json.Marshal(request.GetArguments())
+		if err != nil {
+			argsJSON = []byte("{}")
+		}
+		if err := r.emitter.Emit(events.NewToolCallArgsEvent(toolCallID, string(argsJSON))); err != nil {
+			return nil, fmt.Errorf("mcp: emit ToolCallArgs for %q: %w", name, err)
+		}
+
+		result, handlerErr := handler(ctx, request)
+
+		// The tool already ran; an emit failure here is reported to the
+		// caller but must not mask whatever handler returned.
+		if emitErr := r.emitter.Emit(events.NewToolCallEndEvent(toolCallID)); emitErr != nil && handlerErr == nil {
+			return result, fmt.Errorf("mcp: emit ToolCallEnd for %q: %w", name, emitErr)
+		}
+
+		return result, handlerErr
+	}
+}
+
+// ToolFromStruct builds an mcp.Tool named name from v's exported fields via
+// reflection, so a caller registering a Go-native tool doesn't have to
+// hand-write an mcp.WithString/mcp.WithNumber/... chain for every field. It
+// is a thin wrapper over pkg/tools.SchemaFromType and pkg/tools.ToMCP - see
+// those for the field-mapping rules (jsonschema tag for the description,
+// json tag for the property name and required-ness).
+func ToolFromStruct(name, description string, v interface{}) mcp.Tool {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	def := tools.Definition{Name: name, Description: description, Args: t}
+	if t != nil && t.Kind() == reflect.Struct {
+		def.Schema = tools.SchemaFromType(t)
+	}
+	return tools.ToMCP(def)
+}
+
+// RegisterDefinition is like Register, but takes a pkg/tools.Definition
+// instead of a pre-built mcp.Tool: it derives the mcp.Tool via
+// tools.ToMCP, records def in the shared tools.DefaultRegistry so an
+// AG-UI dispatcher can look up and validate against the same Definition,
+// and wraps handler so arguments are checked with tools.Validate before
+// it runs.
+func (r *toolRegistry) RegisterDefinition(def tools.Definition, handler ToolHandler) error {
+	if err := tools.Register(def); err != nil {
+		return err
+	}
+
+	validating := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		argsJSON, err := json.Marshal(request.GetArguments())
+		if err != nil {
+			return nil, fmt.Errorf("mcp: marshal arguments for %q: %w", def.Name, err)
+		}
+		if err := tools.Validate(def, argsJSON); err != nil {
+			return nil, fmt.Errorf("mcp: validate arguments for %q: %w", def.Name, err)
+		}
+		return handler(ctx, request)
+	}
+
+	return r.Register(def.Name, tools.ToMCP(def), validating)
+}
@@ -7,16 +7,34 @@ import (
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/tools"
 )
 
 const DefaultPort = 3217
 
 type Server struct {
-	server *server.StreamableHTTPServer
-	port   int
+	server   *server.StreamableHTTPServer
+	port     int
+	registry *toolRegistry
 }
 
+// NewServer creates a Server exposing only the demo provide_language_options
+// tool, with no AG-UI event bridging. For a Server whose tools can be
+// registered at runtime, use NewServerWithRegistry.
 func NewServer(port int) (*Server, error) {
+	return NewServerWithRegistry(port, nil)
+}
+
+// NewServerWithRegistry creates a Server whose Registry method exposes a
+// ToolRegistry, so callers can add, remove, and list MCP tools at runtime
+// instead of being fixed at construction time. If emitter is non-nil, every
+// tool invoked through the registry also emits a ToolCallStart/ToolCallArgs/
+// ToolCallEnd triplet through it, tagged with a fresh
+// events.GenerateToolCallID(), so MCP tool calls become first-class AG-UI
+// events alongside model-issued ones.
+func NewServerWithRegistry(port int, emitter events.Emitter) (*Server, error) {
 	// Create a new MCP server
 	s := server.NewMCPServer(
 		"Demo 🚀",
@@ -24,38 +42,27 @@ func NewServer(port int) (*Server, error) {
 		server.WithToolCapabilities(false),
 	)
 
-	// Add tool
-	tool := mcp.NewTool("provide_language_options",
-		mcp.WithDescription("Provide a list of programming languages to choose from"),
-		mcp.WithString("option1",
-			mcp.Required(),
-			mcp.Description("Name of the first programming language option"),
-		),
-		mcp.WithString("option2",
-			mcp.Required(),
-			mcp.Description("Name of the second programming language option"),
-		),
-		mcp.WithString("option3",
-			mcp.Required(),
-			mcp.Description("Name of the third programming language option"),
-		),
-		mcp.WithString("option4",
-			mcp.Required(),
-			mcp.Description("Name of the fourth programming language option"),
-		),
-	)
+	registry := newToolRegistry(s, emitter)
 
-	// Add tool handler
-	s.AddTool(tool, languageChoiceHandler)
+	if err := registry.RegisterDefinition(languageOptionsDef, languageChoiceHandler); err != nil {
+		return nil, err
+	}
 
 	streamableServer := server.NewStreamableHTTPServer(s)
 
 	return &Server{
-		server: streamableServer,
-		port:   port,
+		server:   streamableServer,
+		port:     port,
+		registry: registry,
 	}, nil
 }
 
+// Registry returns s's ToolRegistry, for registering additional tools at
+// runtime.
+func (s *Server) Registry() ToolRegistry {
+	return s.registry
+}
+
 func (s *Server) Start() error {
 	portString := fmt.Sprintf(":%d", s.port)
 	return s.server.Start(portString)
@@ -66,43 +73,38 @@ func (s *Server) Shutdown(ctx context.Context) error {
 }
 
 type LanguageOptions struct {
-	Option1 string
-	Option2 string
-	Option3 string
-	Option4 string
+	Option1 string `json:"option1" jsonschema:"description=Name of the first programming language option"`
+	Option2 string `json:"option2" jsonschema:"description=Name of the second programming language option"`
+	Option3 string `json:"option3" jsonschema:"description=Name of the third programming language option"`
+	Option4 string `json:"option4" jsonschema:"description=Name of the fourth programming language option"`
 }
 
-func languageChoiceHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	optionOne, err := request.RequireString("option1")
-	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
-	}
-
-	optionTwo, err := request.RequireString("option2")
-	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
-	}
+// languageOptionsDef is provide_language_options's shared Definition - the
+// same Schema backs both the mcp.Tool registry.RegisterDefinition derives
+// and the validation it runs before languageChoiceHandler sees a request,
+// so the two can't drift out of sync.
+var languageOptionsDef = tools.DefinitionFor[LanguageOptions](
+	"provide_language_options",
+	"Provide a list of programming languages to choose from",
+)
 
-	optionThree, err := request.RequireString("option3")
+// languageChoiceHandler runs after registry.RegisterDefinition's wrapper has
+// already validated the incoming arguments against languageOptionsDef, so it
+// only needs to unmarshal them.
+func languageChoiceHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	argsJSON, err := json.Marshal(request.GetArguments())
 	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, fmt.Errorf("mcp: marshal provide_language_options arguments: %w", err)
 	}
 
-	optionFour, err := request.RequireString("option4")
-	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+	var options LanguageOptions
+	if err := json.Unmarshal(argsJSON, &options); err != nil {
+		return nil, fmt.Errorf("mcp: unmarshal provide_language_options arguments: %w", err)
 	}
 
-	// Marshal the struct to a JSON byte slice
-	jsonData, err := json.Marshal(LanguageOptions{
-		Option1: optionOne,
-		Option2: optionTwo,
-		Option3: optionThree,
-		Option4: optionFour,
-	})
+	jsonData, err := json.Marshal(options)
 	if err != nil {
-		fmt.Println("Error marshaling JSON:", err)
-		return nil, err
+		return nil, fmt.Errorf("mcp: marshal provide_language_options result: %w", err)
 	}
 
 	return mcp.NewToolResultText(string(jsonData)), nil
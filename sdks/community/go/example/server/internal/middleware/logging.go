@@ -0,0 +1,51 @@
+// Package middleware holds Fiber middleware shared across the example
+// server's routes.
+package middleware
+
+import (
+	"context"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/observability/log"
+	"github.com/gofiber/fiber/v3"
+)
+
+// localsKey is the fiber.Ctx Locals key RequestLogging stores the
+// request's log.Correlation under, for route handlers to read via
+// ContextFromFiber.
+const localsKey = "log_correlation"
+
+// RequestLogging seeds a log.Correlation from the request ID the
+// requestid middleware already assigned (so RequestLogging must be
+// registered after it) and, if present, the W3C "traceparent" header, and
+// stores it in c.Locals so every handler downstream - and anything it logs
+// through log.FromContext - carries the same correlation IDs as this
+// request's top-level log line.
+func RequestLogging() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		var corr log.Correlation
+
+		if requestID, ok := c.Locals("requestid").(string); ok {
+			corr.RequestID = requestID
+		}
+
+		if traceparent := c.Get("traceparent"); traceparent != "" {
+			if traceID, spanID, ok := log.ParseTraceParent(traceparent); ok {
+				corr.TraceID = traceID
+				corr.SpanID = spanID
+			}
+		}
+		corr.TraceState = c.Get("tracestate")
+
+		c.Locals(localsKey, corr)
+		return c.Next()
+	}
+}
+
+// ContextFromFiber returns a context derived from base carrying the
+// log.Correlation RequestLogging attached to c, so a handler can pass it to
+// log.FromContext (and to anything further downstream that accepts a
+// context) instead of threading the raw request/trace IDs by hand.
+func ContextFromFiber(c fiber.Ctx, base context.Context) context.Context {
+	corr, _ := c.Locals(localsKey).(log.Correlation)
+	return log.WithCorrelation(base, corr)
+}
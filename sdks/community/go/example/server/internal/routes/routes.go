@@ -5,14 +5,43 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
 
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/server/internal/agentic"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/server/internal/config"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/example/server/internal/middleware"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/codec"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/sse"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/observability/log"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/recording"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/state"
 	"github.com/gofiber/fiber/v3"
+	"go.uber.org/zap"
 )
 
+// traceCodec encodes events to the wire format pkg/recording trace entries
+// store, shared across requests since codec.Codec is stateless.
+var traceCodec = codec.NewJSONCodec()
+
+// clientIP adapts fiber.Ctx's RemoteAddr and headers into the
+// *http.Request shape config.Config.ClientIP expects, so the same
+// trusted-proxy resolution logic is shared with any other net/http-based
+// transport rather than duplicated per framework.
+func clientIP(cfg *config.Config, c fiber.Ctx) net.IP {
+	header := make(http.Header, len(cfg.ClientIPHeaders))
+	for _, name := range cfg.ClientIPHeaders {
+		if v := c.Get(name); v != "" {
+			header.Set(name, v)
+		}
+	}
+	return cfg.ClientIP(&http.Request{RemoteAddr: c.IP(), Header: header})
+}
+
 // AgenticInput represents the input structure for the tool-based generative UI endpoint
 type AgenticInput struct {
 	ThreadID       string                   `json:"thread_id"`
@@ -24,33 +53,32 @@ type AgenticInput struct {
 	ForwardedProps interface{}              `json:"forwarded_props"`
 }
 
-// AgenticHandler creates a Fiber handler for the tool-based generative UI route
-func AgenticHandler(cfg *config.Config) fiber.Handler {
-	logger := slog.Default()
-	sseWriter := sse.NewSSEWriter().WithLogger(logger)
+// AgenticHandler creates a Fiber handler for the tool-based generative UI
+// route. replay and stateStore should be the same instances handed to any
+// other transport serving the same agent (see pkg/transport/grpc.Server),
+// so a client reconnecting over either transport observes one shared
+// per-thread history.
+func AgenticHandler(cfg *config.Config, replay *state.ReplayRegistry, stateStore *state.Store) fiber.Handler {
+	// sseWriter's own internal diagnostics stay on slog - that's its
+	// established logging convention, orthogonal to the request-level
+	// correlated logging below.
+	sseWriter := sse.NewSSEWriter().WithLogger(slog.Default())
 
 	return func(c fiber.Ctx) error {
-		// Extract request metadata
-		requestID := c.Locals("requestid")
-		if requestID == nil {
-			requestID = "unknown"
-		}
-
-		logCtx := []any{
-			"request_id", requestID,
-			"route", c.Route().Path,
-			"method", c.Method(),
-		}
+		logCtx := middleware.ContextFromFiber(c, context.Background())
+		appLogger := log.FromContext(logCtx)
 
 		// Parse request body first before setting headers
 		var input AgenticInput
 		if err := c.Bind().JSON(&input); err != nil {
-			logger.Error("Failed to parse request body", append(logCtx, "error", err)...)
+			appLogger.Errorw("Failed to parse request body", "error", err)
 			return c.Status(400).JSON(fiber.Map{
 				"error": "Invalid request body",
 			})
 		}
 
+		lastEventID := c.Get("Last-Event-ID")
+
 		// Set SSE headers after validation
 		c.Set("Content-Type", "text/event-stream")
 		c.Set("Cache-Control", "no-cache")
@@ -58,22 +86,28 @@ func AgenticHandler(cfg *config.Config) fiber.Handler {
 		c.Set("Access-Control-Allow-Origin", "*")
 		c.Set("Access-Control-Allow-Headers", "Cache-Control")
 
-		logger.Info("Tool-based generative UI SSE connection established", logCtx...)
+		appLogger.Infow("Tool-based generative UI SSE connection established",
+			"route", c.Route().Path, "method", c.Method(), "client_ip", clientIP(cfg, c).String())
 
 		// Get request context for cancellation
-		ctx := c.RequestCtx()
+		reqCtx := c.RequestCtx()
 
 		// Start streaming
 		return c.SendStreamWriter(func(w *bufio.Writer) {
-			if err := streamAgenticEvents(ctx, w, sseWriter, &input, cfg, logger, logCtx); err != nil {
-				logger.Error("Error streaming tool-based generative UI events", append(logCtx, "error", err)...)
+			if err := streamAgenticEvents(reqCtx, logCtx, w, sseWriter, &input, cfg, replay, stateStore, lastEventID); err != nil {
+				appLogger.Errorw("Error streaming tool-based generative UI events", "error", err)
 			}
 		})
 	}
 }
 
-// streamAgenticEvents implements the tool-based generative UI event sequence
-func streamAgenticEvents(reqCtx context.Context, w *bufio.Writer, sseWriter *sse.SSEWriter, input *AgenticInput, _ *config.Config, logger *slog.Logger, logCtx []any) error {
+// streamAgenticEvents implements the tool-based generative UI event
+// sequence. If lastEventID names a sequence number still held in the
+// thread's shared ReplayRegistry, the events missed since it are replayed
+// before anything new is sent; if it's unknown or has been evicted, a
+// fresh StateSnapshotEvent synthesized from stateStore is sent instead, so
+// the client can reconcile without a gap either way.
+func streamAgenticEvents(reqCtx, logCtx context.Context, w *bufio.Writer, sseWriter *sse.SSEWriter, input *AgenticInput, cfg *config.Config, replay *state.ReplayRegistry, stateStore *state.Store, lastEventID string) error {
 	// Use IDs from input or generate new ones if not provided
 	threadID := input.ThreadID
 	if threadID == "" {
@@ -84,18 +118,38 @@ func streamAgenticEvents(reqCtx context.Context, w *bufio.Writer, sseWriter *sse
 		runID = events.GenerateRunID()
 	}
 
-	// Create a wrapped context for our operations
-	ctx := context.Background()
+	// Every log line from here on - in this function and anything it
+	// calls that accepts logCtx - carries threadID/runID alongside the
+	// request/trace IDs RequestLogging already attached.
+	logCtx = log.WithThreadRun(logCtx, threadID, runID)
+	appLogger := log.FromContext(logCtx)
+
+	// Use logCtx - not a fresh context.Background() - as the base for every
+	// downstream call, so the W3C traceparent RequestLogging parsed out of
+	// this request (and the threadID/runID just added to it) reaches the
+	// log.StartSpan calls inside ProcessInput instead of each starting an
+	// unrelated trace.
+	ctx := logCtx
+
+	recorder, closeRecorder := newRunRecorder(cfg, threadID, runID, appLogger)
+	defer closeRecorder()
+
+	replayBuf := replay.ForThread(threadID)
+	if lastEventID != "" {
+		if err := resumeFromLastEventID(ctx, logCtx, w, sseWriter, replayBuf, stateStore, threadID, lastEventID, recorder); err != nil {
+			return err
+		}
+	}
 
 	// Send RUN_STARTED event
 	runStarted := events.NewRunStartedEvent(threadID, runID)
-	if err := sseWriter.WriteEvent(ctx, w, runStarted); err != nil {
+	if err := writeReplayableEvent(ctx, w, sseWriter, replayBuf, runStarted, recorder); err != nil {
 		return fmt.Errorf("failed to write RUN_STARTED event: %w", err)
 	}
 
 	// Check for cancellation
 	if err := reqCtx.Err(); err != nil {
-		logger.Debug("Client disconnected during RUN_STARTED", append(logCtx, "reason", "context_canceled")...)
+		appLogger.Debugw("Client disconnected during RUN_STARTED", "reason", "context_canceled")
 		return nil
 	}
 
@@ -110,7 +164,7 @@ func streamAgenticEvents(reqCtx context.Context, w *bufio.Writer, sseWriter *sse
 		return fmt.Errorf("last message does not have content")
 	}
 
-	err := agentic.ProcessInput(ctx, w, sseWriter, content)
+	err := agentic.ProcessInput(ctx, w, sseWriter, replayBuf, content, "", agentic.WithStateStore(stateStore))
 	if err != nil {
 		return fmt.Errorf("failed to process input: %w", err)
 
@@ -123,9 +177,140 @@ func streamAgenticEvents(reqCtx context.Context, w *bufio.Writer, sseWriter *sse
 
 	// Send RUN_FINISHED event
 	runFinished := events.NewRunFinishedEvent(threadID, runID)
-	if err := sseWriter.WriteEvent(ctx, w, runFinished); err != nil {
+	if err := writeReplayableEvent(ctx, w, sseWriter, replayBuf, runFinished, recorder); err != nil {
 		return fmt.Errorf("failed to write RUN_FINISHED event: %w", err)
 	}
 
 	return nil
 }
+
+// writeReplayableEvent records event in replayBuf under a fresh sequence
+// ID and writes it to w with that ID as the SSE frame's "id:" field. If
+// recorder is non-nil (cfg.RecordDir was set), the event is also appended
+// to the run's trace file.
+func writeReplayableEvent(ctx context.Context, w *bufio.Writer, sseWriter *sse.SSEWriter, replayBuf *events.ReplayBuffer, event events.Event, recorder *recording.Recorder) error {
+	seq := replayBuf.Add(event)
+	recordEvent(recorder, event)
+	return sseWriter.WriteEventWithID(ctx, w, event, strconv.FormatInt(seq, 10))
+}
+
+// resumeFromLastEventID replays everything buffered since lastEventID, or,
+// if it can't be satisfied from replayBuf (unknown or evicted), writes a
+// fresh StateSnapshotEvent synthesized from stateStore so the client can
+// still reconcile instead of silently missing a gap.
+func resumeFromLastEventID(ctx, logCtx context.Context, w *bufio.Writer, sseWriter *sse.SSEWriter, replayBuf *events.ReplayBuffer, stateStore *state.Store, threadID, lastEventID string, recorder *recording.Recorder) error {
+	appLogger := log.FromContext(logCtx)
+
+	afterSeq, err := strconv.ParseInt(lastEventID, 10, 64)
+	if err != nil {
+		afterSeq = 0
+	}
+
+	replayed, ok := replayBuf.Since(afterSeq)
+	if ok {
+		appLogger.Debugw("Resuming SSE stream from Last-Event-ID", "last_event_id", lastEventID, "replayed", len(replayed))
+		for _, entry := range replayed {
+			recordEvent(recorder, entry.Event)
+			if err := sseWriter.WriteEventWithID(ctx, w, entry.Event, strconv.FormatInt(entry.Seq, 10)); err != nil {
+				return fmt.Errorf("failed to replay buffered event: %w", err)
+			}
+		}
+		return nil
+	}
+
+	appLogger.Debugw("Last-Event-ID could not be resumed, falling back to a snapshot", "last_event_id", lastEventID)
+	snapshot := events.NewStateSnapshotEvent(stateStore.Snapshot(threadID))
+	if err := writeReplayableEvent(ctx, w, sseWriter, replayBuf, snapshot, recorder); err != nil {
+		return fmt.Errorf("failed to write fallback snapshot: %w", err)
+	}
+	return nil
+}
+
+// newRunRecorder returns a Recorder appending the run's events to a JSONL
+// trace file under cfg.RecordDir, and a closer that releases it. If cfg is
+// nil or RecordDir is empty, recording is disabled: both the Recorder and
+// the closer are no-ops, so callers can use them unconditionally.
+func newRunRecorder(cfg *config.Config, threadID, runID string, appLogger *zap.SugaredLogger) (*recording.Recorder, func()) {
+	noop := func() {}
+	if cfg == nil || cfg.RecordDir == "" {
+		return nil, noop
+	}
+
+	if err := os.MkdirAll(cfg.RecordDir, 0o755); err != nil {
+		appLogger.Warnw("Failed to create record directory, disabling recording for this run", "dir", cfg.RecordDir, "error", err)
+		return nil, noop
+	}
+
+	tracePath := filepath.Join(cfg.RecordDir, fmt.Sprintf("%s-%s.jsonl", threadID, runID))
+	f, err := os.Create(tracePath)
+	if err != nil {
+		appLogger.Warnw("Failed to create trace file, disabling recording for this run", "path", tracePath, "error", err)
+		return nil, noop
+	}
+
+	return recording.NewRecorder(f), func() {
+		if err := f.Close(); err != nil {
+			appLogger.Warnw("Failed to close trace file", "path", tracePath, "error", err)
+		}
+	}
+}
+
+// recordEvent best-effort appends event to recorder's trace using
+// traceCodec to recover the wire bytes. recorder may be nil (recording
+// disabled), in which case it's a no-op. A recording failure never fails
+// the request - it only degrades the trace, so it's silently dropped
+// rather than returned or logged.
+func recordEvent(recorder *recording.Recorder, event events.Event) {
+	if recorder == nil {
+		return
+	}
+	raw, err := traceCodec.Encode(event)
+	if err != nil {
+		return
+	}
+	_ = recorder.Record(raw, event)
+}
+
+// ApprovalInput is the body of a frontend decision on a pending tool call,
+// raised by a ToolCallApprovalRequested custom event from the /agentic
+// stream.
+type ApprovalInput struct {
+	ToolCallID string `json:"tool_call_id"`
+	Approved   bool   `json:"approved"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// ApprovalHandler creates a Fiber handler that delivers a frontend's
+// approval decision to whichever in-flight agentic.Handler is blocked
+// waiting on it.
+func ApprovalHandler() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		appLogger := log.FromContext(middleware.ContextFromFiber(c, context.Background()))
+
+		var input ApprovalInput
+		if err := c.Bind().JSON(&input); err != nil {
+			appLogger.Errorw("Failed to parse approval decision", "error", err)
+			return c.Status(400).JSON(fiber.Map{
+				"error": "Invalid request body",
+			})
+		}
+		if input.ToolCallID == "" {
+			return c.Status(400).JSON(fiber.Map{
+				"error": "tool_call_id is required",
+			})
+		}
+
+		resolved := agentic.ResolveApproval(agentic.ApprovalDecision{
+			ToolCallID: input.ToolCallID,
+			Approved:   input.Approved,
+			Reason:     input.Reason,
+		})
+		if !resolved {
+			return c.Status(404).JSON(fiber.Map{
+				"error": "no pending approval for tool_call_id",
+			})
+		}
+
+		return c.JSON(fiber.Map{"ok": true})
+	}
+}
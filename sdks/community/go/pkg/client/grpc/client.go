@@ -0,0 +1,187 @@
+// Package grpc mirrors pkg/client/sse's Client shape for an alternative
+// transport: a bidirectional gRPC stream dialed against the Agentic RPC
+// pkg/transport/grpc.Server exposes, instead of HTTP/1 SSE. Reconnecting to
+// the same underlying agent logic over this transport instead of SSE is a
+// matter of swapping which client a caller constructs; StreamOptions and
+// Frame are deliberately shaped like their sse counterparts for that reason.
+//
+// Like pkg/transport/grpc, which plays the server side of this same RPC,
+// this package is written against a hand-maintained service description
+// rather than protoc-gen-go-grpc output, since this sandbox has no protoc
+// toolchain. Once a real build pipeline can run protoc, it should be
+// rebased onto the generated client stub.
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/grpcstream"
+	grpctransport "github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/transport/grpc"
+)
+
+// agenticMethod is the fully qualified RPC name pkg/transport/grpc's
+// agenticServiceDesc registers server-side ("aguigrpc.Agentic" service,
+// "Agentic" stream). It is hardcoded here rather than imported because
+// that ServiceDesc is unexported - a hand-rolled client only needs the
+// method name and stream shape, not the server's HandlerType machinery.
+const agenticMethod = "/aguigrpc.Agentic/Agentic"
+
+// Config configures a Client. It covers the same concerns as sse.Config
+// that still make sense over gRPC; framing, codec negotiation, and HTTP/2
+// multiplexing are gRPC's job instead of the caller's.
+type Config struct {
+	// Endpoint is the "host:port" the gRPC server (pkg/transport/grpc.Server)
+	// is listening on.
+	Endpoint string
+	// DialOptions are appended after the defaults (insecure transport
+	// credentials and the jsonCodec matching the server's) so a caller can
+	// add TLS, keepalive, or interceptor options.
+	DialOptions []grpc.DialOption
+	// BufferSize sizes the channel Stream returns. Defaults to 100.
+	BufferSize int
+}
+
+// Client streams AG-UI events over a gRPC connection to the Agentic RPC,
+// decoding each *grpcstream.Event into its concrete events.Event natively
+// instead of round-tripping through JSON the way the SSE transport's Frame
+// does.
+type Client struct {
+	config Config
+	conn   *grpc.ClientConn
+}
+
+// NewClient dials config.Endpoint. The connection is established lazily by
+// the underlying gRPC client (NewClient does not block on a successful
+// handshake); call Close when done with it.
+func NewClient(config Config) (*Client, error) {
+	if config.BufferSize == 0 {
+		config.BufferSize = 100
+	}
+
+	dialOpts := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(jsonCodec{})),
+	}, config.DialOptions...)
+
+	conn, err := grpc.NewClient(config.Endpoint, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("grpc: dial %s: %w", config.Endpoint, err)
+	}
+
+	return &Client{config: config, conn: conn}, nil
+}
+
+// Frame is Client's analogue of sse.Frame: one decoded event off the wire,
+// plus the time it was received. Event carries the concrete events.Event
+// directly (a ThinkingTextMessageContentEvent, a ToolCallArgsEvent, ...)
+// rather than sse.Frame's raw Data bytes, since a gRPC stream never
+// serializes through an intermediate frame a caller would otherwise have
+// to decode itself.
+type Frame struct {
+	Event     events.Event
+	Timestamp time.Time
+}
+
+// StreamOptions mirrors sse.StreamOptions' request-shaping fields that
+// still apply over gRPC: Context bounds the call, and ThreadID, RunID and
+// Content are sent as the stream's first ClientMessage to start (or
+// resume) a run.
+type StreamOptions struct {
+	Context context.Context
+	// ThreadID and RunID identify the run, mirroring
+	// pkg/transport/grpc.ClientMessage; empty values let the server
+	// generate fresh ones.
+	ThreadID string
+	RunID    string
+	// Content is the user message starting or continuing the run.
+	Content string
+}
+
+// Stream opens the Agentic bidi stream, sends opts as the first
+// ClientMessage, and returns a channel of decoded Frames alongside an error
+// channel, exactly mirroring sse.Client.Stream's contract. Both channels
+// close once the server ends the stream or ctx is cancelled.
+func (c *Client) Stream(opts StreamOptions) (<-chan Frame, <-chan error, error) {
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	streamDesc := &grpc.StreamDesc{
+		StreamName:    "Agentic",
+		ServerStreams: true,
+		ClientStreams: true,
+	}
+
+	stream, err := c.conn.NewStream(ctx, streamDesc, agenticMethod)
+	if err != nil {
+		return nil, nil, fmt.Errorf("grpc: open Agentic stream: %w", err)
+	}
+
+	msg := grpctransport.ClientMessage{
+		ThreadID: opts.ThreadID,
+		RunID:    opts.RunID,
+		Content:  opts.Content,
+	}
+	if err := stream.SendMsg(&msg); err != nil {
+		return nil, nil, fmt.Errorf("grpc: send ClientMessage: %w", err)
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, nil, fmt.Errorf("grpc: close send side: %w", err)
+	}
+
+	frames := make(chan Frame, c.config.BufferSize)
+	errCh := make(chan error, 1)
+
+	go c.readStream(ctx, stream, frames, errCh)
+
+	return frames, errCh, nil
+}
+
+// readStream pumps decoded Frames from stream onto frames until the server
+// closes it, ctx is done, or a read/decode error occurs.
+func (c *Client) readStream(ctx context.Context, stream grpc.ClientStream, frames chan<- Frame, errCh chan<- error) {
+	defer close(frames)
+	defer close(errCh)
+
+	for {
+		wire := &grpcstream.Event{}
+		if err := stream.RecvMsg(wire); err != nil {
+			if err == io.EOF {
+				return
+			}
+			select {
+			case errCh <- fmt.Errorf("grpc: receive event: %w", err):
+			case <-ctx.Done():
+			}
+			return
+		}
+
+		event, err := grpcstream.FromProto(wire)
+		if err != nil {
+			select {
+			case errCh <- fmt.Errorf("grpc: decode event: %w", err):
+			case <-ctx.Done():
+			}
+			return
+		}
+
+		select {
+		case frames <- Frame{Event: event, Timestamp: time.Now()}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Close tears down the underlying gRPC connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,19 @@
+package grpc
+
+import "encoding/json"
+
+// jsonCodec stands in for the protoc-gen-go-generated protobuf Marshal/
+// Unmarshal a real build of eventstream.proto would provide, for the same
+// reason documented on grpcstream.Codec and pkg/transport/grpc's own
+// jsonCodec: this sandbox has no protoc toolchain. It is installed via
+// grpc.WithDefaultCallOptions(grpc.ForceCodec(...)) in NewClient, matching
+// the jsonCodec pkg/transport/grpc.Server installs server-side, so
+// ClientMessage outbound and *grpcstream.Event inbound marshal as JSON on
+// the wire instead of protobuf.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) { return json.Marshal(v) }
+
+func (jsonCodec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+
+func (jsonCodec) Name() string { return "json" }
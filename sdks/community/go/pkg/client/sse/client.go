@@ -8,10 +8,14 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/sirupsen/logrus"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/codec"
 )
 
 type Config struct {
@@ -23,16 +27,129 @@ type Config struct {
 	ReadTimeout    time.Duration
 	BufferSize     int
 	Logger         *logrus.Logger
+
+	// IdleReadTimeout, if non-zero, is the default per-frame read deadline
+	// for every Stream/Open call that doesn't set StreamOptions.
+	// PerEventDeadline itself: the client arms its read deadline before the
+	// first frame and re-arms it after each frame is successfully parsed,
+	// so a connection that stops producing frames (but never sends EOF or
+	// a TCP reset) is detected and the stream is closed with ErrIdleTimeout
+	// instead of blocking until the caller cancels Context. An explicit
+	// StreamOptions.PerEventDeadline on a given call overrides this.
+	IdleReadTimeout time.Duration
+
+	// AutoReconnect enables automatic reconnection with Last-Event-ID replay
+	// when the stream ends or errors out while the context is still live.
+	AutoReconnect bool
+	// MaxReconnects caps the number of reconnect attempts (0 for unlimited)
+	MaxReconnects int
+	// RetryInterval is the initial reconnect delay; it is overridden by any
+	// "retry:" field the server sends on the stream.
+	RetryInterval time.Duration
+	// MaxBackoff caps the exponential backoff scheduleReconnect doubles
+	// RetryInterval into on repeated failures. Defaults to 30s.
+	MaxBackoff time.Duration
+	// ReconnectPolicy, if set, overrides the default exponential-backoff-
+	// with-jitter wait computation: it is called with the error that ended
+	// the last connection (nil if the stream simply closed) and the 1-based
+	// attempt number, and returns the delay before the next reconnect.
+	// MaxReconnects and Context cancellation are still honored around it.
+	ReconnectPolicy func(err error, attempt int) time.Duration
+
+	// HTTP2 enables HTTP/2 stream multiplexing so many Stream calls to the
+	// same endpoint share one TCP/TLS connection instead of one-per-call.
+	HTTP2 bool
+	// Transport, when set, is used as the base *http.Transport; HTTP2
+	// configures it in place via http2.ConfigureTransports.
+	Transport *http.Transport
+
+	// Decoder overrides the FrameDecoder picked from the response
+	// Content-Type. When nil, Client.stream selects one of the registered
+	// decoders (SSE, NDJSON, gRPC-Web length-prefixed) automatically.
+	Decoder FrameDecoder
+
+	// Codec overrides the event-payload codec.Codec picked from the
+	// response Content-Type. When nil, Client.stream resolves one from
+	// codec.DefaultRegistry, falling back to JSON for a Content-Type
+	// (e.g. "text/event-stream") that only describes framing and carries
+	// no codec information of its own.
+	Codec codec.Codec
+
+	// HighWaterMark and LowWaterMark bound the ring buffer used by Open's
+	// pull-based Stream: the fill loop stops reading once the buffer holds
+	// HighWaterMark frames and resumes once Next has drained it back down to
+	// LowWaterMark. Defaults: 64 and 16.
+	HighWaterMark int
+	LowWaterMark  int
+
+	// RequestMiddlewares wrap the http.RoundTripper used for the initial
+	// POST, applied in order. See RequestMiddleware.
+	RequestMiddlewares []RequestMiddleware
+	// FrameMiddlewares sit between the FrameDecoder and the caller, applied
+	// in order. See FrameMiddleware.
+	FrameMiddlewares []FrameMiddleware
 }
 
 type Client struct {
 	config     Config
 	httpClient *http.Client
 	logger     *logrus.Logger
+
+	// lastEventID persists across reconnects so the server can resume the
+	// stream via a Last-Event-ID request header.
+	lastEventID string
+	// retryInterval tracks the most recently received "retry:" field
+	retryInterval time.Duration
+
+	// resolvedCodec is the event-payload codec.Codec negotiate() picked for
+	// the most recent stream, read via Codec().
+	resolvedCodec codec.Codec
+
+	// readDeadline and writeDeadline back SetReadDeadline/SetWriteDeadline,
+	// letting a caller bound a single in-flight frame read or payload write
+	// without cancelling the context passed to Stream/Open.
+	readDeadline  deadlineTimer
+	writeDeadline deadlineTimer
+}
+
+// SetReadDeadline bounds how long the next frame read may block: once t is
+// reached, the in-flight read is preempted and reported as an error without
+// cancelling the stream's context or closing the Client. A zero t clears the
+// deadline. Modeled on net.Conn.SetReadDeadline.
+func (c *Client) SetReadDeadline(t time.Time) {
+	c.readDeadline.set(t)
+}
+
+// SetWriteDeadline bounds how long the initial request payload write may
+// block. A zero t clears the deadline. Modeled on net.Conn.SetWriteDeadline.
+func (c *Client) SetWriteDeadline(t time.Time) {
+	c.writeDeadline.set(t)
+}
+
+// SetDeadline is shorthand for calling both SetReadDeadline and
+// SetWriteDeadline with t.
+func (c *Client) SetDeadline(t time.Time) {
+	c.SetReadDeadline(t)
+	c.SetWriteDeadline(t)
+}
+
+// Codec returns the event-payload codec resolved for the most recent
+// Stream/Open call (or Config.Codec, if set explicitly), so a caller can
+// decode each Frame.Data with the format the server actually used instead
+// of assuming JSON. Returns the default JSON codec if no stream has been
+// negotiated yet.
+func (c *Client) Codec() codec.Codec {
+	if c.resolvedCodec != nil {
+		return c.resolvedCodec
+	}
+	return codec.NewJSONCodec()
 }
 
 type Frame struct {
 	Data      []byte
+	Event     string
+	ID        string
+	Retry     time.Duration
 	Timestamp time.Time
 }
 
@@ -40,6 +157,66 @@ type StreamOptions struct {
 	Context context.Context
 	Payload interface{}
 	Headers map[string]string
+
+	// Metrics, if non-nil, is updated in place as the stream progresses so
+	// callers can observe bytes read, frames emitted, and time to first byte.
+	Metrics *StreamMetrics
+
+	// PerEventDeadline, if non-zero, arms the Client's read deadline (see
+	// SetReadDeadline) before every frame read and re-arms it after each
+	// frame is emitted, so a stalled individual event (e.g. a hung
+	// tool-call delta) is reported as an error without requiring the caller
+	// to cancel Context and tear down the whole stream.
+	PerEventDeadline time.Duration
+
+	// Resumable enables auto-reconnect with Last-Event-ID replay for this
+	// call only, the per-call equivalent of Config.AutoReconnect. It has no
+	// effect if Config.AutoReconnect is already set.
+	Resumable bool
+
+	// Events, if non-nil, receives a ReconnectEvent for every reconnect
+	// lifecycle transition while this stream is auto-reconnecting
+	// (Config.AutoReconnect or Resumable). Sends are non-blocking: a caller
+	// that doesn't drain Events simply misses events rather than stalling
+	// the stream.
+	Events chan<- ReconnectEvent
+}
+
+// ReconnectEventKind identifies one reconnect lifecycle transition reported
+// on StreamOptions.Events.
+type ReconnectEventKind int
+
+const (
+	// Reconnecting is reported when a connection has ended or errored and
+	// the client is about to wait out the current backoff before retrying.
+	Reconnecting ReconnectEventKind = iota
+	// Reconnected is reported once a new connection opens successfully
+	// after one or more Reconnecting transitions.
+	Reconnected
+	// GaveUp is reported when MaxReconnects is exhausted or Context is
+	// done while waiting to retry; no further events follow it.
+	GaveUp
+)
+
+func (k ReconnectEventKind) String() string {
+	switch k {
+	case Reconnecting:
+		return "Reconnecting"
+	case Reconnected:
+		return "Reconnected"
+	case GaveUp:
+		return "GaveUp"
+	default:
+		return "Unknown"
+	}
+}
+
+// ReconnectEvent reports one reconnect lifecycle transition, including the
+// attempt number and the error (if any) that triggered it.
+type ReconnectEvent struct {
+	Kind    ReconnectEventKind
+	Attempt int
+	Err     error
 }
 
 func NewClient(config Config) *Client {
@@ -59,32 +236,204 @@ func NewClient(config Config) *Client {
 		config.BufferSize = 100
 	}
 
-	transport := &http.Transport{
-		DisableCompression:    true,
-		ExpectContinueTimeout: 0,
-		ResponseHeaderTimeout: config.ConnectTimeout,
-		DisableKeepAlives:     false,
-		MaxIdleConns:          1,
-		MaxIdleConnsPerHost:   1,
-		IdleConnTimeout:       90 * time.Second,
-		TLSHandshakeTimeout:   10 * time.Second,
+	if config.RetryInterval == 0 {
+		config.RetryInterval = 3 * time.Second
+	}
+
+	if config.MaxBackoff == 0 {
+		config.MaxBackoff = 30 * time.Second
+	}
+
+	transport := config.Transport
+	if transport == nil {
+		transport = &http.Transport{
+			DisableCompression:    true,
+			ExpectContinueTimeout: 0,
+			ResponseHeaderTimeout: config.ConnectTimeout,
+			DisableKeepAlives:     false,
+			MaxIdleConns:          1,
+			MaxIdleConnsPerHost:   1,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+		}
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if config.HTTP2 {
+		if _, err := configureHTTP2Transport(transport); err != nil && config.Logger != nil {
+			config.Logger.WithError(err).Warn("failed to configure HTTP/2 transport, falling back to HTTP/1.1")
+		}
 	}
+	roundTripper = chainRoundTripper(roundTripper, config.RequestMiddlewares)
 
 	httpClient := &http.Client{
-		Transport: transport,
+		Transport: roundTripper,
 		Timeout:   0,
 	}
 
 	return &Client{
-		config:     config,
-		httpClient: httpClient,
-		logger:     config.Logger,
+		config:        config,
+		httpClient:    httpClient,
+		logger:        config.Logger,
+		retryInterval: config.RetryInterval,
 	}
 }
 
-// Stream creates a basic SSE stream without reconnection
+// Stream creates an SSE stream. When Config.AutoReconnect is set, the
+// returned channels stay open across reconnects: on stream end or error the
+// client waits the current retry interval (with exponential backoff and
+// jitter on repeated failures), then reopens the request with a
+// Last-Event-ID header set to the most recently seen event ID.
+//
+// Stream predates the pull-based Open/Stream(struct) API and keeps its own
+// goroutine-plus-channel read loop for backward compatibility: a slow
+// consumer blocks that goroutine on a channel send rather than pausing the
+// underlying socket read. Callers that need explicit backpressure (stopping
+// the read when the consumer falls behind) should use Open instead.
 func (c *Client) Stream(opts StreamOptions) (<-chan Frame, <-chan error, error) {
-	return c.stream(opts)
+	if !c.config.AutoReconnect && !opts.Resumable {
+		return c.stream(opts)
+	}
+
+	if opts.Context == nil {
+		opts.Context = context.Background()
+	}
+
+	frames := make(chan Frame, c.config.BufferSize)
+	errCh := make(chan error, 1)
+
+	go c.streamWithReconnect(opts, frames, errCh)
+
+	return frames, errCh, nil
+}
+
+// streamWithReconnect drives one or more connections into the shared frames
+// and errCh channels, reconnecting according to Config.AutoReconnect rules.
+func (c *Client) streamWithReconnect(opts StreamOptions, frames chan<- Frame, errCh chan<- error) {
+	defer close(frames)
+	defer close(errCh)
+
+	backoff := c.retryInterval
+	attempts := 0
+	recovering := false
+
+	for {
+		innerFrames, innerErrs, err := c.stream(opts)
+		if err != nil {
+			recovering = true
+			c.emitReconnectEvent(opts.Events, ReconnectEvent{Kind: Reconnecting, Attempt: attempts + 1, Err: err})
+			if !c.scheduleReconnect(opts.Context, err, &attempts, &backoff, errCh) {
+				c.emitReconnectEvent(opts.Events, ReconnectEvent{Kind: GaveUp, Attempt: attempts, Err: err})
+				return
+			}
+			continue
+		}
+
+		if recovering {
+			c.emitReconnectEvent(opts.Events, ReconnectEvent{Kind: Reconnected, Attempt: attempts})
+			recovering = false
+		}
+
+		streamErr := c.pumpFrames(opts.Context, innerFrames, innerErrs, frames)
+		if streamErr == nil && opts.Context.Err() != nil {
+			return
+		}
+
+		backoff = c.retryInterval
+		recovering = true
+		c.emitReconnectEvent(opts.Events, ReconnectEvent{Kind: Reconnecting, Attempt: attempts + 1, Err: streamErr})
+		if !c.scheduleReconnect(opts.Context, streamErr, &attempts, &backoff, errCh) {
+			c.emitReconnectEvent(opts.Events, ReconnectEvent{Kind: GaveUp, Attempt: attempts, Err: streamErr})
+			return
+		}
+	}
+}
+
+// emitReconnectEvent sends evt on events without blocking, so a caller that
+// isn't draining StreamOptions.Events can't stall the reconnect loop.
+func (c *Client) emitReconnectEvent(events chan<- ReconnectEvent, evt ReconnectEvent) {
+	if events == nil {
+		return
+	}
+	select {
+	case events <- evt:
+	default:
+	}
+}
+
+// pumpFrames forwards frames from src to dst until src closes or ctx is done,
+// tracking the last event ID and retry interval as frames arrive.
+func (c *Client) pumpFrames(ctx context.Context, src <-chan Frame, srcErrs <-chan error, dst chan<- Frame) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case frame, ok := <-src:
+			if !ok {
+				return nil
+			}
+			if frame.ID != "" {
+				c.lastEventID = frame.ID
+			}
+			if frame.Retry > 0 {
+				c.retryInterval = frame.Retry
+			}
+			select {
+			case dst <- frame:
+			case <-ctx.Done():
+				return nil
+			}
+		case err := <-srcErrs:
+			return err
+		}
+	}
+}
+
+// scheduleReconnect waits out the current backoff before the next reconnect
+// attempt, doubling backoff (with jitter) on repeated failures and reporting
+// MaxReconnects exhaustion via errCh. If Config.ReconnectPolicy is set, it
+// replaces the wait computation entirely; backoff is still tracked so
+// behavior is unchanged if ReconnectPolicy is cleared mid-stream.
+func (c *Client) scheduleReconnect(ctx context.Context, err error, attempts *int, backoff *time.Duration, errCh chan<- error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if c.config.MaxReconnects > 0 && *attempts >= c.config.MaxReconnects {
+		select {
+		case errCh <- fmt.Errorf("max reconnect attempts (%d) exceeded", c.config.MaxReconnects):
+		case <-ctx.Done():
+		}
+		return false
+	}
+
+	var wait time.Duration
+	if c.config.ReconnectPolicy != nil {
+		wait = c.config.ReconnectPolicy(err, *attempts+1)
+	} else {
+		wait = *backoff
+		jitter := time.Duration(float64(wait) * 0.2 * (0.5 - randFraction()))
+		wait += jitter
+	}
+
+	select {
+	case <-time.After(wait):
+	case <-ctx.Done():
+		return false
+	}
+
+	*backoff *= 2
+	if *backoff > c.config.MaxBackoff {
+		*backoff = c.config.MaxBackoff
+	}
+	*attempts++
+	return true
+}
+
+// randFraction returns a pseudo-random value in [0, 1) used to jitter
+// reconnect backoff without pulling in a dedicated RNG dependency.
+func randFraction() float64 {
+	return float64(time.Now().UnixNano()%1000) / 1000
 }
 
 // stream is the internal implementation of basic streaming
@@ -93,23 +442,65 @@ func (c *Client) stream(opts StreamOptions) (<-chan Frame, <-chan error, error)
 		opts.Context = context.Background()
 	}
 
+	resp, cancel, decoder, err := c.negotiate(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	frames := make(chan Frame, c.config.BufferSize)
+	errors := make(chan error, 1)
+	handler := c.buildFrameHandler()
+
+	perEventDeadline := opts.PerEventDeadline
+	idleTimeout := false
+	if perEventDeadline == 0 && c.config.IdleReadTimeout > 0 {
+		perEventDeadline = c.config.IdleReadTimeout
+		idleTimeout = true
+	}
+
+	if perEventDeadline > 0 {
+		c.SetReadDeadline(time.Now().Add(perEventDeadline))
+	}
+
+	if _, ok := decoder.(*SSEDecoder); ok && c.config.Decoder == nil {
+		go c.readStream(opts.Context, resp, cancel, frames, errors, opts.Metrics, handler, perEventDeadline, idleTimeout)
+	} else {
+		go c.readStreamWithDecoder(opts.Context, resp, cancel, frames, errors, opts.Metrics, decoder, handler, perEventDeadline, idleTimeout)
+	}
+
+	return frames, errors, nil
+}
+
+// do builds and executes the POST request that opens a stream, returning the
+// raw *http.Response once a 200 status has been confirmed, along with the
+// CancelFunc for the request's context. Canceling a request's context after
+// its headers arrive also aborts any in-progress read of its body, so do
+// does not defer that cancel itself - the returned CancelFunc is the
+// caller's to invoke once the body has been fully consumed or the stream is
+// aborted (mirroring Stream's own cancel field in stream.go). Every error
+// path below returns before handing the CancelFunc off, so do cancels it
+// itself in each of those cases instead.
+func (c *Client) do(opts StreamOptions) (*http.Response, context.CancelFunc, error) {
 	payloadBytes, err := json.Marshal(opts.Payload)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal payload: %w", err)
 	}
 
+	reqCtx, cancel := context.WithCancel(opts.Context)
+
 	req, err := http.NewRequestWithContext(
-		opts.Context,
+		reqCtx,
 		http.MethodPost,
 		c.config.Endpoint,
 		bytes.NewReader(payloadBytes),
 	)
 	if err != nil {
+		cancel()
 		return nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Accept", acceptHeader())
 	req.Header.Set("Cache-Control", "no-cache")
 	req.Header.Set("Connection", "keep-alive")
 
@@ -137,6 +528,10 @@ func (c *Client) stream(opts StreamOptions) (<-chan Frame, <-chan error, error)
 		req.Header.Set(key, value)
 	}
 
+	if c.lastEventID != "" {
+		req.Header.Set("Last-Event-ID", c.lastEventID)
+	}
+
 	if c.logger != nil {
 		c.logger.WithFields(logrus.Fields{
 			"endpoint": c.config.Endpoint,
@@ -145,22 +540,93 @@ func (c *Client) stream(opts StreamOptions) (<-chan Frame, <-chan error, error)
 		}).Debug("Initiating SSE connection")
 	}
 
-	resp, err := c.httpClient.Do(req)
+	type doResult struct {
+		resp *http.Response
+		err  error
+	}
+	doCh := make(chan doResult, 1)
+	go func() {
+		resp, err := c.httpClient.Do(req)
+		doCh <- doResult{resp: resp, err: err}
+	}()
+
+	var result doResult
+	select {
+	case result = <-doCh:
+	case <-c.writeDeadline.channel():
+		// Preempt the in-flight write/connect without closing the Client:
+		// cancel just this request's context and drain doCh so the
+		// goroutine above doesn't leak.
+		cancel()
+		<-doCh
+		return nil, nil, fmt.Errorf("write deadline exceeded")
+	}
+
+	resp, err := result.resp, result.err
 	if err != nil {
+		cancel()
 		return nil, nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		_ = resp.Body.Close()
+		cancel()
 		return nil, nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(body))
 	}
 
+	return resp, cancel, nil
+}
+
+// acceptHeader builds the Accept header value for the initial POST:
+// "text/event-stream" first (the default and only transport framing every
+// AG-UI server is guaranteed to support), followed by every codec.Codec
+// registered in codec.DefaultRegistry as a lower-priority alternate, so a
+// server that supports responding with a payload-codec-specific
+// Content-Type (e.g. "application/x-protobuf" over gRPC-Web framing) knows
+// the client can consume it.
+func acceptHeader() string {
+	accept := "text/event-stream"
+	for _, ct := range codec.DefaultRegistry.ContentTypes() {
+		accept += ", " + ct + ";q=0.9"
+	}
+	return accept
+}
+
+// negotiate performs the HTTP round trip via do and picks the FrameDecoder
+// for the response, shared by the channel-based stream() and the pull-based
+// Open(). The returned CancelFunc is do's request-context cancel, passed
+// through unchanged; callers own invoking it once they're done reading resp.Body.
+func (c *Client) negotiate(opts StreamOptions) (*http.Response, context.CancelFunc, FrameDecoder, error) {
+	resp, cancel, err := c.do(opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
 	contentType := resp.Header.Get("Content-Type")
-	if !strings.HasPrefix(contentType, "text/event-stream") {
+
+	decoder := c.config.Decoder
+	if decoder == nil {
+		decoder = decoderForContentType(contentType)
+	}
+	if decoder == nil {
 		_ = resp.Body.Close()
-		return nil, nil, fmt.Errorf("unexpected content-type: %s", contentType)
+		cancel()
+		return nil, nil, nil, fmt.Errorf("unexpected content-type: %s (supported: %s)", contentType, strings.Join(SupportedContentTypes(), ", "))
+	}
+
+	payloadCodec := c.config.Codec
+	if payloadCodec == nil {
+		var ok bool
+		payloadCodec, ok = codec.Lookup(contentType)
+		if !ok {
+			// contentType describes transport framing only (e.g.
+			// "text/event-stream"), not a payload codec; JSON is the
+			// format every AG-UI server has historically emitted on it.
+			payloadCodec = codec.NewJSONCodec()
+		}
 	}
+	c.resolvedCodec = payloadCodec
 
 	if c.logger != nil {
 		c.logger.WithFields(logrus.Fields{
@@ -169,17 +635,13 @@ func (c *Client) stream(opts StreamOptions) (<-chan Frame, <-chan error, error)
 		}).Info("SSE connection established")
 	}
 
-	frames := make(chan Frame, c.config.BufferSize)
-	errors := make(chan error, 1)
-
-	go c.readStream(opts.Context, resp, frames, errors)
-
-	return frames, errors, nil
+	return resp, cancel, decoder, nil
 }
 
-func (c *Client) readStream(ctx context.Context, resp *http.Response, frames chan<- Frame, errors chan<- error) {
+func (c *Client) readStream(ctx context.Context, resp *http.Response, cancel context.CancelFunc, frames chan<- Frame, errors chan<- error, metrics *StreamMetrics, handler FrameHandler, perEventDeadline time.Duration, idleTimeout bool) {
 	defer func() {
 		_ = resp.Body.Close()
+		cancel()
 		close(frames)
 		close(errors)
 		if c.logger != nil {
@@ -188,7 +650,9 @@ func (c *Client) readStream(ctx context.Context, resp *http.Response, frames cha
 	}()
 
 	reader := bufio.NewReader(resp.Body)
-	var buffer bytes.Buffer
+	var dataBuffer bytes.Buffer
+	var eventType, eventID string
+	var retry time.Duration
 	var frameCount int64
 	var byteCount int64
 	startTime := time.Now()
@@ -221,6 +685,7 @@ func (c *Client) readStream(ctx context.Context, resp *http.Response, frames cha
 
 		// Wait for read result with timeout
 		var result readResult
+		deadlineCh := c.readDeadline.channel()
 		if c.config.ReadTimeout > 0 {
 			select {
 			case result = <-readCh:
@@ -232,6 +697,12 @@ func (c *Client) readStream(ctx context.Context, resp *http.Response, frames cha
 				case <-ctx.Done():
 				}
 				return
+			case <-deadlineCh:
+				select {
+				case errors <- c.deadlineExceededErr(idleTimeout, perEventDeadline):
+				case <-ctx.Done():
+				}
+				return
 			case <-ctx.Done():
 				return
 			}
@@ -239,11 +710,21 @@ func (c *Client) readStream(ctx context.Context, resp *http.Response, frames cha
 			select {
 			case result = <-readCh:
 				// Got result
+			case <-deadlineCh:
+				select {
+				case errors <- c.deadlineExceededErr(idleTimeout, perEventDeadline):
+				case <-ctx.Done():
+				}
+				return
 			case <-ctx.Done():
 				return
 			}
 		}
 
+		if perEventDeadline > 0 {
+			c.SetReadDeadline(time.Now().Add(perEventDeadline))
+		}
+
 		if result.err != nil {
 			if result.err == io.EOF {
 				if c.logger != nil {
@@ -264,45 +745,235 @@ func (c *Client) readStream(ctx context.Context, resp *http.Response, frames cha
 
 		line := result.line
 
+		if byteCount == 0 && metrics != nil {
+			atomic.StoreInt64((*int64)(&metrics.TimeToFirstByte), int64(time.Since(startTime)))
+		}
+
 		byteCount += int64(len(line))
+		if metrics != nil {
+			atomic.StoreInt64(&metrics.BytesRead, byteCount)
+		}
 		line = bytes.TrimSuffix(line, []byte("\n"))
 		line = bytes.TrimSuffix(line, []byte("\r"))
 
 		if len(line) == 0 {
-			if buffer.Len() > 0 {
+			if dataBuffer.Len() > 0 {
+				event := eventType
+				if event == "" {
+					event = "message"
+				}
+
 				frame := Frame{
-					Data:      make([]byte, buffer.Len()),
+					Data:      make([]byte, dataBuffer.Len()),
+					Event:     event,
+					ID:        eventID,
+					Retry:     retry,
 					Timestamp: time.Now(),
 				}
-				copy(frame.Data, buffer.Bytes())
-				buffer.Reset()
+				copy(frame.Data, dataBuffer.Bytes())
 
-				select {
-				case frames <- frame:
-					frameCount++
-					if frameCount%100 == 0 && c.logger != nil {
-						c.logger.WithFields(logrus.Fields{
-							"frames": frameCount,
-							"bytes":  byteCount,
-						}).Debug("SSE stream progress")
+				out, keep, err := handler(ctx, frame)
+				if err != nil {
+					select {
+					case errors <- fmt.Errorf("frame middleware error: %w", err):
+					case <-ctx.Done():
 					}
-				case <-ctx.Done():
 					return
 				}
+
+				if keep {
+					select {
+					case frames <- out:
+						frameCount++
+						if metrics != nil {
+							atomic.StoreInt64(&metrics.FramesEmitted, frameCount)
+						}
+						if frameCount%100 == 0 && c.logger != nil {
+							c.logger.WithFields(logrus.Fields{
+								"frames": frameCount,
+								"bytes":  byteCount,
+							}).Debug("SSE stream progress")
+						}
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+			dataBuffer.Reset()
+			eventType = ""
+			retry = 0
+			continue
+		}
+
+		// Comment lines (keep-alive pings) start with a colon and carry no field
+		if bytes.HasPrefix(line, []byte(":")) {
+			continue
+		}
+
+		field, value := parseSSEField(line)
+		switch field {
+		case "data":
+			if dataBuffer.Len() > 0 {
+				dataBuffer.WriteByte('\n')
+			}
+			dataBuffer.Write(value)
+		case "event":
+			eventType = string(value)
+		case "id":
+			eventID = string(value)
+			c.lastEventID = eventID
+		case "retry":
+			if ms, err := strconv.Atoi(string(value)); err == nil {
+				retry = time.Duration(ms) * time.Millisecond
+				c.retryInterval = retry
+			}
+		}
+	}
+}
+
+// readStreamWithDecoder drives an explicitly-registered or negotiated
+// FrameDecoder other than the default SSEDecoder, applying the same
+// ReadTimeout and metrics bookkeeping as readStream but decoding whole
+// frames per iteration instead of individual lines.
+func (c *Client) readStreamWithDecoder(ctx context.Context, resp *http.Response, cancel context.CancelFunc, frames chan<- Frame, errors chan<- error, metrics *StreamMetrics, decoder FrameDecoder, handler FrameHandler, perEventDeadline time.Duration, idleTimeout bool) {
+	defer func() {
+		_ = resp.Body.Close()
+		cancel()
+		close(frames)
+		close(errors)
+		if c.logger != nil {
+			c.logger.Info("SSE connection closed")
+		}
+	}()
+
+	reader := bufio.NewReader(resp.Body)
+	var frameCount int64
+	startTime := time.Now()
+
+	type decodeResult struct {
+		frame Frame
+		err   error
+	}
+	decodeCh := make(chan decodeResult)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		go func() {
+			frame, err := decoder.Decode(reader)
+			select {
+			case decodeCh <- decodeResult{frame: frame, err: err}:
+			case <-ctx.Done():
+			}
+		}()
+
+		var result decodeResult
+		deadlineCh := c.readDeadline.channel()
+		if c.config.ReadTimeout > 0 {
+			select {
+			case result = <-decodeCh:
+			case <-time.After(c.config.ReadTimeout):
+				select {
+				case errors <- fmt.Errorf("read timeout after %v", c.config.ReadTimeout):
+				case <-ctx.Done():
+				}
+				return
+			case <-deadlineCh:
+				select {
+				case errors <- c.deadlineExceededErr(idleTimeout, perEventDeadline):
+				case <-ctx.Done():
+				}
+				return
+			case <-ctx.Done():
+				return
+			}
+		} else {
+			select {
+			case result = <-decodeCh:
+			case <-deadlineCh:
+				select {
+				case errors <- c.deadlineExceededErr(idleTimeout, perEventDeadline):
+				case <-ctx.Done():
+				}
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if perEventDeadline > 0 {
+			c.SetReadDeadline(time.Now().Add(perEventDeadline))
+		}
+
+		if result.err != nil {
+			if result.err == io.EOF {
+				if c.logger != nil {
+					c.logger.WithFields(logrus.Fields{
+						"frames":   frameCount,
+						"duration": time.Since(startTime),
+					}).Info("stream ended (EOF)")
+				}
+				return
+			}
+			select {
+			case errors <- fmt.Errorf("read error: %w", result.err):
+			case <-ctx.Done():
 			}
+			return
+		}
+
+		if metrics != nil {
+			if frameCount == 0 {
+				atomic.StoreInt64((*int64)(&metrics.TimeToFirstByte), int64(time.Since(startTime)))
+			}
+			atomic.AddInt64(&metrics.BytesRead, int64(len(result.frame.Data)))
+		}
+
+		out, keep, err := handler(ctx, result.frame)
+		if err != nil {
+			select {
+			case errors <- fmt.Errorf("frame middleware error: %w", err):
+			case <-ctx.Done():
+			}
+			return
+		}
+		if !keep {
 			continue
 		}
 
-		if bytes.HasPrefix(line, []byte("data: ")) {
-			data := bytes.TrimPrefix(line, []byte("data: "))
-			if buffer.Len() > 0 {
-				buffer.WriteByte('\n')
+		select {
+		case frames <- out:
+			frameCount++
+			if metrics != nil {
+				atomic.StoreInt64(&metrics.FramesEmitted, frameCount)
 			}
-			buffer.Write(data)
+		case <-ctx.Done():
+			return
 		}
 	}
 }
 
+// parseSSEField splits a raw SSE line of the form "field: value" into its
+// field name and value, stripping a single leading space from the value per
+// the WHATWG EventSource spec. Lines with no colon are treated as a field
+// name with an empty value.
+func parseSSEField(line []byte) (field string, value []byte) {
+	idx := bytes.IndexByte(line, ':')
+	if idx < 0 {
+		return string(line), nil
+	}
+	field = string(line[:idx])
+	value = line[idx+1:]
+	if len(value) > 0 && value[0] == ' ' {
+		value = value[1:]
+	}
+	return field, value
+}
+
 func (c *Client) Close() error {
 	c.httpClient.CloseIdleConnections()
 	return nil
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -20,42 +21,44 @@ func TestStream(t *testing.T) {
 	t.Run("successful stream", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
-			assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
-			
+			accept := r.Header.Get("Accept")
+			assert.True(t, strings.HasPrefix(accept, "text/event-stream"))
+			assert.Contains(t, accept, "application/json")
+
 			w.Header().Set("Content-Type", "text/event-stream")
 			w.WriteHeader(http.StatusOK)
-			
+
 			flusher, ok := w.(http.Flusher)
 			require.True(t, ok)
-			
+
 			fmt.Fprintf(w, "data: first message\n\n")
 			flusher.Flush()
-			
+
 			fmt.Fprintf(w, "data: second message\n\n")
 			flusher.Flush()
-			
+
 			fmt.Fprintf(w, "data: {\"type\":\"json\",\"value\":123}\n\n")
 			flusher.Flush()
 		}))
 		defer server.Close()
-		
+
 		client := NewClient(Config{
 			Endpoint:   server.URL,
 			BufferSize: 10,
 		})
-		
+
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
-		
+
 		frames, errors, err := client.Stream(StreamOptions{
 			Context: ctx,
 			Payload: map[string]string{"test": "data"},
 		})
 		require.NoError(t, err)
-		
+
 		var received []string
 		done := make(chan bool)
-		
+
 		go func() {
 			for {
 				select {
@@ -75,41 +78,41 @@ func TestStream(t *testing.T) {
 				}
 			}
 		}()
-		
+
 		<-done
 		assert.Len(t, received, 3)
 		assert.Contains(t, received, "first message")
 		assert.Contains(t, received, "second message")
 		assert.Contains(t, received, `{"type":"json","value":123}`)
 	})
-	
+
 	t.Run("multiline data handling", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", "text/event-stream")
 			w.WriteHeader(http.StatusOK)
-			
+
 			flusher, ok := w.(http.Flusher)
 			require.True(t, ok)
-			
+
 			// Send multiline data
 			fmt.Fprintf(w, "data: line1\ndata: line2\ndata: line3\n\n")
 			flusher.Flush()
 		}))
 		defer server.Close()
-		
+
 		client := NewClient(Config{
 			Endpoint: server.URL,
 		})
-		
+
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
 		defer cancel()
-		
+
 		frames, _, err := client.Stream(StreamOptions{
 			Context: ctx,
 			Payload: struct{}{},
 		})
 		require.NoError(t, err)
-		
+
 		select {
 		case frame := <-frames:
 			assert.Equal(t, "line1\nline2\nline3", string(frame.Data))
@@ -117,7 +120,7 @@ func TestStream(t *testing.T) {
 			t.Fatal("timeout waiting for frame")
 		}
 	})
-	
+
 	t.Run("authentication headers", func(t *testing.T) {
 		tests := []struct {
 			name           string
@@ -152,7 +155,7 @@ func TestStream(t *testing.T) {
 				expectedValue:  "test-key",
 			},
 		}
-		
+
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
 				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -161,13 +164,13 @@ func TestStream(t *testing.T) {
 					w.WriteHeader(http.StatusOK)
 				}))
 				defer server.Close()
-				
+
 				tt.config.Endpoint = server.URL
 				client := NewClient(tt.config)
-				
+
 				ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
 				defer cancel()
-				
+
 				_, _, err := client.Stream(StreamOptions{
 					Context: ctx,
 					Payload: struct{}{},
@@ -176,7 +179,7 @@ func TestStream(t *testing.T) {
 			})
 		}
 	})
-	
+
 	t.Run("custom headers", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			assert.Equal(t, "custom-value", r.Header.Get("X-Custom-Header"))
@@ -185,14 +188,14 @@ func TestStream(t *testing.T) {
 			w.WriteHeader(http.StatusOK)
 		}))
 		defer server.Close()
-		
+
 		client := NewClient(Config{
 			Endpoint: server.URL,
 		})
-		
+
 		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
 		defer cancel()
-		
+
 		_, _, err := client.Stream(StreamOptions{
 			Context: ctx,
 			Payload: struct{}{},
@@ -203,7 +206,7 @@ func TestStream(t *testing.T) {
 		})
 		require.NoError(t, err)
 	})
-	
+
 	t.Run("error responses", func(t *testing.T) {
 		tests := []struct {
 			name         string
@@ -233,7 +236,7 @@ func TestStream(t *testing.T) {
 				expectedErr: "unexpected content-type: application/json",
 			},
 		}
-		
+
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
 				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -246,11 +249,11 @@ func TestStream(t *testing.T) {
 					}
 				}))
 				defer server.Close()
-				
+
 				client := NewClient(Config{
 					Endpoint: server.URL,
 				})
-				
+
 				_, _, err := client.Stream(StreamOptions{
 					Payload: struct{}{},
 				})
@@ -259,15 +262,15 @@ func TestStream(t *testing.T) {
 			})
 		}
 	})
-	
+
 	t.Run("context cancellation", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", "text/event-stream")
 			w.WriteHeader(http.StatusOK)
-			
+
 			flusher, ok := w.(http.Flusher)
 			require.True(t, ok)
-			
+
 			// Send data slowly
 			for i := 0; i < 100; i++ {
 				fmt.Fprintf(w, "data: message %d\n\n", i)
@@ -276,20 +279,20 @@ func TestStream(t *testing.T) {
 			}
 		}))
 		defer server.Close()
-		
+
 		client := NewClient(Config{
 			Endpoint: server.URL,
 		})
-		
+
 		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
 		defer cancel()
-		
+
 		frames, errors, err := client.Stream(StreamOptions{
 			Context: ctx,
 			Payload: struct{}{},
 		})
 		require.NoError(t, err)
-		
+
 		messageCount := 0
 		for {
 			select {
@@ -308,67 +311,67 @@ func TestStream(t *testing.T) {
 			}
 		}
 	})
-	
+
 	t.Run("invalid payload marshaling", func(t *testing.T) {
 		client := NewClient(Config{
 			Endpoint: "http://localhost",
 		})
-		
+
 		// Create an unmarshalable payload
 		invalidPayload := make(chan int)
-		
+
 		_, _, err := client.Stream(StreamOptions{
 			Payload: invalidPayload,
 		})
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to marshal payload")
 	})
-	
+
 	t.Run("invalid endpoint", func(t *testing.T) {
 		client := NewClient(Config{
 			Endpoint: "http://[::1]:namedport", // Invalid URL
 		})
-		
+
 		_, _, err := client.Stream(StreamOptions{
 			Payload: struct{}{},
 		})
 		require.Error(t, err)
 	})
-	
+
 	t.Run("concurrent reads", func(t *testing.T) {
 		messageCount := 50
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", "text/event-stream")
 			w.WriteHeader(http.StatusOK)
-			
+
 			flusher, ok := w.(http.Flusher)
 			require.True(t, ok)
-			
+
 			for i := 0; i < messageCount; i++ {
 				fmt.Fprintf(w, "data: message-%d\n\n", i)
 				flusher.Flush()
 			}
 		}))
 		defer server.Close()
-		
+
 		client := NewClient(Config{
 			Endpoint:   server.URL,
 			BufferSize: 100,
 		})
-		
+
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
-		
+
 		frames, _, err := client.Stream(StreamOptions{
 			Context: ctx,
 			Payload: struct{}{},
 		})
 		require.NoError(t, err)
-		
+
 		var wg sync.WaitGroup
 		received := make(map[string]bool)
 		mu := sync.Mutex{}
-		
+
 		// Start multiple goroutines to read frames
 		for i := 0; i < 5; i++ {
 			wg.Add(1)
@@ -381,42 +384,42 @@ func TestStream(t *testing.T) {
 				}
 			}()
 		}
-		
+
 		wg.Wait()
 		assert.Len(t, received, messageCount)
 	})
-	
+
 	t.Run("read timeout handling", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", "text/event-stream")
 			w.WriteHeader(http.StatusOK)
-			
+
 			flusher, ok := w.(http.Flusher)
 			require.True(t, ok)
-			
+
 			// Send one message then hang
 			fmt.Fprintf(w, "data: initial\n\n")
 			flusher.Flush()
-			
+
 			// Simulate a hung connection
 			time.Sleep(5 * time.Second)
 		}))
 		defer server.Close()
-		
+
 		client := NewClient(Config{
 			Endpoint:    server.URL,
 			ReadTimeout: 500 * time.Millisecond,
 		})
-		
+
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
-		
+
 		frames, errors, err := client.Stream(StreamOptions{
 			Context: ctx,
 			Payload: struct{}{},
 		})
 		require.NoError(t, err)
-		
+
 		// Should receive initial message
 		select {
 		case frame := <-frames:
@@ -424,7 +427,7 @@ func TestStream(t *testing.T) {
 		case <-time.After(1 * time.Second):
 			t.Fatal("timeout waiting for initial frame")
 		}
-		
+
 		// Should eventually get an error or channel closure due to read timeout
 		select {
 		case <-frames:
@@ -435,51 +438,51 @@ func TestStream(t *testing.T) {
 			t.Fatal("timeout waiting for error or closure")
 		}
 	})
-	
+
 	t.Run("logger output", func(t *testing.T) {
 		var logBuffer bytes.Buffer
 		logger := logrus.New()
 		logger.SetOutput(&logBuffer)
 		logger.SetLevel(logrus.DebugLevel)
-		
+
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", "text/event-stream")
 			w.WriteHeader(http.StatusOK)
-			
+
 			flusher, ok := w.(http.Flusher)
 			require.True(t, ok)
-			
+
 			for i := 0; i < 150; i++ {
 				fmt.Fprintf(w, "data: msg%d\n\n", i)
 				flusher.Flush()
 			}
 		}))
 		defer server.Close()
-		
+
 		client := NewClient(Config{
 			Endpoint: server.URL,
 			Logger:   logger,
 		})
-		
+
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
 		defer cancel()
-		
+
 		frames, _, err := client.Stream(StreamOptions{
 			Context: ctx,
 			Payload: struct{}{},
 		})
 		require.NoError(t, err)
-		
+
 		// Consume all frames
 		go func() {
 			for range frames {
 			}
 		}()
-		
+
 		time.Sleep(500 * time.Millisecond)
 		cancel()
 		time.Sleep(100 * time.Millisecond)
-		
+
 		logs := logBuffer.String()
 		assert.Contains(t, logs, "Initiating SSE connection")
 		assert.Contains(t, logs, "SSE connection established")
@@ -493,20 +496,20 @@ func TestReadStream(t *testing.T) {
 		resp := &http.Response{
 			Body: pr,
 		}
-		
+
 		client := NewClient(Config{})
 		frames := make(chan Frame, 10)
 		errors := make(chan error, 1)
-		
-		go client.readStream(context.Background(), resp, frames, errors)
-		
+
+		go client.readStream(context.Background(), resp, func() {}, frames, errors, nil, FrameHandler(passthroughFrameHandler), 0, false)
+
 		// Write some data then close
 		go func() {
 			pw.Write([]byte("data: test\n\n"))
 			time.Sleep(100 * time.Millisecond)
 			pw.Close()
 		}()
-		
+
 		// Should receive one frame
 		select {
 		case frame := <-frames:
@@ -514,7 +517,7 @@ func TestReadStream(t *testing.T) {
 		case <-time.After(1 * time.Second):
 			t.Fatal("timeout waiting for frame")
 		}
-		
+
 		// Channels should be closed after EOF
 		select {
 		case _, ok := <-frames:
@@ -523,29 +526,29 @@ func TestReadStream(t *testing.T) {
 			t.Fatal("frames channel not closed")
 		}
 	})
-	
+
 	t.Run("carriage return handling", func(t *testing.T) {
 		pr, pw := io.Pipe()
 		resp := &http.Response{
 			Body: pr,
 		}
-		
+
 		client := NewClient(Config{})
 		frames := make(chan Frame, 10)
 		errors := make(chan error, 1)
-		
+
 		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
 		defer cancel()
-		
-		go client.readStream(ctx, resp, frames, errors)
-		
+
+		go client.readStream(ctx, resp, func() {}, frames, errors, nil, FrameHandler(passthroughFrameHandler), 0, false)
+
 		// Write data with carriage returns
 		go func() {
 			pw.Write([]byte("data: test\r\n\r\n"))
 			time.Sleep(100 * time.Millisecond)
 			pw.Close()
 		}()
-		
+
 		select {
 		case frame := <-frames:
 			assert.Equal(t, "test", string(frame.Data))
@@ -553,29 +556,29 @@ func TestReadStream(t *testing.T) {
 			t.Fatal("timeout waiting for frame")
 		}
 	})
-	
+
 	t.Run("empty lines between data", func(t *testing.T) {
 		pr, pw := io.Pipe()
 		resp := &http.Response{
 			Body: pr,
 		}
-		
+
 		client := NewClient(Config{})
 		frames := make(chan Frame, 10)
 		errors := make(chan error, 1)
-		
+
 		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
 		defer cancel()
-		
-		go client.readStream(ctx, resp, frames, errors)
-		
+
+		go client.readStream(ctx, resp, func() {}, frames, errors, nil, FrameHandler(passthroughFrameHandler), 0, false)
+
 		go func() {
 			// Multiple empty lines should be ignored
 			pw.Write([]byte("\n\n\ndata: test\n\n\n\n"))
 			time.Sleep(100 * time.Millisecond)
 			pw.Close()
 		}()
-		
+
 		select {
 		case frame := <-frames:
 			assert.Equal(t, "test", string(frame.Data))
@@ -583,32 +586,63 @@ func TestReadStream(t *testing.T) {
 			t.Fatal("timeout waiting for frame")
 		}
 	})
-	
-	t.Run("non-data lines ignored", func(t *testing.T) {
+
+	t.Run("non-data fields populate Frame metadata", func(t *testing.T) {
 		pr, pw := io.Pipe()
 		resp := &http.Response{
 			Body: pr,
 		}
-		
+
 		client := NewClient(Config{})
 		frames := make(chan Frame, 10)
 		errors := make(chan error, 1)
-		
+
 		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
 		defer cancel()
-		
-		go client.readStream(ctx, resp, frames, errors)
-		
+
+		go client.readStream(ctx, resp, func() {}, frames, errors, nil, FrameHandler(passthroughFrameHandler), 0, false)
+
 		go func() {
-			// Lines without "data: " prefix should be ignored
+			// event:, id:, and retry: fields should populate Frame metadata
 			pw.Write([]byte("event: custom\nid: 123\nretry: 1000\ndata: actual-data\n\n"))
 			time.Sleep(100 * time.Millisecond)
 			pw.Close()
 		}()
-		
+
 		select {
 		case frame := <-frames:
 			assert.Equal(t, "actual-data", string(frame.Data))
+			assert.Equal(t, "custom", frame.Event)
+			assert.Equal(t, "123", frame.ID)
+			assert.Equal(t, 1000*time.Millisecond, frame.Retry)
+			assert.Equal(t, "123", client.lastEventID)
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for frame")
+		}
+	})
+
+	t.Run("missing event type defaults to message", func(t *testing.T) {
+		pr, pw := io.Pipe()
+		resp := &http.Response{Body: pr}
+
+		client := NewClient(Config{})
+		frames := make(chan Frame, 10)
+		errors := make(chan error, 1)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+
+		go client.readStream(ctx, resp, func() {}, frames, errors, nil, FrameHandler(passthroughFrameHandler), 0, false)
+
+		go func() {
+			pw.Write([]byte("data: plain\n\n"))
+			time.Sleep(100 * time.Millisecond)
+			pw.Close()
+		}()
+
+		select {
+		case frame := <-frames:
+			assert.Equal(t, "message", frame.Event)
 		case <-time.After(1 * time.Second):
 			t.Fatal("timeout waiting for frame")
 		}
@@ -640,17 +674,17 @@ func TestReadStreamWithErrors(t *testing.T) {
 			data: []byte("data: partial\n"),
 			err:  fmt.Errorf("network error"),
 		}
-		
+
 		resp := &http.Response{
 			Body: io.NopCloser(reader),
 		}
-		
+
 		client := NewClient(Config{})
 		frames := make(chan Frame, 10)
 		errors := make(chan error, 1)
-		
-		go client.readStream(context.Background(), resp, frames, errors)
-		
+
+		go client.readStream(context.Background(), resp, func() {}, frames, errors, nil, FrameHandler(passthroughFrameHandler), 0, false)
+
 		select {
 		case err := <-errors:
 			assert.Contains(t, err.Error(), "read error")
@@ -666,29 +700,29 @@ func BenchmarkStream(b *testing.B) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "text/event-stream")
 		w.WriteHeader(http.StatusOK)
-		
+
 		flusher, ok := w.(http.Flusher)
 		if !ok {
 			return
 		}
-		
+
 		for i := 0; i < 1000; i++ {
 			fmt.Fprintf(w, "data: message %d with some payload data\n\n", i)
 			flusher.Flush()
 		}
 	}))
 	defer server.Close()
-	
+
 	client := NewClient(Config{
 		Endpoint:   server.URL,
 		BufferSize: 100,
 	})
-	
+
 	b.ResetTimer()
-	
+
 	for i := 0; i < b.N; i++ {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		
+
 		frames, _, err := client.Stream(StreamOptions{
 			Context: ctx,
 			Payload: struct{}{},
@@ -696,7 +730,7 @@ func BenchmarkStream(b *testing.B) {
 		if err != nil {
 			b.Fatal(err)
 		}
-		
+
 		count := 0
 		for range frames {
 			count++
@@ -710,22 +744,22 @@ func BenchmarkStream(b *testing.B) {
 
 func BenchmarkReadStream(b *testing.B) {
 	data := bytes.Repeat([]byte("data: benchmark message with some test data\n\n"), 1000)
-	
+
 	b.ResetTimer()
-	
+
 	for i := 0; i < b.N; i++ {
 		frames := make(chan Frame, 100)
 		errors := make(chan error, 1)
-		
+
 		resp := &http.Response{
 			Body: io.NopCloser(bytes.NewReader(data)),
 		}
-		
+
 		client := NewClient(Config{})
-		
+
 		ctx, cancel := context.WithCancel(context.Background())
-		go client.readStream(ctx, resp, frames, errors)
-		
+		go client.readStream(ctx, resp, func() {}, frames, errors, nil, FrameHandler(passthroughFrameHandler), 0, false)
+
 		count := 0
 		for range frames {
 			count++
@@ -735,4 +769,4 @@ func BenchmarkReadStream(b *testing.B) {
 			}
 		}
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,69 @@
+package sse
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrIdleTimeout is the error wrapped into a stream's error channel when
+// Config.IdleReadTimeout (and not an explicit StreamOptions.
+// PerEventDeadline) is what caused the read deadline to fire - i.e. the
+// server simply stopped sending frames without closing the connection.
+var ErrIdleTimeout = errors.New("sse: no frame received within idle read timeout")
+
+// deadlineExceededErr reports a fired read deadline as ErrIdleTimeout when
+// it came from Config.IdleReadTimeout, or as a generic per-event-deadline
+// error when the caller set StreamOptions.PerEventDeadline explicitly -
+// callers distinguishing a stuck connection from an intentionally tight
+// per-call deadline can errors.Is(err, ErrIdleTimeout).
+func (c *Client) deadlineExceededErr(idleTimeout bool, d time.Duration) error {
+	if idleTimeout {
+		return fmt.Errorf("%w: %v", ErrIdleTimeout, d)
+	}
+	return fmt.Errorf("per-event deadline exceeded")
+}
+
+// deadlineTimer arms a cancelable timer channel that closes when it fires,
+// modeled on how net.Conn's SetReadDeadline/SetWriteDeadline let a caller
+// preempt a single in-flight I/O call without closing the underlying
+// connection. Re-arming (via set) replaces the channel returned by any
+// earlier call to channel, so a goroutine that captured the old channel
+// keeps waiting on a deadline that will never fire again - callers must
+// re-fetch channel() after every set.
+type deadlineTimer struct {
+	mu    sync.Mutex
+	timer *time.Timer
+	c     chan struct{}
+}
+
+// set arms the timer to fire at t, replacing any previously armed deadline.
+// A zero t clears the deadline.
+func (d *deadlineTimer) set(t time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.timer != nil {
+		d.timer.Stop()
+		d.timer = nil
+		d.c = nil
+	}
+
+	if t.IsZero() {
+		return
+	}
+
+	c := make(chan struct{})
+	d.c = c
+	d.timer = time.AfterFunc(time.Until(t), func() { close(c) })
+}
+
+// channel returns the channel for the currently armed deadline, or nil if
+// none is set. A nil channel blocks forever in a select, so callers can
+// include it unconditionally.
+func (d *deadlineTimer) channel() <-chan struct{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.c
+}
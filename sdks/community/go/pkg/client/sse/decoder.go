@@ -0,0 +1,167 @@
+package sse
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FrameDecoder parses one transport's wire format into Frame values.
+// Implementations are stateful where the wire format requires it (e.g. SSE's
+// blank-line dispatch spans multiple reads), so a single FrameDecoder
+// instance must be reused across calls to Decode for the lifetime of one
+// stream. Decode blocks until a full frame is available and returns io.EOF
+// (or a wrapped io.EOF) when the stream ends cleanly between frames.
+type FrameDecoder interface {
+	Decode(r *bufio.Reader) (Frame, error)
+}
+
+// SSEDecoder implements the WHATWG EventSource framing: "field: value" lines
+// terminated by a blank line, with ":"-prefixed comment lines ignored.
+type SSEDecoder struct {
+	dataBuffer bytes.Buffer
+	eventType  string
+	eventID    string
+	retry      time.Duration
+}
+
+// NewSSEDecoder returns a FrameDecoder for text/event-stream responses.
+func NewSSEDecoder() *SSEDecoder {
+	return &SSEDecoder{}
+}
+
+func (d *SSEDecoder) Decode(r *bufio.Reader) (Frame, error) {
+	for {
+		line, err := r.ReadBytes('\n')
+		if err != nil {
+			return Frame{}, err
+		}
+
+		line = bytes.TrimSuffix(line, []byte("\n"))
+		line = bytes.TrimSuffix(line, []byte("\r"))
+
+		if len(line) == 0 {
+			if d.dataBuffer.Len() == 0 {
+				continue
+			}
+
+			event := d.eventType
+			if event == "" {
+				event = "message"
+			}
+
+			frame := Frame{
+				Data:      make([]byte, d.dataBuffer.Len()),
+				Event:     event,
+				ID:        d.eventID,
+				Retry:     d.retry,
+				Timestamp: time.Now(),
+			}
+			copy(frame.Data, d.dataBuffer.Bytes())
+
+			d.dataBuffer.Reset()
+			d.eventType = ""
+			d.retry = 0
+			return frame, nil
+		}
+
+		if bytes.HasPrefix(line, []byte(":")) {
+			continue
+		}
+
+		field, value := parseSSEField(line)
+		switch field {
+		case "data":
+			if d.dataBuffer.Len() > 0 {
+				d.dataBuffer.WriteByte('\n')
+			}
+			d.dataBuffer.Write(value)
+		case "event":
+			d.eventType = string(value)
+		case "id":
+			d.eventID = string(value)
+		case "retry":
+			if ms, err := strconv.Atoi(string(value)); err == nil {
+				d.retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+}
+
+// NDJSONDecoder reads one JSON object per line, used for
+// application/x-ndjson and application/jsonl responses. Each line becomes a
+// Frame verbatim; blank lines between objects are skipped.
+type NDJSONDecoder struct{}
+
+func (d *NDJSONDecoder) Decode(r *bufio.Reader) (Frame, error) {
+	for {
+		line, err := r.ReadBytes('\n')
+		trimmed := bytes.TrimSpace(line)
+
+		if len(trimmed) > 0 {
+			data := make([]byte, len(trimmed))
+			copy(data, trimmed)
+			return Frame{Data: data, Event: "message", Timestamp: time.Now()}, nil
+		}
+
+		if err != nil {
+			return Frame{}, err
+		}
+	}
+}
+
+// LengthPrefixedDecoder reads gRPC-Web framing: a 5-byte header (1 byte
+// compressed-flag, 4 byte big-endian length) followed by exactly that many
+// payload bytes, letting the client consume AG-UI servers exposed over
+// gRPC-Web+proto.
+type LengthPrefixedDecoder struct{}
+
+func (d *LengthPrefixedDecoder) Decode(r *bufio.Reader) (Frame, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return Frame{}, err
+	}
+
+	length := binary.BigEndian.Uint32(header[1:])
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return Frame{}, err
+	}
+
+	return Frame{Data: payload, Event: "message", Timestamp: time.Now()}, nil
+}
+
+// decoderFactories maps a negotiated Content-Type (ignoring parameters like
+// "; charset=utf-8") to a constructor for the FrameDecoder that understands
+// it. Client.stream consults this when Config.Decoder is not set explicitly.
+var decoderFactories = map[string]func() FrameDecoder{
+	"text/event-stream":          func() FrameDecoder { return NewSSEDecoder() },
+	"application/x-ndjson":       func() FrameDecoder { return &NDJSONDecoder{} },
+	"application/jsonl":          func() FrameDecoder { return &NDJSONDecoder{} },
+	"application/grpc-web+proto": func() FrameDecoder { return &LengthPrefixedDecoder{} },
+}
+
+// decoderForContentType returns the registered FrameDecoder for contentType,
+// or nil if no decoder is registered for it.
+func decoderForContentType(contentType string) FrameDecoder {
+	base := strings.TrimSpace(strings.SplitN(contentType, ";", 2)[0])
+	factory, ok := decoderFactories[base]
+	if !ok {
+		return nil
+	}
+	return factory()
+}
+
+// SupportedContentTypes returns the Content-Type values Client can consume,
+// derived from the registered decoders.
+func SupportedContentTypes() []string {
+	types := make([]string, 0, len(decoderFactories))
+	for ct := range decoderFactories {
+		types = append(types, ct)
+	}
+	return types
+}
@@ -0,0 +1,85 @@
+package sse
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+func TestSSEDecoder_Decode(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader("event: custom\nid: 1\ndata: hello\n\n"))
+	decoder := NewSSEDecoder()
+
+	frame, err := decoder.Decode(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(frame.Data) != "hello" || frame.Event != "custom" || frame.ID != "1" {
+		t.Errorf("unexpected frame: %+v", frame)
+	}
+}
+
+func TestNDJSONDecoder_Decode(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader("{\"a\":1}\n{\"a\":2}\n"))
+	decoder := &NDJSONDecoder{}
+
+	frame, err := decoder.Decode(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(frame.Data) != `{"a":1}` {
+		t.Errorf("expected first JSON line, got %q", frame.Data)
+	}
+
+	frame, err = decoder.Decode(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(frame.Data) != `{"a":2}` {
+		t.Errorf("expected second JSON line, got %q", frame.Data)
+	}
+}
+
+func TestLengthPrefixedDecoder_Decode(t *testing.T) {
+	var buf bytes.Buffer
+	buf.WriteByte(0)
+	length := make([]byte, 4)
+	binary.BigEndian.PutUint32(length, 5)
+	buf.Write(length)
+	buf.WriteString("hello")
+
+	decoder := &LengthPrefixedDecoder{}
+	frame, err := decoder.Decode(bufio.NewReader(&buf))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(frame.Data) != "hello" {
+		t.Errorf("expected payload %q, got %q", "hello", frame.Data)
+	}
+}
+
+func TestDecoderForContentType(t *testing.T) {
+	tests := []struct {
+		contentType string
+		wantNil     bool
+	}{
+		{"text/event-stream", false},
+		{"text/event-stream; charset=utf-8", false},
+		{"application/x-ndjson", false},
+		{"application/jsonl", false},
+		{"application/grpc-web+proto", false},
+		{"application/json", true},
+	}
+
+	for _, tt := range tests {
+		decoder := decoderForContentType(tt.contentType)
+		if tt.wantNil && decoder != nil {
+			t.Errorf("%s: expected nil decoder, got %T", tt.contentType, decoder)
+		}
+		if !tt.wantNil && decoder == nil {
+			t.Errorf("%s: expected a decoder, got nil", tt.contentType)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package sse
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+)
+
+// RequestMiddleware wraps the http.RoundTripper used for the initial POST
+// that opens a stream, modeled after http.RoundTripper chains and gRPC
+// unary interceptors. Register middlewares via Config.RequestMiddlewares;
+// they apply in order, with the first entry seeing the request first (and
+// the response last) — e.g. auth-token refresh before an OpenTelemetry span,
+// or vice versa, depending on which is listed first.
+type RequestMiddleware func(next http.RoundTripper) http.RoundTripper
+
+// roundTripperFunc adapts a function to http.RoundTripper, mirroring
+// http.HandlerFunc.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// chainRoundTripper applies middlewares around base in the order described
+// on RequestMiddleware.
+func chainRoundTripper(base http.RoundTripper, middlewares []RequestMiddleware) http.RoundTripper {
+	rt := base
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		rt = middlewares[i](rt)
+	}
+	return rt
+}
+
+// FrameHandler processes one decoded frame before it reaches the caller.
+// Returning keep=false filters the frame out silently; a non-nil error is
+// treated the same as a decode error and ends the stream.
+type FrameHandler func(ctx context.Context, frame Frame) (out Frame, keep bool, err error)
+
+// FrameMiddleware wraps a FrameHandler, sitting between the decoder and the
+// output channel/Stream.Next. Use it to filter frames, decode JSON payloads
+// into typed events, collapse partial tokens into whole messages, or fan out
+// to multiple subscribers. Register via Config.FrameMiddlewares; they apply
+// in order with the same first-sees-it-first convention as
+// RequestMiddleware.
+type FrameMiddleware func(next FrameHandler) FrameHandler
+
+func passthroughFrameHandler(ctx context.Context, frame Frame) (Frame, bool, error) {
+	return frame, true, nil
+}
+
+// buildFrameHandler composes Config.FrameMiddlewares around the passthrough
+// handler. Called once per stream so middlewares with per-stream state
+// (e.g. token collapsing) get a fresh closure.
+func (c *Client) buildFrameHandler() FrameHandler {
+	handler := FrameHandler(passthroughFrameHandler)
+	for i := len(c.config.FrameMiddlewares) - 1; i >= 0; i-- {
+		handler = c.config.FrameMiddlewares[i](handler)
+	}
+	return handler
+}
+
+// LoggingMiddleware is a built-in RequestMiddleware that reproduces the
+// connection-lifecycle logging Client performs by default, for callers who
+// want the same "Initiating SSE connection" / "SSE connection established"
+// log lines without them being hard-coded into Stream.
+func LoggingMiddleware(logger *logrus.Logger) RequestMiddleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			logger.WithFields(logrus.Fields{
+				"endpoint": req.URL.String(),
+				"method":   req.Method,
+				"headers":  req.Header,
+			}).Debug("Initiating SSE connection")
+
+			resp, err := next.RoundTrip(req)
+			if err != nil {
+				logger.WithError(err).Warn("SSE connection failed")
+				return resp, err
+			}
+
+			logger.WithFields(logrus.Fields{
+				"status":       resp.StatusCode,
+				"content_type": resp.Header.Get("Content-Type"),
+			}).Info("SSE connection established")
+
+			return resp, nil
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package sse
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestMiddleware_AppliedInOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("data: hi\n\n"))
+	}))
+	defer server.Close()
+
+	var order []string
+	mw := func(name string) RequestMiddleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+
+	client := NewClient(Config{
+		Endpoint:           server.URL,
+		RequestMiddlewares: []RequestMiddleware{mw("first"), mw("second")},
+	})
+
+	frames, _, err := client.Stream(StreamOptions{Context: context.Background(), Payload: map[string]string{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	<-frames
+
+	if len(order) != 2 || order[0] != "first" || order[1] != "second" {
+		t.Errorf("expected [first second], got %v", order)
+	}
+}
+
+func TestFrameMiddleware_FilterAndTransform(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("data: drop\n\ndata: keep\n\n"))
+	}))
+	defer server.Close()
+
+	dropFilter := func(next FrameHandler) FrameHandler {
+		return func(ctx context.Context, frame Frame) (Frame, bool, error) {
+			if string(frame.Data) == "drop" {
+				return frame, false, nil
+			}
+			return next(ctx, frame)
+		}
+	}
+
+	client := NewClient(Config{
+		Endpoint:         server.URL,
+		FrameMiddlewares: []FrameMiddleware{dropFilter},
+	})
+
+	frames, _, err := client.Stream(StreamOptions{Context: context.Background(), Payload: map[string]string{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	frame, ok := <-frames
+	if !ok {
+		t.Fatal("expected one frame, channel closed early")
+	}
+	if string(frame.Data) != "keep" {
+		t.Errorf("expected filtered frame to be %q, got %q", "keep", frame.Data)
+	}
+}
@@ -0,0 +1,130 @@
+package sse
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+// StreamMetrics captures observability data for a single Stream call so
+// callers can diagnose head-of-line blocking and slow endpoints.
+type StreamMetrics struct {
+	// BytesRead is the total number of raw bytes read off the wire
+	BytesRead int64
+	// FramesEmitted is the number of SSE frames dispatched to the caller
+	FramesEmitted int64
+	// TimeToFirstByte is the delay between request start and the first byte read
+	TimeToFirstByte time.Duration
+}
+
+// configureHTTP2Transport wraps base (or a sane default) with HTTP/2 support
+// so many Stream calls to the same host multiplex over one TCP/TLS
+// connection instead of opening a fresh HTTP/1.1 connection each time.
+func configureHTTP2Transport(base *http.Transport) (*http.Transport, error) {
+	if base == nil {
+		base = &http.Transport{
+			TLSClientConfig: &tls.Config{},
+		}
+	}
+
+	h2Transport, err := http2.ConfigureTransports(base)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure HTTP/2 transport: %w", err)
+	}
+
+	h2Transport.ReadIdleTimeout = 30 * time.Second
+	h2Transport.PingTimeout = 15 * time.Second
+	h2Transport.AllowHTTP = false
+
+	return base, nil
+}
+
+// ClientPool shares one Client (and therefore, when HTTP2 is enabled, one
+// TCP/TLS connection) across many concurrent Stream calls to the same
+// endpoint, queuing new calls once the server's concurrent-stream limit is
+// reached.
+type ClientPool struct {
+	mu      sync.Mutex
+	clients map[string]*pooledClient
+	newFunc func(Config) *Client
+}
+
+type pooledClient struct {
+	client      *Client
+	maxStreams  int
+	mu          sync.Mutex
+	activeCount int
+	waiters     []chan struct{}
+}
+
+// NewClientPool creates an empty ClientPool. Acquire's maxStreams bounds
+// concurrent streams per endpoint (0 for unbounded, relying on the server's
+// SETTINGS_MAX_CONCURRENT_STREAMS to apply backpressure naturally).
+func NewClientPool() *ClientPool {
+	return &ClientPool{
+		clients: make(map[string]*pooledClient),
+		newFunc: NewClient,
+	}
+}
+
+// Acquire returns the shared Client for config.Endpoint (creating one if
+// needed), and blocks until a stream slot is available if maxStreams is set
+// and currently exhausted. The limit is the maxStreams of the first Acquire
+// for that endpoint; later calls' maxStreams values are ignored. The
+// returned release func must be called when the stream ends.
+func (p *ClientPool) Acquire(ctx context.Context, config Config, maxStreams int) (*Client, func(), error) {
+	p.mu.Lock()
+	pc, ok := p.clients[config.Endpoint]
+	if !ok {
+		pc = &pooledClient{
+			client:     p.newFunc(config),
+			maxStreams: maxStreams,
+		}
+		p.clients[config.Endpoint] = pc
+	}
+	p.mu.Unlock()
+
+	if err := pc.waitForSlot(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	release := func() { pc.releaseSlot() }
+	return pc.client, release, nil
+}
+
+func (pc *pooledClient) waitForSlot(ctx context.Context) error {
+	for {
+		pc.mu.Lock()
+		if pc.maxStreams <= 0 || pc.activeCount < pc.maxStreams {
+			pc.activeCount++
+			pc.mu.Unlock()
+			return nil
+		}
+		wait := make(chan struct{})
+		pc.waiters = append(pc.waiters, wait)
+		pc.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (pc *pooledClient) releaseSlot() {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	pc.activeCount--
+	if len(pc.waiters) > 0 {
+		next := pc.waiters[0]
+		pc.waiters = pc.waiters[1:]
+		close(next)
+	}
+}
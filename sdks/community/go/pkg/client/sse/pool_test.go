@@ -0,0 +1,87 @@
+package sse
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClientPool_AcquireSameEndpoint(t *testing.T) {
+	pool := NewClientPool()
+	config := Config{Endpoint: "http://localhost:8080/sse"}
+
+	c1, release1, err := pool.Acquire(context.Background(), config, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	release1()
+
+	c2, release2, err := pool.Acquire(context.Background(), config, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer release2()
+
+	if c1 != c2 {
+		t.Error("expected pool to return the same Client for the same endpoint")
+	}
+}
+
+func TestClientPool_LimitsConcurrentStreams(t *testing.T) {
+	pool := NewClientPool()
+	config := Config{Endpoint: "http://localhost:8080/sse"}
+
+	_, release1, err := pool.Acquire(context.Background(), config, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, _, err := pool.Acquire(ctx, config, 1); err == nil {
+		t.Error("expected second Acquire to block until the context times out")
+	}
+
+	release1()
+
+	_, release2, err := pool.Acquire(context.Background(), config, 1)
+	if err != nil {
+		t.Fatalf("expected slot to free up after release, got error: %v", err)
+	}
+	release2()
+}
+
+func TestClientPool_ReleaseUnblocksWaiter(t *testing.T) {
+	pool := NewClientPool()
+	config := Config{Endpoint: "http://localhost:8080/sse"}
+
+	_, release1, err := pool.Acquire(context.Background(), config, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var acquired int32
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, release2, err := pool.Acquire(context.Background(), config, 1)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		atomic.StoreInt32(&acquired, 1)
+		release2()
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	release1()
+	wg.Wait()
+
+	if atomic.LoadInt32(&acquired) != 1 {
+		t.Error("expected waiter to acquire a slot once released")
+	}
+}
@@ -0,0 +1,134 @@
+package sse
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStream_AutoReconnect(t *testing.T) {
+	var attempts int32
+	var lastEventIDSeen atomic.Value
+	lastEventIDSeen.Store("")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&attempts, 1)
+		lastEventIDSeen.Store(r.Header.Get("Last-Event-ID"))
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		flusher := w.(http.Flusher)
+
+		if n == 1 {
+			w.Write([]byte("id: 1\ndata: first\n\n"))
+			flusher.Flush()
+			return // connection ends, should trigger reconnect
+		}
+
+		w.Write([]byte("id: 2\ndata: second\n\n"))
+		flusher.Flush()
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{
+		Endpoint:      server.URL,
+		AutoReconnect: true,
+		RetryInterval: 20 * time.Millisecond,
+		MaxReconnects: 5,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	frames, _, err := client.Stream(StreamOptions{Context: ctx, Payload: map[string]string{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var gotFirst, gotSecond bool
+	for !gotSecond {
+		select {
+		case frame, ok := <-frames:
+			if !ok {
+				t.Fatal("frames channel closed before second frame arrived")
+			}
+			switch string(frame.Data) {
+			case "first":
+				gotFirst = true
+			case "second":
+				gotSecond = true
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for reconnected frame")
+		}
+	}
+
+	if !gotFirst {
+		t.Error("expected to receive the first frame before reconnecting")
+	}
+	if lastEventIDSeen.Load().(string) != "1" {
+		t.Errorf("expected reconnect to send Last-Event-ID: 1, got %q", lastEventIDSeen.Load())
+	}
+}
+
+func TestStream_ReconnectPolicyOverridesBackoff(t *testing.T) {
+	var attempts int32
+	var policyCalls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&attempts, 1)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		flusher := w.(http.Flusher)
+
+		if n == 1 {
+			w.Write([]byte("id: 1\ndata: first\n\n"))
+			flusher.Flush()
+			return
+		}
+
+		w.Write([]byte("id: 2\ndata: second\n\n"))
+		flusher.Flush()
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{
+		Endpoint:      server.URL,
+		AutoReconnect: true,
+		RetryInterval: time.Second, // ignored when ReconnectPolicy is honored; policyCalls below checks that it was
+		MaxReconnects: 5,
+		ReconnectPolicy: func(err error, attempt int) time.Duration {
+			atomic.AddInt32(&policyCalls, 1)
+			return 10 * time.Millisecond
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	frames, _, err := client.Stream(StreamOptions{Context: ctx, Payload: map[string]string{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for {
+		select {
+		case frame, ok := <-frames:
+			if !ok {
+				t.Fatal("frames channel closed before second frame arrived")
+			}
+			if string(frame.Data) == "second" {
+				if atomic.LoadInt32(&policyCalls) == 0 {
+					t.Error("expected ReconnectPolicy to be called at least once")
+				}
+				return
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for reconnected frame; ReconnectPolicy was not honored")
+		}
+	}
+}
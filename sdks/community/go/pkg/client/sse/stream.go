@@ -0,0 +1,220 @@
+package sse
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// StreamStats reports a Stream's buffering state so callers can tell a slow
+// consumer apart from a dead connection.
+type StreamStats struct {
+	// BufferedFrames is the number of decoded frames waiting in the ring
+	// buffer for Next to be called.
+	BufferedFrames int
+	// BytesRead is the total number of frame payload bytes decoded so far.
+	BytesRead int64
+	// Paused is true when the fill loop has stopped issuing reads because
+	// the ring buffer is at or above its high-water mark.
+	Paused bool
+}
+
+// Stream is a pull-based alternative to the channel returned by
+// Client.Stream. Unlike that channel, which keeps a goroutine parked on a
+// blocking send when the consumer is slow (so the underlying socket read
+// stops responding and the server eventually trips its own write/read
+// timeout), Stream stops issuing decoder reads once its ring buffer reaches
+// HighWaterMark and resumes once the consumer has drained it back down to
+// LowWaterMark. Over HTTP/2 this is enough to make the server pause too:
+// golang.org/x/net/http2 only emits WINDOW_UPDATE frames as buffered data is
+// read by the caller, so pausing reads starves the flow-control window
+// without any extra API calls.
+type Stream struct {
+	resp    *http.Response
+	decoder FrameDecoder
+	reader  *bufio.Reader
+	cancel  context.CancelFunc
+	// reqCancel is do's request-context CancelFunc, passed through by
+	// negotiate. It must not be invoked until resp.Body is done being read -
+	// canceling the request context aborts an in-progress body read - so
+	// fill calls it only in its own defer, alongside resp.Body.Close.
+	reqCancel context.CancelFunc
+
+	highWaterMark int
+	lowWaterMark  int
+
+	handler FrameHandler
+
+	mu      sync.Mutex
+	buf     []Frame
+	paused  bool
+	err     error
+	closed  bool
+	resumeC chan struct{}
+	fillC   chan struct{}
+
+	bytesRead int64
+}
+
+// Open negotiates a FrameDecoder exactly as Stream does, but returns a pull
+// API instead of channels. Call Next to read frames and Close when done.
+func (c *Client) Open(opts StreamOptions) (*Stream, error) {
+	resp, reqCancel, decoder, err := c.negotiate(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(opts.Context)
+
+	high := c.config.HighWaterMark
+	if high <= 0 {
+		high = 64
+	}
+	low := c.config.LowWaterMark
+	if low <= 0 || low >= high {
+		low = high / 4
+	}
+
+	s := &Stream{
+		resp:          resp,
+		decoder:       decoder,
+		reader:        bufio.NewReader(resp.Body),
+		cancel:        cancel,
+		reqCancel:     reqCancel,
+		highWaterMark: high,
+		lowWaterMark:  low,
+		handler:       c.buildFrameHandler(),
+		resumeC:       make(chan struct{}, 1),
+		fillC:         make(chan struct{}, 1),
+	}
+
+	go s.fill(ctx)
+
+	return s, nil
+}
+
+// fill runs the decode loop, pausing once buf reaches highWaterMark and
+// resuming when signalled by a Next call that drained it to lowWaterMark.
+func (s *Stream) fill(ctx context.Context) {
+	defer func() {
+		_ = s.resp.Body.Close()
+		s.reqCancel()
+	}()
+
+	for {
+		s.mu.Lock()
+		if len(s.buf) >= s.highWaterMark {
+			s.paused = true
+			s.mu.Unlock()
+
+			select {
+			case <-s.resumeC:
+			case <-ctx.Done():
+				return
+			}
+
+			s.mu.Lock()
+			s.paused = false
+		}
+		s.mu.Unlock()
+
+		frame, err := s.decoder.Decode(s.reader)
+		if err == nil {
+			var keep bool
+			frame, keep, err = s.handler(ctx, frame)
+			if err == nil && !keep {
+				continue
+			}
+		}
+
+		s.mu.Lock()
+		if err != nil {
+			s.err = err
+			s.mu.Unlock()
+			s.notifyFill()
+			return
+		}
+
+		atomic.AddInt64(&s.bytesRead, int64(len(frame.Data)))
+		s.buf = append(s.buf, frame)
+		s.mu.Unlock()
+		s.notifyFill()
+
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+	}
+}
+
+func (s *Stream) notifyFill() {
+	select {
+	case s.fillC <- struct{}{}:
+	default:
+	}
+}
+
+// Next returns the next buffered frame, waiting for one to arrive if the
+// buffer is empty. It returns the decode error (including io.EOF) once the
+// underlying stream ends and the buffer has drained.
+func (s *Stream) Next(ctx context.Context) (Frame, error) {
+	for {
+		s.mu.Lock()
+		if len(s.buf) > 0 {
+			frame := s.buf[0]
+			s.buf = s.buf[1:]
+			resume := s.paused && len(s.buf) <= s.lowWaterMark
+			s.mu.Unlock()
+
+			if resume {
+				select {
+				case s.resumeC <- struct{}{}:
+				default:
+				}
+			}
+			return frame, nil
+		}
+
+		if s.err != nil {
+			err := s.err
+			s.mu.Unlock()
+			return Frame{}, err
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-s.fillC:
+		case <-ctx.Done():
+			return Frame{}, ctx.Err()
+		}
+	}
+}
+
+// Stats reports the Stream's current buffering state.
+func (s *Stream) Stats() StreamStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return StreamStats{
+		BufferedFrames: len(s.buf),
+		BytesRead:      atomic.LoadInt64(&s.bytesRead),
+		Paused:         s.paused,
+	}
+}
+
+// Close stops the fill loop and releases the underlying response body.
+func (s *Stream) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	s.mu.Unlock()
+
+	s.cancel()
+	return nil
+}
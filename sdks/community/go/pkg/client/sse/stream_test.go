@@ -0,0 +1,71 @@
+package sse
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_OpenAndNext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("data: one\n\ndata: two\n\n"))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Endpoint: server.URL})
+
+	stream, err := client.Open(StreamOptions{Context: context.Background(), Payload: map[string]string{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer stream.Close()
+
+	frame, err := stream.Next(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(frame.Data) != "one" {
+		t.Errorf("expected %q, got %q", "one", frame.Data)
+	}
+
+	frame, err = stream.Next(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(frame.Data) != "two" {
+		t.Errorf("expected %q, got %q", "two", frame.Data)
+	}
+}
+
+func TestStream_PausesAtHighWaterMark(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		flusher := w.(http.Flusher)
+		for i := 0; i < 10; i++ {
+			w.Write([]byte("data: frame\n\n"))
+		}
+		flusher.Flush()
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Endpoint: server.URL, HighWaterMark: 2, LowWaterMark: 1})
+
+	stream, err := client.Open(StreamOptions{Context: context.Background(), Payload: map[string]string{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer stream.Close()
+
+	if _, err := stream.Next(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	stats := stream.Stats()
+	if stats.BufferedFrames > 2 {
+		t.Errorf("expected buffer to be bounded near the high-water mark, got %d frames", stats.BufferedFrames)
+	}
+}
@@ -0,0 +1,139 @@
+package events
+
+import (
+	"strings"
+	"sync"
+)
+
+// chunkCall accumulates the partial fields a ChunkNormalizer has seen for
+// one tool call in progress, until enough is known to flush a
+// ToolCallStartEvent.
+type chunkCall struct {
+	id              string
+	name            string
+	parentMessageID *string
+	started         bool
+	ended           bool
+	pending         strings.Builder // args buffered before a Start has been flushed
+}
+
+// ChunkNormalizer reassembles a stream of ToolCallChunkEvent - the
+// provider-facing shape an OpenAI-style streaming delta arrives in, where
+// id/name/delta trickle in across several chunks and may be interleaved
+// across multiple concurrent tool calls - into the ToolCallStartEvent /
+// ToolCallArgsEvent / ToolCallEndEvent triples StreamValidator and
+// SequenceValidator expect. It is safe for concurrent use.
+type ChunkNormalizer struct {
+	mu      sync.Mutex
+	calls   map[string]*chunkCall
+	order   []string // insertion order, so Flush closes calls deterministically
+	current string   // id of the call a chunk with no ToolCallID continues
+}
+
+// NewChunkNormalizer creates a ChunkNormalizer with no calls in progress.
+func NewChunkNormalizer() *ChunkNormalizer {
+	return &ChunkNormalizer{calls: make(map[string]*chunkCall)}
+}
+
+// Next folds chunk into whichever call it belongs to - identified by its
+// own ToolCallID, or, if omitted, by continuing the call the previous
+// chunk with an ID established - and returns the Start/Args events that
+// become emittable as a result, in order. It returns nil, nil if chunk
+// only updates still-buffered state (e.g. a name or args delta before any
+// chunk has named the call) without becoming emittable yet.
+func (n *ChunkNormalizer) Next(chunk *ToolCallChunkEvent) ([]Event, error) {
+	if err := chunk.Validate(); err != nil {
+		return nil, err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	call := n.resolve(chunk)
+
+	if chunk.ParentMessageID != nil {
+		call.parentMessageID = chunk.ParentMessageID
+	}
+	if chunk.ToolCallName != nil && call.name == "" {
+		call.name = *chunk.ToolCallName
+	}
+
+	var out []Event
+	if !call.started && call.name != "" {
+		var opts []ToolCallStartOption
+		if call.parentMessageID != nil {
+			opts = append(opts, WithParentMessageID(*call.parentMessageID))
+		}
+		out = append(out, NewToolCallStartEvent(call.id, call.name, opts...))
+		call.started = true
+
+		if call.pending.Len() > 0 {
+			out = append(out, NewToolCallArgsEvent(call.id, call.pending.String()))
+			call.pending.Reset()
+		}
+	}
+
+	if chunk.Delta != nil {
+		if call.started {
+			out = append(out, NewToolCallArgsEvent(call.id, *chunk.Delta))
+		} else {
+			call.pending.WriteString(*chunk.Delta)
+		}
+	}
+
+	return out, nil
+}
+
+// resolve returns the chunkCall chunk belongs to, creating one (and
+// recording it as current, for later ID-omitted chunks to continue) if
+// chunk names a ToolCallID not seen before. A chunk with no ToolCallID
+// continues whichever call is current; if there is none (the stream's
+// very first chunk omitted it, or the current call has already ended), a
+// synthetic ID is minted so the call still has one to key Start/Args/End
+// on.
+func (n *ChunkNormalizer) resolve(chunk *ToolCallChunkEvent) *chunkCall {
+	if chunk.ToolCallID != nil {
+		id := *chunk.ToolCallID
+		call, ok := n.calls[id]
+		if !ok {
+			call = &chunkCall{id: id}
+			n.calls[id] = call
+			n.order = append(n.order, id)
+		}
+		n.current = id
+		return call
+	}
+
+	if n.current != "" {
+		if call, ok := n.calls[n.current]; ok && !call.ended {
+			return call
+		}
+	}
+
+	id := GenerateToolCallID()
+	call := &chunkCall{id: id}
+	n.calls[id] = call
+	n.order = append(n.order, id)
+	n.current = id
+	return call
+}
+
+// Flush emits a ToolCallEndEvent for every call that has had a Start
+// flushed but no End yet, in the order each call was first seen, for a
+// caller whose underlying chunk stream has terminated (cleanly or not)
+// while calls were still open. It is safe to call Flush more than once;
+// already-ended calls are skipped.
+func (n *ChunkNormalizer) Flush() []Event {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var out []Event
+	for _, id := range n.order {
+		call := n.calls[id]
+		if call.started && !call.ended {
+			out = append(out, NewToolCallEndEvent(call.id))
+			call.ended = true
+		}
+	}
+	return out
+}
@@ -0,0 +1,95 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkNormalizer(t *testing.T) {
+	t.Run("buffers args until the name is known, then flushes Start then Args", func(t *testing.T) {
+		n := NewChunkNormalizer()
+
+		out, err := n.Next(NewToolCallChunkEvent().WithToolCallChunkID("tool-1").WithToolCallChunkDelta(`{"q":`))
+		require.NoError(t, err)
+		assert.Empty(t, out)
+
+		out, err = n.Next(NewToolCallChunkEvent().WithToolCallChunkID("tool-1").WithToolCallChunkName("search"))
+		require.NoError(t, err)
+		require.Len(t, out, 2)
+
+		start, ok := out[0].(*ToolCallStartEvent)
+		require.True(t, ok)
+		assert.Equal(t, "tool-1", start.ToolCallID)
+		assert.Equal(t, "search", start.ToolCallName)
+
+		args, ok := out[1].(*ToolCallArgsEvent)
+		require.True(t, ok)
+		assert.Equal(t, `{"q":`, args.Delta)
+
+		out, err = n.Next(NewToolCallChunkEvent().WithToolCallChunkID("tool-1").WithToolCallChunkDelta(`"hi"}`))
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		args, ok = out[0].(*ToolCallArgsEvent)
+		require.True(t, ok)
+		assert.Equal(t, `"hi"}`, args.Delta)
+
+		ended := n.Flush()
+		require.Len(t, ended, 1)
+		end, ok := ended[0].(*ToolCallEndEvent)
+		require.True(t, ok)
+		assert.Equal(t, "tool-1", end.ToolCallID)
+	})
+
+	t.Run("later chunks with no ToolCallID continue the current call", func(t *testing.T) {
+		n := NewChunkNormalizer()
+
+		_, err := n.Next(NewToolCallChunkEvent().WithToolCallChunkID("tool-1").WithToolCallChunkName("search"))
+		require.NoError(t, err)
+
+		out, err := n.Next(NewToolCallChunkEvent().WithToolCallChunkDelta(`{}`))
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		args, ok := out[0].(*ToolCallArgsEvent)
+		require.True(t, ok)
+		assert.Equal(t, "tool-1", args.ToolCallID)
+	})
+
+	t.Run("interleaved chunks across concurrent tool calls stay correlated by ID", func(t *testing.T) {
+		n := NewChunkNormalizer()
+
+		_, err := n.Next(NewToolCallChunkEvent().WithToolCallChunkID("tool-1").WithToolCallChunkName("search"))
+		require.NoError(t, err)
+		_, err = n.Next(NewToolCallChunkEvent().WithToolCallChunkID("tool-2").WithToolCallChunkName("fetch"))
+		require.NoError(t, err)
+
+		out, err := n.Next(NewToolCallChunkEvent().WithToolCallChunkID("tool-1").WithToolCallChunkDelta("a"))
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		assert.Equal(t, "tool-1", out[0].(*ToolCallArgsEvent).ToolCallID)
+
+		out, err = n.Next(NewToolCallChunkEvent().WithToolCallChunkID("tool-2").WithToolCallChunkDelta("b"))
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		assert.Equal(t, "tool-2", out[0].(*ToolCallArgsEvent).ToolCallID)
+
+		ended := n.Flush()
+		require.Len(t, ended, 2)
+	})
+
+	t.Run("Flush only closes started calls and is idempotent", func(t *testing.T) {
+		n := NewChunkNormalizer()
+		_, err := n.Next(NewToolCallChunkEvent().WithToolCallChunkDelta("buffered, never named"))
+		require.NoError(t, err)
+
+		assert.Empty(t, n.Flush())
+
+		_, err = n.Next(NewToolCallChunkEvent().WithToolCallChunkID("tool-1").WithToolCallChunkName("search"))
+		require.NoError(t, err)
+
+		first := n.Flush()
+		require.Len(t, first, 1)
+		assert.Empty(t, n.Flush())
+	})
+}
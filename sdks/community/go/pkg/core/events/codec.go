@@ -0,0 +1,68 @@
+package events
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Codec encodes and decodes a single event to/from one wire format. It is
+// defined here rather than imported from pkg/encoding/codec so this package
+// never has to depend on a specific wire format: every
+// pkg/encoding/codec.Codec (JSON, Protobuf, CBOR, or a custom registration)
+// already satisfies this interface structurally, with no adapter needed.
+type Codec interface {
+	// Encode serializes event into this codec's wire format.
+	Encode(event Event) ([]byte, error)
+	// Decode parses data, previously produced by Encode, back into an Event.
+	Decode(data []byte) (Event, error)
+	// ContentType is the MIME type this codec reads and writes.
+	ContentType() string
+}
+
+// MarshalWith serializes event using c, for a caller that has already
+// negotiated a wire format (e.g. against an HTTP Accept header) and wants a
+// single call site instead of branching between ToJSON and ToProto itself.
+func MarshalWith(event Event, c Codec) ([]byte, error) {
+	return c.Encode(event)
+}
+
+// maxDecodeStreamFrame bounds the length prefix DecodeStream will accept,
+// guarding against a corrupt or hostile stream claiming an unbounded frame.
+const maxDecodeStreamFrame = 64 << 20 // 64 MiB
+
+// DecodeStream reads a sequence of 4-byte-big-endian-length-prefixed
+// frames from r - the same framing pkg/encoding/wire.WriteFrame writes -
+// decoding each with c, until r is exhausted. It exists for a long-running
+// connection that wants to avoid paying JSON's encode/decode overhead on
+// every high-frequency TextMessageContentEvent/ToolCallArgsEvent delta by
+// picking a binary Codec (e.g. cbor.CBORCodec or protobuf.ProtobufCodec)
+// once, instead of decoding frame-by-frame through EventFromJSON.
+func DecodeStream(r io.Reader, c Codec) ([]Event, error) {
+	var result []Event
+	for {
+		var length [4]byte
+		if _, err := io.ReadFull(r, length[:]); err != nil {
+			if err == io.EOF {
+				return result, nil
+			}
+			return result, fmt.Errorf("events: failed to read frame length: %w", err)
+		}
+
+		n := binary.BigEndian.Uint32(length[:])
+		if n > maxDecodeStreamFrame {
+			return result, fmt.Errorf("events: frame of %d bytes exceeds max frame size of %d", n, maxDecodeStreamFrame)
+		}
+
+		data := make([]byte, n)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return result, fmt.Errorf("events: failed to read frame body: %w", err)
+		}
+
+		event, err := c.Decode(data)
+		if err != nil {
+			return result, fmt.Errorf("events: failed to decode frame: %w", err)
+		}
+		result = append(result, event)
+	}
+}
@@ -0,0 +1,74 @@
+package events
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jsonCodec is a minimal Codec built from ToJSON/EventFromJSON, standing in
+// for a real pkg/encoding/codec.Codec implementation without this package
+// importing one (it lives one layer above events to avoid an import cycle).
+type jsonCodec struct{}
+
+func (jsonCodec) Encode(event Event) ([]byte, error) { return event.ToJSON() }
+func (jsonCodec) Decode(data []byte) (Event, error)  { return EventFromJSON(data) }
+func (jsonCodec) ContentType() string                { return "application/json" }
+
+func TestMarshalWith(t *testing.T) {
+	event := NewRunStartedEvent("thread-1", "run-1")
+
+	data, err := MarshalWith(event, jsonCodec{})
+	require.NoError(t, err)
+
+	decoded, err := jsonCodec{}.Decode(data)
+	require.NoError(t, err)
+	assert.Equal(t, EventTypeRunStarted, decoded.Type())
+}
+
+func TestDecodeStream(t *testing.T) {
+	t.Run("decodes every length-prefixed frame", func(t *testing.T) {
+		c := jsonCodec{}
+		events := []Event{
+			NewRunStartedEvent("thread-1", "run-1"),
+			NewRunFinishedEvent("thread-1", "run-1"),
+		}
+
+		var buf bytes.Buffer
+		for _, event := range events {
+			data, err := c.Encode(event)
+			require.NoError(t, err)
+
+			var length [4]byte
+			binary.BigEndian.PutUint32(length[:], uint32(len(data)))
+			buf.Write(length[:])
+			buf.Write(data)
+		}
+
+		decoded, err := DecodeStream(&buf, c)
+		require.NoError(t, err)
+		require.Len(t, decoded, 2)
+		assert.Equal(t, EventTypeRunStarted, decoded[0].Type())
+		assert.Equal(t, EventTypeRunFinished, decoded[1].Type())
+	})
+
+	t.Run("empty reader decodes no frames", func(t *testing.T) {
+		decoded, err := DecodeStream(&bytes.Buffer{}, jsonCodec{})
+		require.NoError(t, err)
+		assert.Empty(t, decoded)
+	})
+
+	t.Run("truncated frame body errors", func(t *testing.T) {
+		var buf bytes.Buffer
+		var length [4]byte
+		binary.BigEndian.PutUint32(length[:], 10)
+		buf.Write(length[:])
+		buf.WriteString("short")
+
+		_, err := DecodeStream(&buf, jsonCodec{})
+		assert.Error(t, err)
+	})
+}
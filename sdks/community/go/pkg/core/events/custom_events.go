@@ -54,6 +54,13 @@ func (e *RawEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
 
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *RawEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
 // CustomEvent contains custom application-specific event data
 type CustomEvent struct {
 	*BaseEvent
@@ -102,3 +109,55 @@ func (e *CustomEvent) Validate() error {
 func (e *CustomEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
+
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *CustomEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
+// UnknownEvent is the placeholder EventFromJSONLenient returns in place of a
+// hard error: either the raw bytes' "type" field names an EventType this SDK
+// doesn't recognize, or the payload otherwise failed to unmarshal. It keeps
+// the original bytes and the parse failure around so a caller can quarantine
+// or replay the offending message instead of losing it.
+type UnknownEvent struct {
+	*BaseEvent
+	Raw        json.RawMessage `json:"raw"`
+	ParseError string          `json:"parseError"`
+}
+
+// NewUnknownEvent wraps raw and the error that kept it from parsing into a
+// known event.
+func NewUnknownEvent(raw []byte, parseErr error) *UnknownEvent {
+	event := &UnknownEvent{
+		BaseEvent: NewBaseEvent(EventTypeUnknown),
+		Raw:       append(json.RawMessage(nil), raw...),
+	}
+
+	if parseErr != nil {
+		event.ParseError = parseErr.Error()
+	}
+
+	return event
+}
+
+// Validate always fails: an UnknownEvent exists to record a message that
+// could not be parsed into a known event, so it can never itself be valid.
+// See ParseError for why and Raw for the original bytes.
+func (e *UnknownEvent) Validate() error {
+	return fmt.Errorf("UnknownEvent validation failed: %s", e.ParseError)
+}
+
+// ToJSON serializes the event to JSON
+func (e *UnknownEvent) ToJSON() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *UnknownEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
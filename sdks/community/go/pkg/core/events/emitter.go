@@ -0,0 +1,24 @@
+package events
+
+// Emitter accepts a fully-formed Event for forwarding to wherever a stream
+// consumer is listening - an SSE writer, a gRPC stream, the channel
+// backing an agent Handler's returnChan, and so on. It lets a producer
+// that only knows how to construct events (e.g. an MCP tool registry
+// bridging tool calls into the AG-UI event stream) stay decoupled from
+// whichever transport is actually in use.
+type Emitter interface {
+	// Emit forwards event downstream. Implementations should treat a
+	// failure to forward as fatal to the caller, since a dropped event
+	// leaves the stream's lifecycle invariants (see Validator) unsatisfied
+	// for whoever is consuming it.
+	Emit(event Event) error
+}
+
+// EmitterFunc adapts a plain function to the Emitter interface, the way
+// http.HandlerFunc adapts a function to http.Handler.
+type EmitterFunc func(event Event) error
+
+// Emit calls f.
+func (f EmitterFunc) Emit(event Event) error {
+	return f(event)
+}
@@ -31,6 +31,7 @@ const (
 	EventTypeRunError           EventType = "RUN_ERROR"
 	EventTypeStepStarted        EventType = "STEP_STARTED"
 	EventTypeStepFinished       EventType = "STEP_FINISHED"
+	EventTypeUsage              EventType = "USAGE"
 
 	// Thinking events for reasoning phase support
 	EventTypeThinkingStart              EventType = "THINKING_START"
@@ -39,6 +40,14 @@ const (
 	EventTypeThinkingTextMessageContent EventType = "THINKING_TEXT_MESSAGE_CONTENT"
 	EventTypeThinkingTextMessageEnd     EventType = "THINKING_TEXT_MESSAGE_END"
 
+	// Structured reasoning-step events, for thinking phases that go beyond
+	// an opaque text delta stream
+	EventTypeThinkingStepStart     EventType = "THINKING_STEP_START"
+	EventTypeThinkingStepContent   EventType = "THINKING_STEP_CONTENT"
+	EventTypeThinkingStepEnd       EventType = "THINKING_STEP_END"
+	EventTypeThinkingToolCallStart EventType = "THINKING_TOOL_CALL_START"
+	EventTypeThinkingToolCallEnd   EventType = "THINKING_TOOL_CALL_END"
+
 	// EventTypeUnknown represents an unrecognized event type
 	EventTypeUnknown EventType = "UNKNOWN"
 )
@@ -64,11 +73,17 @@ var validEventTypes = map[EventType]bool{
 	EventTypeRunError:                   true,
 	EventTypeStepStarted:                true,
 	EventTypeStepFinished:               true,
+	EventTypeUsage:                      true,
 	EventTypeThinkingStart:              true,
 	EventTypeThinkingEnd:                true,
 	EventTypeThinkingTextMessageStart:   true,
 	EventTypeThinkingTextMessageContent: true,
 	EventTypeThinkingTextMessageEnd:     true,
+	EventTypeThinkingStepStart:          true,
+	EventTypeThinkingStepContent:        true,
+	EventTypeThinkingStepEnd:            true,
+	EventTypeThinkingToolCallStart:      true,
+	EventTypeThinkingToolCallEnd:        true,
 }
 
 // Event defines the common interface for all AG-UI events
@@ -94,6 +109,11 @@ type Event interface {
 	// ToJSON serializes the event to JSON for cross-SDK compatibility
 	ToJSON() ([]byte, error)
 
+	// ToProto serializes the event to a minimal protobuf envelope for
+	// cross-SDK compatibility, for callers speaking application/x-protobuf
+	// instead of JSON (see protobuf.ProtobufEncoder)
+	ToProto() ([]byte, error)
+
 	// GetBaseEvent returns the underlying base event
 	GetBaseEvent() *BaseEvent
 }
@@ -103,6 +123,22 @@ type BaseEvent struct {
 	EventType   EventType `json:"type"`
 	TimestampMs *int64    `json:"timestamp,omitempty"`
 	RawEvent    any       `json:"rawEvent,omitempty"`
+	// SchemaVersionValue identifies the version of this event type's schema
+	// the payload was produced against, so a schema-aware decoder can look
+	// up the matching schema (and, if it differs from the current version,
+	// migrate the payload) instead of assuming every payload matches the
+	// latest Go struct shape.
+	SchemaVersionValue string `json:"schemaVersion,omitempty"`
+	// Extensions carries CloudEvents extension attributes that arrived on a
+	// structured-mode envelope but aren't part of the AG-UI event shape
+	// (see json.CloudEventsEnvelope), so round-tripping an event back
+	// through a CloudEvents binding doesn't drop them. Empty for events that
+	// never passed through a CloudEvents envelope.
+	Extensions map[string]any `json:"extensions,omitempty"`
+	// IDValue holds an externally supplied event ID, e.g. the CloudEvents
+	// "id" attribute of the envelope this event was decoded from. Empty
+	// unless such an ID was assigned; see ID.
+	IDValue string `json:"id,omitempty"`
 }
 
 // Type returns the event type
@@ -115,14 +151,31 @@ func (b *BaseEvent) Timestamp() *int64 {
 	return b.TimestampMs
 }
 
+// SchemaVersion returns the schema version this event's payload was produced
+// against, or "" if unset (which a SchemaRegistry should treat as its oldest
+// or default version, depending on the registry).
+func (b *BaseEvent) SchemaVersion() string {
+	return b.SchemaVersionValue
+}
+
+// SetSchemaVersion sets the schema version this event's payload was produced
+// against.
+func (b *BaseEvent) SetSchemaVersion(version string) {
+	b.SchemaVersionValue = version
+}
+
 // SetTimestamp sets the event timestamp
 func (b *BaseEvent) SetTimestamp(timestamp int64) {
 	b.TimestampMs = &timestamp
 }
 
-// ID returns the unique identifier for this event
+// ID returns the unique identifier for this event: IDValue if one was
+// assigned (e.g. from a CloudEvents envelope's "id" attribute), otherwise a
+// synthetic ID derived from the event type and timestamp.
 func (b *BaseEvent) ID() string {
-	// Generate a unique ID based on event type and timestamp
+	if b.IDValue != "" {
+		return b.IDValue
+	}
 	if b.TimestampMs != nil {
 		return fmt.Sprintf("%s_%d", b.EventType, *b.TimestampMs)
 	}
@@ -143,14 +196,58 @@ func (b *BaseEvent) ToJSON() ([]byte, error) {
 		eventData["data"] = b.RawEvent
 	}
 
+	if b.SchemaVersionValue != "" {
+		eventData["schemaVersion"] = b.SchemaVersionValue
+	}
+
 	return json.Marshal(eventData)
 }
 
+// ToProto serializes the base event to a minimal protobuf envelope. Event
+// types that override ToJSON to include their own fields also override
+// ToProto to match (see e.g. RunStartedEvent.ToProto); this default only
+// covers events that never do more than embed *BaseEvent.
+func (b *BaseEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(b)
+}
+
 // GetBaseEvent returns the base event
 func (b *BaseEvent) GetBaseEvent() *BaseEvent {
 	return b
 }
 
+// ToProtoEnvelope serializes event to a minimal protobuf envelope, carrying
+// its JSON form (from ToJSON) as field 1 (bytes) of the envelope. The SDK
+// does not yet generate typed protobuf messages per event type, so this
+// keeps protobuf.ProtobufEncoder's CrossSDKCompatibility path working
+// without one; it mirrors the approach sse.ProtobufFrameEncoder already
+// uses for SSE framing, and is forward-compatible with a future generated
+// schema that reserves field 1 for the raw payload.
+func ToProtoEnvelope(event Event) ([]byte, error) {
+	jsonData, err := event.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+	return encodeProtoBytesField(1, jsonData), nil
+}
+
+// encodeProtoBytesField encodes a single length-delimited (wire type 2)
+// protobuf field.
+func encodeProtoBytesField(fieldNum int, value []byte) []byte {
+	tag := byte(fieldNum<<3) | 2
+	buf := []byte{tag}
+	buf = appendProtoVarint(buf, uint64(len(value)))
+	return append(buf, value...)
+}
+
+func appendProtoVarint(buf []byte, v uint64) []byte {
+	for v >= 0x80 {
+		buf = append(buf, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(buf, byte(v))
+}
+
 // ThreadID returns the thread ID (default implementation returns empty string)
 func (b *BaseEvent) ThreadID() string {
 	return ""
@@ -188,153 +285,13 @@ func isValidEventType(eventType EventType) bool {
 	return validEventTypes[eventType]
 }
 
-// ValidateSequence validates a sequence of events according to AG-UI protocol rules
+// ValidateSequence validates a sequence of events according to AG-UI
+// protocol rules. It is a thin wrapper over ValidateSequenceWith with no
+// custom validators registered; see ValidateSequenceWith to hook
+// EventTypeRaw/EventTypeCustom/EventTypeStateSnapshot/EventTypeStateDelta/
+// EventTypeMessagesSnapshot, which otherwise pass through unchecked.
 func ValidateSequence(events []Event) error {
-	if len(events) == 0 {
-		return nil
-	}
-
-	// Track active runs, messages, tool calls, and steps
-	activeRuns := make(map[string]bool)
-	activeMessages := make(map[string]bool)
-	activeToolCalls := make(map[string]bool)
-	activeSteps := make(map[string]bool)
-	finishedRuns := make(map[string]bool)
-
-	for i, event := range events {
-		if err := event.Validate(); err != nil {
-			return fmt.Errorf("event %d validation failed: %w", i, err)
-		}
-
-		// Check sequence-specific validation rules
-		switch event.Type() {
-		case EventTypeRunStarted:
-			if runEvent, ok := event.(*RunStartedEvent); ok {
-				if activeRuns[runEvent.RunID()] {
-					return fmt.Errorf("run %s already started", runEvent.RunID())
-				}
-				if finishedRuns[runEvent.RunID()] {
-					return fmt.Errorf("cannot restart finished run %s", runEvent.RunID())
-				}
-				activeRuns[runEvent.RunID()] = true
-			}
-
-		case EventTypeRunFinished:
-			if runEvent, ok := event.(*RunFinishedEvent); ok {
-				if !activeRuns[runEvent.RunID()] {
-					return fmt.Errorf("cannot finish run %s that was not started", runEvent.RunID())
-				}
-				delete(activeRuns, runEvent.RunID())
-				finishedRuns[runEvent.RunID()] = true
-			}
-
-		case EventTypeRunError:
-			if runEvent, ok := event.(*RunErrorEvent); ok {
-				if runEvent.RunID() != "" && !activeRuns[runEvent.RunID()] {
-					return fmt.Errorf("cannot error run %s that was not started", runEvent.RunID())
-				}
-				if runEvent.RunID() != "" {
-					delete(activeRuns, runEvent.RunID())
-					finishedRuns[runEvent.RunID()] = true
-				}
-			}
-
-		case EventTypeStepStarted:
-			if stepEvent, ok := event.(*StepStartedEvent); ok {
-				if activeSteps[stepEvent.StepName] {
-					return fmt.Errorf("step %s already started", stepEvent.StepName)
-				}
-				activeSteps[stepEvent.StepName] = true
-			}
-
-		case EventTypeStepFinished:
-			if stepEvent, ok := event.(*StepFinishedEvent); ok {
-				if !activeSteps[stepEvent.StepName] {
-					return fmt.Errorf("cannot finish step %s that was not started", stepEvent.StepName)
-				}
-				delete(activeSteps, stepEvent.StepName)
-			}
-
-		case EventTypeTextMessageStart:
-			if msgEvent, ok := event.(*TextMessageStartEvent); ok {
-				if activeMessages[msgEvent.MessageID] {
-					return fmt.Errorf("message %s already started", msgEvent.MessageID)
-				}
-				activeMessages[msgEvent.MessageID] = true
-			}
-
-		case EventTypeTextMessageContent:
-			if msgEvent, ok := event.(*TextMessageContentEvent); ok {
-				if !activeMessages[msgEvent.MessageID] {
-					return fmt.Errorf("cannot add content to message %s that was not started", msgEvent.MessageID)
-				}
-				// Content events are valid between start and end
-			}
-
-		case EventTypeTextMessageEnd:
-			if msgEvent, ok := event.(*TextMessageEndEvent); ok {
-				if !activeMessages[msgEvent.MessageID] {
-					return fmt.Errorf("cannot end message %s that was not started", msgEvent.MessageID)
-				}
-				delete(activeMessages, msgEvent.MessageID)
-			}
-
-		case EventTypeToolCallStart:
-			if toolEvent, ok := event.(*ToolCallStartEvent); ok {
-				if activeToolCalls[toolEvent.ToolCallID] {
-					return fmt.Errorf("tool call %s already started", toolEvent.ToolCallID)
-				}
-				activeToolCalls[toolEvent.ToolCallID] = true
-			}
-
-		case EventTypeToolCallArgs:
-			if toolEvent, ok := event.(*ToolCallArgsEvent); ok {
-				if !activeToolCalls[toolEvent.ToolCallID] {
-					return fmt.Errorf("cannot add args to tool call %s that was not started", toolEvent.ToolCallID)
-				}
-				// Args events are valid between start and end
-			}
-
-		case EventTypeToolCallEnd:
-			if toolEvent, ok := event.(*ToolCallEndEvent); ok {
-				if !activeToolCalls[toolEvent.ToolCallID] {
-					return fmt.Errorf("cannot end tool call %s that was not started", toolEvent.ToolCallID)
-				}
-				delete(activeToolCalls, toolEvent.ToolCallID)
-			}
-
-		case EventTypeStateSnapshot:
-			// State snapshot events are always valid in sequence context
-			// They represent complete state at any point in time
-			// Additional validation could be added if needed (e.g., frequency limits)
-
-		case EventTypeStateDelta:
-			// State delta events are always valid in sequence context
-			// They represent incremental changes at any point in time
-			// Additional validation could be added if needed (e.g., conflict detection)
-
-		case EventTypeMessagesSnapshot:
-			// Message snapshot events are always valid in sequence context
-			// They represent complete message state at any point in time
-			// Additional validation could be added if needed (e.g., consistency checks)
-
-		case EventTypeRaw:
-			// Raw events are always valid in sequence context
-			// They contain external data that should be passed through
-			// Additional validation could be added via custom validators
-
-		case EventTypeCustom:
-			// Custom events are always valid in sequence context
-			// They contain application-specific data
-			// Additional validation could be added via custom validators
-
-		default:
-			// This should not happen due to prior validation, but add safety check
-			return fmt.Errorf("unknown event type in sequence: %s", event.Type())
-		}
-	}
-
-	return nil
+	return ValidateSequenceWith(events)
 }
 
 // EventFromJSON parses an event from JSON data
@@ -385,6 +342,28 @@ func EventFromJSON(data []byte) (Event, error) {
 		event = &RawEvent{}
 	case EventTypeCustom:
 		event = &CustomEvent{}
+	case EventTypeUsage:
+		event = &UsageEvent{}
+	case EventTypeThinkingStart:
+		event = &ThinkingStartEvent{}
+	case EventTypeThinkingEnd:
+		event = &ThinkingEndEvent{}
+	case EventTypeThinkingTextMessageStart:
+		event = &ThinkingTextMessageStartEvent{}
+	case EventTypeThinkingTextMessageContent:
+		event = &ThinkingTextMessageContentEvent{}
+	case EventTypeThinkingTextMessageEnd:
+		event = &ThinkingTextMessageEndEvent{}
+	case EventTypeThinkingStepStart:
+		event = &ThinkingStepStartEvent{}
+	case EventTypeThinkingStepContent:
+		event = &ThinkingStepContentEvent{}
+	case EventTypeThinkingStepEnd:
+		event = &ThinkingStepEndEvent{}
+	case EventTypeThinkingToolCallStart:
+		event = &ThinkingToolCallStartEvent{}
+	case EventTypeThinkingToolCallEnd:
+		event = &ThinkingToolCallEndEvent{}
 	default:
 		return nil, fmt.Errorf("unknown event type: %s", base.Type)
 	}
@@ -396,3 +375,17 @@ func EventFromJSON(data []byte) (Event, error) {
 
 	return event, nil
 }
+
+// EventFromJSONLenient parses an event from JSON data like EventFromJSON,
+// but never fails: an unrecognized "type" or an unmarshal error produces an
+// UnknownEvent wrapping data and the error instead of a nil event, so a
+// streaming consumer can keep pace with a partially malformed feed and
+// inspect or discard the offending message later instead of losing its
+// place in the stream.
+func EventFromJSONLenient(data []byte) Event {
+	event, err := EventFromJSON(data)
+	if err != nil {
+		return NewUnknownEvent(data, err)
+	}
+	return event
+}
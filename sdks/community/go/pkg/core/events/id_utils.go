@@ -1,7 +1,9 @@
 package events
 
 import (
+	"crypto/rand"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -104,6 +106,191 @@ func (g *TimestampIDGenerator) generateTimestampID(typePrefix string) string {
 	return fmt.Sprintf("%s-%d-%s", typePrefix, timestamp, shortUUID)
 }
 
+// crockfordAlphabet is the Crockford base32 alphabet ULIDs are encoded
+// with: 32 symbols, excluding the visually ambiguous I, L, O, U.
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ulidEncodedLen is the length of a ULID's string encoding: 16 bytes
+// (48-bit timestamp || 80-bit randomness) packed 5 bits per symbol.
+const ulidEncodedLen = 26
+
+// ULIDGeneratorOptions configures a ULIDGenerator.
+type ULIDGeneratorOptions struct {
+	// Prefixed selects whether generated IDs keep the existing
+	// "run-"/"msg-"/etc. type prefix ahead of the ULID, or are returned as
+	// a bare 26-character ULID for callers that need cross-SDK interop
+	// with systems expecting a raw ULID.
+	Prefixed bool
+}
+
+// ULIDGenerator implements IDGenerator using ULIDs (Universally Unique
+// Lexicographically Sortable Identifiers): a 48-bit millisecond timestamp
+// followed by 80 bits of randomness, Crockford-base32 encoded. Unlike
+// DefaultIDGenerator's UUIDv4 or TimestampIDGenerator's
+// timestamp-plus-short-UUID scheme, IDs from the same generator sort
+// lexicographically in creation order, which lets an event store that
+// scans by ID range skip a separate timestamp index.
+//
+// Within the same millisecond, the random component is incremented rather
+// than regenerated (the standard ULID monotonic-factory pattern), so IDs
+// minted in the same tick still sort correctly relative to one another.
+type ULIDGenerator struct {
+	mu         sync.Mutex
+	options    ULIDGeneratorOptions
+	lastMillis int64
+	lastRandom [10]byte
+}
+
+// NewULIDGenerator creates a new ULID-based ID generator.
+func NewULIDGenerator(options ULIDGeneratorOptions) *ULIDGenerator {
+	return &ULIDGenerator{options: options, lastMillis: -1}
+}
+
+// GenerateRunID generates a ULID-based run ID.
+func (g *ULIDGenerator) GenerateRunID() string {
+	return g.generateULID("run")
+}
+
+// GenerateMessageID generates a ULID-based message ID.
+func (g *ULIDGenerator) GenerateMessageID() string {
+	return g.generateULID("msg")
+}
+
+// GenerateToolCallID generates a ULID-based tool call ID.
+func (g *ULIDGenerator) GenerateToolCallID() string {
+	return g.generateULID("tool")
+}
+
+// GenerateThreadID generates a ULID-based thread ID.
+func (g *ULIDGenerator) GenerateThreadID() string {
+	return g.generateULID("thread")
+}
+
+// GenerateStepID generates a ULID-based step ID.
+func (g *ULIDGenerator) GenerateStepID() string {
+	return g.generateULID("step")
+}
+
+// generateULID mints a new monotonic ULID and applies the type prefix
+// unless the generator was configured with ULIDGeneratorOptions.Prefixed
+// set to false.
+func (g *ULIDGenerator) generateULID(typePrefix string) string {
+	g.mu.Lock()
+	now := time.Now().UnixMilli()
+	if now <= g.lastMillis {
+		incrementRandom(&g.lastRandom)
+		now = g.lastMillis
+	} else {
+		if _, err := rand.Read(g.lastRandom[:]); err != nil {
+			// crypto/rand failing indicates a broken system entropy
+			// source; there is no sane fallback, so surface it the same
+			// way uuid.New() does elsewhere in this file - by panicking.
+			panic(fmt.Sprintf("events: read random ULID suffix: %v", err))
+		}
+		g.lastMillis = now
+	}
+	id := encodeULID(now, g.lastRandom)
+	g.mu.Unlock()
+
+	if !g.options.Prefixed {
+		return id
+	}
+	return fmt.Sprintf("%s-%s", typePrefix, id)
+}
+
+// incrementRandom adds 1 to random, treated as an 80-bit big-endian
+// integer, so consecutive IDs minted within the same millisecond still
+// sort in generation order.
+func incrementRandom(random *[10]byte) {
+	for i := len(random) - 1; i >= 0; i-- {
+		random[i]++
+		if random[i] != 0 {
+			return
+		}
+	}
+}
+
+// encodeULID packs a 48-bit millisecond timestamp and 80 bits of
+// randomness into the 26-character Crockford base32 ULID encoding.
+func encodeULID(millis int64, random [10]byte) string {
+	var out [ulidEncodedLen]byte
+
+	// Timestamp: 48 bits = 10 base32 symbols (5 bits each).
+	out[0] = crockfordAlphabet[(millis>>45)&0x1F]
+	out[1] = crockfordAlphabet[(millis>>40)&0x1F]
+	out[2] = crockfordAlphabet[(millis>>35)&0x1F]
+	out[3] = crockfordAlphabet[(millis>>30)&0x1F]
+	out[4] = crockfordAlphabet[(millis>>25)&0x1F]
+	out[5] = crockfordAlphabet[(millis>>20)&0x1F]
+	out[6] = crockfordAlphabet[(millis>>15)&0x1F]
+	out[7] = crockfordAlphabet[(millis>>10)&0x1F]
+	out[8] = crockfordAlphabet[(millis>>5)&0x1F]
+	out[9] = crockfordAlphabet[millis&0x1F]
+
+	// Randomness: 80 bits = 16 base32 symbols. Treat the 10 random bytes
+	// as a single big-endian integer and peel off 5 bits at a time.
+	r := uint64(random[0])<<32 | uint64(random[1])<<24 | uint64(random[2])<<16 | uint64(random[3])<<8 | uint64(random[4])
+	out[10] = crockfordAlphabet[(r>>35)&0x1F]
+	out[11] = crockfordAlphabet[(r>>30)&0x1F]
+	out[12] = crockfordAlphabet[(r>>25)&0x1F]
+	out[13] = crockfordAlphabet[(r>>20)&0x1F]
+	out[14] = crockfordAlphabet[(r>>15)&0x1F]
+	out[15] = crockfordAlphabet[(r>>10)&0x1F]
+	out[16] = crockfordAlphabet[(r>>5)&0x1F]
+	out[17] = crockfordAlphabet[r&0x1F]
+
+	r2 := uint64(random[5])<<32 | uint64(random[6])<<24 | uint64(random[7])<<16 | uint64(random[8])<<8 | uint64(random[9])
+	out[18] = crockfordAlphabet[(r2>>35)&0x1F]
+	out[19] = crockfordAlphabet[(r2>>30)&0x1F]
+	out[20] = crockfordAlphabet[(r2>>25)&0x1F]
+	out[21] = crockfordAlphabet[(r2>>20)&0x1F]
+	out[22] = crockfordAlphabet[(r2>>15)&0x1F]
+	out[23] = crockfordAlphabet[(r2>>10)&0x1F]
+	out[24] = crockfordAlphabet[(r2>>5)&0x1F]
+	out[25] = crockfordAlphabet[r2&0x1F]
+
+	return string(out[:])
+}
+
+// crockfordValue maps a Crockford base32 symbol back to its 5-bit value,
+// or -1 if r is not a valid symbol.
+func crockfordValue(r byte) int {
+	switch {
+	case r >= '0' && r <= '9':
+		return int(r - '0')
+	case r >= 'A' && r <= 'Z':
+		for i := 0; i < len(crockfordAlphabet); i++ {
+			if crockfordAlphabet[i] == r {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// ParseULID extracts the creation timestamp embedded in a ULID-based ID.
+// id may be a bare 26-character ULID or a prefixed ID produced by
+// ULIDGenerator with ULIDGeneratorOptions.Prefixed set - the timestamp is
+// decoded from the last 26 characters, since a ULID's own alphabet never
+// contains the "-" the type prefix is joined with.
+func ParseULID(id string) (time.Time, error) {
+	if len(id) < ulidEncodedLen {
+		return time.Time{}, fmt.Errorf("events: %q is too short to contain a ULID", id)
+	}
+	encoded := id[len(id)-ulidEncodedLen:]
+
+	var millis int64
+	for i := 0; i < 10; i++ {
+		v := crockfordValue(encoded[i])
+		if v < 0 {
+			return time.Time{}, fmt.Errorf("events: %q is not a valid ULID", id)
+		}
+		millis = millis<<5 | int64(v)
+	}
+
+	return time.UnixMilli(millis), nil
+}
+
 // Global default ID generator instance
 var defaultIDGenerator IDGenerator = NewDefaultIDGenerator()
 
@@ -191,6 +191,110 @@ func TestTimestampIDGenerator(t *testing.T) {
 	})
 }
 
+func TestULIDGenerator(t *testing.T) {
+	t.Run("NewULIDGenerator", func(t *testing.T) {
+		gen := NewULIDGenerator(ULIDGeneratorOptions{Prefixed: true})
+		assert.NotNil(t, gen)
+	})
+
+	t.Run("GenerateRunID_Prefixed", func(t *testing.T) {
+		gen := NewULIDGenerator(ULIDGeneratorOptions{Prefixed: true})
+		id := gen.GenerateRunID()
+
+		assert.True(t, strings.HasPrefix(id, "run-"))
+		assert.Len(t, strings.TrimPrefix(id, "run-"), ulidEncodedLen)
+	})
+
+	t.Run("GenerateRunID_Unprefixed", func(t *testing.T) {
+		gen := NewULIDGenerator(ULIDGeneratorOptions{Prefixed: false})
+		id := gen.GenerateRunID()
+
+		assert.Len(t, id, ulidEncodedLen)
+	})
+
+	t.Run("AllIDTypes_Prefixed", func(t *testing.T) {
+		gen := NewULIDGenerator(ULIDGeneratorOptions{Prefixed: true})
+
+		assert.True(t, strings.HasPrefix(gen.GenerateMessageID(), "msg-"))
+		assert.True(t, strings.HasPrefix(gen.GenerateToolCallID(), "tool-"))
+		assert.True(t, strings.HasPrefix(gen.GenerateThreadID(), "thread-"))
+		assert.True(t, strings.HasPrefix(gen.GenerateStepID(), "step-"))
+	})
+
+	t.Run("Lexicographic_Ordering", func(t *testing.T) {
+		gen := NewULIDGenerator(ULIDGeneratorOptions{Prefixed: false})
+
+		id1 := gen.GenerateRunID()
+		time.Sleep(2 * time.Millisecond)
+		id2 := gen.GenerateRunID()
+
+		assert.Less(t, id1, id2)
+	})
+
+	t.Run("Monotonic_WithinSameMillisecond", func(t *testing.T) {
+		gen := NewULIDGenerator(ULIDGeneratorOptions{Prefixed: false})
+
+		var ids []string
+		for i := 0; i < 100; i++ {
+			ids = append(ids, gen.GenerateRunID())
+		}
+
+		for i := 1; i < len(ids); i++ {
+			assert.Less(t, ids[i-1], ids[i])
+		}
+	})
+
+	t.Run("Concurrent_Uniqueness", func(t *testing.T) {
+		gen := NewULIDGenerator(ULIDGeneratorOptions{Prefixed: false})
+		idChan := make(chan string, 100)
+
+		for i := 0; i < 100; i++ {
+			go func() {
+				idChan <- gen.GenerateRunID()
+			}()
+		}
+
+		ids := make(map[string]bool)
+		for i := 0; i < 100; i++ {
+			id := <-idChan
+			assert.False(t, ids[id], "duplicate ULID generated: %s", id)
+			ids[id] = true
+		}
+	})
+}
+
+func TestParseULID(t *testing.T) {
+	t.Run("RoundTrip_Unprefixed", func(t *testing.T) {
+		gen := NewULIDGenerator(ULIDGeneratorOptions{Prefixed: false})
+		before := time.Now()
+		id := gen.GenerateRunID()
+
+		parsed, err := ParseULID(id)
+		require.NoError(t, err)
+		assert.WithinDuration(t, before, parsed, 2*time.Second)
+	})
+
+	t.Run("RoundTrip_Prefixed", func(t *testing.T) {
+		gen := NewULIDGenerator(ULIDGeneratorOptions{Prefixed: true})
+		before := time.Now()
+		id := gen.GenerateThreadID()
+
+		parsed, err := ParseULID(id)
+		require.NoError(t, err)
+		assert.WithinDuration(t, before, parsed, 2*time.Second)
+	})
+
+	t.Run("TooShort", func(t *testing.T) {
+		_, err := ParseULID("short")
+		assert.Error(t, err)
+	})
+
+	t.Run("InvalidCharacters", func(t *testing.T) {
+		_, err := ParseULID(strings.Repeat("!", ulidEncodedLen))
+		assert.Error(t, err)
+	})
+}
+
 func TestGlobalIDGenerator(t *testing.T) {
 	t.Run("GetDefaultIDGenerator", func(t *testing.T) {
 		gen := GetDefaultIDGenerator()
@@ -0,0 +1,94 @@
+package events
+
+import "fmt"
+
+// Action tells ValidateJSONSequence what to do with a raw message that
+// failed to parse or validate.
+type Action int
+
+const (
+	// ActionDrop omits the malformed event from the returned slice and
+	// continues with the rest of the sequence.
+	ActionDrop Action = iota
+	// ActionKeep includes the malformed event (typically the UnknownEvent
+	// placeholder EventFromJSONLenient produced) in the returned slice and
+	// continues with the rest of the sequence.
+	ActionKeep
+	// ActionFail aborts ValidateJSONSequence immediately, returning the
+	// malformed event's error.
+	ActionFail
+)
+
+// ValidateSequenceOptions configures ValidateJSONSequence's tolerance for
+// malformed events in a raw JSON event stream.
+type ValidateSequenceOptions struct {
+	// ContinueOnError, when true, keeps processing later messages after a
+	// malformed one (equivalent to ActionKeep) instead of aborting
+	// immediately. Ignored once OnMalformed is set, since OnMalformed's
+	// return value then decides the outcome for every malformed message.
+	ContinueOnError bool
+
+	// MaxErrors aborts ValidateJSONSequence once more than MaxErrors
+	// malformed messages have been seen, regardless of ContinueOnError or
+	// what OnMalformed returns. Zero means unlimited.
+	MaxErrors int
+
+	// OnMalformed, if set, is called for every raw message that fails to
+	// parse into a known event (EventFromJSONLenient substituted an
+	// UnknownEvent) or fails its own Validate/sequence check, and its
+	// return value decides that message's fate: ActionDrop, ActionKeep, or
+	// ActionFail. When nil, ContinueOnError alone governs the decision.
+	OnMalformed func(idx int, raw []byte, err error) Action
+}
+
+// ValidateJSONSequence parses each element of raw with EventFromJSONLenient
+// and runs the results through the same built-in lifecycle invariants as
+// ValidateSequence, using opts to decide whether a malformed or invalid
+// message aborts the whole sequence or is dropped/kept and quarantined for
+// later inspection. This lets a streaming consumer tolerate a partially
+// malformed feed (e.g. a garbled upstream LLM chunk) instead of tearing down
+// the whole run on the first bad message.
+func ValidateJSONSequence(raw [][]byte, opts ValidateSequenceOptions) ([]Event, error) {
+	state := newSequenceState()
+	validator := NewSequenceValidator()
+	result := make([]Event, 0, len(raw))
+	errCount := 0
+
+	for i, data := range raw {
+		event := EventFromJSONLenient(data)
+
+		err := event.Validate()
+		if err == nil {
+			err = validator.checkBuiltin(event, state)
+		}
+
+		if err == nil {
+			result = append(result, event)
+			continue
+		}
+
+		errCount++
+		if opts.MaxErrors > 0 && errCount > opts.MaxErrors {
+			return nil, fmt.Errorf("event %d: too many malformed events (max %d): %w", i, opts.MaxErrors, err)
+		}
+
+		action := ActionFail
+		switch {
+		case opts.OnMalformed != nil:
+			action = opts.OnMalformed(i, data, err)
+		case opts.ContinueOnError:
+			action = ActionKeep
+		}
+
+		switch action {
+		case ActionDrop:
+			continue
+		case ActionKeep:
+			result = append(result, event)
+		default:
+			return nil, fmt.Errorf("event %d validation failed: %w", i, err)
+		}
+	}
+
+	return result, nil
+}
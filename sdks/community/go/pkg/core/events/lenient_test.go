@@ -0,0 +1,80 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventFromJSONLenient(t *testing.T) {
+	t.Run("UnknownType", func(t *testing.T) {
+		event := EventFromJSONLenient([]byte(`{"type":"NOT_A_REAL_TYPE"}`))
+
+		unknown, ok := event.(*UnknownEvent)
+		require.True(t, ok)
+		assert.Equal(t, EventTypeUnknown, unknown.Type())
+		assert.NotEmpty(t, unknown.ParseError)
+		assert.Error(t, unknown.Validate())
+	})
+
+	t.Run("InvalidJSON", func(t *testing.T) {
+		event := EventFromJSONLenient([]byte(`not json`))
+
+		unknown, ok := event.(*UnknownEvent)
+		require.True(t, ok)
+		assert.Equal(t, []byte("not json"), []byte(unknown.Raw))
+	})
+
+	t.Run("ValidEventParsesNormally", func(t *testing.T) {
+		valid := NewRunStartedEvent("thread-1", "run-1")
+		data, err := valid.ToJSON()
+		require.NoError(t, err)
+
+		event := EventFromJSONLenient(data)
+
+		_, ok := event.(*UnknownEvent)
+		assert.False(t, ok)
+		assert.Equal(t, EventTypeRunStarted, event.Type())
+	})
+}
+
+func TestValidateJSONSequence(t *testing.T) {
+	validRun, err := NewRunStartedEvent("thread-1", "run-1").ToJSON()
+	require.NoError(t, err)
+	malformed := []byte(`{"type":"NOT_A_REAL_TYPE"}`)
+
+	t.Run("FailsByDefault", func(t *testing.T) {
+		_, err := ValidateJSONSequence([][]byte{validRun, malformed}, ValidateSequenceOptions{})
+		assert.Error(t, err)
+	})
+
+	t.Run("ContinueOnErrorKeepsPlaceholder", func(t *testing.T) {
+		result, err := ValidateJSONSequence([][]byte{validRun, malformed}, ValidateSequenceOptions{ContinueOnError: true})
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		_, ok := result[1].(*UnknownEvent)
+		assert.True(t, ok)
+	})
+
+	t.Run("OnMalformedCanDrop", func(t *testing.T) {
+		var seenIdx int
+		result, err := ValidateJSONSequence([][]byte{validRun, malformed}, ValidateSequenceOptions{
+			OnMalformed: func(idx int, raw []byte, err error) Action {
+				seenIdx = idx
+				return ActionDrop
+			},
+		})
+		require.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, 1, seenIdx)
+	})
+
+	t.Run("MaxErrorsAborts", func(t *testing.T) {
+		_, err := ValidateJSONSequence([][]byte{malformed, malformed}, ValidateSequenceOptions{
+			ContinueOnError: true,
+			MaxErrors:       1,
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,75 @@
+package events
+
+import "sync"
+
+// TypeRegistry maps an EventType to a factory that allocates a fresh,
+// zero-valued Event of the matching concrete type. It exists so a decoder
+// can build the right Go struct for an event type via a lookup instead of a
+// hard-coded switch that every new event type (and every new codec -
+// Protobuf, CBOR, CloudEvents) would otherwise need its own copy of.
+//
+// A TypeRegistry is safe for concurrent use.
+type TypeRegistry struct {
+	mu        sync.RWMutex
+	factories map[EventType]func() Event
+}
+
+// NewTypeRegistry creates an empty TypeRegistry.
+func NewTypeRegistry() *TypeRegistry {
+	return &TypeRegistry{factories: make(map[EventType]func() Event)}
+}
+
+// Register associates eventType with factory, overwriting any existing
+// registration for that type.
+func (r *TypeRegistry) Register(eventType EventType, factory func() Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.factories[eventType] = factory
+}
+
+// Lookup returns the factory registered for eventType, and whether one was
+// found.
+func (r *TypeRegistry) Lookup(eventType EventType) (factory func() Event, ok bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	factory, ok = r.factories[eventType]
+	return factory, ok
+}
+
+// DefaultTypeRegistry is pre-populated with the built-in AG-UI event types
+// at package init, so a decoder that doesn't call WithRegistry still gets
+// full coverage for free. Callers needing application-specific event types
+// can Register onto this registry directly, or build their own with
+// NewTypeRegistry and swap it in.
+var DefaultTypeRegistry = NewTypeRegistry()
+
+func init() {
+	DefaultTypeRegistry.Register(EventTypeTextMessageStart, func() Event { return &TextMessageStartEvent{} })
+	DefaultTypeRegistry.Register(EventTypeTextMessageContent, func() Event { return &TextMessageContentEvent{} })
+	DefaultTypeRegistry.Register(EventTypeTextMessageEnd, func() Event { return &TextMessageEndEvent{} })
+	DefaultTypeRegistry.Register(EventTypeToolCallStart, func() Event { return &ToolCallStartEvent{} })
+	DefaultTypeRegistry.Register(EventTypeToolCallArgs, func() Event { return &ToolCallArgsEvent{} })
+	DefaultTypeRegistry.Register(EventTypeToolCallEnd, func() Event { return &ToolCallEndEvent{} })
+	DefaultTypeRegistry.Register(EventTypeStateSnapshot, func() Event { return &StateSnapshotEvent{} })
+	DefaultTypeRegistry.Register(EventTypeStateDelta, func() Event { return &StateDeltaEvent{} })
+	DefaultTypeRegistry.Register(EventTypeMessagesSnapshot, func() Event { return &MessagesSnapshotEvent{} })
+	DefaultTypeRegistry.Register(EventTypeRaw, func() Event { return &RawEvent{} })
+	DefaultTypeRegistry.Register(EventTypeCustom, func() Event { return &CustomEvent{} })
+	DefaultTypeRegistry.Register(EventTypeRunStarted, func() Event { return &RunStartedEvent{} })
+	DefaultTypeRegistry.Register(EventTypeRunFinished, func() Event { return &RunFinishedEvent{} })
+	DefaultTypeRegistry.Register(EventTypeRunError, func() Event { return &RunErrorEvent{} })
+	DefaultTypeRegistry.Register(EventTypeStepStarted, func() Event { return &StepStartedEvent{} })
+	DefaultTypeRegistry.Register(EventTypeStepFinished, func() Event { return &StepFinishedEvent{} })
+	DefaultTypeRegistry.Register(EventTypeUsage, func() Event { return &UsageEvent{} })
+	DefaultTypeRegistry.Register(EventTypeToolCallResult, func() Event { return &ToolCallResultEvent{} })
+	DefaultTypeRegistry.Register(EventTypeThinkingStart, func() Event { return &ThinkingStartEvent{} })
+	DefaultTypeRegistry.Register(EventTypeThinkingEnd, func() Event { return &ThinkingEndEvent{} })
+	DefaultTypeRegistry.Register(EventTypeThinkingTextMessageStart, func() Event { return &ThinkingTextMessageStartEvent{} })
+	DefaultTypeRegistry.Register(EventTypeThinkingTextMessageContent, func() Event { return &ThinkingTextMessageContentEvent{} })
+	DefaultTypeRegistry.Register(EventTypeThinkingTextMessageEnd, func() Event { return &ThinkingTextMessageEndEvent{} })
+	DefaultTypeRegistry.Register(EventTypeThinkingStepStart, func() Event { return &ThinkingStepStartEvent{} })
+	DefaultTypeRegistry.Register(EventTypeThinkingStepContent, func() Event { return &ThinkingStepContentEvent{} })
+	DefaultTypeRegistry.Register(EventTypeThinkingStepEnd, func() Event { return &ThinkingStepEndEvent{} })
+	DefaultTypeRegistry.Register(EventTypeThinkingToolCallStart, func() Event { return &ThinkingToolCallStartEvent{} })
+	DefaultTypeRegistry.Register(EventTypeThinkingToolCallEnd, func() Event { return &ThinkingToolCallEndEvent{} })
+}
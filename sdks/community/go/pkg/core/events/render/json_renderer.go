@@ -0,0 +1,142 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// JSONRenderer renders each event as a JSON object, one per line (or
+// pretty-printed across multiple lines when Indent is set). With
+// IncludeHistory, the streaming chunk events of a scope (ToolCallArgsEvent,
+// TextMessageContentEvent) are buffered instead of rendered individually,
+// then attached as a "history" array on the event that closes their scope
+// (ToolCallEndEvent, TextMessageEndEvent) - so a reader sees one
+// self-contained record per logical operation instead of having to stitch
+// chunks back together itself. A JSONRenderer is safe for concurrent use.
+type JSONRenderer struct {
+	Indent         bool
+	IncludeHistory bool
+
+	mu          sync.Mutex
+	toolArgs    map[string][]events.Event
+	msgContents map[string][]events.Event
+}
+
+// Render writes event as a JSON object followed by a newline.
+func (r *JSONRenderer) Render(w io.Writer, event events.Event) error {
+	if r.IncludeHistory {
+		if r.buffer(event) {
+			return nil
+		}
+	}
+
+	data, err := event.ToJSON()
+	if err != nil {
+		return fmt.Errorf("render: failed to marshal event: %w", err)
+	}
+
+	if r.IncludeHistory {
+		if history, ok := r.takeHistory(event); ok {
+			data, err = attachHistory(data, history)
+			if err != nil {
+				return fmt.Errorf("render: failed to attach history: %w", err)
+			}
+		}
+	}
+
+	if r.Indent {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, data, "", "  "); err != nil {
+			return fmt.Errorf("render: failed to indent JSON: %w", err)
+		}
+		data = buf.Bytes()
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+// buffer stores event instead of rendering it immediately, if it's a
+// streaming chunk whose history belongs under a later scope-closing event.
+// It reports whether event was buffered.
+func (r *JSONRenderer) buffer(event events.Event) bool {
+	switch e := event.(type) {
+	case *events.ToolCallArgsEvent:
+		r.mu.Lock()
+		if r.toolArgs == nil {
+			r.toolArgs = make(map[string][]events.Event)
+		}
+		r.toolArgs[e.ToolCallID] = append(r.toolArgs[e.ToolCallID], e)
+		r.mu.Unlock()
+		return true
+
+	case *events.TextMessageContentEvent:
+		r.mu.Lock()
+		if r.msgContents == nil {
+			r.msgContents = make(map[string][]events.Event)
+		}
+		r.msgContents[e.MessageID] = append(r.msgContents[e.MessageID], e)
+		r.mu.Unlock()
+		return true
+
+	default:
+		return false
+	}
+}
+
+// takeHistory returns and clears the buffered chunks for event's scope, if
+// event is one of the scope-closing types buffer's chunks accumulate under.
+func (r *JSONRenderer) takeHistory(event events.Event) ([]events.Event, bool) {
+	switch e := event.(type) {
+	case *events.ToolCallEndEvent:
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		chunks, ok := r.toolArgs[e.ToolCallID]
+		delete(r.toolArgs, e.ToolCallID)
+		return chunks, ok
+
+	case *events.TextMessageEndEvent:
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		chunks, ok := r.msgContents[e.MessageID]
+		delete(r.msgContents, e.MessageID)
+		return chunks, ok
+
+	default:
+		return nil, false
+	}
+}
+
+// attachHistory adds a "history" field containing each chunk's JSON form to
+// the already-marshaled event data.
+func attachHistory(data []byte, chunks []events.Event) ([]byte, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+
+	history := make([]json.RawMessage, 0, len(chunks))
+	for _, chunk := range chunks {
+		chunkData, err := chunk.ToJSON()
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, chunkData)
+	}
+
+	historyData, err := json.Marshal(history)
+	if err != nil {
+		return nil, err
+	}
+	obj["history"] = historyData
+
+	return json.Marshal(obj)
+}
@@ -0,0 +1,160 @@
+// Package render formats AG-UI events for human or machine consumption - a
+// one-line table view, a sectioned "detailed" dump, or JSON (optionally
+// pretty-printed and enriched with correlated history) - so a CLI built on
+// this module can offer a --detailed flag without reimplementing
+// formatting itself.
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// Renderer writes one event to w in some presentation format.
+type Renderer interface {
+	Render(w io.Writer, event events.Event) error
+}
+
+// TableRenderer renders each event as a single line: type, timestamp, and
+// whichever of thread/run/message/tool-call ID the event carries.
+type TableRenderer struct{}
+
+// Render writes event as a single table row.
+func (TableRenderer) Render(w io.Writer, event events.Event) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-32s %s", event.Type(), formatTimestamp(event))
+	writeIDs(&b, event)
+	b.WriteString("\n")
+
+	_, err := w.Write([]byte(b.String()))
+	return err
+}
+
+// DetailedRenderer renders each event as a sectioned, human-readable dump:
+// a header line with the event type, then the timestamp and (when set) the
+// thread and run IDs on their own lines, then RawEvent.Event,
+// StateDeltaEvent.Delta, or ToolCallArgsEvent.Delta (after its tool call ID)
+// pretty-printed as JSON; any other event is dumped as its full JSON form.
+type DetailedRenderer struct{}
+
+// Render writes event as a multi-line, sectioned dump.
+func (DetailedRenderer) Render(w io.Writer, event events.Event) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "=== %s ===\n", event.Type())
+	fmt.Fprintf(&b, "timestamp: %s\n", formatTimestamp(event))
+	if tid := event.ThreadID(); tid != "" {
+		fmt.Fprintf(&b, "thread:    %s\n", tid)
+	}
+	if rid := event.RunID(); rid != "" {
+		fmt.Fprintf(&b, "run:       %s\n", rid)
+	}
+
+	switch e := event.(type) {
+	case *events.RawEvent:
+		b.WriteString("event:\n")
+		writePrettyValue(&b, e.Event)
+	case *events.StateDeltaEvent:
+		b.WriteString("delta:\n")
+		writePrettyValue(&b, e.Delta)
+	case *events.ToolCallArgsEvent:
+		fmt.Fprintf(&b, "tool_call: %s\n", e.ToolCallID)
+		b.WriteString("delta:\n")
+		writePrettyJSONString(&b, e.Delta)
+	default:
+		data, err := event.ToJSON()
+		if err != nil {
+			return fmt.Errorf("render: failed to marshal event: %w", err)
+		}
+		b.WriteString("data:\n")
+		writePrettyJSONString(&b, string(data))
+	}
+	b.WriteString("\n")
+
+	_, err := w.Write([]byte(b.String()))
+	return err
+}
+
+// formatTimestamp renders event's timestamp as RFC3339, or "-" if unset.
+func formatTimestamp(event events.Event) string {
+	ts := event.Timestamp()
+	if ts == nil {
+		return "-"
+	}
+	return time.UnixMilli(*ts).UTC().Format(time.RFC3339)
+}
+
+// writeIDs appends whichever correlating IDs event carries as "key=value"
+// pairs - the message/tool-call IDs TableRenderer can't get from the Event
+// interface alone.
+func writeIDs(b *strings.Builder, event events.Event) {
+	if tid := event.ThreadID(); tid != "" {
+		fmt.Fprintf(b, " thread=%s", tid)
+	}
+	if rid := event.RunID(); rid != "" {
+		fmt.Fprintf(b, " run=%s", rid)
+	}
+	if mid, ok := messageID(event); ok {
+		fmt.Fprintf(b, " message=%s", mid)
+	}
+	if tcid, ok := toolCallID(event); ok {
+		fmt.Fprintf(b, " tool_call=%s", tcid)
+	}
+}
+
+func messageID(event events.Event) (string, bool) {
+	switch e := event.(type) {
+	case *events.TextMessageStartEvent:
+		return e.MessageID, true
+	case *events.TextMessageContentEvent:
+		return e.MessageID, true
+	case *events.TextMessageEndEvent:
+		return e.MessageID, true
+	default:
+		return "", false
+	}
+}
+
+func toolCallID(event events.Event) (string, bool) {
+	switch e := event.(type) {
+	case *events.ToolCallStartEvent:
+		return e.ToolCallID, true
+	case *events.ToolCallArgsEvent:
+		return e.ToolCallID, true
+	case *events.ToolCallEndEvent:
+		return e.ToolCallID, true
+	default:
+		return "", false
+	}
+}
+
+// writePrettyValue marshals v as indented JSON, falling back to "%+v" if it
+// doesn't marshal.
+func writePrettyValue(b *strings.Builder, v any) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		fmt.Fprintf(b, "%+v\n", v)
+		return
+	}
+	b.Write(data)
+	b.WriteString("\n")
+}
+
+// writePrettyJSONString pretty-prints s if it parses as JSON, otherwise
+// writes it verbatim - ToolCallArgsEvent.Delta is only valid JSON once every
+// chunk has arrived, so a single delta is often a fragment.
+func writePrettyJSONString(b *strings.Builder, s string) {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(s), "", "  "); err != nil {
+		b.WriteString(s)
+		b.WriteString("\n")
+		return
+	}
+	b.WriteString(buf.String())
+	b.WriteString("\n")
+}
@@ -0,0 +1,60 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableRenderer(t *testing.T) {
+	var b strings.Builder
+	event := events.NewToolCallStartEvent("tool-1", "search")
+
+	require.NoError(t, (TableRenderer{}).Render(&b, event))
+
+	out := b.String()
+	assert.Contains(t, out, "TOOL_CALL_START")
+	assert.Contains(t, out, "tool_call=tool-1")
+	assert.True(t, strings.HasSuffix(out, "\n"))
+}
+
+func TestDetailedRenderer(t *testing.T) {
+	var b strings.Builder
+	event := events.NewToolCallArgsEvent("tool-1", `{"q": "weather"}`)
+
+	require.NoError(t, (DetailedRenderer{}).Render(&b, event))
+
+	out := b.String()
+	assert.Contains(t, out, "=== TOOL_CALL_ARGS ===")
+	assert.Contains(t, out, "tool_call: tool-1")
+	assert.Contains(t, out, "\"q\": \"weather\"")
+}
+
+func TestJSONRenderer(t *testing.T) {
+	t.Run("WithoutHistory", func(t *testing.T) {
+		var b strings.Builder
+		r := &JSONRenderer{}
+		event := events.NewRunStartedEvent("thread-1", "run-1")
+
+		require.NoError(t, r.Render(&b, event))
+		assert.Contains(t, b.String(), `"type":"RUN_STARTED"`)
+	})
+
+	t.Run("IncludeHistoryBuffersChunksUnderEnd", func(t *testing.T) {
+		var b strings.Builder
+		r := &JSONRenderer{IncludeHistory: true}
+
+		require.NoError(t, r.Render(&b, events.NewToolCallStartEvent("tool-1", "search")))
+		require.NoError(t, r.Render(&b, events.NewToolCallArgsEvent("tool-1", `{"q":`)))
+		require.NoError(t, r.Render(&b, events.NewToolCallArgsEvent("tool-1", `"weather"}`)))
+		require.NoError(t, r.Render(&b, events.NewToolCallEndEvent("tool-1")))
+
+		out := b.String()
+		assert.Equal(t, 2, strings.Count(out, "\n"), "args chunks should be buffered, not rendered individually")
+		assert.Contains(t, out, `"history":[`)
+		assert.Contains(t, out, `\"q\":`)
+	})
+}
@@ -0,0 +1,21 @@
+package render
+
+import (
+	"io"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// Stream renders every event received on ch to w using r, until ch is
+// closed or r.Render returns an error. This is the entry point a CLI wires
+// a --detailed/--json flag to: pick TableRenderer, DetailedRenderer, or a
+// JSONRenderer and hand it and the event channel to Stream instead of
+// reimplementing the read loop.
+func Stream(w io.Writer, ch <-chan events.Event, r Renderer) error {
+	for event := range ch {
+		if err := r.Render(w, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package events
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// SequenceIDGenerator produces strictly increasing sequence numbers used to
+// key a ReplayBuffer, so buffered events can be ordered and compared for
+// gaps independently of wall-clock time or any single event's own ID.
+type SequenceIDGenerator interface {
+	// NextSequenceID returns the next strictly increasing sequence ID.
+	NextSequenceID() int64
+}
+
+// DefaultSequenceIDGenerator implements SequenceIDGenerator with an atomic
+// counter, starting at 1 so 0 can mean "nothing received yet" to a
+// ReplayBuffer's caller.
+type DefaultSequenceIDGenerator struct {
+	counter int64
+}
+
+// NewDefaultSequenceIDGenerator creates a DefaultSequenceIDGenerator.
+func NewDefaultSequenceIDGenerator() *DefaultSequenceIDGenerator {
+	return &DefaultSequenceIDGenerator{}
+}
+
+// NextSequenceID returns the next strictly increasing sequence ID.
+func (g *DefaultSequenceIDGenerator) NextSequenceID() int64 {
+	return atomic.AddInt64(&g.counter, 1)
+}
+
+// SequencedEvent pairs an Event with the sequence ID a ReplayBuffer
+// assigned it, so a caller replaying buffered events can re-emit each one
+// under its original ID.
+type SequencedEvent struct {
+	Seq   int64
+	Event Event
+}
+
+// ReplayBuffer is a bounded, per-thread ring buffer of recently emitted
+// events, keyed by a SequenceIDGenerator-assigned sequence number, so a
+// client reconnecting with a Last-Event-ID can resume a thread's stream
+// without gaps. One ReplayBuffer is meant to back one thread; a server
+// handling many threads keeps a ReplayBuffer per thread ID.
+type ReplayBuffer struct {
+	mu       sync.Mutex
+	entries  []SequencedEvent
+	stored   []time.Time
+	maxCount int
+	ttl      time.Duration
+	gen      SequenceIDGenerator
+}
+
+// NewReplayBuffer creates a ReplayBuffer holding at most maxCount events (0
+// for unbounded) that remain eligible for replay for at most ttl after
+// being added (0 disables TTL eviction), using gen to assign sequence
+// numbers. A nil gen defaults to a DefaultSequenceIDGenerator private to
+// this buffer.
+func NewReplayBuffer(maxCount int, ttl time.Duration, gen SequenceIDGenerator) *ReplayBuffer {
+	if gen == nil {
+		gen = NewDefaultSequenceIDGenerator()
+	}
+	return &ReplayBuffer{
+		maxCount: maxCount,
+		ttl:      ttl,
+		gen:      gen,
+	}
+}
+
+// Add appends event to the buffer under a freshly assigned sequence ID,
+// evicting the oldest entry once maxCount is exceeded, and returns the
+// assigned sequence ID so the caller can surface it as the SSE frame's id.
+func (b *ReplayBuffer) Add(event Event) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	seq := b.gen.NextSequenceID()
+	b.entries = append(b.entries, SequencedEvent{Seq: seq, Event: event})
+	b.stored = append(b.stored, time.Now())
+
+	if b.maxCount > 0 {
+		for len(b.entries) > b.maxCount {
+			b.entries = b.entries[1:]
+			b.stored = b.stored[1:]
+		}
+	}
+	return seq
+}
+
+// Since returns the buffered events with sequence numbers strictly greater
+// than afterSeq, in order. ok is false if afterSeq can't be satisfied from
+// what's currently buffered - either because afterSeq is nonzero and older
+// than the oldest buffered entry (afterSeq itself, and possibly entries after
+// it, have been evicted), or because a matching entry has aged out past
+// ttl - telling the caller to fall back
+// to a fresh snapshot instead of a partial, gappy replay.
+func (b *ReplayBuffer) Since(afterSeq int64) (replayed []SequencedEvent, ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.entries) == 0 {
+		return nil, afterSeq == 0
+	}
+
+	if oldest := b.entries[0].Seq; afterSeq != 0 && afterSeq < oldest {
+		return nil, false
+	}
+
+	var cutoff time.Time
+	if b.ttl > 0 {
+		cutoff = time.Now().Add(-b.ttl)
+	}
+
+	for i, e := range b.entries {
+		if e.Seq <= afterSeq {
+			continue
+		}
+		if b.ttl > 0 && b.stored[i].Before(cutoff) {
+			return nil, false
+		}
+		replayed = append(replayed, e)
+	}
+	return replayed, true
+}
@@ -0,0 +1,58 @@
+package events
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReplayBufferSinceReturnsOnlyNewerEntries(t *testing.T) {
+	buf := NewReplayBuffer(0, 0, nil)
+
+	firstSeq := buf.Add(NewRunStartedEvent("thread-1", "run-1"))
+	buf.Add(NewStepStartedEvent("step-1"))
+	thirdSeq := buf.Add(NewRunFinishedEvent("thread-1", "run-1"))
+
+	replayed, ok := buf.Since(firstSeq)
+	if !ok {
+		t.Fatalf("expected ok, buffer hasn't evicted anything")
+	}
+	if len(replayed) != 2 {
+		t.Fatalf("expected 2 events after the first, got %d", len(replayed))
+	}
+	if replayed[len(replayed)-1].Seq != thirdSeq {
+		t.Fatalf("expected the last replayed entry to be seq %d, got %d", thirdSeq, replayed[len(replayed)-1].Seq)
+	}
+}
+
+func TestReplayBufferEvictsOldestPastMaxCount(t *testing.T) {
+	buf := NewReplayBuffer(2, 0, nil)
+
+	firstSeq := buf.Add(NewRunStartedEvent("thread-1", "run-1"))
+	buf.Add(NewStepStartedEvent("step-1"))
+	buf.Add(NewRunFinishedEvent("thread-1", "run-1"))
+
+	if _, ok := buf.Since(firstSeq); ok {
+		t.Fatalf("expected !ok: the first entry should have been evicted once maxCount=2 was exceeded")
+	}
+}
+
+func TestReplayBufferTTLExpiresEntries(t *testing.T) {
+	buf := NewReplayBuffer(0, time.Millisecond, nil)
+
+	seq := buf.Add(NewRunStartedEvent("thread-1", "run-1"))
+	time.Sleep(5 * time.Millisecond)
+	buf.Add(NewRunFinishedEvent("thread-1", "run-1"))
+
+	if _, ok := buf.Since(seq - 1); ok {
+		t.Fatalf("expected !ok once the oldest entry has aged out past ttl")
+	}
+}
+
+func TestReplayBufferSinceOnEmptyBuffer(t *testing.T) {
+	buf := NewReplayBuffer(0, 0, nil)
+
+	replayed, ok := buf.Since(0)
+	if !ok || replayed != nil {
+		t.Fatalf("expected (nil, true) for a fresh buffer with no Last-Event-ID, got (%v, %v)", replayed, ok)
+	}
+}
@@ -10,6 +10,11 @@ type RunStartedEvent struct {
 	*BaseEvent
 	ThreadIDValue string `json:"threadId"`
 	RunIDValue    string `json:"runId"`
+	// ParentRunIDValue links this run to the one it followed - e.g. when a
+	// multi-agent handoff closes one run and opens another under a new
+	// agent - so a trace viewer can follow the conversation across the
+	// switch. Empty for a run that wasn't handed off from another.
+	ParentRunIDValue string `json:"parentRunId,omitempty"`
 }
 
 // NewRunStartedEvent creates a new run started event
@@ -57,6 +62,13 @@ func WithAutoThreadID() RunStartedOption {
 	}
 }
 
+// WithParentRunID sets the run ID this run was handed off from.
+func WithParentRunID(parentRunID string) RunStartedOption {
+	return func(e *RunStartedEvent) {
+		e.ParentRunIDValue = parentRunID
+	}
+}
+
 // Validate validates the run started event
 func (e *RunStartedEvent) Validate() error {
 	if err := e.BaseEvent.Validate(); err != nil {
@@ -89,12 +101,24 @@ func (e *RunStartedEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
 
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *RunStartedEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
 // RunFinishedEvent indicates that an agent run has finished successfully
 type RunFinishedEvent struct {
 	*BaseEvent
 	ThreadIDValue string      `json:"threadId"`
 	RunIDValue    string      `json:"runId"`
 	Result        interface{} `json:"result,omitempty"`
+	// FinishReason is the underlying LLM's stop reason for the run's last
+	// generation (e.g. "stop", "tool_calls", "length"), taken from
+	// llms.ContentResponse.Choices[].StopReason. Empty if the run finished
+	// without ever recording one.
+	FinishReason string `json:"finishReason,omitempty"`
 }
 
 // NewRunFinishedEvent creates a new run finished event
@@ -149,6 +173,13 @@ func WithResult(result interface{}) RunFinishedOption {
 	}
 }
 
+// WithFinishReason sets the underlying LLM's stop reason for the run.
+func WithFinishReason(finishReason string) RunFinishedOption {
+	return func(e *RunFinishedEvent) {
+		e.FinishReason = finishReason
+	}
+}
+
 // Validate validates the run finished event
 func (e *RunFinishedEvent) Validate() error {
 	if err := e.BaseEvent.Validate(); err != nil {
@@ -181,6 +212,13 @@ func (e *RunFinishedEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
 
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *RunFinishedEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
 // RunErrorEvent indicates that an agent run has encountered an error
 type RunErrorEvent struct {
 	*BaseEvent
@@ -252,10 +290,21 @@ func (e *RunErrorEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
 
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *RunErrorEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
 // StepStartedEvent indicates that an agent step has started
 type StepStartedEvent struct {
 	*BaseEvent
 	StepName string `json:"stepName"`
+	// AgentName identifies which agent profile is driving this step, for a
+	// UI to display which agent is active. Empty when the caller isn't
+	// using named agent profiles.
+	AgentName string `json:"agentName,omitempty"`
 }
 
 // NewStepStartedEvent creates a new step started event
@@ -292,6 +341,13 @@ func WithAutoStepName() StepStartedOption {
 	}
 }
 
+// WithAgentName sets the agent profile name driving this step.
+func WithAgentName(agentName string) StepStartedOption {
+	return func(e *StepStartedEvent) {
+		e.AgentName = agentName
+	}
+}
+
 // Validate validates the step started event
 func (e *StepStartedEvent) Validate() error {
 	if err := e.BaseEvent.Validate(); err != nil {
@@ -310,6 +366,13 @@ func (e *StepStartedEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
 
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *StepStartedEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
 // StepFinishedEvent indicates that an agent step has finished
 type StepFinishedEvent struct {
 	*BaseEvent
@@ -367,3 +430,10 @@ func (e *StepFinishedEvent) Validate() error {
 func (e *StepFinishedEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
+
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *StepFinishedEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
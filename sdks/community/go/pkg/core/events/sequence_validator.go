@@ -0,0 +1,364 @@
+package events
+
+import "fmt"
+
+// SequenceState tracks the lifecycle scopes a SequenceValidator has opened
+// so far while walking a sequence of events, exposed read-only so a custom
+// validator registered via SequenceValidator.RegisterValidator can base its
+// own checks on the same bookkeeping instead of duplicating it.
+type SequenceState struct {
+	activeRuns          map[string]bool
+	activeMessages      map[string]bool
+	activeToolCalls     map[string]bool
+	activeSteps         map[string]bool
+	finishedRuns        map[string]bool
+	thinkingOpen        bool
+	thinkingTextOpen    bool
+	activeThinkingSteps map[string]bool
+	activeThinkingTools map[string]bool
+}
+
+func newSequenceState() *SequenceState {
+	return &SequenceState{
+		activeRuns:          make(map[string]bool),
+		activeMessages:      make(map[string]bool),
+		activeToolCalls:     make(map[string]bool),
+		activeSteps:         make(map[string]bool),
+		finishedRuns:        make(map[string]bool),
+		activeThinkingSteps: make(map[string]bool),
+		activeThinkingTools: make(map[string]bool),
+	}
+}
+
+// IsThinkingOpen reports whether a ThinkingStartEvent has been seen with no
+// matching ThinkingEndEvent yet.
+func (s *SequenceState) IsThinkingOpen() bool { return s.thinkingOpen }
+
+// IsThinkingStepActive reports whether stepID has a ThinkingStepStartEvent
+// with no matching ThinkingStepEndEvent yet.
+func (s *SequenceState) IsThinkingStepActive(stepID string) bool {
+	return s.activeThinkingSteps[stepID]
+}
+
+// IsThinkingToolCallActive reports whether toolCallID has a
+// ThinkingToolCallStartEvent with no matching ThinkingToolCallEndEvent yet.
+func (s *SequenceState) IsThinkingToolCallActive(toolCallID string) bool {
+	return s.activeThinkingTools[toolCallID]
+}
+
+// IsRunActive reports whether runID has a RunStartedEvent with no matching
+// RunFinishedEvent/RunErrorEvent yet.
+func (s *SequenceState) IsRunActive(runID string) bool { return s.activeRuns[runID] }
+
+// IsRunFinished reports whether runID has already been finished or errored.
+func (s *SequenceState) IsRunFinished(runID string) bool { return s.finishedRuns[runID] }
+
+// IsMessageActive reports whether messageID has a TextMessageStartEvent
+// with no matching TextMessageEndEvent yet.
+func (s *SequenceState) IsMessageActive(messageID string) bool { return s.activeMessages[messageID] }
+
+// IsToolCallActive reports whether toolCallID has a ToolCallStartEvent with
+// no matching ToolCallEndEvent yet.
+func (s *SequenceState) IsToolCallActive(toolCallID string) bool {
+	return s.activeToolCalls[toolCallID]
+}
+
+// IsStepActive reports whether stepName has a StepStartedEvent with no
+// matching StepFinishedEvent yet.
+func (s *SequenceState) IsStepActive(stepName string) bool { return s.activeSteps[stepName] }
+
+// SequenceValidator drives ValidateSequenceWith: it applies the built-in
+// AG-UI lifecycle invariants (the ones ValidateSequence has always
+// enforced) and, after the built-in check for an event's type has passed,
+// runs any custom validators registered for that type via
+// RegisterValidator. This is the hook for EventTypeRaw, EventTypeCustom,
+// EventTypeStateSnapshot, EventTypeStateDelta, and EventTypeMessagesSnapshot,
+// which the built-in rules always pass through unchecked.
+type SequenceValidator struct {
+	custom map[EventType][]func(event Event, state *SequenceState) error
+}
+
+// NewSequenceValidator creates a SequenceValidator with no custom
+// validators registered.
+func NewSequenceValidator() *SequenceValidator {
+	return &SequenceValidator{custom: make(map[EventType][]func(Event, *SequenceState) error)}
+}
+
+// RegisterValidator adds fn as an additional check run for every event of
+// eventType, after the built-in invariant for that type has already
+// passed. Multiple validators registered for the same eventType all run,
+// in registration order; the first error returned aborts validation.
+func (v *SequenceValidator) RegisterValidator(eventType EventType, fn func(event Event, state *SequenceState) error) {
+	v.custom[eventType] = append(v.custom[eventType], fn)
+}
+
+// SequenceValidatorOption configures a SequenceValidator built by
+// ValidateSequenceWith.
+type SequenceValidatorOption func(*SequenceValidator)
+
+// WithValidator is a SequenceValidatorOption that registers fn for
+// eventType, for the common case of a one-off ValidateSequenceWith call
+// that doesn't need to reuse a SequenceValidator across multiple sequences.
+func WithValidator(eventType EventType, fn func(event Event, state *SequenceState) error) SequenceValidatorOption {
+	return func(v *SequenceValidator) {
+		v.RegisterValidator(eventType, fn)
+	}
+}
+
+// ValidateSequenceWith validates events like ValidateSequence, additionally
+// applying opts to a fresh SequenceValidator before the run, so callers can
+// plug in policies such as state-delta conflict detection, snapshot
+// frequency limits, or JSON-Patch validity checks via WithValidator.
+func ValidateSequenceWith(events []Event, opts ...SequenceValidatorOption) error {
+	v := NewSequenceValidator()
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v.Validate(events)
+}
+
+// Validate runs v's built-in AG-UI lifecycle invariants plus any custom
+// validators registered on v over events, in order.
+func (v *SequenceValidator) Validate(events []Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	state := newSequenceState()
+
+	for i, event := range events {
+		if err := event.Validate(); err != nil {
+			return fmt.Errorf("event %d validation failed: %w", i, err)
+		}
+
+		if err := v.checkBuiltin(event, state); err != nil {
+			return fmt.Errorf("event %d validation failed: %w", i, err)
+		}
+
+		for _, fn := range v.custom[event.Type()] {
+			if err := fn(event, state); err != nil {
+				return fmt.Errorf("event %d custom validation failed: %w", i, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkBuiltin applies the lifecycle invariants ValidateSequence has always
+// enforced, updating state in place.
+func (v *SequenceValidator) checkBuiltin(event Event, state *SequenceState) error {
+	switch event.Type() {
+	case EventTypeRunStarted:
+		if runEvent, ok := event.(*RunStartedEvent); ok {
+			if state.activeRuns[runEvent.RunID()] {
+				return fmt.Errorf("run %s already started", runEvent.RunID())
+			}
+			if state.finishedRuns[runEvent.RunID()] {
+				return fmt.Errorf("cannot restart finished run %s", runEvent.RunID())
+			}
+			state.activeRuns[runEvent.RunID()] = true
+		}
+
+	case EventTypeRunFinished:
+		if runEvent, ok := event.(*RunFinishedEvent); ok {
+			if !state.activeRuns[runEvent.RunID()] {
+				return fmt.Errorf("cannot finish run %s that was not started", runEvent.RunID())
+			}
+			delete(state.activeRuns, runEvent.RunID())
+			state.finishedRuns[runEvent.RunID()] = true
+		}
+
+	case EventTypeRunError:
+		if runEvent, ok := event.(*RunErrorEvent); ok {
+			if runEvent.RunID() != "" && !state.activeRuns[runEvent.RunID()] {
+				return fmt.Errorf("cannot error run %s that was not started", runEvent.RunID())
+			}
+			if runEvent.RunID() != "" {
+				delete(state.activeRuns, runEvent.RunID())
+				state.finishedRuns[runEvent.RunID()] = true
+			}
+		}
+
+	case EventTypeStepStarted:
+		if stepEvent, ok := event.(*StepStartedEvent); ok {
+			if state.activeSteps[stepEvent.StepName] {
+				return fmt.Errorf("step %s already started", stepEvent.StepName)
+			}
+			state.activeSteps[stepEvent.StepName] = true
+		}
+
+	case EventTypeStepFinished:
+		if stepEvent, ok := event.(*StepFinishedEvent); ok {
+			if !state.activeSteps[stepEvent.StepName] {
+				return fmt.Errorf("cannot finish step %s that was not started", stepEvent.StepName)
+			}
+			delete(state.activeSteps, stepEvent.StepName)
+		}
+
+	case EventTypeTextMessageStart:
+		if msgEvent, ok := event.(*TextMessageStartEvent); ok {
+			if state.activeMessages[msgEvent.MessageID] {
+				return fmt.Errorf("message %s already started", msgEvent.MessageID)
+			}
+			state.activeMessages[msgEvent.MessageID] = true
+		}
+
+	case EventTypeTextMessageContent:
+		if msgEvent, ok := event.(*TextMessageContentEvent); ok {
+			if !state.activeMessages[msgEvent.MessageID] {
+				return fmt.Errorf("cannot add content to message %s that was not started", msgEvent.MessageID)
+			}
+			// Content events are valid between start and end
+		}
+
+	case EventTypeTextMessageEnd:
+		if msgEvent, ok := event.(*TextMessageEndEvent); ok {
+			if !state.activeMessages[msgEvent.MessageID] {
+				return fmt.Errorf("cannot end message %s that was not started", msgEvent.MessageID)
+			}
+			delete(state.activeMessages, msgEvent.MessageID)
+		}
+
+	case EventTypeToolCallStart:
+		if toolEvent, ok := event.(*ToolCallStartEvent); ok {
+			if state.activeToolCalls[toolEvent.ToolCallID] {
+				return fmt.Errorf("tool call %s already started", toolEvent.ToolCallID)
+			}
+			state.activeToolCalls[toolEvent.ToolCallID] = true
+		}
+
+	case EventTypeToolCallArgs:
+		if toolEvent, ok := event.(*ToolCallArgsEvent); ok {
+			if !state.activeToolCalls[toolEvent.ToolCallID] {
+				return fmt.Errorf("cannot add args to tool call %s that was not started", toolEvent.ToolCallID)
+			}
+			// Args events are valid between start and end
+		}
+
+	case EventTypeToolCallEnd:
+		if toolEvent, ok := event.(*ToolCallEndEvent); ok {
+			if !state.activeToolCalls[toolEvent.ToolCallID] {
+				return fmt.Errorf("cannot end tool call %s that was not started", toolEvent.ToolCallID)
+			}
+			delete(state.activeToolCalls, toolEvent.ToolCallID)
+		}
+
+	case EventTypeThinkingStart:
+		if state.thinkingOpen {
+			return fmt.Errorf("thinking already started")
+		}
+		state.thinkingOpen = true
+
+	case EventTypeThinkingEnd:
+		if !state.thinkingOpen {
+			return fmt.Errorf("cannot end thinking that was not started")
+		}
+		if state.thinkingTextOpen {
+			return fmt.Errorf("cannot end thinking while a thinking text message is still open")
+		}
+		if len(state.activeThinkingSteps) > 0 {
+			return fmt.Errorf("cannot end thinking while a thinking step is still open")
+		}
+		if len(state.activeThinkingTools) > 0 {
+			return fmt.Errorf("cannot end thinking while a thinking tool call is still open")
+		}
+		state.thinkingOpen = false
+
+	case EventTypeThinkingTextMessageStart:
+		if !state.thinkingOpen {
+			return fmt.Errorf("thinking text message started before ThinkingStart")
+		}
+		if state.thinkingTextOpen {
+			return fmt.Errorf("thinking text message already started")
+		}
+		state.thinkingTextOpen = true
+
+	case EventTypeThinkingTextMessageContent:
+		if !state.thinkingTextOpen {
+			return fmt.Errorf("thinking text content received before ThinkingTextMessageStart")
+		}
+
+	case EventTypeThinkingTextMessageEnd:
+		if !state.thinkingTextOpen {
+			return fmt.Errorf("cannot end thinking text message that was not started")
+		}
+		state.thinkingTextOpen = false
+
+	case EventTypeThinkingStepStart:
+		if stepEvent, ok := event.(*ThinkingStepStartEvent); ok {
+			if !state.thinkingOpen {
+				return fmt.Errorf("thinking step started before ThinkingStart")
+			}
+			if state.activeThinkingSteps[stepEvent.StepID] {
+				return fmt.Errorf("thinking step %s already started", stepEvent.StepID)
+			}
+			state.activeThinkingSteps[stepEvent.StepID] = true
+		}
+
+	case EventTypeThinkingStepContent:
+		if stepEvent, ok := event.(*ThinkingStepContentEvent); ok {
+			if !state.activeThinkingSteps[stepEvent.StepID] {
+				return fmt.Errorf("content for thinking step %s received before ThinkingStepStart", stepEvent.StepID)
+			}
+		}
+
+	case EventTypeThinkingStepEnd:
+		if stepEvent, ok := event.(*ThinkingStepEndEvent); ok {
+			if !state.activeThinkingSteps[stepEvent.StepID] {
+				return fmt.Errorf("cannot end thinking step %s that was not started", stepEvent.StepID)
+			}
+			delete(state.activeThinkingSteps, stepEvent.StepID)
+		}
+
+	case EventTypeThinkingToolCallStart:
+		if toolEvent, ok := event.(*ThinkingToolCallStartEvent); ok {
+			if !state.thinkingOpen {
+				return fmt.Errorf("thinking tool call started before ThinkingStart")
+			}
+			if state.activeThinkingTools[toolEvent.ToolCallID] {
+				return fmt.Errorf("thinking tool call %s already started", toolEvent.ToolCallID)
+			}
+			state.activeThinkingTools[toolEvent.ToolCallID] = true
+		}
+
+	case EventTypeThinkingToolCallEnd:
+		if toolEvent, ok := event.(*ThinkingToolCallEndEvent); ok {
+			if !state.activeThinkingTools[toolEvent.ToolCallID] {
+				return fmt.Errorf("cannot end thinking tool call %s that was not started", toolEvent.ToolCallID)
+			}
+			delete(state.activeThinkingTools, toolEvent.ToolCallID)
+		}
+
+	case EventTypeStateSnapshot:
+		// State snapshot events are always valid in sequence context; they
+		// represent complete state at any point in time. Register a
+		// validator for EventTypeStateSnapshot for policies like
+		// frequency limits.
+
+	case EventTypeStateDelta:
+		// State delta events are always valid in sequence context; they
+		// represent incremental changes at any point in time. Register a
+		// validator for EventTypeStateDelta for policies like conflict
+		// detection or JSON-Patch validity checks.
+
+	case EventTypeMessagesSnapshot:
+		// Message snapshot events are always valid in sequence context.
+		// Register a validator for EventTypeMessagesSnapshot for
+		// consistency checks.
+
+	case EventTypeRaw:
+		// Raw events pass external data through unchecked. Register a
+		// validator for EventTypeRaw to constrain it.
+
+	case EventTypeCustom:
+		// Custom events carry application-specific data. Register a
+		// validator for EventTypeCustom to constrain it.
+
+	default:
+		// This should not happen due to prior validation, but add safety check
+		return fmt.Errorf("unknown event type in sequence: %s", event.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package events
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSequenceValidatorCustom(t *testing.T) {
+	t.Run("RegisteredValidatorRuns", func(t *testing.T) {
+		var seen []any
+		evs := []Event{
+			NewStateSnapshotEvent(map[string]interface{}{"count": 1}),
+			NewStateSnapshotEvent(map[string]interface{}{"count": 2}),
+		}
+
+		err := ValidateSequenceWith(evs, WithValidator(EventTypeStateSnapshot, func(event Event, state *SequenceState) error {
+			seen = append(seen, event)
+			return nil
+		}))
+
+		assert.NoError(t, err)
+		assert.Len(t, seen, 2)
+	})
+
+	t.Run("CustomValidatorCanReject", func(t *testing.T) {
+		evs := []Event{
+			NewStateSnapshotEvent(map[string]interface{}{}),
+		}
+
+		snapshots := 0
+		err := ValidateSequenceWith(evs, WithValidator(EventTypeStateSnapshot, func(event Event, state *SequenceState) error {
+			snapshots++
+			if snapshots > 0 {
+				return fmt.Errorf("too many snapshots")
+			}
+			return nil
+		}))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("CustomValidatorSeesBuiltinState", func(t *testing.T) {
+		evs := []Event{
+			NewRunStartedEvent("thread-1", "run-1"),
+			NewTextMessageStartEvent("msg-1"),
+		}
+
+		var activeDuringStart bool
+		err := ValidateSequenceWith(evs, WithValidator(EventTypeTextMessageStart, func(event Event, state *SequenceState) error {
+			activeDuringStart = state.IsRunActive("run-1")
+			return nil
+		}))
+
+		assert.NoError(t, err)
+		assert.True(t, activeDuringStart)
+	})
+
+	t.Run("ValidateSequenceStillWorks", func(t *testing.T) {
+		evs := []Event{
+			NewRunStartedEvent("thread-1", "run-1"),
+			NewRunFinishedEvent("thread-1", "run-1"),
+		}
+		assert.NoError(t, ValidateSequence(evs))
+	})
+}
@@ -0,0 +1,53 @@
+package events
+
+import (
+	"sync"
+	"testing"
+)
+
+// BenchmarkDefaultIDGeneratorContended and BenchmarkSnowflakeIDGeneratorContended
+// compare GenerateRunID under concurrent contention, since that's the case
+// SnowflakeIDGenerator exists for: RunParallel drives b.N calls across
+// GOMAXPROCS goroutines, the same shape as many agent runs issuing IDs from
+// separate goroutines against one shared generator.
+func BenchmarkDefaultIDGeneratorContended(b *testing.B) {
+	gen := NewDefaultIDGenerator()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = gen.GenerateRunID()
+		}
+	})
+}
+
+func BenchmarkSnowflakeIDGeneratorContended(b *testing.B) {
+	gen := NewSnowflakeIDGenerator("", 1)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = gen.GenerateRunID()
+		}
+	})
+}
+
+// BenchmarkSnowflakeIDGeneratorUniqueness is a correctness smoke test
+// shaped like a benchmark: it fails if the mutex-guarded sequence ever lets
+// two concurrently-generated IDs collide, which a naive unguarded
+// timestamp-only scheme would under enough contention.
+func BenchmarkSnowflakeIDGeneratorUniqueness(b *testing.B) {
+	gen := NewSnowflakeIDGenerator("", 1)
+	seen := make(map[string]bool, b.N)
+	var mu sync.Mutex
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			id := gen.GenerateRunID()
+			mu.Lock()
+			if seen[id] {
+				b.Errorf("duplicate ID generated under contention: %s", id)
+			}
+			seen[id] = true
+			mu.Unlock()
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package events
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnowflakeIDGenerator(t *testing.T) {
+	t.Run("GenerateRunID_HasPrefixAndIsUnique", func(t *testing.T) {
+		gen := NewSnowflakeIDGenerator("", 1)
+		id := gen.GenerateRunID()
+		assert.True(t, strings.HasPrefix(id, "run-"))
+
+		id2 := gen.GenerateRunID()
+		assert.NotEqual(t, id, id2)
+	})
+
+	t.Run("NamespacePrefix", func(t *testing.T) {
+		gen := NewSnowflakeIDGenerator("myapp", 1)
+		id := gen.GenerateMessageID()
+		assert.True(t, strings.HasPrefix(id, "myapp-msg-"))
+	})
+
+	t.Run("NodeIDMaskedTo10Bits", func(t *testing.T) {
+		gen := NewSnowflakeIDGenerator("", snowflakeMaxNode+5)
+		assert.Equal(t, int64(4), gen.nodeID)
+	})
+
+	t.Run("DifferentNodesProduceDifferentIDsForSameMillisecond", func(t *testing.T) {
+		genA := NewSnowflakeIDGenerator("", 1)
+		genB := NewSnowflakeIDGenerator("", 2)
+
+		idA := genA.next()
+		idB := genB.next()
+		assert.NotEqual(t, idA, idB)
+
+		nodeMask := int64(snowflakeMaxNode)
+		assert.Equal(t, int64(1), int64(idA>>snowflakeSequenceBits)&nodeMask)
+		assert.Equal(t, int64(2), int64(idB>>snowflakeSequenceBits)&nodeMask)
+	})
+
+	t.Run("SequenceIncrementsWithinSameMillisecond", func(t *testing.T) {
+		gen := NewSnowflakeIDGenerator("", 1)
+		gen.lastTimestamp = currentMillis()
+		gen.sequence = 5
+
+		id := gen.next()
+		seq := id & snowflakeMaxSequence
+		assert.Equal(t, uint64(6), seq)
+	})
+
+	t.Run("ClockMovingBackwardBlocksUntilCaughtUp", func(t *testing.T) {
+		gen := NewSnowflakeIDGenerator("", 1)
+		future := currentMillis() + 5 // simulate a future "last" timestamp
+		gen.lastTimestamp = future
+
+		id := gen.next()
+		observedMs := int64(id>>(snowflakeNodeBits+snowflakeSequenceBits)) + snowflakeEpochMs
+		assert.GreaterOrEqual(t, observedMs, future)
+	})
+
+	t.Run("ConcurrentGenerationProducesUniqueIDs", func(t *testing.T) {
+		gen := NewSnowflakeIDGenerator("", 1)
+		const n = 200
+		ids := make(chan string, n)
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ids <- gen.GenerateRunID()
+			}()
+		}
+		wg.Wait()
+		close(ids)
+
+		seen := make(map[string]bool, n)
+		for id := range ids {
+			assert.False(t, seen[id], "duplicate ID generated: %s", id)
+			seen[id] = true
+		}
+		assert.Equal(t, n, len(seen))
+	})
+
+	t.Run("ImplementsIDGenerator", func(t *testing.T) {
+		var gen IDGenerator = NewSnowflakeIDGenerator("", 1)
+		assert.NotEmpty(t, gen.GenerateStepID())
+	})
+}
@@ -47,6 +47,13 @@ func (e *StateSnapshotEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
 
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *StateSnapshotEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
 // JSONPatchOperation represents a JSON Patch operation (RFC 6902)
 type JSONPatchOperation struct {
 	Op    string `json:"op"`              // "add", "remove", "replace", "move", "copy", "test"
@@ -119,6 +126,13 @@ func (e *StateDeltaEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
 
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *StateDeltaEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
 // Message represents a message in the conversation
 type Message struct {
 	ID         string     `json:"id"`
@@ -213,3 +227,10 @@ func validateToolCall(toolCall ToolCall) error {
 func (e *MessagesSnapshotEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
+
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *MessagesSnapshotEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
@@ -0,0 +1,383 @@
+package events
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ValidationError is the error a StreamValidator's Next returns when an
+// event violates a lifecycle invariant, identifying the offending event
+// type and which invariant it broke so a caller can log, alert on, or
+// branch on a specific violation instead of pattern-matching an error
+// string.
+type ValidationError struct {
+	EventType EventType
+	Invariant string
+	Detail    string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s violates %s invariant: %s", e.EventType, e.Invariant, e.Detail)
+}
+
+func newValidationError(eventType EventType, invariant, detail string) *ValidationError {
+	return &ValidationError{EventType: eventType, Invariant: invariant, Detail: detail}
+}
+
+// Invariant names a ValidationError can report, one per lifecycle scope
+// StreamValidator enforces.
+const (
+	InvariantEventShape   = "event-shape"
+	InvariantRunLifecycle = "run-lifecycle"
+	InvariantStep         = "step-lifecycle"
+	InvariantMessage      = "message-lifecycle"
+	InvariantToolCall     = "tool-call-lifecycle"
+	InvariantToolResult   = "tool-call-result"
+	InvariantThinking     = "thinking-lifecycle"
+	InvariantState        = "state-lifecycle"
+)
+
+// streamValidatorState holds every scope a StreamValidator has open,
+// isolated from the StreamValidator struct itself so Snapshot/Restore can
+// swap it out as a unit under the validator's lock.
+type streamValidatorState struct {
+	activeRuns          map[string]bool // keyed by runKey(threadID, runID)
+	finishedRuns        map[string]bool // keyed by runKey(threadID, runID)
+	activeSteps         map[string]bool
+	activeMessages      map[string]bool
+	activeToolCalls     map[string]bool
+	closedToolCalls     map[string]bool // started and ended, awaiting a result
+	thinkingOpen        bool
+	thinkingTextOpen    bool
+	activeThinkingSteps map[string]bool
+	activeThinkingTools map[string]bool
+	stateInitialized    bool
+}
+
+func newStreamValidatorState() *streamValidatorState {
+	return &streamValidatorState{
+		activeRuns:          make(map[string]bool),
+		finishedRuns:        make(map[string]bool),
+		activeSteps:         make(map[string]bool),
+		activeMessages:      make(map[string]bool),
+		activeToolCalls:     make(map[string]bool),
+		closedToolCalls:     make(map[string]bool),
+		activeThinkingSteps: make(map[string]bool),
+		activeThinkingTools: make(map[string]bool),
+	}
+}
+
+// runKey identifies an open run by threadID+runID rather than runID alone,
+// so two threads that happen to reuse the same runID don't collide -
+// unlike SequenceValidator and Validator, which only ever key by runID.
+func runKey(threadID, runID string) string {
+	return threadID + "\x00" + runID
+}
+
+// runIDOf recovers the runID half of a runKey, for RunErrorEvent, which
+// carries no threadID of its own.
+func runIDOf(key string) string {
+	if i := strings.LastIndex(key, "\x00"); i >= 0 {
+		return key[i+1:]
+	}
+	return key
+}
+
+func (s *streamValidatorState) findActiveRun(runID string) (string, bool) {
+	for key := range s.activeRuns {
+		if runIDOf(key) == runID {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// StreamValidator enforces the same AG-UI lifecycle invariants as
+// SequenceValidator and Validator, but incrementally: Next takes one event
+// at a time, the shape an SSE stream or a long-lived agent run actually
+// arrives in. Unlike Validator, runs are tracked by threadID+runID so a
+// single StreamValidator can watch several concurrent threads, and tool
+// call results are checked against their matching start/end. StreamValidator
+// is safe for concurrent use: Next, Reset, Snapshot, and Restore all hold
+// an internal lock, so a proxy can Snapshot from one goroutine while
+// another continues feeding it events, and persist the result across a
+// reconnect without replaying history.
+type StreamValidator struct {
+	mu    sync.Mutex
+	state *streamValidatorState
+}
+
+// NewStreamValidator creates a StreamValidator with no open scopes.
+func NewStreamValidator() *StreamValidator {
+	return &StreamValidator{state: newStreamValidatorState()}
+}
+
+// Next validates event against every scope opened by events seen so far,
+// advances that state, and returns a *ValidationError identifying the
+// offending event and invariant if event violates it.
+func (v *StreamValidator) Next(event Event) error {
+	if err := event.Validate(); err != nil {
+		return newValidationError(event.Type(), InvariantEventShape, err.Error())
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.state.check(event)
+}
+
+// Reset discards all open scopes, as if no events had been seen.
+func (v *StreamValidator) Reset() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.state = newStreamValidatorState()
+}
+
+// StreamValidatorSnapshot is an opaque, serializable copy of a
+// StreamValidator's open scopes at a point in time. A caller (e.g. a proxy
+// terminating the SSE connection) can persist it across a reconnect and
+// later hand it to Restore to resume validation without replaying the
+// stream from the start.
+type StreamValidatorSnapshot struct {
+	ActiveRuns          []string
+	FinishedRuns        []string
+	ActiveSteps         []string
+	ActiveMessages      []string
+	ActiveToolCalls     []string
+	ClosedToolCalls     []string
+	ThinkingOpen        bool
+	ThinkingTextOpen    bool
+	ActiveThinkingSteps []string
+	ActiveThinkingTools []string
+	StateInitialized    bool
+}
+
+// Snapshot returns a point-in-time copy of v's open scopes, safe to read
+// and persist even while another goroutine concurrently calls Next on v.
+func (v *StreamValidator) Snapshot() StreamValidatorSnapshot {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	s := v.state
+	return StreamValidatorSnapshot{
+		ActiveRuns:          keysOf(s.activeRuns),
+		FinishedRuns:        keysOf(s.finishedRuns),
+		ActiveSteps:         keysOf(s.activeSteps),
+		ActiveMessages:      keysOf(s.activeMessages),
+		ActiveToolCalls:     keysOf(s.activeToolCalls),
+		ClosedToolCalls:     keysOf(s.closedToolCalls),
+		ThinkingOpen:        s.thinkingOpen,
+		ThinkingTextOpen:    s.thinkingTextOpen,
+		ActiveThinkingSteps: keysOf(s.activeThinkingSteps),
+		ActiveThinkingTools: keysOf(s.activeThinkingTools),
+		StateInitialized:    s.stateInitialized,
+	}
+}
+
+// Restore replaces v's open scopes with snap, e.g. after reconnecting to a
+// stream whose StreamValidator state was persisted via Snapshot.
+func (v *StreamValidator) Restore(snap StreamValidatorSnapshot) {
+	state := newStreamValidatorState()
+	setOf(state.activeRuns, snap.ActiveRuns)
+	setOf(state.finishedRuns, snap.FinishedRuns)
+	setOf(state.activeSteps, snap.ActiveSteps)
+	setOf(state.activeMessages, snap.ActiveMessages)
+	setOf(state.activeToolCalls, snap.ActiveToolCalls)
+	setOf(state.closedToolCalls, snap.ClosedToolCalls)
+	setOf(state.activeThinkingSteps, snap.ActiveThinkingSteps)
+	setOf(state.activeThinkingTools, snap.ActiveThinkingTools)
+	state.thinkingOpen = snap.ThinkingOpen
+	state.thinkingTextOpen = snap.ThinkingTextOpen
+	state.stateInitialized = snap.StateInitialized
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.state = state
+}
+
+func keysOf(m map[string]bool) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
+
+func setOf(m map[string]bool, keys []string) {
+	for _, k := range keys {
+		m[k] = true
+	}
+}
+
+// check applies the lifecycle invariants and updates state in place,
+// mirroring SequenceValidator.checkBuiltin's rules but keying runs by
+// threadID+runID and additionally requiring a ToolCallResultEvent to match
+// a tool call that has already started and ended.
+func (s *streamValidatorState) check(event Event) error {
+	switch e := event.(type) {
+	case *RunStartedEvent:
+		key := runKey(e.ThreadID(), e.RunID())
+		if s.activeRuns[key] {
+			return newValidationError(event.Type(), InvariantRunLifecycle, fmt.Sprintf("run %s already started", e.RunID()))
+		}
+		if s.finishedRuns[key] {
+			return newValidationError(event.Type(), InvariantRunLifecycle, fmt.Sprintf("cannot restart finished run %s", e.RunID()))
+		}
+		s.activeRuns[key] = true
+
+	case *RunFinishedEvent:
+		key := runKey(e.ThreadID(), e.RunID())
+		if !s.activeRuns[key] {
+			return newValidationError(event.Type(), InvariantRunLifecycle, fmt.Sprintf("cannot finish run %s that was not started", e.RunID()))
+		}
+		delete(s.activeRuns, key)
+		s.finishedRuns[key] = true
+
+	case *RunErrorEvent:
+		key, ok := s.findActiveRun(e.RunID())
+		if !ok {
+			return newValidationError(event.Type(), InvariantRunLifecycle, fmt.Sprintf("cannot error run %s that was not started", e.RunID()))
+		}
+		delete(s.activeRuns, key)
+		s.finishedRuns[key] = true
+
+	case *StepStartedEvent:
+		if s.activeSteps[e.StepName] {
+			return newValidationError(event.Type(), InvariantStep, fmt.Sprintf("step %s already started", e.StepName))
+		}
+		s.activeSteps[e.StepName] = true
+
+	case *StepFinishedEvent:
+		if !s.activeSteps[e.StepName] {
+			return newValidationError(event.Type(), InvariantStep, fmt.Sprintf("cannot finish step %s that was not started", e.StepName))
+		}
+		delete(s.activeSteps, e.StepName)
+
+	case *TextMessageStartEvent:
+		if s.activeMessages[e.MessageID] {
+			return newValidationError(event.Type(), InvariantMessage, fmt.Sprintf("message %s already started", e.MessageID))
+		}
+		s.activeMessages[e.MessageID] = true
+
+	case *TextMessageContentEvent:
+		if !s.activeMessages[e.MessageID] {
+			return newValidationError(event.Type(), InvariantMessage, fmt.Sprintf("content for message %s received before TextMessageStart", e.MessageID))
+		}
+
+	case *TextMessageEndEvent:
+		if !s.activeMessages[e.MessageID] {
+			return newValidationError(event.Type(), InvariantMessage, fmt.Sprintf("cannot end message %s that was not started", e.MessageID))
+		}
+		delete(s.activeMessages, e.MessageID)
+
+	case *ToolCallStartEvent:
+		if s.activeToolCalls[e.ToolCallID] || s.closedToolCalls[e.ToolCallID] {
+			return newValidationError(event.Type(), InvariantToolCall, fmt.Sprintf("tool call %s already started", e.ToolCallID))
+		}
+		s.activeToolCalls[e.ToolCallID] = true
+
+	case *ToolCallArgsEvent:
+		if !s.activeToolCalls[e.ToolCallID] {
+			return newValidationError(event.Type(), InvariantToolCall, fmt.Sprintf("args for tool call %s received while not open", e.ToolCallID))
+		}
+
+	case *ToolCallEndEvent:
+		if !s.activeToolCalls[e.ToolCallID] {
+			return newValidationError(event.Type(), InvariantToolCall, fmt.Sprintf("cannot end tool call %s that was not started", e.ToolCallID))
+		}
+		delete(s.activeToolCalls, e.ToolCallID)
+		s.closedToolCalls[e.ToolCallID] = true
+
+	case *ToolCallResultEvent:
+		if !s.closedToolCalls[e.ToolCallID] {
+			return newValidationError(event.Type(), InvariantToolResult, fmt.Sprintf("result for tool call %s received before a matching start/end", e.ToolCallID))
+		}
+		delete(s.closedToolCalls, e.ToolCallID)
+
+	case *ThinkingStartEvent:
+		if s.thinkingOpen {
+			return newValidationError(event.Type(), InvariantThinking, "thinking already started")
+		}
+		s.thinkingOpen = true
+
+	case *ThinkingEndEvent:
+		if !s.thinkingOpen {
+			return newValidationError(event.Type(), InvariantThinking, "cannot end thinking that was not started")
+		}
+		if s.thinkingTextOpen {
+			return newValidationError(event.Type(), InvariantThinking, "cannot end thinking while a thinking text message is still open")
+		}
+		if len(s.activeThinkingSteps) > 0 {
+			return newValidationError(event.Type(), InvariantThinking, "cannot end thinking while a thinking step is still open")
+		}
+		if len(s.activeThinkingTools) > 0 {
+			return newValidationError(event.Type(), InvariantThinking, "cannot end thinking while a thinking tool call is still open")
+		}
+		s.thinkingOpen = false
+
+	case *ThinkingTextMessageStartEvent:
+		if !s.thinkingOpen {
+			return newValidationError(event.Type(), InvariantThinking, "thinking text message started before ThinkingStart")
+		}
+		if s.thinkingTextOpen {
+			return newValidationError(event.Type(), InvariantThinking, "thinking text message already started")
+		}
+		s.thinkingTextOpen = true
+
+	case *ThinkingTextMessageContentEvent:
+		if !s.thinkingTextOpen {
+			return newValidationError(event.Type(), InvariantThinking, "thinking text content received before ThinkingTextMessageStart")
+		}
+
+	case *ThinkingTextMessageEndEvent:
+		if !s.thinkingTextOpen {
+			return newValidationError(event.Type(), InvariantThinking, "cannot end thinking text message that was not started")
+		}
+		s.thinkingTextOpen = false
+
+	case *ThinkingStepStartEvent:
+		if !s.thinkingOpen {
+			return newValidationError(event.Type(), InvariantThinking, "thinking step started before ThinkingStart")
+		}
+		if s.activeThinkingSteps[e.StepID] {
+			return newValidationError(event.Type(), InvariantThinking, fmt.Sprintf("thinking step %s already started", e.StepID))
+		}
+		s.activeThinkingSteps[e.StepID] = true
+
+	case *ThinkingStepContentEvent:
+		if !s.activeThinkingSteps[e.StepID] {
+			return newValidationError(event.Type(), InvariantThinking, fmt.Sprintf("content for thinking step %s received before ThinkingStepStart", e.StepID))
+		}
+
+	case *ThinkingStepEndEvent:
+		if !s.activeThinkingSteps[e.StepID] {
+			return newValidationError(event.Type(), InvariantThinking, fmt.Sprintf("cannot end thinking step %s that was not started", e.StepID))
+		}
+		delete(s.activeThinkingSteps, e.StepID)
+
+	case *ThinkingToolCallStartEvent:
+		if !s.thinkingOpen {
+			return newValidationError(event.Type(), InvariantThinking, "thinking tool call started before ThinkingStart")
+		}
+		if s.activeThinkingTools[e.ToolCallID] {
+			return newValidationError(event.Type(), InvariantThinking, fmt.Sprintf("thinking tool call %s already started", e.ToolCallID))
+		}
+		s.activeThinkingTools[e.ToolCallID] = true
+
+	case *ThinkingToolCallEndEvent:
+		if !s.activeThinkingTools[e.ToolCallID] {
+			return newValidationError(event.Type(), InvariantThinking, fmt.Sprintf("cannot end thinking tool call %s that was not started", e.ToolCallID))
+		}
+		delete(s.activeThinkingTools, e.ToolCallID)
+
+	case *StateSnapshotEvent:
+		s.stateInitialized = true
+
+	case *StateDeltaEvent:
+		if !s.stateInitialized {
+			return newValidationError(event.Type(), InvariantState, "state delta received before any StateSnapshot")
+		}
+		s.stateInitialized = true
+	}
+
+	return nil
+}
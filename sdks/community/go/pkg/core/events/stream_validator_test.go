@@ -0,0 +1,90 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamValidator(t *testing.T) {
+	t.Run("ValidSequence", func(t *testing.T) {
+		v := NewStreamValidator()
+
+		for _, event := range []Event{
+			NewRunStartedEvent("thread-1", "run-1"),
+			NewToolCallStartEvent("tool-1", "search"),
+			NewToolCallArgsEvent("tool-1", "{}"),
+			NewToolCallEndEvent("tool-1"),
+			NewToolCallResultEvent("msg-1", "tool-1", "42"),
+			NewRunFinishedEvent("thread-1", "run-1"),
+		} {
+			require.NoError(t, v.Next(event))
+		}
+	})
+
+	t.Run("ConcurrentRunsWithSameRunIDOnDifferentThreads", func(t *testing.T) {
+		v := NewStreamValidator()
+		require.NoError(t, v.Next(NewRunStartedEvent("thread-1", "run-1")))
+		require.NoError(t, v.Next(NewRunStartedEvent("thread-2", "run-1")))
+		require.NoError(t, v.Next(NewRunFinishedEvent("thread-1", "run-1")))
+		require.NoError(t, v.Next(NewRunFinishedEvent("thread-2", "run-1")))
+	})
+
+	t.Run("CannotRestartFinishedRun", func(t *testing.T) {
+		v := NewStreamValidator()
+		require.NoError(t, v.Next(NewRunStartedEvent("thread-1", "run-1")))
+		require.NoError(t, v.Next(NewRunFinishedEvent("thread-1", "run-1")))
+
+		err := v.Next(NewRunStartedEvent("thread-1", "run-1"))
+		var valErr *ValidationError
+		require.ErrorAs(t, err, &valErr)
+		assert.Equal(t, InvariantRunLifecycle, valErr.Invariant)
+	})
+
+	t.Run("ToolCallResultBeforeEnd", func(t *testing.T) {
+		v := NewStreamValidator()
+		require.NoError(t, v.Next(NewRunStartedEvent("thread-1", "run-1")))
+		require.NoError(t, v.Next(NewToolCallStartEvent("tool-1", "search")))
+
+		err := v.Next(NewToolCallResultEvent("msg-1", "tool-1", "42"))
+		var valErr *ValidationError
+		require.ErrorAs(t, err, &valErr)
+		assert.Equal(t, InvariantToolResult, valErr.Invariant)
+	})
+
+	t.Run("ToolCallResultWithoutStart", func(t *testing.T) {
+		v := NewStreamValidator()
+		require.NoError(t, v.Next(NewRunStartedEvent("thread-1", "run-1")))
+
+		err := v.Next(NewToolCallResultEvent("msg-1", "tool-1", "42"))
+		var valErr *ValidationError
+		require.ErrorAs(t, err, &valErr)
+		assert.Equal(t, InvariantToolResult, valErr.Invariant)
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		v := NewStreamValidator()
+		require.NoError(t, v.Next(NewRunStartedEvent("thread-1", "run-1")))
+
+		v.Reset()
+
+		require.NoError(t, v.Next(NewRunStartedEvent("thread-1", "run-1")))
+	})
+
+	t.Run("SnapshotRestoreResumesWithoutReplay", func(t *testing.T) {
+		v := NewStreamValidator()
+		require.NoError(t, v.Next(NewRunStartedEvent("thread-1", "run-1")))
+		require.NoError(t, v.Next(NewToolCallStartEvent("tool-1", "search")))
+
+		snap := v.Snapshot()
+
+		fresh := NewStreamValidator()
+		fresh.Restore(snap)
+
+		require.NoError(t, fresh.Next(NewToolCallArgsEvent("tool-1", "{}")))
+		require.NoError(t, fresh.Next(NewToolCallEndEvent("tool-1")))
+		require.NoError(t, fresh.Next(NewToolCallResultEvent("msg-1", "tool-1", "42")))
+		require.NoError(t, fresh.Next(NewRunFinishedEvent("thread-1", "run-1")))
+	})
+}
@@ -37,6 +37,13 @@ func (e *ThinkingStartEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
 
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *ThinkingStartEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
 // ThinkingEndEvent indicates the end of a thinking/reasoning phase
 type ThinkingEndEvent struct {
 	*BaseEvent
@@ -62,9 +69,23 @@ func (e *ThinkingEndEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
 
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *ThinkingEndEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
 // ThinkingTextMessageStartEvent indicates the start of a thinking text message
 type ThinkingTextMessageStartEvent struct {
 	*BaseEvent
+	// StepID optionally names the reasoning step this text belongs to, so a
+	// ThinkingTrace can group deltas into structured ReasoningStep records
+	// instead of one undifferentiated blob. Absent (the zero value) on this
+	// start event makes ThinkingTrace open a new step named "step-N", nested
+	// under the step it already has open, if any; on the content and end
+	// events that refer here, absent means the step ThinkingTrace has open.
+	StepID string `json:"step_id,omitempty"`
 }
 
 // NewThinkingTextMessageStartEvent creates a new thinking text message start event
@@ -74,6 +95,12 @@ func NewThinkingTextMessageStartEvent() *ThinkingTextMessageStartEvent {
 	}
 }
 
+// WithStepID sets the reasoning step ID for the thinking text message
+func (e *ThinkingTextMessageStartEvent) WithStepID(stepID string) *ThinkingTextMessageStartEvent {
+	e.StepID = stepID
+	return e
+}
+
 // Validate validates the thinking text message start event
 func (e *ThinkingTextMessageStartEvent) Validate() error {
 	if err := e.BaseEvent.Validate(); err != nil {
@@ -87,10 +114,20 @@ func (e *ThinkingTextMessageStartEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
 
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *ThinkingTextMessageStartEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
 // ThinkingTextMessageContentEvent contains streaming thinking text content
 type ThinkingTextMessageContentEvent struct {
 	*BaseEvent
 	Delta string `json:"delta"`
+	// StepID optionally names the reasoning step this delta belongs to; see
+	// ThinkingTextMessageStartEvent.StepID.
+	StepID string `json:"step_id,omitempty"`
 }
 
 // NewThinkingTextMessageContentEvent creates a new thinking text message content event
@@ -101,6 +138,12 @@ func NewThinkingTextMessageContentEvent(delta string) *ThinkingTextMessageConten
 	}
 }
 
+// WithStepID sets the reasoning step ID for the thinking text message content
+func (e *ThinkingTextMessageContentEvent) WithStepID(stepID string) *ThinkingTextMessageContentEvent {
+	e.StepID = stepID
+	return e
+}
+
 // Validate validates the thinking text message content event
 func (e *ThinkingTextMessageContentEvent) Validate() error {
 	if err := e.BaseEvent.Validate(); err != nil {
@@ -119,9 +162,19 @@ func (e *ThinkingTextMessageContentEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
 
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *ThinkingTextMessageContentEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
 // ThinkingTextMessageEndEvent indicates the end of a thinking text message
 type ThinkingTextMessageEndEvent struct {
 	*BaseEvent
+	// StepID optionally names the reasoning step this closes; see
+	// ThinkingTextMessageStartEvent.StepID.
+	StepID string `json:"step_id,omitempty"`
 }
 
 // NewThinkingTextMessageEndEvent creates a new thinking text message end event
@@ -131,6 +184,12 @@ func NewThinkingTextMessageEndEvent() *ThinkingTextMessageEndEvent {
 	}
 }
 
+// WithStepID sets the reasoning step ID for the thinking text message end
+func (e *ThinkingTextMessageEndEvent) WithStepID(stepID string) *ThinkingTextMessageEndEvent {
+	e.StepID = stepID
+	return e
+}
+
 // Validate validates the thinking text message end event
 func (e *ThinkingTextMessageEndEvent) Validate() error {
 	if err := e.BaseEvent.Validate(); err != nil {
@@ -143,3 +202,312 @@ func (e *ThinkingTextMessageEndEvent) Validate() error {
 func (e *ThinkingTextMessageEndEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
+
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *ThinkingTextMessageEndEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
+// OpenStep builds a ThinkingStepStartEvent for starting a structured
+// reasoning step within this thinking phase, the structured counterpart to
+// the plain-text THINKING_TEXT_MESSAGE_* stream.
+func (e *ThinkingStartEvent) OpenStep(stepID string, kind ThinkingStepKind) *ThinkingStepStartEvent {
+	return NewThinkingStepStartEvent(stepID, kind)
+}
+
+// ThinkingStepKind classifies a ThinkingStepStartEvent so a front-end can
+// render each structured reasoning step differently (e.g. a plan as a
+// bullet list, a tool_call as an inline invocation card).
+type ThinkingStepKind string
+
+const (
+	ThinkingStepKindPlan        ThinkingStepKind = "plan"
+	ThinkingStepKindObservation ThinkingStepKind = "observation"
+	ThinkingStepKindToolCall    ThinkingStepKind = "tool_call"
+	ThinkingStepKindReflection  ThinkingStepKind = "reflection"
+)
+
+// validThinkingStepKinds is a map for O(1) lookup of valid ThinkingStepKind
+// values.
+var validThinkingStepKinds = map[ThinkingStepKind]bool{
+	ThinkingStepKindPlan:        true,
+	ThinkingStepKindObservation: true,
+	ThinkingStepKindToolCall:    true,
+	ThinkingStepKindReflection:  true,
+}
+
+// ThinkingStepStartEvent opens one structured reasoning step within an
+// active thinking phase, unlike ThinkingTextMessageStartEvent's opaque text
+// delta stream.
+type ThinkingStepStartEvent struct {
+	*BaseEvent
+	StepID string           `json:"step_id"`
+	Kind   ThinkingStepKind `json:"kind"`
+}
+
+// NewThinkingStepStartEvent creates a new thinking step start event.
+func NewThinkingStepStartEvent(stepID string, kind ThinkingStepKind) *ThinkingStepStartEvent {
+	return &ThinkingStepStartEvent{
+		BaseEvent: NewBaseEvent(EventTypeThinkingStepStart),
+		StepID:    stepID,
+		Kind:      kind,
+	}
+}
+
+// Validate validates the thinking step start event
+func (e *ThinkingStepStartEvent) Validate() error {
+	if err := e.BaseEvent.Validate(); err != nil {
+		return err
+	}
+
+	if e.StepID == "" {
+		return fmt.Errorf("ThinkingStepStartEvent validation failed: step_id field is required")
+	}
+
+	if !validThinkingStepKinds[e.Kind] {
+		return fmt.Errorf("ThinkingStepStartEvent validation failed: invalid kind %q", e.Kind)
+	}
+
+	return nil
+}
+
+// ToJSON serializes the event to JSON
+func (e *ThinkingStepStartEvent) ToJSON() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *ThinkingStepStartEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
+// ThinkingStepContentEvent carries content for an open ThinkingStepStartEvent:
+// Delta is a plain-text increment, same as ThinkingTextMessageContentEvent's,
+// and Payload optionally carries structured data (e.g. a partial plan
+// object) a front-end can render without parsing Delta itself.
+type ThinkingStepContentEvent struct {
+	*BaseEvent
+	StepID  string          `json:"step_id"`
+	Delta   string          `json:"delta,omitempty"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// NewThinkingStepContentEvent creates a new thinking step content event.
+func NewThinkingStepContentEvent(stepID, delta string) *ThinkingStepContentEvent {
+	return &ThinkingStepContentEvent{
+		BaseEvent: NewBaseEvent(EventTypeThinkingStepContent),
+		StepID:    stepID,
+		Delta:     delta,
+	}
+}
+
+// WithPayload attaches a structured payload to the thinking step content
+// event, e.g. a partial tool-call argument object rendered as a form instead
+// of raw text.
+func (e *ThinkingStepContentEvent) WithPayload(payload json.RawMessage) *ThinkingStepContentEvent {
+	e.Payload = payload
+	return e
+}
+
+// Validate validates the thinking step content event
+func (e *ThinkingStepContentEvent) Validate() error {
+	if err := e.BaseEvent.Validate(); err != nil {
+		return err
+	}
+
+	if e.StepID == "" {
+		return fmt.Errorf("ThinkingStepContentEvent validation failed: step_id field is required")
+	}
+
+	if e.Delta == "" && len(e.Payload) == 0 {
+		return fmt.Errorf("ThinkingStepContentEvent validation failed: at least one of delta or payload is required")
+	}
+
+	return nil
+}
+
+// ToJSON serializes the event to JSON
+func (e *ThinkingStepContentEvent) ToJSON() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *ThinkingStepContentEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
+// ThinkingStepStatus reports how a ThinkingStepEndEvent's step concluded.
+type ThinkingStepStatus string
+
+const (
+	ThinkingStepStatusCompleted ThinkingStepStatus = "completed"
+	ThinkingStepStatusFailed    ThinkingStepStatus = "failed"
+	ThinkingStepStatusCancelled ThinkingStepStatus = "cancelled"
+)
+
+// validThinkingStepStatuses is a map for O(1) lookup of valid
+// ThinkingStepStatus values.
+var validThinkingStepStatuses = map[ThinkingStepStatus]bool{
+	ThinkingStepStatusCompleted: true,
+	ThinkingStepStatusFailed:    true,
+	ThinkingStepStatusCancelled: true,
+}
+
+// ThinkingStepEndEvent closes a ThinkingStepStartEvent, unlike
+// ThinkingTextMessageEndEvent it reports how the step concluded and an
+// optional structured Result.
+type ThinkingStepEndEvent struct {
+	*BaseEvent
+	StepID string             `json:"step_id"`
+	Status ThinkingStepStatus `json:"status"`
+	Result json.RawMessage    `json:"result,omitempty"`
+}
+
+// NewThinkingStepEndEvent creates a new thinking step end event.
+func NewThinkingStepEndEvent(stepID string, status ThinkingStepStatus) *ThinkingStepEndEvent {
+	return &ThinkingStepEndEvent{
+		BaseEvent: NewBaseEvent(EventTypeThinkingStepEnd),
+		StepID:    stepID,
+		Status:    status,
+	}
+}
+
+// WithResult attaches the step's structured result.
+func (e *ThinkingStepEndEvent) WithResult(result json.RawMessage) *ThinkingStepEndEvent {
+	e.Result = result
+	return e
+}
+
+// Validate validates the thinking step end event
+func (e *ThinkingStepEndEvent) Validate() error {
+	if err := e.BaseEvent.Validate(); err != nil {
+		return err
+	}
+
+	if e.StepID == "" {
+		return fmt.Errorf("ThinkingStepEndEvent validation failed: step_id field is required")
+	}
+
+	if !validThinkingStepStatuses[e.Status] {
+		return fmt.Errorf("ThinkingStepEndEvent validation failed: invalid status %q", e.Status)
+	}
+
+	return nil
+}
+
+// ToJSON serializes the event to JSON
+func (e *ThinkingStepEndEvent) ToJSON() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *ThinkingStepEndEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
+// ThinkingToolCallStartEvent marks the start of reasoning that narrates a
+// specific tool call, so a front-end can visually attach this thinking step
+// to the ToolCallStartEvent it produced instead of showing them as
+// unrelated streams.
+type ThinkingToolCallStartEvent struct {
+	*BaseEvent
+	ToolCallID string `json:"tool_call_id"`
+	// StepID optionally names the reasoning step this tool call belongs to;
+	// see ThinkingTextMessageStartEvent.StepID.
+	StepID string `json:"step_id,omitempty"`
+}
+
+// NewThinkingToolCallStartEvent creates a new thinking tool call start event.
+func NewThinkingToolCallStartEvent(toolCallID string) *ThinkingToolCallStartEvent {
+	return &ThinkingToolCallStartEvent{
+		BaseEvent:  NewBaseEvent(EventTypeThinkingToolCallStart),
+		ToolCallID: toolCallID,
+	}
+}
+
+// WithStepID sets the reasoning step ID for the thinking tool call start
+func (e *ThinkingToolCallStartEvent) WithStepID(stepID string) *ThinkingToolCallStartEvent {
+	e.StepID = stepID
+	return e
+}
+
+// Validate validates the thinking tool call start event
+func (e *ThinkingToolCallStartEvent) Validate() error {
+	if err := e.BaseEvent.Validate(); err != nil {
+		return err
+	}
+
+	if e.ToolCallID == "" {
+		return fmt.Errorf("ThinkingToolCallStartEvent validation failed: tool_call_id field is required")
+	}
+
+	return nil
+}
+
+// ToJSON serializes the event to JSON
+func (e *ThinkingToolCallStartEvent) ToJSON() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *ThinkingToolCallStartEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
+// ThinkingToolCallEndEvent closes a ThinkingToolCallStartEvent.
+type ThinkingToolCallEndEvent struct {
+	*BaseEvent
+	ToolCallID string `json:"tool_call_id"`
+	// StepID optionally names the reasoning step this closes; see
+	// ThinkingTextMessageStartEvent.StepID.
+	StepID string `json:"step_id,omitempty"`
+}
+
+// NewThinkingToolCallEndEvent creates a new thinking tool call end event.
+func NewThinkingToolCallEndEvent(toolCallID string) *ThinkingToolCallEndEvent {
+	return &ThinkingToolCallEndEvent{
+		BaseEvent:  NewBaseEvent(EventTypeThinkingToolCallEnd),
+		ToolCallID: toolCallID,
+	}
+}
+
+// WithStepID sets the reasoning step ID for the thinking tool call end
+func (e *ThinkingToolCallEndEvent) WithStepID(stepID string) *ThinkingToolCallEndEvent {
+	e.StepID = stepID
+	return e
+}
+
+// Validate validates the thinking tool call end event
+func (e *ThinkingToolCallEndEvent) Validate() error {
+	if err := e.BaseEvent.Validate(); err != nil {
+		return err
+	}
+
+	if e.ToolCallID == "" {
+		return fmt.Errorf("ThinkingToolCallEndEvent validation failed: tool_call_id field is required")
+	}
+
+	return nil
+}
+
+// ToJSON serializes the event to JSON
+func (e *ThinkingToolCallEndEvent) ToJSON() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *ThinkingToolCallEndEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
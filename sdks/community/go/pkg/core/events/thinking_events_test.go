@@ -245,6 +245,173 @@ func TestThinkingTextMessageEndEvent(t *testing.T) {
 	})
 }
 
+func TestThinkingStepStartEvent(t *testing.T) {
+	t.Run("basic creation", func(t *testing.T) {
+		event := NewThinkingStepStartEvent("step-1", ThinkingStepKindPlan)
+
+		if event.Type() != EventTypeThinkingStepStart {
+			t.Errorf("expected event type %s, got %s", EventTypeThinkingStepStart, event.Type())
+		}
+
+		if err := event.Validate(); err != nil {
+			t.Errorf("validation failed: %v", err)
+		}
+	})
+
+	t.Run("validation requires step id", func(t *testing.T) {
+		event := NewThinkingStepStartEvent("", ThinkingStepKindPlan)
+
+		if err := event.Validate(); err == nil {
+			t.Error("expected validation to fail for empty step id")
+		}
+	})
+
+	t.Run("validation requires known kind", func(t *testing.T) {
+		event := NewThinkingStepStartEvent("step-1", ThinkingStepKind("bogus"))
+
+		if err := event.Validate(); err == nil {
+			t.Error("expected validation to fail for unknown kind")
+		}
+	})
+
+	t.Run("OpenStep helper", func(t *testing.T) {
+		start := NewThinkingStartEvent()
+		step := start.OpenStep("step-1", ThinkingStepKindObservation)
+
+		if step.StepID != "step-1" || step.Kind != ThinkingStepKindObservation {
+			t.Errorf("expected step-1/observation, got %s/%s", step.StepID, step.Kind)
+		}
+	})
+
+	t.Run("JSON serialization", func(t *testing.T) {
+		event := NewThinkingStepStartEvent("step-1", ThinkingStepKindToolCall)
+
+		jsonData, err := event.ToJSON()
+		if err != nil {
+			t.Fatalf("failed to serialize to JSON: %v", err)
+		}
+
+		var decoded map[string]interface{}
+		if err := json.Unmarshal(jsonData, &decoded); err != nil {
+			t.Fatalf("failed to unmarshal JSON: %v", err)
+		}
+
+		if decoded["step_id"] != "step-1" {
+			t.Errorf("expected step_id step-1 in JSON, got %v", decoded["step_id"])
+		}
+
+		if decoded["kind"] != string(ThinkingStepKindToolCall) {
+			t.Errorf("expected kind %s in JSON, got %v", ThinkingStepKindToolCall, decoded["kind"])
+		}
+	})
+}
+
+func TestThinkingStepContentEvent(t *testing.T) {
+	t.Run("basic creation", func(t *testing.T) {
+		event := NewThinkingStepContentEvent("step-1", "considering options")
+
+		if event.Type() != EventTypeThinkingStepContent {
+			t.Errorf("expected event type %s, got %s", EventTypeThinkingStepContent, event.Type())
+		}
+
+		if err := event.Validate(); err != nil {
+			t.Errorf("validation failed: %v", err)
+		}
+	})
+
+	t.Run("validation requires delta or payload", func(t *testing.T) {
+		event := NewThinkingStepContentEvent("step-1", "")
+
+		if err := event.Validate(); err == nil {
+			t.Error("expected validation to fail when delta and payload are both empty")
+		}
+	})
+
+	t.Run("payload alone satisfies validation", func(t *testing.T) {
+		event := NewThinkingStepContentEvent("step-1", "").WithPayload(json.RawMessage(`{"option":"a"}`))
+
+		if err := event.Validate(); err != nil {
+			t.Errorf("validation failed: %v", err)
+		}
+	})
+}
+
+func TestThinkingStepEndEvent(t *testing.T) {
+	t.Run("basic creation", func(t *testing.T) {
+		event := NewThinkingStepEndEvent("step-1", ThinkingStepStatusCompleted)
+
+		if event.Type() != EventTypeThinkingStepEnd {
+			t.Errorf("expected event type %s, got %s", EventTypeThinkingStepEnd, event.Type())
+		}
+
+		if err := event.Validate(); err != nil {
+			t.Errorf("validation failed: %v", err)
+		}
+	})
+
+	t.Run("validation requires known status", func(t *testing.T) {
+		event := NewThinkingStepEndEvent("step-1", ThinkingStepStatus("bogus"))
+
+		if err := event.Validate(); err == nil {
+			t.Error("expected validation to fail for unknown status")
+		}
+	})
+
+	t.Run("WithResult", func(t *testing.T) {
+		event := NewThinkingStepEndEvent("step-1", ThinkingStepStatusFailed).WithResult(json.RawMessage(`{"error":"timeout"}`))
+
+		if string(event.Result) != `{"error":"timeout"}` {
+			t.Errorf("expected result to be set, got %s", event.Result)
+		}
+	})
+}
+
+func TestThinkingToolCallEvents(t *testing.T) {
+	t.Run("start requires tool call id", func(t *testing.T) {
+		event := NewThinkingToolCallStartEvent("")
+
+		if err := event.Validate(); err == nil {
+			t.Error("expected validation to fail for empty tool call id")
+		}
+	})
+
+	t.Run("start basic creation", func(t *testing.T) {
+		event := NewThinkingToolCallStartEvent("call-1").WithStepID("step-1")
+
+		if event.Type() != EventTypeThinkingToolCallStart {
+			t.Errorf("expected event type %s, got %s", EventTypeThinkingToolCallStart, event.Type())
+		}
+
+		if event.StepID != "step-1" {
+			t.Errorf("expected step id step-1, got %s", event.StepID)
+		}
+
+		if err := event.Validate(); err != nil {
+			t.Errorf("validation failed: %v", err)
+		}
+	})
+
+	t.Run("end requires tool call id", func(t *testing.T) {
+		event := NewThinkingToolCallEndEvent("")
+
+		if err := event.Validate(); err == nil {
+			t.Error("expected validation to fail for empty tool call id")
+		}
+	})
+
+	t.Run("end basic creation", func(t *testing.T) {
+		event := NewThinkingToolCallEndEvent("call-1")
+
+		if event.Type() != EventTypeThinkingToolCallEnd {
+			t.Errorf("expected event type %s, got %s", EventTypeThinkingToolCallEnd, event.Type())
+		}
+
+		if err := event.Validate(); err != nil {
+			t.Errorf("validation failed: %v", err)
+		}
+	})
+}
+
 // Helper function to check if a string contains a substring
 func contains(s, substr string) bool {
 	return len(s) > 0 && len(substr) > 0 &&
@@ -0,0 +1,184 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ReasoningStep is a structured record of one thinking-phase reasoning step,
+// assembled by ThinkingTrace from the raw THINKING_TEXT_MESSAGE_* delta
+// stream.
+type ReasoningStep struct {
+	ID           string   `json:"id"`
+	Title        string   `json:"title,omitempty"`
+	StartedAt    *int64   `json:"startedAt,omitempty"`
+	EndedAt      *int64   `json:"endedAt,omitempty"`
+	Tokens       int      `json:"tokens"`
+	ChildStepIDs []string `json:"childStepIds,omitempty"`
+	Text         string   `json:"text"`
+}
+
+// ThinkingTrace accumulates a THINKING_START/THINKING_TEXT_MESSAGE_*/
+// THINKING_END event stream into structured ReasoningStep records, turning
+// the otherwise opaque delta stream into a queryable reasoning artifact
+// suitable for evaluation and audit. A ThinkingTrace is safe for concurrent
+// use.
+type ThinkingTrace struct {
+	mu    sync.Mutex
+	title string
+	steps []*ReasoningStep
+	byID  map[string]*ReasoningStep
+
+	current *ReasoningStep
+	stack   []*ReasoningStep
+	seq     int
+}
+
+// NewThinkingTrace creates an empty ThinkingTrace.
+func NewThinkingTrace() *ThinkingTrace {
+	return &ThinkingTrace{byID: make(map[string]*ReasoningStep)}
+}
+
+// Ingest feeds event into the trace. It accepts ThinkingStartEvent,
+// ThinkingTextMessageStartEvent, ThinkingTextMessageContentEvent,
+// ThinkingTextMessageEndEvent, and ThinkingEndEvent; any other event type is
+// an error. A ThinkingTextMessageStartEvent opened while a step is already
+// open nests the new step as a child of it (recorded in the parent's
+// ChildStepIDs), so a reasoning trace that narrates sub-steps round-trips
+// faithfully.
+func (t *ThinkingTrace) Ingest(event Event) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch e := event.(type) {
+	case *ThinkingStartEvent:
+		if e.Title != nil {
+			t.title = *e.Title
+		}
+
+	case *ThinkingTextMessageStartEvent:
+		stepID := e.StepID
+		if stepID == "" {
+			t.seq++
+			stepID = fmt.Sprintf("step-%d", t.seq)
+		}
+
+		step := &ReasoningStep{ID: stepID, StartedAt: e.Timestamp()}
+		if t.current != nil {
+			t.current.ChildStepIDs = append(t.current.ChildStepIDs, stepID)
+		}
+
+		t.stack = append(t.stack, t.current)
+		t.steps = append(t.steps, step)
+		t.byID[stepID] = step
+		t.current = step
+
+	case *ThinkingTextMessageContentEvent:
+		step := t.resolveStepLocked(e.StepID)
+		if step == nil {
+			return fmt.Errorf("ThinkingTrace: content for step %q with no matching start", e.StepID)
+		}
+		step.Text += e.Delta
+		step.Tokens = len(strings.Fields(step.Text))
+
+	case *ThinkingTextMessageEndEvent:
+		step := t.resolveStepLocked(e.StepID)
+		if step == nil {
+			return fmt.Errorf("ThinkingTrace: end for step %q with no matching start", e.StepID)
+		}
+		step.EndedAt = e.Timestamp()
+
+		if n := len(t.stack); n > 0 {
+			t.current = t.stack[n-1]
+			t.stack = t.stack[:n-1]
+		} else {
+			t.current = nil
+		}
+
+	case *ThinkingEndEvent:
+		t.current = nil
+		t.stack = nil
+
+	default:
+		return fmt.Errorf("ThinkingTrace: unsupported event type %s", event.Type())
+	}
+
+	return nil
+}
+
+// resolveStepLocked looks up the step a content/end event applies to: the
+// step named by stepID, or the currently open step when stepID is empty.
+// t.mu must already be held.
+func (t *ThinkingTrace) resolveStepLocked(stepID string) *ReasoningStep {
+	if stepID == "" {
+		return t.current
+	}
+	return t.byID[stepID]
+}
+
+// Steps returns the accumulated reasoning steps in the order they started.
+func (t *ThinkingTrace) Steps() []*ReasoningStep {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	steps := make([]*ReasoningStep, len(t.steps))
+	copy(steps, t.steps)
+	return steps
+}
+
+// ToJSON serializes the trace's title and accumulated steps to JSON.
+func (t *ThinkingTrace) ToJSON() ([]byte, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return json.Marshal(struct {
+		Title string           `json:"title,omitempty"`
+		Steps []*ReasoningStep `json:"steps"`
+	}{Title: t.title, Steps: t.steps})
+}
+
+// Redact rewrites every step's Text through redact, e.g. to scrub
+// chain-of-thought content before the trace is persisted or shipped
+// elsewhere. Tokens is recomputed from the redacted text.
+func (t *ThinkingTrace) Redact(redact func(text string) string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, step := range t.steps {
+		step.Text = redact(step.Text)
+		step.Tokens = len(strings.Fields(step.Text))
+	}
+}
+
+// Summarize compresses the trace's accumulated reasoning to at most maxChars
+// and returns it as a CustomEvent (name "thinking.summary") suitable for
+// emitting to a client that only wants the gist of the reasoning rather than
+// the full, possibly sensitive, chain-of-thought.
+func (t *ThinkingTrace) Summarize(maxChars int) *CustomEvent {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var b strings.Builder
+	for _, step := range t.steps {
+		if b.Len() > 0 {
+			b.WriteString(" ")
+		}
+		b.WriteString(step.Text)
+	}
+
+	summary := b.String()
+	truncated := false
+	if maxChars > 0 && len(summary) > maxChars {
+		summary = summary[:maxChars]
+		truncated = true
+	}
+
+	return NewCustomEvent("thinking.summary", WithValue(map[string]any{
+		"title":     t.title,
+		"stepCount": len(t.steps),
+		"text":      summary,
+		"truncated": truncated,
+	}))
+}
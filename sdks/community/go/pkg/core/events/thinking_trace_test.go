@@ -0,0 +1,98 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThinkingTrace(t *testing.T) {
+	t.Run("AccumulatesSingleStep", func(t *testing.T) {
+		trace := NewThinkingTrace()
+
+		require.NoError(t, trace.Ingest(NewThinkingStartEvent().WithTitle("Analyzing request")))
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageStartEvent()))
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageContentEvent("Let's ")))
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageContentEvent("think.")))
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageEndEvent()))
+		require.NoError(t, trace.Ingest(NewThinkingEndEvent()))
+
+		steps := trace.Steps()
+		require.Len(t, steps, 1)
+		assert.Equal(t, "Let's think.", steps[0].Text)
+		assert.Equal(t, 2, steps[0].Tokens)
+		assert.NotNil(t, steps[0].StartedAt)
+		assert.NotNil(t, steps[0].EndedAt)
+	})
+
+	t.Run("NestedStepsTrackChildren", func(t *testing.T) {
+		trace := NewThinkingTrace()
+
+		require.NoError(t, trace.Ingest(NewThinkingStartEvent()))
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageStartEvent().WithStepID("outer")))
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageStartEvent().WithStepID("inner")))
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageContentEvent("details").WithStepID("inner")))
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageEndEvent().WithStepID("inner")))
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageContentEvent("summary").WithStepID("outer")))
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageEndEvent().WithStepID("outer")))
+
+		steps := trace.Steps()
+		require.Len(t, steps, 2)
+		assert.Equal(t, []string{"inner"}, steps[0].ChildStepIDs)
+		assert.Equal(t, "summary", steps[0].Text)
+	})
+
+	t.Run("ContentBeforeStartErrors", func(t *testing.T) {
+		trace := NewThinkingTrace()
+		err := trace.Ingest(NewThinkingTextMessageContentEvent("orphaned"))
+		assert.Error(t, err)
+	})
+
+	t.Run("UnsupportedEventErrors", func(t *testing.T) {
+		trace := NewThinkingTrace()
+		err := trace.Ingest(NewRunStartedEvent("thread-1", "run-1"))
+		assert.Error(t, err)
+	})
+
+	t.Run("Redact", func(t *testing.T) {
+		trace := NewThinkingTrace()
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageStartEvent()))
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageContentEvent("my secret plan")))
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageEndEvent()))
+
+		trace.Redact(func(text string) string { return "[redacted]" })
+
+		steps := trace.Steps()
+		require.Len(t, steps, 1)
+		assert.Equal(t, "[redacted]", steps[0].Text)
+	})
+
+	t.Run("Summarize", func(t *testing.T) {
+		trace := NewThinkingTrace()
+		require.NoError(t, trace.Ingest(NewThinkingStartEvent().WithTitle("Plan")))
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageStartEvent()))
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageContentEvent("a rather long reasoning trace")))
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageEndEvent()))
+
+		summary := trace.Summarize(10)
+
+		assert.Equal(t, EventTypeCustom, summary.Type())
+		assert.Equal(t, "thinking.summary", summary.Name)
+		value, ok := summary.Value.(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, true, value["truncated"])
+		assert.Len(t, value["text"], 10)
+	})
+
+	t.Run("ToJSON", func(t *testing.T) {
+		trace := NewThinkingTrace()
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageStartEvent()))
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageContentEvent("hi")))
+		require.NoError(t, trace.Ingest(NewThinkingTextMessageEndEvent()))
+
+		data, err := trace.ToJSON()
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"text":"hi"`)
+	})
+}
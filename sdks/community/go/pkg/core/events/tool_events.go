@@ -69,6 +69,13 @@ func (e *ToolCallStartEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
 
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *ToolCallStartEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
 // ToolCallArgsEvent contains streaming tool call arguments
 type ToolCallArgsEvent struct {
 	*BaseEvent
@@ -134,6 +141,13 @@ func (e *ToolCallArgsEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
 
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *ToolCallArgsEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
 // ToolCallEndEvent indicates the end of a tool call
 type ToolCallEndEvent struct {
 	*BaseEvent
@@ -192,6 +206,13 @@ func (e *ToolCallEndEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
 
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *ToolCallEndEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
 // ToolCallResultEvent represents the result of a tool call execution
 type ToolCallResultEvent struct {
 	*BaseEvent
@@ -239,6 +260,13 @@ func (e *ToolCallResultEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
 
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *ToolCallResultEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
+
 // ToolCallChunkEvent represents a chunk of tool call data
 type ToolCallChunkEvent struct {
 	*BaseEvent
@@ -297,3 +325,10 @@ func (e *ToolCallChunkEvent) Validate() error {
 func (e *ToolCallChunkEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
+
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *ToolCallChunkEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
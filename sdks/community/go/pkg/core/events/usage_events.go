@@ -0,0 +1,102 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UsageEvent reports token usage for one LLM round-trip, or - when emitted
+// alongside a run's final RunFinished - cumulative usage across every
+// round-trip in the run, so an integrator can bill or meter a run without
+// parsing every TextMessage/ToolCall event in the stream.
+type UsageEvent struct {
+	*BaseEvent
+	ThreadIDValue    string `json:"threadId"`
+	RunIDValue       string `json:"runId"`
+	MessageID        string `json:"messageId,omitempty"`
+	Model            string `json:"model,omitempty"`
+	PromptTokens     int    `json:"promptTokens"`
+	CompletionTokens int    `json:"completionTokens"`
+	TotalTokens      int    `json:"totalTokens"`
+}
+
+// NewUsageEvent creates a new usage event.
+func NewUsageEvent(threadID, runID string, options ...UsageEventOption) *UsageEvent {
+	event := &UsageEvent{
+		BaseEvent:     NewBaseEvent(EventTypeUsage),
+		ThreadIDValue: threadID,
+		RunIDValue:    runID,
+	}
+
+	for _, opt := range options {
+		opt(event)
+	}
+
+	return event
+}
+
+// UsageEventOption defines options for creating usage events
+type UsageEventOption func(*UsageEvent)
+
+// WithUsageMessageID associates the usage event with the LLM generation
+// that produced it.
+func WithUsageMessageID(messageID string) UsageEventOption {
+	return func(e *UsageEvent) {
+		e.MessageID = messageID
+	}
+}
+
+// WithUsageModel records the model name the usage was billed against.
+func WithUsageModel(model string) UsageEventOption {
+	return func(e *UsageEvent) {
+		e.Model = model
+	}
+}
+
+// WithTokenCounts sets the prompt, completion, and total token counts.
+func WithTokenCounts(promptTokens, completionTokens, totalTokens int) UsageEventOption {
+	return func(e *UsageEvent) {
+		e.PromptTokens = promptTokens
+		e.CompletionTokens = completionTokens
+		e.TotalTokens = totalTokens
+	}
+}
+
+// Validate validates the usage event
+func (e *UsageEvent) Validate() error {
+	if err := e.BaseEvent.Validate(); err != nil {
+		return err
+	}
+
+	if e.ThreadIDValue == "" {
+		return fmt.Errorf("UsageEvent validation failed: threadId field is required")
+	}
+
+	if e.RunIDValue == "" {
+		return fmt.Errorf("UsageEvent validation failed: runId field is required")
+	}
+
+	return nil
+}
+
+// ThreadID returns the thread ID
+func (e *UsageEvent) ThreadID() string {
+	return e.ThreadIDValue
+}
+
+// RunID returns the run ID
+func (e *UsageEvent) RunID() string {
+	return e.RunIDValue
+}
+
+// ToJSON serializes the event to JSON
+func (e *UsageEvent) ToJSON() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// ToProto serializes the event to a minimal protobuf envelope for
+// cross-SDK compatibility, carrying the event's JSON form as field 1
+// (bytes) of the envelope. See events.ToProtoEnvelope.
+func (e *UsageEvent) ToProto() ([]byte, error) {
+	return ToProtoEnvelope(e)
+}
@@ -0,0 +1,233 @@
+package events
+
+import "fmt"
+
+// ValidationMode controls how a Validator reacts to a protocol violation.
+type ValidationMode int
+
+const (
+	// ValidationModeStrict returns the violation as an error from
+	// Validator.Validate, for callers that want to abort the run
+	// immediately on the first malformed event.
+	ValidationModeStrict ValidationMode = iota
+	// ValidationModeBestEffort never returns an error from Validate;
+	// instead it substitutes a synthetic RunErrorEvent (code
+	// ProtocolViolationCode) for the offending event, so a caller can keep
+	// consuming the rest of the stream.
+	ValidationModeBestEffort
+)
+
+// ProtocolViolationCode is the RunErrorEvent.Code a Validator attaches to
+// the synthetic error it produces in ValidationModeBestEffort.
+const ProtocolViolationCode = "PROTOCOL_VIOLATION"
+
+// Validator enforces the AG-UI event lifecycle invariants across a stream
+// of events seen one at a time, complementing ValidateSequence (which
+// checks a whole slice at once): a RunStartedEvent must precede any
+// sub-event, every *Start must precede its matching *Content/*Args/*End
+// with the same ID, nested ThinkingTextMessage*, ThinkingStep*, and
+// ThinkingToolCall* frames must close before ThinkingEnd, and a
+// StateDeltaEvent must follow a StateSnapshotEvent or a prior delta.
+// Validator is not safe for concurrent use.
+type Validator struct {
+	mode ValidationMode
+
+	runStarted          string // non-empty while a run is open, holding its run ID
+	activeSteps         map[string]bool
+	activeMessages      map[string]bool
+	activeToolCalls     map[string]bool
+	thinkingOpen        bool
+	thinkingTextOpen    bool
+	activeThinkingSteps map[string]bool
+	activeThinkingTools map[string]bool
+	stateInitialized    bool
+}
+
+// NewValidator creates a Validator that reacts to violations according to
+// mode.
+func NewValidator(mode ValidationMode) *Validator {
+	return &Validator{
+		mode:                mode,
+		activeSteps:         make(map[string]bool),
+		activeMessages:      make(map[string]bool),
+		activeToolCalls:     make(map[string]bool),
+		activeThinkingSteps: make(map[string]bool),
+		activeThinkingTools: make(map[string]bool),
+	}
+}
+
+// Validate checks event against the scopes opened by every event seen so
+// far. In ValidationModeStrict, a violation is returned as an error and
+// event is nil. In ValidationModeBestEffort, Validate never errors;
+// instead a violation produces a synthetic RunErrorEvent in place of
+// event, which the caller can forward to the stream like any other event.
+func (v *Validator) Validate(event Event) (Event, error) {
+	if err := v.check(event); err != nil {
+		if v.mode == ValidationModeStrict {
+			return nil, err
+		}
+		return NewRunErrorEvent(err.Error(), WithErrorCode(ProtocolViolationCode), WithRunID(v.runStarted)), nil
+	}
+	return event, nil
+}
+
+// check applies the lifecycle invariants and updates scope state, without
+// regard to ValidationMode.
+func (v *Validator) check(event Event) error {
+	switch e := event.(type) {
+	case *RunStartedEvent:
+		if v.runStarted != "" {
+			return fmt.Errorf("run %s already started", e.RunID())
+		}
+		v.runStarted = e.RunID()
+		return nil
+	case *RunFinishedEvent:
+		if v.runStarted == "" {
+			return fmt.Errorf("cannot finish run %s that was not started", e.RunID())
+		}
+		v.runStarted = ""
+		return nil
+	case *RunErrorEvent:
+		v.runStarted = ""
+		return nil
+	}
+
+	if v.runStarted == "" {
+		return fmt.Errorf("event %s received before RunStarted", event.Type())
+	}
+
+	switch e := event.(type) {
+	case *StepStartedEvent:
+		if v.activeSteps[e.StepName] {
+			return fmt.Errorf("step %s already started", e.StepName)
+		}
+		v.activeSteps[e.StepName] = true
+
+	case *StepFinishedEvent:
+		if !v.activeSteps[e.StepName] {
+			return fmt.Errorf("cannot finish step %s that was not started", e.StepName)
+		}
+		delete(v.activeSteps, e.StepName)
+
+	case *TextMessageStartEvent:
+		if v.activeMessages[e.MessageID] {
+			return fmt.Errorf("message %s already started", e.MessageID)
+		}
+		v.activeMessages[e.MessageID] = true
+
+	case *TextMessageContentEvent:
+		if !v.activeMessages[e.MessageID] {
+			return fmt.Errorf("content for message %s received before TextMessageStart", e.MessageID)
+		}
+
+	case *TextMessageEndEvent:
+		if !v.activeMessages[e.MessageID] {
+			return fmt.Errorf("cannot end message %s that was not started", e.MessageID)
+		}
+		delete(v.activeMessages, e.MessageID)
+
+	case *ToolCallStartEvent:
+		if v.activeToolCalls[e.ToolCallID] {
+			return fmt.Errorf("tool call %s already started", e.ToolCallID)
+		}
+		v.activeToolCalls[e.ToolCallID] = true
+
+	case *ToolCallArgsEvent:
+		if !v.activeToolCalls[e.ToolCallID] {
+			return fmt.Errorf("args for tool call %s received before ToolCallStart", e.ToolCallID)
+		}
+
+	case *ToolCallEndEvent:
+		if !v.activeToolCalls[e.ToolCallID] {
+			return fmt.Errorf("cannot end tool call %s that was not started", e.ToolCallID)
+		}
+		delete(v.activeToolCalls, e.ToolCallID)
+
+	case *ThinkingStartEvent:
+		if v.thinkingOpen {
+			return fmt.Errorf("thinking already started")
+		}
+		v.thinkingOpen = true
+
+	case *ThinkingEndEvent:
+		if !v.thinkingOpen {
+			return fmt.Errorf("cannot end thinking that was not started")
+		}
+		if v.thinkingTextOpen {
+			return fmt.Errorf("cannot end thinking while a thinking text message is still open")
+		}
+		if len(v.activeThinkingSteps) > 0 {
+			return fmt.Errorf("cannot end thinking while a thinking step is still open")
+		}
+		if len(v.activeThinkingTools) > 0 {
+			return fmt.Errorf("cannot end thinking while a thinking tool call is still open")
+		}
+		v.thinkingOpen = false
+
+	case *ThinkingTextMessageStartEvent:
+		if !v.thinkingOpen {
+			return fmt.Errorf("thinking text message started before ThinkingStart")
+		}
+		if v.thinkingTextOpen {
+			return fmt.Errorf("thinking text message already started")
+		}
+		v.thinkingTextOpen = true
+
+	case *ThinkingTextMessageContentEvent:
+		if !v.thinkingTextOpen {
+			return fmt.Errorf("thinking text content received before ThinkingTextMessageStart")
+		}
+
+	case *ThinkingTextMessageEndEvent:
+		if !v.thinkingTextOpen {
+			return fmt.Errorf("cannot end thinking text message that was not started")
+		}
+		v.thinkingTextOpen = false
+
+	case *ThinkingStepStartEvent:
+		if !v.thinkingOpen {
+			return fmt.Errorf("thinking step started before ThinkingStart")
+		}
+		if v.activeThinkingSteps[e.StepID] {
+			return fmt.Errorf("thinking step %s already started", e.StepID)
+		}
+		v.activeThinkingSteps[e.StepID] = true
+
+	case *ThinkingStepContentEvent:
+		if !v.activeThinkingSteps[e.StepID] {
+			return fmt.Errorf("content for thinking step %s received before ThinkingStepStart", e.StepID)
+		}
+
+	case *ThinkingStepEndEvent:
+		if !v.activeThinkingSteps[e.StepID] {
+			return fmt.Errorf("cannot end thinking step %s that was not started", e.StepID)
+		}
+		delete(v.activeThinkingSteps, e.StepID)
+
+	case *ThinkingToolCallStartEvent:
+		if !v.thinkingOpen {
+			return fmt.Errorf("thinking tool call started before ThinkingStart")
+		}
+		if v.activeThinkingTools[e.ToolCallID] {
+			return fmt.Errorf("thinking tool call %s already started", e.ToolCallID)
+		}
+		v.activeThinkingTools[e.ToolCallID] = true
+
+	case *ThinkingToolCallEndEvent:
+		if !v.activeThinkingTools[e.ToolCallID] {
+			return fmt.Errorf("cannot end thinking tool call %s that was not started", e.ToolCallID)
+		}
+		delete(v.activeThinkingTools, e.ToolCallID)
+
+	case *StateSnapshotEvent:
+		v.stateInitialized = true
+
+	case *StateDeltaEvent:
+		if !v.stateInitialized {
+			return fmt.Errorf("state delta received before any StateSnapshot")
+		}
+		v.stateInitialized = true
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator(t *testing.T) {
+	t.Run("ValidSequence", func(t *testing.T) {
+		v := NewValidator(ValidationModeStrict)
+
+		for _, event := range []Event{
+			NewRunStartedEvent("thread-1", "run-1"),
+			NewTextMessageStartEvent("msg-1"),
+			NewTextMessageContentEvent("msg-1", "Hello"),
+			NewTextMessageEndEvent("msg-1"),
+			NewRunFinishedEvent("thread-1", "run-1"),
+		} {
+			out, err := v.Validate(event)
+			require.NoError(t, err)
+			assert.Same(t, event, out)
+		}
+	})
+
+	t.Run("Strict_ContentBeforeStart", func(t *testing.T) {
+		v := NewValidator(ValidationModeStrict)
+		_, err := v.Validate(NewRunStartedEvent("thread-1", "run-1"))
+		require.NoError(t, err)
+
+		_, err = v.Validate(NewTextMessageContentEvent("msg-1", "Hello"))
+		assert.Error(t, err)
+	})
+
+	t.Run("Strict_ToolCallArgsBeforeStart", func(t *testing.T) {
+		v := NewValidator(ValidationModeStrict)
+		_, err := v.Validate(NewRunStartedEvent("thread-1", "run-1"))
+		require.NoError(t, err)
+
+		_, err = v.Validate(NewToolCallArgsEvent("tool-1", "{}"))
+		assert.Error(t, err)
+	})
+
+	t.Run("Strict_EventBeforeRunStarted", func(t *testing.T) {
+		v := NewValidator(ValidationModeStrict)
+		_, err := v.Validate(NewTextMessageStartEvent("msg-1"))
+		assert.Error(t, err)
+	})
+
+	t.Run("Strict_ThinkingTextOutlivesThinkingEnd", func(t *testing.T) {
+		v := NewValidator(ValidationModeStrict)
+		_, err := v.Validate(NewRunStartedEvent("thread-1", "run-1"))
+		require.NoError(t, err)
+		_, err = v.Validate(NewThinkingStartEvent())
+		require.NoError(t, err)
+		_, err = v.Validate(NewThinkingTextMessageStartEvent())
+		require.NoError(t, err)
+
+		_, err = v.Validate(NewThinkingEndEvent())
+		assert.Error(t, err)
+	})
+
+	t.Run("Strict_ThinkingStepOutlivesThinkingEnd", func(t *testing.T) {
+		v := NewValidator(ValidationModeStrict)
+		_, err := v.Validate(NewRunStartedEvent("thread-1", "run-1"))
+		require.NoError(t, err)
+		_, err = v.Validate(NewThinkingStartEvent())
+		require.NoError(t, err)
+		_, err = v.Validate(NewThinkingStepStartEvent("step-1", ThinkingStepKindPlan))
+		require.NoError(t, err)
+
+		_, err = v.Validate(NewThinkingEndEvent())
+		assert.Error(t, err)
+	})
+
+	t.Run("Strict_ThinkingToolCallBeforeThinkingStart", func(t *testing.T) {
+		v := NewValidator(ValidationModeStrict)
+		_, err := v.Validate(NewRunStartedEvent("thread-1", "run-1"))
+		require.NoError(t, err)
+
+		_, err = v.Validate(NewThinkingToolCallStartEvent("call-1"))
+		assert.Error(t, err)
+	})
+
+	t.Run("Strict_StateDeltaBeforeSnapshot", func(t *testing.T) {
+		v := NewValidator(ValidationModeStrict)
+		_, err := v.Validate(NewRunStartedEvent("thread-1", "run-1"))
+		require.NoError(t, err)
+
+		_, err = v.Validate(NewStateDeltaEvent([]JSONPatchOperation{{Op: "add", Path: "/x", Value: 1}}))
+		assert.Error(t, err)
+	})
+
+	t.Run("BestEffort_SubstitutesSyntheticRunError", func(t *testing.T) {
+		v := NewValidator(ValidationModeBestEffort)
+		_, err := v.Validate(NewRunStartedEvent("thread-1", "run-1"))
+		require.NoError(t, err)
+
+		out, err := v.Validate(NewTextMessageEndEvent("msg-1"))
+		require.NoError(t, err)
+
+		errEvent, ok := out.(*RunErrorEvent)
+		require.True(t, ok)
+		require.NotNil(t, errEvent.Code)
+		assert.Equal(t, ProtocolViolationCode, *errEvent.Code)
+	})
+}
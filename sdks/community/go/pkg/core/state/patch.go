@@ -0,0 +1,118 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// applyOp applies a single RFC 6902 JSON Patch operation to root in place,
+// mutating root's nested maps/slices to reflect the operation. move and
+// copy resolve op.From against root directly, since the source may live
+// anywhere in the tree, not just under op.Path's parent. mode controls
+// whether a remove/replace against a path that doesn't exist is an error
+// (StrictMode) or a no-op (LenientMode).
+func applyOp(root map[string]interface{}, op events.JSONPatchOperation, mode ApplyMode) error {
+	switch op.Op {
+	case "add":
+		return navigate(root, op.Path, func(container any, key string) (any, error) {
+			return addAt(container, key, op.Value)
+		})
+
+	case "remove":
+		if mode == LenientMode {
+			if _, ok := getPointer(root, op.Path); !ok {
+				return nil
+			}
+		}
+		return navigate(root, op.Path, removeAt)
+
+	case "replace":
+		if mode == LenientMode {
+			if _, ok := getPointer(root, op.Path); !ok {
+				return nil
+			}
+		}
+		return navigate(root, op.Path, func(container any, key string) (any, error) {
+			return replaceAt(container, key, op.Value)
+		})
+
+	case "move":
+		value, ok := getPointer(root, op.From)
+		if !ok {
+			return fmt.Errorf("move: source path %q not found", op.From)
+		}
+		value = deepCopyValue(value)
+		if err := navigate(root, op.From, removeAt); err != nil {
+			return fmt.Errorf("move: %w", err)
+		}
+		return navigate(root, op.Path, func(container any, key string) (any, error) {
+			return addAt(container, key, value)
+		})
+
+	case "copy":
+		value, ok := getPointer(root, op.From)
+		if !ok {
+			return fmt.Errorf("copy: source path %q not found", op.From)
+		}
+		value = deepCopyValue(value)
+		return navigate(root, op.Path, func(container any, key string) (any, error) {
+			return addAt(container, key, value)
+		})
+
+	case "test":
+		value, ok := getPointer(root, op.Path)
+		if !ok {
+			return fmt.Errorf("test: path %q not found", op.Path)
+		}
+		if !reflect.DeepEqual(value, op.Value) {
+			return fmt.Errorf("test: value at %q does not match", op.Path)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported op %q", op.Op)
+	}
+}
+
+// navigate is the entry point into mutateAt for a full JSON Pointer path,
+// handling the root document itself as a special case since it's always the
+// map held directly in root rather than something reachable via mutateAt.
+func navigate(root map[string]interface{}, pointer string, mutate func(container any, key string) (any, error)) error {
+	tokens, err := splitPointer(pointer)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("path must not be the document root")
+	}
+
+	newRoot, err := mutateAt(root, tokens, mutate)
+	if err != nil {
+		return err
+	}
+
+	// root is always the map itself (a JSON document's root is always an
+	// object here), so mutateAt's in-place map mutation already updated it;
+	// newRoot is only ever a distinct value when the final container is a
+	// slice nested deeper than the root, which mutateAt already reassigned
+	// into its parent map.
+	_ = newRoot
+	return nil
+}
+
+// deepCopyValue returns an independent copy of v, so a moved/copied value
+// doesn't alias the tree it was read from.
+func deepCopyValue(v any) any {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var out any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return v
+	}
+	return out
+}
@@ -0,0 +1,197 @@
+package state
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// splitPointer splits an RFC 6901 JSON Pointer into its unescaped tokens.
+// The root pointer "" yields no tokens.
+func splitPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("pointer %q must start with '/'", pointer)
+	}
+
+	raw := strings.Split(pointer[1:], "/")
+	tokens := make([]string, len(raw))
+	for i, t := range raw {
+		t = strings.ReplaceAll(t, "~1", "/")
+		t = strings.ReplaceAll(t, "~0", "~")
+		tokens[i] = t
+	}
+	return tokens, nil
+}
+
+// getPointer resolves pointer against root, returning false if any segment
+// along the way is missing.
+func getPointer(root map[string]interface{}, pointer string) (any, bool) {
+	tokens, err := splitPointer(pointer)
+	if err != nil {
+		return nil, false
+	}
+	if len(tokens) == 0 {
+		return root, true
+	}
+
+	var cur any = root
+	for _, tok := range tokens {
+		switch node := cur.(type) {
+		case map[string]interface{}:
+			v, ok := node[tok]
+			if !ok {
+				return nil, false
+			}
+			cur = v
+		case []interface{}:
+			idx, err := arrayIndex(tok, len(node))
+			if err != nil {
+				return nil, false
+			}
+			cur = node[idx]
+		default:
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+// arrayIndex parses tok as an array index into an array of the given
+// length, for operations (get/remove/replace) where the index must
+// reference an existing element.
+func arrayIndex(tok string, length int) (int, error) {
+	idx, err := strconv.Atoi(tok)
+	if err != nil {
+		return 0, fmt.Errorf("invalid array index %q", tok)
+	}
+	if idx < 0 || idx >= length {
+		return 0, fmt.Errorf("array index %d out of range [0,%d)", idx, length)
+	}
+	return idx, nil
+}
+
+// arrayInsertIndex parses tok as an insertion index into an array of the
+// given length, per RFC 6902's "-" (append) and out-of-range rules for
+// "add".
+func arrayInsertIndex(tok string, length int) (int, error) {
+	if tok == "-" {
+		return length, nil
+	}
+	idx, err := strconv.Atoi(tok)
+	if err != nil {
+		return 0, fmt.Errorf("invalid array index %q", tok)
+	}
+	if idx < 0 || idx > length {
+		return 0, fmt.Errorf("array index %d out of range [0,%d]", idx, length)
+	}
+	return idx, nil
+}
+
+// mutateAt recursively descends tokens into parent, applying mutate to the
+// container that directly holds the final path segment, and returns the
+// (possibly new, for slices whose length changed) value to reassign at this
+// level. Reassignment is necessary because, unlike a map, a Go slice held
+// in an interface{} can't be grown or shrunk in place through its parent.
+func mutateAt(parent any, tokens []string, mutate func(container any, key string) (any, error)) (any, error) {
+	if len(tokens) == 1 {
+		return mutate(parent, tokens[0])
+	}
+
+	tok := tokens[0]
+	switch node := parent.(type) {
+	case map[string]interface{}:
+		child, ok := node[tok]
+		if !ok {
+			return nil, fmt.Errorf("path segment %q not found", tok)
+		}
+		newChild, err := mutateAt(child, tokens[1:], mutate)
+		if err != nil {
+			return nil, err
+		}
+		node[tok] = newChild
+		return node, nil
+	case []interface{}:
+		idx, err := arrayIndex(tok, len(node))
+		if err != nil {
+			return nil, err
+		}
+		newChild, err := mutateAt(node[idx], tokens[1:], mutate)
+		if err != nil {
+			return nil, err
+		}
+		node[idx] = newChild
+		return node, nil
+	default:
+		return nil, fmt.Errorf("path segment %q: not a container", tok)
+	}
+}
+
+// addAt inserts value at the container/key addressed by mutateAt, appending
+// to a slice on "-" and inserting at an index otherwise.
+func addAt(container any, key string, value any) (any, error) {
+	switch node := container.(type) {
+	case map[string]interface{}:
+		node[key] = value
+		return node, nil
+	case []interface{}:
+		idx, err := arrayInsertIndex(key, len(node))
+		if err != nil {
+			return nil, err
+		}
+		out := make([]interface{}, 0, len(node)+1)
+		out = append(out, node[:idx]...)
+		out = append(out, value)
+		out = append(out, node[idx:]...)
+		return out, nil
+	default:
+		return nil, fmt.Errorf("path segment %q: not a container", key)
+	}
+}
+
+// removeAt deletes the value at the container/key addressed by mutateAt.
+func removeAt(container any, key string) (any, error) {
+	switch node := container.(type) {
+	case map[string]interface{}:
+		if _, ok := node[key]; !ok {
+			return nil, fmt.Errorf("member %q not found", key)
+		}
+		delete(node, key)
+		return node, nil
+	case []interface{}:
+		idx, err := arrayIndex(key, len(node))
+		if err != nil {
+			return nil, err
+		}
+		out := make([]interface{}, 0, len(node)-1)
+		out = append(out, node[:idx]...)
+		out = append(out, node[idx+1:]...)
+		return out, nil
+	default:
+		return nil, fmt.Errorf("path segment %q: not a container", key)
+	}
+}
+
+// replaceAt overwrites the value at the container/key addressed by
+// mutateAt; unlike addAt, the target must already exist.
+func replaceAt(container any, key string, value any) (any, error) {
+	switch node := container.(type) {
+	case map[string]interface{}:
+		if _, ok := node[key]; !ok {
+			return nil, fmt.Errorf("member %q not found", key)
+		}
+		node[key] = value
+		return node, nil
+	case []interface{}:
+		idx, err := arrayIndex(key, len(node))
+		if err != nil {
+			return nil, err
+		}
+		node[idx] = value
+		return node, nil
+	default:
+		return nil, fmt.Errorf("path segment %q: not a container", key)
+	}
+}
@@ -0,0 +1,329 @@
+// Package state maintains client-side thread state built from AG-UI
+// StateSnapshotEvent/StateDeltaEvent events: a StateSnapshotEvent replaces
+// the state wholesale, while a StateDeltaEvent applies RFC 6902 JSON Patch
+// operations against it atomically, so either every operation in the delta
+// takes effect or (on a failed "test" guard or an out-of-range path) none
+// of them do.
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// ApplyMode controls how ApplyDelta reacts to a remove/replace operation
+// whose path doesn't exist in the current state.
+type ApplyMode int
+
+const (
+	// StrictMode fails the whole delta (as if a "test" guard had failed)
+	// when a remove or replace targets a path that isn't present.
+	StrictMode ApplyMode = iota
+	// LenientMode treats a remove/replace on a missing path as a no-op
+	// instead of an error, for optimistic UIs that may see a delta for
+	// state they've already locally removed.
+	LenientMode
+)
+
+// Store holds the current thread state plus enough history to Undo the
+// most recently committed snapshot or delta. A Store is safe for
+// concurrent use.
+type Store struct {
+	mu      sync.RWMutex
+	state   map[string]interface{}
+	version int
+	history []map[string]interface{}
+	mode    ApplyMode
+
+	subscribers map[string][]func(path string, value any)
+}
+
+// StoreOption configures a Store built by NewStore.
+type StoreOption func(*Store)
+
+// WithApplyMode sets the ApplyMode a Store uses for remove/replace
+// operations against a missing path. Stores default to StrictMode.
+func WithApplyMode(mode ApplyMode) StoreOption {
+	return func(s *Store) {
+		s.mode = mode
+	}
+}
+
+// NewStore creates an empty Store, in StrictMode unless overridden by
+// WithApplyMode.
+func NewStore(opts ...StoreOption) *Store {
+	s := &Store{
+		state:       make(map[string]interface{}),
+		subscribers: make(map[string][]func(path string, value any)),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Version returns the number of snapshots/deltas committed so far, so a
+// caller can correlate a rendered message with the state it reflects.
+func (s *Store) Version() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.version
+}
+
+// Snapshot returns a deep copy of the current state.
+func (s *Store) Snapshot() map[string]interface{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return deepCopyState(s.state)
+}
+
+// Get returns the value at the RFC 6901 JSON Pointer path, and whether it
+// was found.
+func (s *Store) Get(path string) (any, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return getPointer(s.state, path)
+}
+
+// Subscribe registers callback to run, with the value at path in the state
+// after the change, after a committed ApplyDelta with an operation whose
+// Path (or, for move, From) is exactly path, and after every Reconcile or
+// Undo whether or not the value at path changed. An operation on a parent
+// or child of path does not trigger it, and it is not run when path is
+// absent from the new state. It returns an unsubscribe function.
+func (s *Store) Subscribe(path string, callback func(path string, value any)) func() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subscribers[path] = append(s.subscribers[path], callback)
+	idx := len(s.subscribers[path]) - 1
+
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if subs := s.subscribers[path]; idx < len(subs) {
+			subs[idx] = nil
+		}
+	}
+}
+
+// Reconcile replaces the entire state with snapshot, per a
+// StateSnapshotEvent, recording the prior state so it can be restored by
+// Undo.
+func (s *Store) Reconcile(snapshot any) error {
+	converted, err := toStateMap(snapshot)
+	if err != nil {
+		return fmt.Errorf("state: failed to reconcile snapshot: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.history = append(s.history, s.state)
+	s.state = converted
+	s.version++
+	s.notifyAllLocked()
+	return nil
+}
+
+// ApplyDelta applies ops atomically against the current state: either every
+// operation succeeds and the result is committed as the new state, or the
+// first failure (including a failed "test" guard) aborts the whole delta
+// and the state is left exactly as it was. On success it returns a
+// human-readable log line per operation.
+func (s *Store) ApplyDelta(ops []events.JSONPatchOperation) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	working := deepCopyState(s.state)
+	log := make([]string, 0, len(ops))
+	for i, op := range ops {
+		if err := applyOp(working, op, s.mode); err != nil {
+			return nil, fmt.Errorf("state: operation %d (%s %s) failed: %w", i, op.Op, op.Path, err)
+		}
+		log = append(log, logLine(op))
+	}
+
+	s.history = append(s.history, s.state)
+	s.state = working
+	s.version++
+	s.notifyTouchedLocked(ops)
+	return log, nil
+}
+
+// Undo reverts the most recently committed ApplyDelta or Reconcile call. It
+// returns an error if there is nothing left to revert to.
+func (s *Store) Undo() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.history) == 0 {
+		return fmt.Errorf("state: nothing to undo")
+	}
+
+	s.state = s.history[len(s.history)-1]
+	s.history = s.history[:len(s.history)-1]
+	s.version++
+	s.notifyAllLocked()
+	return nil
+}
+
+// EmitSnapshot returns a *events.StateSnapshotEvent carrying the current
+// state, for a caller that wants to checkpoint on demand (e.g. on a timer,
+// or in response to a client that reports having missed deltas) instead of
+// waiting for the next one it would otherwise send.
+func (s *Store) EmitSnapshot() *events.StateSnapshotEvent {
+	return events.NewStateSnapshotEvent(s.Snapshot())
+}
+
+// Diff computes the minimal RFC 6902 JSON Patch that transforms from into
+// to, so a consumer that has from (e.g. its last reconciled snapshot) and
+// receives to (a fresher snapshot, not a delta stream) can be brought
+// up to date with a single events.StateDeltaEvent instead of a full
+// Reconcile. A value that becomes null in to is emitted as a "remove" of
+// its path, so the patched document lacks that key rather than holding
+// null.
+func Diff(from, to map[string]interface{}) []events.JSONPatchOperation {
+	var ops []events.JSONPatchOperation
+	diffAt("", from, to, &ops)
+	return ops
+}
+
+// diffAt appends the operations needed to turn from into to at path into
+// ops, recursing into matching maps so unchanged siblings don't generate an
+// operation.
+func diffAt(path string, from, to interface{}, ops *[]events.JSONPatchOperation) {
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+	if fromIsMap && toIsMap {
+		diffMaps(path, fromMap, toMap, ops)
+		return
+	}
+
+	if reflect.DeepEqual(from, to) {
+		return
+	}
+	if from == nil {
+		*ops = append(*ops, events.JSONPatchOperation{Op: "add", Path: path, Value: to})
+		return
+	}
+	if to == nil {
+		*ops = append(*ops, events.JSONPatchOperation{Op: "remove", Path: path})
+		return
+	}
+	*ops = append(*ops, events.JSONPatchOperation{Op: "replace", Path: path, Value: to})
+}
+
+// diffMaps appends operations for every key added, removed, or changed
+// between from and to at path, recursing into nested maps present on both
+// sides.
+func diffMaps(path string, from, to map[string]interface{}, ops *[]events.JSONPatchOperation) {
+	for key, toValue := range to {
+		childPath := path + "/" + escapePointerToken(key)
+		fromValue, existed := from[key]
+		if !existed {
+			*ops = append(*ops, events.JSONPatchOperation{Op: "add", Path: childPath, Value: toValue})
+			continue
+		}
+		diffAt(childPath, fromValue, toValue, ops)
+	}
+	for key := range from {
+		if _, stillPresent := to[key]; !stillPresent {
+			*ops = append(*ops, events.JSONPatchOperation{Op: "remove", Path: path + "/" + escapePointerToken(key)})
+		}
+	}
+}
+
+// escapePointerToken escapes a JSON Pointer reference token per RFC 6901:
+// "~" becomes "~0" and "/" becomes "~1", in that order so an escaped "/"
+// isn't re-escaped by the "~" rule.
+func escapePointerToken(token string) string {
+	token = strings.ReplaceAll(token, "~", "~0")
+	token = strings.ReplaceAll(token, "/", "~1")
+	return token
+}
+
+func logLine(op events.JSONPatchOperation) string {
+	if op.From != "" {
+		return fmt.Sprintf("%s %s -> %s", op.Op, op.From, op.Path)
+	}
+	return fmt.Sprintf("%s %s", op.Op, op.Path)
+}
+
+// notifyTouchedLocked runs subscribers for every path an ApplyDelta call
+// touched (its Path and, for move, its From). s.mu must be held.
+func (s *Store) notifyTouchedLocked(ops []events.JSONPatchOperation) {
+	touched := make(map[string]bool, len(ops))
+	for _, op := range ops {
+		touched[op.Path] = true
+		if op.From != "" {
+			touched[op.From] = true
+		}
+	}
+	for path := range touched {
+		s.notifyPathLocked(path)
+	}
+}
+
+// notifyAllLocked runs every registered subscriber, for changes (Reconcile,
+// Undo) that don't have a bounded set of touched paths. s.mu must be held.
+func (s *Store) notifyAllLocked() {
+	for path := range s.subscribers {
+		s.notifyPathLocked(path)
+	}
+}
+
+func (s *Store) notifyPathLocked(path string) {
+	subs, ok := s.subscribers[path]
+	if !ok {
+		return
+	}
+	value, found := getPointer(s.state, path)
+	if !found {
+		return
+	}
+	for _, cb := range subs {
+		if cb != nil {
+			cb(path, value)
+		}
+	}
+}
+
+// toStateMap converts an arbitrary StateSnapshotEvent.Snapshot value into a
+// map[string]interface{}, round-tripping through JSON when it isn't one
+// already.
+func toStateMap(snapshot any) (map[string]interface{}, error) {
+	if m, ok := snapshot.(map[string]interface{}); ok {
+		return deepCopyState(m), nil
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// deepCopyState returns an independent copy of m, so mutating the working
+// copy during ApplyDelta can't corrupt the committed state it was cloned
+// from (or vice versa, for a state.Snapshot handed to a caller).
+func deepCopyState(m map[string]interface{}) map[string]interface{} {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return make(map[string]interface{})
+	}
+	var out map[string]interface{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return make(map[string]interface{})
+	}
+	return out
+}
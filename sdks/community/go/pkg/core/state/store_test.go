@@ -0,0 +1,147 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	t.Run("Reconcile then Get", func(t *testing.T) {
+		s := NewStore()
+		err := s.Reconcile(map[string]interface{}{
+			"user": map[string]interface{}{"name": "ada"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, s.Version())
+
+		value, ok := s.Get("/user/name")
+		require.True(t, ok)
+		assert.Equal(t, "ada", value)
+	})
+
+	t.Run("ApplyDelta commits every op on success", func(t *testing.T) {
+		s := NewStore()
+		require.NoError(t, s.Reconcile(map[string]interface{}{
+			"todos": []interface{}{"write tests"},
+		}))
+
+		log, err := s.ApplyDelta([]events.JSONPatchOperation{
+			{Op: "add", Path: "/todos/-", Value: "ship it"},
+			{Op: "replace", Path: "/todos/0", Value: "write more tests"},
+		})
+		require.NoError(t, err)
+		assert.Len(t, log, 2)
+
+		value, ok := s.Get("/todos")
+		require.True(t, ok)
+		assert.Equal(t, []interface{}{"write more tests", "ship it"}, value)
+		assert.Equal(t, 2, s.Version())
+	})
+
+	t.Run("ApplyDelta is atomic on failure", func(t *testing.T) {
+		s := NewStore()
+		require.NoError(t, s.Reconcile(map[string]interface{}{
+			"count": float64(1),
+		}))
+
+		_, err := s.ApplyDelta([]events.JSONPatchOperation{
+			{Op: "replace", Path: "/count", Value: float64(2)},
+			{Op: "remove", Path: "/missing"},
+		})
+		assert.Error(t, err)
+
+		// The first op must not have been committed despite succeeding on
+		// its own, since the whole delta failed.
+		value, ok := s.Get("/count")
+		require.True(t, ok)
+		assert.Equal(t, float64(1), value)
+		assert.Equal(t, 1, s.Version())
+	})
+
+	t.Run("Undo reverts the last commit", func(t *testing.T) {
+		s := NewStore()
+		require.NoError(t, s.Reconcile(map[string]interface{}{"n": float64(1)}))
+		_, err := s.ApplyDelta([]events.JSONPatchOperation{
+			{Op: "replace", Path: "/n", Value: float64(2)},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, s.Undo())
+		value, ok := s.Get("/n")
+		require.True(t, ok)
+		assert.Equal(t, float64(1), value)
+	})
+
+	t.Run("Undo with empty history errors", func(t *testing.T) {
+		s := NewStore()
+		assert.Error(t, s.Undo())
+	})
+
+	t.Run("Subscribe is notified on commit", func(t *testing.T) {
+		s := NewStore()
+		var got any
+		unsubscribe := s.Subscribe("/n", func(path string, value any) {
+			got = value
+		})
+		defer unsubscribe()
+
+		require.NoError(t, s.Reconcile(map[string]interface{}{"n": float64(42)}))
+		assert.Equal(t, float64(42), got)
+	})
+
+	t.Run("LenientMode no-ops remove/replace on a missing path", func(t *testing.T) {
+		s := NewStore(WithApplyMode(LenientMode))
+		require.NoError(t, s.Reconcile(map[string]interface{}{"count": float64(1)}))
+
+		log, err := s.ApplyDelta([]events.JSONPatchOperation{
+			{Op: "remove", Path: "/missing"},
+			{Op: "replace", Path: "/also-missing", Value: float64(2)},
+			{Op: "replace", Path: "/count", Value: float64(2)},
+		})
+		require.NoError(t, err)
+		assert.Len(t, log, 3)
+
+		value, ok := s.Get("/count")
+		require.True(t, ok)
+		assert.Equal(t, float64(2), value)
+	})
+
+	t.Run("EmitSnapshot returns the current state", func(t *testing.T) {
+		s := NewStore()
+		require.NoError(t, s.Reconcile(map[string]interface{}{"n": float64(7)}))
+
+		snapshot := s.EmitSnapshot()
+		assert.Equal(t, map[string]interface{}{"n": float64(7)}, snapshot.Snapshot)
+	})
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("produces add/remove/replace for changed keys", func(t *testing.T) {
+		from := map[string]interface{}{
+			"name":    "ada",
+			"removed": "bye",
+			"nested":  map[string]interface{}{"a": float64(1), "b": float64(2)},
+		}
+		to := map[string]interface{}{
+			"name":   "ada lovelace",
+			"added":  "hi",
+			"nested": map[string]interface{}{"a": float64(1), "b": float64(3)},
+		}
+
+		ops := Diff(from, to)
+
+		s := NewStore()
+		require.NoError(t, s.Reconcile(from))
+		_, err := s.ApplyDelta(ops)
+		require.NoError(t, err)
+		assert.Equal(t, to, s.Snapshot())
+	})
+
+	t.Run("no changes produces no operations", func(t *testing.T) {
+		doc := map[string]interface{}{"n": float64(1)}
+		assert.Empty(t, Diff(doc, doc))
+	})
+}
@@ -0,0 +1,316 @@
+package encoding
+
+import (
+	"bytes"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+)
+
+// defaultBucketBoundaries are the size classes the package-level buffer and
+// slice pools bucket into by default: powers of two from 512B to 4MiB, the
+// range buffer_sizing.go's estimates fall into for a single AG-UI event.
+// This replaces the old fixed small (4KB)/medium (64KB)/large (1MB) split,
+// which forced a 2KB event into the same 1MB-capped pool as a 900KB one.
+var defaultBucketBoundaries = []int{
+	512, 1024, 2048, 4096, 8192, 16384, 32768, 65536,
+	131072, 262144, 524288, 1048576, 2097152, 4194304,
+}
+
+// BucketStats is a point-in-time snapshot of one size bucket's pooling
+// activity, aggregated across every shard, so an operator can see whether
+// a bucket's boundaries are well chosen instead of guessing.
+type BucketStats struct {
+	// Boundary is this bucket's size class, in bytes.
+	Boundary int
+	// Hits counts Get calls this bucket satisfied from a pooled object.
+	Hits int64
+	// Misses counts Get calls this bucket had to allocate fresh for.
+	Misses int64
+	// Evictions counts Put calls that discarded an object instead of
+	// pooling it, because its capacity didn't exactly match this bucket
+	// (including objects larger than every configured boundary) - the
+	// guard against the oversized-item-pollutes-the-pool bug.
+	Evictions int64
+	// Live is the number of objects currently sitting in this bucket's
+	// shards, available for reuse.
+	Live int64
+}
+
+// bucketShard is one runtime.GOMAXPROCS-sized shard of a bucket, each
+// backed by its own sync.Pool so concurrent Get/Put from goroutines
+// scheduled on different Ps don't contend on the same pool.
+type bucketShard struct {
+	pool      sync.Pool
+	hits      int64
+	misses    int64
+	evictions int64
+	live      int64
+}
+
+// BucketedPool is a size-classed object pool modeled on franz-go's bucketed
+// buffer pool: objects are grouped into a fixed, ascending set of size
+// buckets instead of a small/medium/large split, each bucket sharded
+// runtime.GOMAXPROCS-ways to spread contention, with Get/Put/Stats exposing
+// exactly which bucket absorbed a given request.
+//
+// A BucketedPool is safe for concurrent use, except that Reset replaces
+// the shards without synchronization and so must not run concurrently with
+// any other method.
+type BucketedPool struct {
+	boundaries []int
+	shards     [][]*bucketShard // shards[bucketIdx][shardIdx]
+	newFunc    func(size int) interface{}
+	capOf      func(interface{}) int
+	resetFunc  func(interface{})
+
+	// totalGets and totalPuts are cumulative counters across every bucket,
+	// independent of the per-bucket hit/miss/eviction breakdown in Stats -
+	// PoolManager's reaper and Metrics use these as the pool_gets_total /
+	// pool_puts_total series.
+	totalGets    int64
+	totalPuts    int64
+	lastUsedNano int64
+}
+
+// NewBucketedPool creates a BucketedPool with the given size-class
+// boundaries (sorted ascending internally; the slice passed in need not
+// already be sorted). newFunc allocates a fresh object sized to exactly
+// fill a bucket, capOf reports an object's capacity (used to route Put to
+// the bucket it exactly fills), and resetFunc clears an object's contents
+// before it re-enters the pool.
+func NewBucketedPool(boundaries []int, newFunc func(size int) interface{}, capOf func(interface{}) int, resetFunc func(interface{})) *BucketedPool {
+	sorted := append([]int(nil), boundaries...)
+	sort.Ints(sorted)
+
+	numShards := runtime.GOMAXPROCS(0)
+	if numShards < 1 {
+		numShards = 1
+	}
+
+	shards := make([][]*bucketShard, len(sorted))
+	for i := range shards {
+		shards[i] = make([]*bucketShard, numShards)
+		for j := range shards[i] {
+			shards[i][j] = &bucketShard{}
+		}
+	}
+
+	return &BucketedPool{
+		boundaries: sorted,
+		shards:     shards,
+		newFunc:    newFunc,
+		capOf:      capOf,
+		resetFunc:  resetFunc,
+	}
+}
+
+// Get returns an object sized to fit at least n bytes: the smallest
+// configured bucket boundary >= n, pulled from a pooled object if one is
+// available in that bucket's shard or freshly allocated otherwise. A
+// request larger than every boundary is allocated directly at size n and
+// bypasses pooling entirely, rather than rounding up into (and polluting)
+// the largest bucket.
+func (p *BucketedPool) Get(n int) interface{} {
+	atomic.AddInt64(&p.totalGets, 1)
+	atomic.StoreInt64(&p.lastUsedNano, time.Now().UnixNano())
+
+	idx, ok := p.bucketFor(n)
+	if !ok {
+		return p.newFunc(n)
+	}
+
+	shard := p.shard(idx)
+	if v := shard.pool.Get(); v != nil {
+		atomic.AddInt64(&shard.hits, 1)
+		atomic.AddInt64(&shard.live, -1)
+		return v
+	}
+
+	atomic.AddInt64(&shard.misses, 1)
+	return p.newFunc(p.boundaries[idx])
+}
+
+// Put returns v to the bucket whose boundary its capacity exactly matches.
+// An object whose capacity matches no boundary - including one larger than
+// every configured bucket, grown past its original bucket's boundary by a
+// caller appending to it - is discarded (counted as an eviction on the
+// bucket it originally came from) instead of being pooled, guarding against
+// the class of bug where one oversized item stuck in a pool keeps getting
+// handed back out and inflating every caller's working set.
+func (p *BucketedPool) Put(v interface{}) {
+	atomic.AddInt64(&p.totalPuts, 1)
+	atomic.StoreInt64(&p.lastUsedNano, time.Now().UnixNano())
+
+	c := p.capOf(v)
+
+	idx, exact := p.exactBucket(c)
+	if !exact {
+		if attributeTo, ok := p.originBucket(c); ok {
+			atomic.AddInt64(&p.shard(attributeTo).evictions, 1)
+		} else if len(p.boundaries) > 0 {
+			atomic.AddInt64(&p.shard(0).evictions, 1)
+		}
+		return
+	}
+
+	p.resetFunc(v)
+	shard := p.shard(idx)
+	shard.pool.Put(v)
+	atomic.AddInt64(&shard.live, 1)
+}
+
+// Reset discards every pooled object and zeroes every bucket's counters.
+func (p *BucketedPool) Reset() {
+	for i := range p.shards {
+		for j := range p.shards[i] {
+			p.shards[i][j] = &bucketShard{}
+		}
+	}
+}
+
+// Stats returns a snapshot of every bucket's counters, in ascending
+// boundary order.
+func (p *BucketedPool) Stats() []BucketStats {
+	out := make([]BucketStats, len(p.boundaries))
+	for i, boundary := range p.boundaries {
+		var hits, misses, evictions, live int64
+		for _, shard := range p.shards[i] {
+			hits += atomic.LoadInt64(&shard.hits)
+			misses += atomic.LoadInt64(&shard.misses)
+			evictions += atomic.LoadInt64(&shard.evictions)
+			live += atomic.LoadInt64(&shard.live)
+		}
+		out[i] = BucketStats{Boundary: boundary, Hits: hits, Misses: misses, Evictions: evictions, Live: live}
+	}
+	return out
+}
+
+// LiveBytes estimates the pool's current footprint: each live (pooled, not
+// checked out) object counted at its bucket's boundary size. Satisfies
+// Reapable for PoolManager's reaper.
+func (p *BucketedPool) LiveBytes() int64 {
+	var total int64
+	for i, boundary := range p.boundaries {
+		for _, shard := range p.shards[i] {
+			total += int64(boundary) * atomic.LoadInt64(&shard.live)
+		}
+	}
+	return total
+}
+
+// LastUsed returns the time of the most recent Get or Put. Satisfies
+// Reapable for PoolManager's reaper.
+func (p *BucketedPool) LastUsed() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&p.lastUsedNano))
+}
+
+// CumulativeStats returns the pool's lifetime Get/Put/eviction counts and
+// current LiveBytes, the source of PoolManager.Metrics' pool_gets_total /
+// pool_puts_total / pool_evictions_total / pool_live_bytes series.
+func (p *BucketedPool) CumulativeStats() (gets, puts, evictions, liveBytes int64) {
+	for _, stats := range p.Stats() {
+		evictions += stats.Evictions
+	}
+	return atomic.LoadInt64(&p.totalGets), atomic.LoadInt64(&p.totalPuts), evictions, p.LiveBytes()
+}
+
+// bucketFor returns the index of the smallest bucket boundary >= n, and
+// whether one exists (n may exceed every configured boundary).
+func (p *BucketedPool) bucketFor(n int) (int, bool) {
+	idx := sort.SearchInts(p.boundaries, n)
+	if idx >= len(p.boundaries) {
+		return 0, false
+	}
+	return idx, true
+}
+
+// originBucket returns the index of the largest bucket boundary <= n - the
+// bucket an object of capacity n must have grown out of - and whether one
+// exists (n may be smaller than every configured boundary). Put uses this,
+// not bucketFor, to attribute an evicted object back to the bucket it came
+// from: bucketFor's smallest-boundary->=-n search answers "which bucket would
+// serve this capacity", the wrong question for an object that already grew
+// past its original bucket.
+func (p *BucketedPool) originBucket(n int) (int, bool) {
+	idx := sort.SearchInts(p.boundaries, n+1)
+	if idx == 0 {
+		return 0, false
+	}
+	return idx - 1, true
+}
+
+// exactBucket returns the index of the bucket whose boundary equals c
+// exactly, and whether one exists.
+func (p *BucketedPool) exactBucket(c int) (int, bool) {
+	idx := sort.SearchInts(p.boundaries, c)
+	if idx >= len(p.boundaries) || p.boundaries[idx] != c {
+		return 0, false
+	}
+	return idx, true
+}
+
+// shard picks one of bucket idx's runtime.GOMAXPROCS shards using a fast,
+// per-call pseudo-random hash instead of a real per-P index (the Go
+// runtime does not expose which P the current goroutine is running on):
+// the address of a stack-local variable varies across concurrently
+// executing goroutines (each with its own stack) cheaply enough to spread
+// load without an atomic counter or a syscall.
+func (p *BucketedPool) shard(idx int) *bucketShard {
+	shards := p.shards[idx]
+	if len(shards) == 1 {
+		return shards[0]
+	}
+	var local byte
+	h := uintptr(unsafe.Pointer(&local))
+	return shards[(h>>4)%uintptr(len(shards))]
+}
+
+// newBufferBucketedPool builds the BucketedPool backing package-level
+// buffer pooling (GetBuffer/PutBuffer and friends).
+func newBufferBucketedPool() *BucketedPool {
+	return NewBucketedPool(
+		defaultBucketBoundaries,
+		func(size int) interface{} {
+			return bytes.NewBuffer(make([]byte, 0, size))
+		},
+		func(v interface{}) int {
+			return v.(*bytes.Buffer).Cap()
+		},
+		func(v interface{}) {
+			buf := v.(*bytes.Buffer)
+			zeroBytes(buf.Bytes())
+			buf.Reset()
+		},
+	)
+}
+
+// newSliceBucketedPool builds the BucketedPool backing package-level slice
+// pooling (GetSlice/PutSlice and friends).
+func newSliceBucketedPool() *BucketedPool {
+	return NewBucketedPool(
+		defaultBucketBoundaries,
+		func(size int) interface{} {
+			return make([]byte, 0, size)
+		},
+		func(v interface{}) int {
+			return cap(v.([]byte))
+		},
+		func(v interface{}) {
+			slice := v.([]byte)
+			zeroBytes(slice[:cap(slice)])
+		},
+	)
+}
+
+// zeroBytes overwrites b in place, the same secure-zeroing
+// BufferPool/SlicePool apply by default, so a buffer or slice that carried
+// sensitive event data doesn't leak it to the next, unrelated Get.
+func zeroBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
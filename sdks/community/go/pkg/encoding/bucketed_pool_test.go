@@ -0,0 +1,135 @@
+package encoding
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestBucketedPool_GetRoundsUpToSmallestFittingBucket(t *testing.T) {
+	pool := NewBucketedPool(
+		[]int{64, 256, 1024},
+		func(size int) interface{} { return make([]byte, 0, size) },
+		func(v interface{}) int { return cap(v.([]byte)) },
+		func(v interface{}) {},
+	)
+
+	v := pool.Get(100)
+	if got := cap(v.([]byte)); got != 256 {
+		t.Errorf("expected a 256-byte slice for a 100-byte request, got %d", got)
+	}
+
+	stats := pool.Stats()
+	if stats[1].Misses != 1 {
+		t.Errorf("expected 1 miss on the 256 bucket, got %+v", stats[1])
+	}
+}
+
+func TestBucketedPool_PutThenGetIsAHit(t *testing.T) {
+	pool := NewBucketedPool(
+		[]int{64, 256, 1024},
+		func(size int) interface{} { return make([]byte, 0, size) },
+		func(v interface{}) int { return cap(v.([]byte)) },
+		func(v interface{}) {},
+	)
+
+	v := pool.Get(200) // miss, allocates a 256-byte slice
+	pool.Put(v)
+
+	if got := pool.Stats()[1].Live; got != 1 {
+		t.Fatalf("expected 1 live object after Put, got %d", got)
+	}
+
+	v2 := pool.Get(200)
+	if cap(v2.([]byte)) != 256 {
+		t.Fatalf("expected the reused 256-byte slice, got cap %d", cap(v2.([]byte)))
+	}
+
+	stats := pool.Stats()[1]
+	if stats.Hits != 1 || stats.Misses != 1 {
+		t.Errorf("expected 1 hit and 1 miss, got %+v", stats)
+	}
+}
+
+func TestBucketedPool_PutRejectsNonMatchingCapacity(t *testing.T) {
+	pool := NewBucketedPool(
+		[]int{64, 256, 1024},
+		func(size int) interface{} { return make([]byte, 0, size) },
+		func(v interface{}) int { return cap(v.([]byte)) },
+		func(v interface{}) {},
+	)
+
+	// A slice whose capacity doesn't land on any configured boundary
+	// (e.g. grown by append past its original bucket) must be discarded
+	// rather than pooled, so it can't pollute a bucket with an oversized
+	// or undersized object.
+	odd := make([]byte, 0, 300)
+	pool.Put(odd)
+
+	for i, stats := range pool.Stats() {
+		if stats.Live != 0 {
+			t.Errorf("bucket %d: expected no live objects from a rejected Put, got %d", i, stats.Live)
+		}
+	}
+	if pool.Stats()[1].Evictions != 1 {
+		t.Errorf("expected the rejected Put to be attributed as an eviction on the 256 bucket, got %+v", pool.Stats()[1])
+	}
+}
+
+func TestBucketedPool_GetBeyondLargestBucketBypassesPooling(t *testing.T) {
+	pool := NewBucketedPool(
+		[]int{64, 256},
+		func(size int) interface{} { return make([]byte, 0, size) },
+		func(v interface{}) int { return cap(v.([]byte)) },
+		func(v interface{}) {},
+	)
+
+	v := pool.Get(1000)
+	if got := cap(v.([]byte)); got != 1000 {
+		t.Errorf("expected an exact 1000-byte allocation for an oversized request, got %d", got)
+	}
+	for i, stats := range pool.Stats() {
+		if stats.Hits != 0 || stats.Misses != 0 {
+			t.Errorf("bucket %d: oversized Get should not touch bucket stats, got %+v", i, stats)
+		}
+	}
+}
+
+func TestBucketedPool_ConcurrentGetPut(t *testing.T) {
+	pool := NewBucketedPool(
+		defaultBucketBoundaries,
+		func(size int) interface{} { return make([]byte, 0, size) },
+		func(v interface{}) int { return cap(v.([]byte)) },
+		func(v interface{}) {},
+	)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				v := pool.Get(2000)
+				pool.Put(v)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestGetBufferPutBufferRoundTrip(t *testing.T) {
+	ResetAllPools()
+
+	buf := GetBuffer(100)
+	buf.WriteString("hello")
+	PutBuffer(buf)
+
+	stats := BufferPoolStats()
+	if stats[0].Boundary != 512 || stats[0].Live != 1 {
+		t.Errorf("expected the 512-byte bucket to have 1 live buffer, got %+v", stats[0])
+	}
+
+	buf2 := GetBuffer(100)
+	if buf2.Len() != 0 {
+		t.Errorf("expected a reused buffer to come back empty, got len %d", buf2.Len())
+	}
+}
@@ -0,0 +1,296 @@
+// Package cbor implements the encoding.Encoder/Decoder contract for
+// application/cbor (RFC 8949), a second binary sibling to
+// pkg/encoding/protobuf for callers that want a compact wire format but,
+// unlike the protobuf package's JSON-in-envelope placeholder, a real
+// field-by-field encoding today: CBOR's major types map directly onto the
+// map[string]interface{}/[]interface{}/string/float64/bool/nil tree an
+// event's JSON form already unmarshals into, including StateSnapshotEvent's
+// arbitrary Snapshot value, with no schema to generate first.
+package cbor
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// CBOR major types (RFC 8949 Section 3).
+const (
+	majorUnsigned = 0
+	majorNegative = 1
+	majorText     = 3
+	majorArray    = 4
+	majorMap      = 5
+	majorSimple   = 7
+)
+
+// Simple values and float width markers under major type 7.
+const (
+	simpleFalse   = 20
+	simpleTrue    = 21
+	simpleNull    = 22
+	additionalF64 = 27
+)
+
+// Marshal encodes v - built from the same
+// map[string]interface{}/[]interface{}/string/float64/bool/nil tree
+// encoding/json.Unmarshal produces into an interface{} - as CBOR. Map keys
+// are written in sorted order so the same value always produces the same
+// bytes. This is not RFC 8949's core deterministic encoding: keys are
+// ordered by string value rather than by their encoded bytes, and every
+// number is written as a 64-bit float rather than in its shortest form.
+func Marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := encodeValue(&buf, v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes a single CBOR value, previously produced by Marshal,
+// back into the same interface{} tree Marshal accepted.
+func Unmarshal(data []byte) (interface{}, error) {
+	v, n, err := decodeValue(data)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(data) {
+		return nil, fmt.Errorf("cbor: %d trailing byte(s) after value", len(data)-n)
+	}
+	return v, nil
+}
+
+func encodeValue(buf *bytes.Buffer, v interface{}) error {
+	switch val := v.(type) {
+	case nil:
+		buf.WriteByte(majorSimple<<5 | simpleNull)
+		return nil
+	case bool:
+		if val {
+			buf.WriteByte(majorSimple<<5 | simpleTrue)
+		} else {
+			buf.WriteByte(majorSimple<<5 | simpleFalse)
+		}
+		return nil
+	case string:
+		writeHead(buf, majorText, uint64(len(val)))
+		buf.WriteString(val)
+		return nil
+	case float64:
+		buf.WriteByte(majorSimple<<5 | additionalF64)
+		var bits [8]byte
+		putUint64(bits[:], math.Float64bits(val))
+		buf.Write(bits[:])
+		return nil
+	case int:
+		return encodeValue(buf, float64(val))
+	case []interface{}:
+		writeHead(buf, majorArray, uint64(len(val)))
+		for _, elem := range val {
+			if err := encodeValue(buf, elem); err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		writeHead(buf, majorMap, uint64(len(val)))
+		for _, k := range keys {
+			writeHead(buf, majorText, uint64(len(k)))
+			buf.WriteString(k)
+			if err := encodeValue(buf, val[k]); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("cbor: unsupported value type %T", v)
+	}
+}
+
+// writeHead writes a major type's initial byte plus whatever additional
+// length bytes count requires, per RFC 8949 Section 3's encoding of the
+// argument that follows a major type (a string/array/map's length here;
+// Marshal never emits an unsigned/negative integer major type on its own,
+// since every number in the tree is a float64, but writeHead is shared with
+// decodeValue's symmetry in mind).
+func writeHead(buf *bytes.Buffer, major byte, count uint64) {
+	switch {
+	case count < 24:
+		buf.WriteByte(major<<5 | byte(count))
+	case count <= 0xff:
+		buf.WriteByte(major<<5 | 24)
+		buf.WriteByte(byte(count))
+	case count <= 0xffff:
+		buf.WriteByte(major<<5 | 25)
+		var b [2]byte
+		putUint16(b[:], uint16(count))
+		buf.Write(b[:])
+	case count <= 0xffffffff:
+		buf.WriteByte(major<<5 | 26)
+		var b [4]byte
+		putUint32(b[:], uint32(count))
+		buf.Write(b[:])
+	default:
+		buf.WriteByte(major<<5 | 27)
+		var b [8]byte
+		putUint64(b[:], count)
+		buf.Write(b[:])
+	}
+}
+
+func decodeValue(data []byte) (interface{}, int, error) {
+	if len(data) == 0 {
+		return nil, 0, fmt.Errorf("cbor: unexpected end of input")
+	}
+
+	major := data[0] >> 5
+	additional := data[0] & 0x1f
+
+	switch major {
+	case majorText:
+		count, head, err := readCount(data, additional)
+		if err != nil {
+			return nil, 0, err
+		}
+		end := head + int(count)
+		if end > len(data) {
+			return nil, 0, fmt.Errorf("cbor: truncated text string")
+		}
+		return string(data[head:end]), end, nil
+
+	case majorArray:
+		count, head, err := readCount(data, additional)
+		if err != nil {
+			return nil, 0, err
+		}
+		out := make([]interface{}, 0, count)
+		pos := head
+		for i := uint64(0); i < count; i++ {
+			v, n, err := decodeValue(data[pos:])
+			if err != nil {
+				return nil, 0, err
+			}
+			out = append(out, v)
+			pos += n
+		}
+		return out, pos, nil
+
+	case majorMap:
+		count, head, err := readCount(data, additional)
+		if err != nil {
+			return nil, 0, err
+		}
+		out := make(map[string]interface{}, count)
+		pos := head
+		for i := uint64(0); i < count; i++ {
+			keyVal, n, err := decodeValue(data[pos:])
+			if err != nil {
+				return nil, 0, err
+			}
+			key, ok := keyVal.(string)
+			if !ok {
+				return nil, 0, fmt.Errorf("cbor: map key at index %d is not a text string", i)
+			}
+			pos += n
+
+			v, n, err := decodeValue(data[pos:])
+			if err != nil {
+				return nil, 0, err
+			}
+			out[key] = v
+			pos += n
+		}
+		return out, pos, nil
+
+	case majorSimple:
+		switch additional {
+		case simpleFalse:
+			return false, 1, nil
+		case simpleTrue:
+			return true, 1, nil
+		case simpleNull:
+			return nil, 1, nil
+		case additionalF64:
+			if len(data) < 9 {
+				return nil, 0, fmt.Errorf("cbor: truncated float64")
+			}
+			return math.Float64frombits(getUint64(data[1:9])), 9, nil
+		default:
+			return nil, 0, fmt.Errorf("cbor: unsupported simple value %d", additional)
+		}
+
+	case majorUnsigned, majorNegative:
+		count, head, err := readCount(data, additional)
+		if err != nil {
+			return nil, 0, err
+		}
+		if major == majorUnsigned {
+			return float64(count), head, nil
+		}
+		return -1 - float64(count), head, nil
+
+	default:
+		return nil, 0, fmt.Errorf("cbor: unsupported major type %d", major)
+	}
+}
+
+// readCount decodes the length/value argument that follows a major type's
+// initial byte, returning it alongside the total number of bytes consumed
+// (including that initial byte).
+func readCount(data []byte, additional byte) (uint64, int, error) {
+	switch {
+	case additional < 24:
+		return uint64(additional), 1, nil
+	case additional == 24:
+		if len(data) < 2 {
+			return 0, 0, fmt.Errorf("cbor: truncated 1-byte length")
+		}
+		return uint64(data[1]), 2, nil
+	case additional == 25:
+		if len(data) < 3 {
+			return 0, 0, fmt.Errorf("cbor: truncated 2-byte length")
+		}
+		return uint64(getUint16(data[1:3])), 3, nil
+	case additional == 26:
+		if len(data) < 5 {
+			return 0, 0, fmt.Errorf("cbor: truncated 4-byte length")
+		}
+		return uint64(getUint32(data[1:5])), 5, nil
+	case additional == 27:
+		if len(data) < 9 {
+			return 0, 0, fmt.Errorf("cbor: truncated 8-byte length")
+		}
+		return getUint64(data[1:9]), 9, nil
+	default:
+		return 0, 0, fmt.Errorf("cbor: indefinite-length items are not supported (additional info %d)", additional)
+	}
+}
+
+func putUint16(b []byte, v uint16) { b[0] = byte(v >> 8); b[1] = byte(v) }
+func putUint32(b []byte, v uint32) {
+	b[0], b[1], b[2], b[3] = byte(v>>24), byte(v>>16), byte(v>>8), byte(v)
+}
+func putUint64(b []byte, v uint64) {
+	for i := 0; i < 8; i++ {
+		b[i] = byte(v >> uint(56-8*i))
+	}
+}
+
+func getUint16(b []byte) uint16 { return uint16(b[0])<<8 | uint16(b[1]) }
+func getUint32(b []byte) uint32 {
+	return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+}
+func getUint64(b []byte) uint64 {
+	var v uint64
+	for i := 0; i < 8; i++ {
+		v = v<<8 | uint64(b[i])
+	}
+	return v
+}
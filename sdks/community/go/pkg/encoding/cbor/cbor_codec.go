@@ -0,0 +1,75 @@
+package cbor
+
+import (
+	"context"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+)
+
+// CBORCodec composes CBOREncoder and CBORDecoder into the Codec interface,
+// mirroring json.JSONCodec and protobuf.ProtobufCodec.
+type CBORCodec struct {
+	*CBOREncoder
+	*CBORDecoder
+}
+
+// Ensure CBORCodec implements the core interfaces
+var (
+	_ encoding.Encoder             = (*CBORCodec)(nil)
+	_ encoding.Decoder             = (*CBORCodec)(nil)
+	_ encoding.ContentTypeProvider = (*CBORCodec)(nil)
+	_ encoding.Codec               = (*CBORCodec)(nil)
+)
+
+// NewCBORCodec creates a new CBOR codec with the given options.
+func NewCBORCodec(encOptions *encoding.EncodingOptions, decOptions *encoding.DecodingOptions) *CBORCodec {
+	return &CBORCodec{
+		CBOREncoder: NewCBOREncoder(encOptions),
+		CBORDecoder: NewCBORDecoder(decOptions),
+	}
+}
+
+// NewDefaultCBORCodec creates a new CBOR codec with default options.
+func NewDefaultCBORCodec() *CBORCodec {
+	return NewCBORCodec(
+		&encoding.EncodingOptions{
+			CrossSDKCompatibility: true,
+			ValidateOutput:        true,
+		},
+		&encoding.DecodingOptions{
+			Strict:         true,
+			ValidateEvents: true,
+		},
+	)
+}
+
+// Encode delegates to the encoder.
+func (c *CBORCodec) Encode(ctx context.Context, event events.Event) ([]byte, error) {
+	return c.CBOREncoder.Encode(ctx, event)
+}
+
+// EncodeMultiple delegates to the encoder.
+func (c *CBORCodec) EncodeMultiple(ctx context.Context, evts []events.Event) ([]byte, error) {
+	return c.CBOREncoder.EncodeMultiple(ctx, evts)
+}
+
+// Decode delegates to the decoder.
+func (c *CBORCodec) Decode(ctx context.Context, data []byte) (events.Event, error) {
+	return c.CBORDecoder.Decode(ctx, data)
+}
+
+// DecodeMultiple delegates to the decoder.
+func (c *CBORCodec) DecodeMultiple(ctx context.Context, data []byte) ([]events.Event, error) {
+	return c.CBORDecoder.DecodeMultiple(ctx, data)
+}
+
+// ContentType returns the MIME type for CBOR.
+func (c *CBORCodec) ContentType() string {
+	return ContentTypeCBOR
+}
+
+// SupportsStreaming indicates that the CBOR codec supports streaming.
+func (c *CBORCodec) SupportsStreaming() bool {
+	return true
+}
@@ -0,0 +1,112 @@
+package cbor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+	agjson "github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/json"
+)
+
+// Ensure CBORDecoder implements the focused interfaces
+var (
+	_ encoding.Decoder                     = (*CBORDecoder)(nil)
+	_ encoding.ContentTypeProvider         = (*CBORDecoder)(nil)
+	_ encoding.StreamingCapabilityProvider = (*CBORDecoder)(nil)
+)
+
+// CBORDecoder implements the Decoder interface for CBOR format. Like
+// ProtobufDecoder, it re-serializes the decoded payload to JSON and hands
+// that to json.JSONDecoder's concrete-type dispatch rather than
+// duplicating it here, so ValidateEvents/Strict apply identically across
+// every wire format.
+type CBORDecoder struct {
+	options *encoding.DecodingOptions
+	inner   *agjson.JSONDecoder
+}
+
+// NewCBORDecoder creates a new CBOR decoder with the given options.
+func NewCBORDecoder(options *encoding.DecodingOptions) *CBORDecoder {
+	if options == nil {
+		options = &encoding.DecodingOptions{}
+	}
+	return &CBORDecoder{options: options, inner: agjson.NewJSONDecoder(options)}
+}
+
+// Decode decodes a single CBOR-encoded event.
+func (d *CBORDecoder) Decode(ctx context.Context, data []byte) (events.Event, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, &encoding.DecodingError{Format: "cbor", Message: "context cancelled", Cause: err}
+	}
+
+	if d.options.MaxSize > 0 && int64(len(data)) > d.options.MaxSize {
+		return nil, &encoding.DecodingError{
+			Format:  "cbor",
+			Message: fmt.Sprintf("input exceeds max size of %d bytes", d.options.MaxSize),
+		}
+	}
+
+	tree, err := Unmarshal(data)
+	if err != nil {
+		return nil, &encoding.DecodingError{Format: "cbor", Data: data, Message: "failed to decode CBOR value", Cause: err}
+	}
+
+	jsonData, err := json.Marshal(tree)
+	if err != nil {
+		return nil, &encoding.DecodingError{Format: "cbor", Data: data, Message: "failed to re-encode decoded value as JSON", Cause: err}
+	}
+
+	event, err := d.inner.Decode(ctx, jsonData)
+	if err != nil {
+		return nil, &encoding.DecodingError{Format: "cbor", Data: data, Message: "failed to decode event", Cause: err}
+	}
+
+	return event, nil
+}
+
+// DecodeMultiple decodes a sequence of varint-length-prefixed CBOR
+// messages, the framing CBOREncoder.EncodeMultiple writes.
+func (d *CBORDecoder) DecodeMultiple(ctx context.Context, data []byte) ([]events.Event, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, &encoding.DecodingError{Format: "cbor", Message: "context cancelled", Cause: err}
+	}
+
+	if len(data) == 0 {
+		return []events.Event{}, nil
+	}
+
+	var result []events.Event
+	rest := data
+	for i := 0; len(rest) > 0; i++ {
+		length, n, err := readVarint(rest)
+		if err != nil {
+			return nil, &encoding.DecodingError{Format: "cbor", Data: data, Message: fmt.Sprintf("failed to read length prefix for message %d", i), Cause: err}
+		}
+		rest = rest[n:]
+
+		if uint64(len(rest)) < length {
+			return nil, &encoding.DecodingError{Format: "cbor", Data: data, Message: fmt.Sprintf("truncated message %d: expected %d bytes, have %d", i, length, len(rest))}
+		}
+
+		event, err := d.Decode(ctx, rest[:length])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, event)
+		rest = rest[length:]
+	}
+
+	return result, nil
+}
+
+// ContentType returns the MIME type for CBOR.
+func (d *CBORDecoder) ContentType() string {
+	return ContentTypeCBOR
+}
+
+// SupportsStreaming indicates that the CBOR decoder supports streaming.
+func (d *CBORDecoder) SupportsStreaming() bool {
+	return true
+}
@@ -0,0 +1,160 @@
+package cbor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+)
+
+// ContentTypeCBOR is the MIME type for CBOR-encoded events.
+const ContentTypeCBOR = "application/cbor"
+
+// Ensure CBOREncoder implements the focused interfaces
+var (
+	_ encoding.Encoder                     = (*CBOREncoder)(nil)
+	_ encoding.ContentTypeProvider         = (*CBOREncoder)(nil)
+	_ encoding.StreamingCapabilityProvider = (*CBOREncoder)(nil)
+)
+
+// CBOREncoder implements the Encoder interface for CBOR format, mirroring
+// ProtobufEncoder's structure including its concurrency guard. Unlike
+// ProtobufEncoder, it does not go through event.ToProto's JSON envelope: it
+// round-trips event.ToJSON() through encoding/json into the generic tree
+// Marshal encodes field-by-field, so the wire bytes actually reflect CBOR's
+// type system instead of opaquely wrapping JSON text.
+type CBOREncoder struct {
+	options          *encoding.EncodingOptions
+	activeOperations int32
+	maxConcurrent    int32
+}
+
+// NewCBOREncoder creates a new CBOR encoder with the given options.
+func NewCBOREncoder(options *encoding.EncodingOptions) *CBOREncoder {
+	if options == nil {
+		options = &encoding.EncodingOptions{
+			CrossSDKCompatibility: true,
+			ValidateOutput:        true,
+		}
+	}
+	return &CBOREncoder{
+		options:       options,
+		maxConcurrent: 100,
+	}
+}
+
+// Encode encodes a single event to CBOR.
+func (e *CBOREncoder) Encode(ctx context.Context, event events.Event) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, &encoding.EncodingError{Format: "cbor", Message: "context cancelled", Cause: err}
+	}
+
+	if e.maxConcurrent > 0 {
+		current := atomic.AddInt32(&e.activeOperations, 1)
+		if current > e.maxConcurrent {
+			atomic.AddInt32(&e.activeOperations, -1)
+			return nil, &encoding.EncodingError{
+				Format:  "cbor",
+				Message: fmt.Sprintf("encoding concurrency limit exceeded: %d", e.maxConcurrent),
+			}
+		}
+		defer atomic.AddInt32(&e.activeOperations, -1)
+	}
+
+	if event == nil {
+		return nil, &encoding.EncodingError{Format: "cbor", Message: "cannot encode nil event"}
+	}
+
+	if e.options.ValidateOutput {
+		if err := event.Validate(); err != nil {
+			return nil, &encoding.EncodingError{Format: "cbor", Event: event, Message: "event validation failed", Cause: err}
+		}
+	}
+
+	data, err := e.marshal(event)
+	if err != nil {
+		return nil, &encoding.EncodingError{Format: "cbor", Event: event, Message: "failed to encode event", Cause: err}
+	}
+
+	if e.options.MaxSize > 0 && int64(len(data)) > e.options.MaxSize {
+		return nil, &encoding.EncodingError{
+			Format:  "cbor",
+			Event:   event,
+			Message: fmt.Sprintf("encoded event exceeds max size of %d bytes", e.options.MaxSize),
+		}
+	}
+
+	return data, nil
+}
+
+// marshal converts event to its JSON tree via ToJSON/json.Unmarshal, then
+// encodes that tree as CBOR.
+func (e *CBOREncoder) marshal(event events.Event) ([]byte, error) {
+	jsonData, err := event.ToJSON()
+	if err != nil {
+		return nil, fmt.Errorf("cbor: failed to obtain JSON form: %w", err)
+	}
+
+	var tree interface{}
+	if err := json.Unmarshal(jsonData, &tree); err != nil {
+		return nil, fmt.Errorf("cbor: failed to parse JSON form: %w", err)
+	}
+
+	return Marshal(tree)
+}
+
+// EncodeMultiple encodes multiple events as a stream of varint-length-
+// prefixed CBOR messages, the same framing ProtobufEncoder.EncodeMultiple
+// uses for its envelope messages.
+func (e *CBOREncoder) EncodeMultiple(ctx context.Context, evts []events.Event) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, &encoding.EncodingError{Format: "cbor", Message: "context cancelled", Cause: err}
+	}
+
+	if len(evts) == 0 {
+		return []byte{}, nil
+	}
+
+	if e.options.ValidateOutput {
+		for i, event := range evts {
+			if event == nil {
+				return nil, &encoding.EncodingError{Format: "cbor", Message: fmt.Sprintf("cannot encode nil event at index %d", i)}
+			}
+			if err := event.Validate(); err != nil {
+				return nil, &encoding.EncodingError{Format: "cbor", Event: event, Message: fmt.Sprintf("event validation failed at index %d", i), Cause: err}
+			}
+		}
+	}
+
+	var buf []byte
+	totalSize := int64(0)
+	for i, event := range evts {
+		data, err := e.marshal(event)
+		if err != nil {
+			return nil, &encoding.EncodingError{Format: "cbor", Event: event, Message: fmt.Sprintf("failed to encode event at index %d", i), Cause: err}
+		}
+
+		totalSize += int64(len(data))
+		if e.options.MaxSize > 0 && totalSize > e.options.MaxSize {
+			return nil, &encoding.EncodingError{Format: "cbor", Message: fmt.Sprintf("encoded events exceed max size of %d bytes", e.options.MaxSize)}
+		}
+
+		buf = appendVarint(buf, uint64(len(data)))
+		buf = append(buf, data...)
+	}
+
+	return buf, nil
+}
+
+// ContentType returns the MIME type for CBOR.
+func (e *CBOREncoder) ContentType() string {
+	return ContentTypeCBOR
+}
+
+// SupportsStreaming indicates that the CBOR encoder supports streaming.
+func (e *CBOREncoder) SupportsStreaming() bool {
+	return true
+}
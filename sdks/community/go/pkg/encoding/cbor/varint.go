@@ -0,0 +1,31 @@
+package cbor
+
+import "fmt"
+
+// appendVarint appends v to buf as a base-128 varint, the same framing
+// pkg/encoding/protobuf uses to length-prefix its EncodeMultiple messages.
+func appendVarint(buf []byte, v uint64) []byte {
+	for v >= 0x80 {
+		buf = append(buf, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(buf, byte(v))
+}
+
+// readVarint reads a base-128 varint from the start of data, returning the
+// decoded value and the number of bytes consumed.
+func readVarint(data []byte) (uint64, int, error) {
+	var result uint64
+	var shift uint
+	for i, b := range data {
+		if i >= 10 {
+			return 0, 0, fmt.Errorf("cbor: varint too long")
+		}
+		result |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return result, i + 1, nil
+		}
+		shift += 7
+	}
+	return 0, 0, fmt.Errorf("cbor: unexpected end of input reading varint")
+}
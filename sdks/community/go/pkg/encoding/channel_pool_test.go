@@ -0,0 +1,67 @@
+package encoding
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestChannelPool_GetPutRoundTrip(t *testing.T) {
+	pool := NewChannelPool(2, func() int { return 0 })
+
+	a := pool.Get()
+	b := pool.Get()
+	pool.Put(a)
+	pool.Put(b)
+
+	if v, ok := pool.TryGet(); !ok || v != a {
+		t.Fatalf("expected TryGet to return the Put value %d, got %d (ok=%v)", a, v, ok)
+	}
+}
+
+func TestChannelPool_TryGetReturnsFalseWhenDrained(t *testing.T) {
+	pool := NewChannelPool(1, func() int { return 42 })
+
+	if _, ok := pool.TryGet(); !ok {
+		t.Fatal("expected the pre-filled pool to yield one object")
+	}
+	if _, ok := pool.TryGet(); ok {
+		t.Fatal("expected TryGet to return false once the pool is drained")
+	}
+}
+
+func TestChannelPool_GetContextTimesOutWhenDrained(t *testing.T) {
+	pool := NewChannelPool(1, func() int { return 1 })
+	pool.Get() // drain the only object
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := pool.GetContext(ctx); err == nil {
+		t.Fatal("expected GetContext to time out on a drained pool")
+	}
+}
+
+func TestChannelPool_PutDiscardsBeyondCapacity(t *testing.T) {
+	pool := NewChannelPool(1, func() int { return 0 })
+
+	pool.Put(1) // already full; must not block
+	v, ok := pool.TryGet()
+	if !ok || v != 0 {
+		t.Fatalf("expected the original pre-filled object, got %d (ok=%v)", v, ok)
+	}
+}
+
+func TestChannelPool_Reset(t *testing.T) {
+	pool := NewChannelPool(2, func() int { return 7 })
+	pool.Get()
+	pool.Get()
+
+	pool.Reset()
+
+	for i := 0; i < 2; i++ {
+		if v, ok := pool.TryGet(); !ok || v != 7 {
+			t.Fatalf("expected Reset to refill with freshly built objects, got %d (ok=%v)", v, ok)
+		}
+	}
+}
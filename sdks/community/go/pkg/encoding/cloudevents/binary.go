@@ -0,0 +1,69 @@
+package cloudevents
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// CloudEvents v1.0 binary-mode HTTP headers. In binary mode the event data
+// is the literal HTTP body (no envelope wrapping); every other envelope
+// attribute is carried as a "ce-" prefixed header instead.
+const (
+	HeaderSpecVersion = "ce-specversion"
+	HeaderID          = "ce-id"
+	HeaderSource      = "ce-source"
+	HeaderType        = "ce-type"
+	HeaderTime        = "ce-time"
+)
+
+// BinaryHeaders builds the CloudEvents v1.0 binary-mode headers for env.
+// env.Data is not included; the caller sends it as the request/response
+// body verbatim, with Content-Type set to env.DataContentType.
+func BinaryHeaders(env *Envelope) http.Header {
+	header := make(http.Header, 6)
+	header.Set(HeaderSpecVersion, env.SpecVersion)
+	header.Set(HeaderID, env.ID)
+	header.Set(HeaderSource, env.Source)
+	header.Set(HeaderType, env.Type)
+	if env.Time != "" {
+		header.Set(HeaderTime, env.Time)
+	}
+	if env.DataContentType != "" {
+		header.Set("Content-Type", env.DataContentType)
+	}
+	return header
+}
+
+// EnvelopeFromBinaryHeaders reconstructs an Envelope from CloudEvents
+// binary-mode headers and the raw body, the mirror of BinaryHeaders.
+func EnvelopeFromBinaryHeaders(header http.Header, body []byte) (*Envelope, error) {
+	specVersion := header.Get(HeaderSpecVersion)
+	if specVersion == "" {
+		return nil, fmt.Errorf("cloudevents: missing required header %q", HeaderSpecVersion)
+	}
+
+	ceID := header.Get(HeaderID)
+	if ceID == "" {
+		return nil, fmt.Errorf("cloudevents: missing required header %q", HeaderID)
+	}
+
+	source := header.Get(HeaderSource)
+	if source == "" {
+		return nil, fmt.Errorf("cloudevents: missing required header %q", HeaderSource)
+	}
+
+	ceT := header.Get(HeaderType)
+	if ceT == "" {
+		return nil, fmt.Errorf("cloudevents: missing required header %q", HeaderType)
+	}
+
+	return &Envelope{
+		SpecVersion:     specVersion,
+		ID:              ceID,
+		Source:          source,
+		Type:            ceT,
+		Time:            header.Get(HeaderTime),
+		DataContentType: header.Get("Content-Type"),
+		Data:            body,
+	}, nil
+}
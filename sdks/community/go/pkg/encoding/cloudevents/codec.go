@@ -0,0 +1,379 @@
+package cloudevents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+	agjson "github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/json"
+)
+
+// Ensure Codec implements the focused interfaces
+var (
+	_ encoding.Encoder                     = (*Codec)(nil)
+	_ encoding.Decoder                     = (*Codec)(nil)
+	_ encoding.ContentTypeProvider         = (*Codec)(nil)
+	_ encoding.StreamingCapabilityProvider = (*Codec)(nil)
+	_ encoding.Codec                       = (*Codec)(nil)
+)
+
+// Codec encodes and decodes AG-UI events as CloudEvents v1.0 structured-mode
+// JSON envelopes. The original event's own JSON encoding is carried verbatim
+// as the envelope's "data", so decoding delegates the payload itself to the
+// json package, which picks the concrete event struct from the "type" field
+// inside data; the CE "type" attribute is only checked to be one this
+// package maps to an AG-UI event type.
+type Codec struct {
+	// Source is used as the CloudEvents "source" attribute for every event
+	// encoded by this Codec, e.g. "/ag-ui/run/{runID}".
+	Source string
+
+	decoder *agjson.JSONDecoder
+}
+
+// NewCodec creates a CloudEvents codec that stamps source onto every
+// encoded envelope.
+func NewCodec(source string) *Codec {
+	return &Codec{
+		Source:  source,
+		decoder: agjson.NewJSONDecoder(nil),
+	}
+}
+
+// ContentType returns the CloudEvents structured-mode JSON content type.
+func (c *Codec) ContentType() string {
+	return ContentTypeStructured
+}
+
+// SupportsStreaming reports that NDJSON-framed CloudEvents streaming is
+// available via StreamCodec.
+func (c *Codec) SupportsStreaming() bool {
+	return true
+}
+
+// Encode encodes a single event as a CloudEvents v1.0 envelope.
+func (c *Codec) Encode(ctx context.Context, event events.Event) ([]byte, error) {
+	env, err := c.envelope(event)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(env)
+	if err != nil {
+		return nil, &encoding.EncodingError{
+			Format:  "cloudevents",
+			Event:   event,
+			Message: "failed to marshal CloudEvents envelope",
+			Cause:   err,
+		}
+	}
+
+	return data, nil
+}
+
+// EncodeMultiple encodes events as a CloudEvents batch (a JSON array of
+// structured-mode envelopes), per the application/cloudevents-batch+json
+// media type.
+func (c *Codec) EncodeMultiple(ctx context.Context, evts []events.Event) ([]byte, error) {
+	envs := make([]*Envelope, 0, len(evts))
+	for _, event := range evts {
+		env, err := c.envelope(event)
+		if err != nil {
+			return nil, err
+		}
+		envs = append(envs, env)
+	}
+
+	data, err := json.Marshal(envs)
+	if err != nil {
+		return nil, &encoding.EncodingError{
+			Format:  "cloudevents",
+			Message: "failed to marshal CloudEvents batch",
+			Cause:   err,
+		}
+	}
+
+	return data, nil
+}
+
+// envelope builds the CloudEvents envelope for event without serializing it.
+func (c *Codec) envelope(event events.Event) (*Envelope, error) {
+	if event == nil {
+		return nil, &encoding.EncodingError{
+			Format:  "cloudevents",
+			Message: "cannot encode nil event",
+		}
+	}
+
+	ceT, err := ceType(event.Type())
+	if err != nil {
+		return nil, &encoding.EncodingError{
+			Format:  "cloudevents",
+			Event:   event,
+			Message: "unsupported event type",
+			Cause:   err,
+		}
+	}
+
+	data, err := event.ToJSON()
+	if err != nil {
+		return nil, &encoding.EncodingError{
+			Format:  "cloudevents",
+			Event:   event,
+			Message: "failed to encode event body",
+			Cause:   err,
+		}
+	}
+
+	var ceTime string
+	if ts := event.Timestamp(); ts != nil {
+		ceTime = time.UnixMilli(*ts).UTC().Format(time.RFC3339Nano)
+	}
+
+	return &Envelope{
+		SpecVersion:     specVersion,
+		ID:              eventID(event),
+		Source:          c.Source,
+		Type:            ceT,
+		Subject:         subjectFor(event),
+		Time:            ceTime,
+		DataContentType: "application/json",
+		Data:            json.RawMessage(data),
+	}, nil
+}
+
+// messageType is the CloudEvents "type" attribute used for the individual
+// message envelopes produced by EncodeMessagesSnapshotBatch. Messages aren't
+// AG-UI events themselves, so they fall outside the EventType-keyed
+// typeToSuffix table.
+const messageType = "io.agui.message"
+
+// EncodeMessagesSnapshotBatch encodes event as a CloudEvents batch (a JSON
+// array of structured-mode envelopes), one envelope per event.Messages
+// entry, per the application/cloudevents-batch+json media type. Unlike
+// EncodeMultiple, which batches whole AG-UI events, this unpacks a single
+// MessagesSnapshotEvent into one envelope per message so a CE-speaking
+// consumer can route/filter on individual messages without first decoding
+// the snapshot.
+func (c *Codec) EncodeMessagesSnapshotBatch(ctx context.Context, event *events.MessagesSnapshotEvent) ([]byte, error) {
+	if event == nil {
+		return nil, &encoding.EncodingError{
+			Format:  "cloudevents",
+			Message: "cannot encode nil event",
+		}
+	}
+
+	var ceTime string
+	if ts := event.Timestamp(); ts != nil {
+		ceTime = time.UnixMilli(*ts).UTC().Format(time.RFC3339Nano)
+	}
+
+	envs := make([]*Envelope, 0, len(event.Messages))
+	for _, msg := range event.Messages {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return nil, &encoding.EncodingError{
+				Format:  "cloudevents",
+				Event:   event,
+				Message: "failed to encode message body",
+				Cause:   err,
+			}
+		}
+
+		id := msg.ID
+		if id == "" {
+			id = events.GenerateMessageID()
+		}
+
+		envs = append(envs, &Envelope{
+			SpecVersion:     specVersion,
+			ID:              id,
+			Source:          c.Source,
+			Type:            messageType,
+			Subject:         msg.ID,
+			Time:            ceTime,
+			DataContentType: "application/json",
+			Data:            json.RawMessage(data),
+		})
+	}
+
+	data, err := json.Marshal(envs)
+	if err != nil {
+		return nil, &encoding.EncodingError{
+			Format:  "cloudevents",
+			Event:   event,
+			Message: "failed to marshal CloudEvents batch",
+			Cause:   err,
+		}
+	}
+
+	return data, nil
+}
+
+// subjectFor derives the CloudEvents "subject" attribute from the event's
+// thread and run IDs, so a consumer can filter/route without first
+// decoding the event body. Either ID may be empty (e.g. a RawEvent).
+func subjectFor(event events.Event) string {
+	threadID := event.ThreadID()
+	runID := event.RunID()
+	switch {
+	case threadID != "" && runID != "":
+		return threadID + "/" + runID
+	case threadID != "":
+		return threadID
+	default:
+		return runID
+	}
+}
+
+// eventID derives a CloudEvents "id" from the event's own ID when it has
+// one (BaseEvent-derived events don't expose one directly, so fall back to
+// a freshly generated message ID).
+func eventID(event events.Event) string {
+	type identified interface{ ID() string }
+	if e, ok := event.(identified); ok {
+		if id := e.ID(); id != "" {
+			return id
+		}
+	}
+	return events.GenerateMessageID()
+}
+
+// Decode decodes a single CloudEvents envelope back into an AG-UI event.
+func (c *Codec) Decode(ctx context.Context, data []byte) (events.Event, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, &encoding.DecodingError{
+			Format:  "cloudevents",
+			Message: "context cancelled",
+			Cause:   err,
+		}
+	}
+
+	if len(data) == 0 {
+		return nil, &encoding.DecodingError{
+			Format:  "cloudevents",
+			Message: "empty data",
+		}
+	}
+
+	var env Envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, &encoding.DecodingError{
+			Format:  "cloudevents",
+			Data:    data,
+			Message: "failed to decode CloudEvents envelope",
+			Cause:   err,
+		}
+	}
+
+	if _, err := eventTypeFromCEType(env.Type); err != nil {
+		return nil, &encoding.DecodingError{
+			Format:  "cloudevents",
+			Data:    data,
+			Message: "failed to route CE type to an AG-UI event",
+			Cause:   err,
+		}
+	}
+
+	event, err := c.decoder.Decode(ctx, env.Data)
+	if err != nil {
+		return nil, &encoding.DecodingError{
+			Format:  "cloudevents",
+			Data:    data,
+			Message: "failed to decode event data",
+			Cause:   err,
+		}
+	}
+
+	return event, nil
+}
+
+// EventFromCloudEvent decodes a single CloudEvents v1.0 structured-mode
+// JSON envelope (as produced by Codec.Encode) back into an AG-UI event, for
+// a caller that received the CloudEvent from a broker without already
+// holding a Codec of its own.
+func EventFromCloudEvent(data []byte) (events.Event, error) {
+	return NewCodec("").Decode(context.Background(), data)
+}
+
+// EncodeBinary encodes event as CloudEvents v1.0 binary content mode: the
+// returned headers carry every envelope attribute except "data", which is
+// returned separately as the raw body so the caller can send it as-is (e.g.
+// as an HTTP request body) instead of wrapping it in a JSON envelope.
+func (c *Codec) EncodeBinary(ctx context.Context, event events.Event) (http.Header, []byte, error) {
+	env, err := c.envelope(event)
+	if err != nil {
+		return nil, nil, err
+	}
+	return BinaryHeaders(env), env.Data, nil
+}
+
+// DecodeBinary reconstructs an event from CloudEvents v1.0 binary-mode
+// headers and body, the mirror of EncodeBinary.
+func (c *Codec) DecodeBinary(ctx context.Context, header http.Header, body []byte) (events.Event, error) {
+	env, err := EnvelopeFromBinaryHeaders(header, body)
+	if err != nil {
+		return nil, &encoding.DecodingError{
+			Format:  "cloudevents",
+			Data:    body,
+			Message: "failed to decode CloudEvents binary-mode headers",
+			Cause:   err,
+		}
+	}
+
+	if _, err := eventTypeFromCEType(env.Type); err != nil {
+		return nil, &encoding.DecodingError{
+			Format:  "cloudevents",
+			Data:    body,
+			Message: "failed to route CE type to an AG-UI event",
+			Cause:   err,
+		}
+	}
+
+	event, err := c.decoder.Decode(ctx, env.Data)
+	if err != nil {
+		return nil, &encoding.DecodingError{
+			Format:  "cloudevents",
+			Data:    body,
+			Message: "failed to decode event data",
+			Cause:   err,
+		}
+	}
+
+	return event, nil
+}
+
+// DecodeMultiple decodes a CloudEvents batch (a JSON array of envelopes).
+func (c *Codec) DecodeMultiple(ctx context.Context, data []byte) ([]events.Event, error) {
+	if len(data) == 0 {
+		return nil, &encoding.DecodingError{
+			Format:  "cloudevents",
+			Message: "empty data",
+		}
+	}
+
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, &encoding.DecodingError{
+			Format:  "cloudevents",
+			Data:    data,
+			Message: "failed to decode CloudEvents batch",
+			Cause:   err,
+		}
+	}
+
+	result := make([]events.Event, 0, len(raw))
+	for i, env := range raw {
+		event, err := c.Decode(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("event %d: %w", i, err)
+		}
+		result = append(result, event)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,154 @@
+// Package cloudevents encodes and decodes AG-UI events as CloudEvents v1.0
+// envelopes (structured mode), so AG-UI events are directly consumable by
+// any CNCF CloudEvents receiver.
+package cloudevents
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+const (
+	// ContentTypeStructured is the CloudEvents v1.0 structured-mode JSON content type.
+	ContentTypeStructured = "application/cloudevents+json"
+	// ContentTypeBatch is the CloudEvents v1.0 structured-mode batch JSON content type.
+	ContentTypeBatch = "application/cloudevents-batch+json"
+
+	specVersion = "1.0"
+)
+
+// Envelope is a CloudEvents v1.0 structured-mode JSON envelope carrying an
+// AG-UI event as its data payload.
+type Envelope struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            string          `json:"time,omitempty"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// typeToSuffix maps an AG-UI EventType to the CloudEvents "type" suffix used
+// after the "io.agui." prefix, e.g. EventTypeRunStarted -> "run.started".
+var typeToSuffix = map[events.EventType]string{
+	events.EventTypeRunStarted:                 "run.started",
+	events.EventTypeRunFinished:                "run.finished",
+	events.EventTypeRunError:                   "run.error",
+	events.EventTypeStepStarted:                "step.started",
+	events.EventTypeStepFinished:               "step.finished",
+	events.EventTypeTextMessageStart:           "text_message.start",
+	events.EventTypeTextMessageContent:         "text_message.content",
+	events.EventTypeTextMessageEnd:             "text_message.end",
+	events.EventTypeTextMessageChunk:           "text_message.chunk",
+	events.EventTypeToolCallStart:              "tool_call.start",
+	events.EventTypeToolCallArgs:               "tool_call.args",
+	events.EventTypeToolCallEnd:                "tool_call.end",
+	events.EventTypeToolCallChunk:              "tool_call.chunk",
+	events.EventTypeToolCallResult:             "tool_call.result",
+	events.EventTypeStateSnapshot:              "state.snapshot",
+	events.EventTypeStateDelta:                 "state.delta",
+	events.EventTypeMessagesSnapshot:           "messages.snapshot",
+	events.EventTypeRaw:                        "raw",
+	events.EventTypeCustom:                     "custom",
+	events.EventTypeThinkingStart:              "thinking.start",
+	events.EventTypeThinkingEnd:                "thinking.end",
+	events.EventTypeThinkingTextMessageStart:   "thinking.text_message.start",
+	events.EventTypeThinkingTextMessageContent: "thinking.text_message.content",
+	events.EventTypeThinkingTextMessageEnd:     "thinking.text_message.end",
+	events.EventTypeThinkingStepStart:          "thinking.step.start",
+	events.EventTypeThinkingStepContent:        "thinking.step.content",
+	events.EventTypeThinkingStepEnd:            "thinking.step.end",
+	events.EventTypeThinkingToolCallStart:      "thinking.tool_call.start",
+	events.EventTypeThinkingToolCallEnd:        "thinking.tool_call.end",
+}
+
+// suffixToType is the inverse of typeToSuffix, built once at init time.
+var suffixToType = func() map[string]events.EventType {
+	m := make(map[string]events.EventType, len(typeToSuffix))
+	for et, suffix := range typeToSuffix {
+		m[suffix] = et
+	}
+	return m
+}()
+
+// ceType returns the CloudEvents "type" attribute for an AG-UI EventType,
+// e.g. "io.agui.run.started".
+func ceType(et events.EventType) (string, error) {
+	suffix, ok := typeToSuffix[et]
+	if !ok {
+		return "", fmt.Errorf("cloudevents: no CE type mapping for event type %q", et)
+	}
+	return "io.agui." + suffix, nil
+}
+
+// eventTypeFromCEType reverses ceType, routing a CloudEvents "type"
+// attribute back to the concrete AG-UI EventType.
+func eventTypeFromCEType(t string) (events.EventType, error) {
+	suffix := strings.TrimPrefix(t, "io.agui.")
+	et, ok := suffixToType[suffix]
+	if !ok {
+		return "", fmt.Errorf("cloudevents: unrecognized CE type %q", t)
+	}
+	return et, nil
+}
+
+// CloudEventOption customizes an Envelope built by NewCloudEvent.
+type CloudEventOption func(*Envelope)
+
+// WithID overrides the envelope's auto-generated "id" attribute, e.g. to
+// reuse an ID already assigned upstream instead of minting a fresh one.
+func WithID(id string) CloudEventOption {
+	return func(e *Envelope) { e.ID = id }
+}
+
+// WithTime overrides the envelope's "time" attribute, e.g. to stamp the
+// original occurrence time instead of the moment the envelope was built.
+func WithTime(t time.Time) CloudEventOption {
+	return func(e *Envelope) { e.Time = t.UTC().Format(time.RFC3339Nano) }
+}
+
+// WithDataContentType overrides the envelope's "datacontenttype" attribute,
+// e.g. when data isn't JSON.
+func WithDataContentType(contentType string) CloudEventOption {
+	return func(e *Envelope) { e.DataContentType = contentType }
+}
+
+// NewCloudEvent builds a CloudEvents v1.0 envelope for eventType/source/data,
+// mapping eventType to its stable CE "type" string (e.g.
+// EventTypeTextMessageContent -> "io.agui.text_message.content") and JSON-
+// marshaling data as the envelope's "data". Unlike Codec.Encode, data need
+// not be an events.Event - this is the entry point for emitting a CloudEvent
+// from an arbitrary payload, e.g. inside a CloudEventsSink.
+func NewCloudEvent(eventType events.EventType, source string, data any, opts ...CloudEventOption) (*Envelope, error) {
+	ceT, err := ceType(eventType)
+	if err != nil {
+		return nil, fmt.Errorf("cloudevents: unsupported event type: %w", err)
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("cloudevents: failed to marshal data: %w", err)
+	}
+
+	env := &Envelope{
+		SpecVersion:     specVersion,
+		ID:              events.GenerateMessageID(),
+		Source:          source,
+		Type:            ceT,
+		Time:            time.Now().UTC().Format(time.RFC3339Nano),
+		DataContentType: "application/json",
+		Data:            body,
+	}
+
+	for _, opt := range opts {
+		opt(env)
+	}
+
+	return env, nil
+}
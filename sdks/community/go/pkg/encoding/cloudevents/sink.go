@@ -0,0 +1,120 @@
+package cloudevents
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/errors"
+)
+
+// CloudEventsSink POSTs AG-UI events to an external URL as CloudEvents
+// v1.0 structured-mode JSON envelopes, so a caller can fan its event stream
+// out to a CloudEvents-aware webhook without hand-rolling HTTP delivery.
+type CloudEventsSink struct {
+	// URL is the endpoint every event is POSTed to.
+	URL string
+	// Codec builds the structured-mode envelope for each event.
+	// NewCloudEventsSink constructs one stamped with Source.
+	Codec *Codec
+	// Client is the HTTP client used for delivery. http.DefaultClient is
+	// used if nil.
+	Client *http.Client
+	// MaxRetries is the number of additional delivery attempts after an
+	// initial failure, with exponential backoff between attempts. Zero
+	// means a single attempt and no retries.
+	MaxRetries int
+	// RetryInterval is the initial backoff before the first retry,
+	// doubling (capped at 30s) after each subsequent failure. Defaults to
+	// 500ms if zero.
+	RetryInterval time.Duration
+}
+
+// NewCloudEventsSink creates a CloudEventsSink that delivers to url,
+// stamping source as the CloudEvents "source" attribute of every envelope.
+func NewCloudEventsSink(url, source string) *CloudEventsSink {
+	return &CloudEventsSink{
+		URL:        url,
+		Codec:      NewCodec(source),
+		MaxRetries: 3,
+	}
+}
+
+// Send encodes event as a CloudEvents envelope and POSTs it to s.URL,
+// retrying with exponential backoff on request failure or a non-2xx
+// response. Every attempt carries the same "Idempotency-Key" header (the
+// envelope's CloudEvents "id"), so a receiver that dedupes by that header
+// can safely treat retried deliveries as a single event.
+func (s *CloudEventsSink) Send(ctx context.Context, event events.Event) error {
+	env, err := s.Codec.envelope(event)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(env)
+	if err != nil {
+		return errors.NewEncodingError(errors.CodeEncodingFailed, "failed to marshal CloudEvents envelope").
+			WithOperation("send").WithCause(err)
+	}
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	backoff := s.RetryInterval
+	if backoff <= 0 {
+		backoff = 500 * time.Millisecond
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= s.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			backoff *= 2
+			if backoff > 30*time.Second {
+				backoff = 30 * time.Second
+			}
+		}
+
+		if err := s.deliver(ctx, client, env.ID, body); err != nil {
+			lastErr = err
+			continue
+		}
+		return nil
+	}
+
+	return errors.NewEncodingError(errors.CodeEncodingFailed,
+		fmt.Sprintf("delivery to %s failed after %d attempts", s.URL, s.MaxRetries+1)).
+		WithOperation("send").WithCause(lastErr)
+}
+
+// deliver makes a single POST attempt.
+func (s *CloudEventsSink) deliver(ctx context.Context, client *http.Client, idempotencyKey string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", ContentTypeStructured)
+	req.Header.Set("Idempotency-Key", idempotencyKey)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("delivery returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package cloudevents
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+)
+
+// Ensure StreamCodec implements the full streaming interface
+var (
+	_ encoding.StreamCodec   = (*StreamCodec)(nil)
+	_ encoding.StreamEncoder = (*streamEncoder)(nil)
+	_ encoding.StreamDecoder = (*streamDecoder)(nil)
+)
+
+// StreamCodec emits and consumes NDJSON-framed CloudEvents: one structured
+// JSON envelope per line, so a stream of AG-UI events can be read/written
+// incrementally instead of all at once.
+type StreamCodec struct {
+	*Codec
+
+	enc *streamEncoder
+	dec *streamDecoder
+}
+
+// NewStreamCodec creates an NDJSON-framed CloudEvents StreamCodec.
+func NewStreamCodec(source string) *StreamCodec {
+	codec := NewCodec(source)
+	return &StreamCodec{
+		Codec: codec,
+		enc:   &streamEncoder{codec: codec},
+		dec:   &streamDecoder{codec: codec},
+	}
+}
+
+// EncodeStream writes each event from input to output as one NDJSON line,
+// flushing after every event, until input closes or ctx is cancelled.
+func (s *StreamCodec) EncodeStream(ctx context.Context, input <-chan events.Event, output io.Writer) error {
+	if err := s.StartEncoding(ctx, output); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case event, ok := <-input:
+			if !ok {
+				return s.EndEncoding(ctx)
+			}
+			if err := s.WriteEvent(ctx, event); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// DecodeStream reads NDJSON lines from input, decoding each into an event
+// sent to output, until input is exhausted or ctx is cancelled. It does not
+// close output; the caller owns that channel.
+func (s *StreamCodec) DecodeStream(ctx context.Context, input io.Reader, output chan<- events.Event) error {
+	if err := s.StartDecoding(ctx, input); err != nil {
+		return err
+	}
+
+	for {
+		event, err := s.ReadEvent(ctx)
+		if err != nil {
+			if err == io.EOF {
+				return s.EndDecoding(ctx)
+			}
+			return err
+		}
+
+		select {
+		case output <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *StreamCodec) StartEncoding(ctx context.Context, w io.Writer) error {
+	return s.enc.StartStream(ctx, w)
+}
+
+func (s *StreamCodec) WriteEvent(ctx context.Context, event events.Event) error {
+	return s.enc.WriteEvent(ctx, event)
+}
+
+func (s *StreamCodec) EndEncoding(ctx context.Context) error {
+	return s.enc.EndStream(ctx)
+}
+
+func (s *StreamCodec) StartDecoding(ctx context.Context, r io.Reader) error {
+	return s.dec.StartStream(ctx, r)
+}
+
+func (s *StreamCodec) ReadEvent(ctx context.Context) (events.Event, error) {
+	return s.dec.ReadEvent(ctx)
+}
+
+func (s *StreamCodec) EndDecoding(ctx context.Context) error {
+	return s.dec.EndStream(ctx)
+}
+
+func (s *StreamCodec) GetStreamEncoder() encoding.StreamEncoder {
+	return s.enc
+}
+
+func (s *StreamCodec) GetStreamDecoder() encoding.StreamDecoder {
+	return s.dec
+}
+
+// streamEncoder is the StreamEncoder half of StreamCodec.
+type streamEncoder struct {
+	codec *Codec
+	w     io.Writer
+}
+
+func (e *streamEncoder) ContentType() string { return ContentTypeStructured }
+
+func (e *streamEncoder) StartStream(ctx context.Context, w io.Writer) error {
+	e.w = w
+	return nil
+}
+
+func (e *streamEncoder) WriteEvent(ctx context.Context, event events.Event) error {
+	if e.w == nil {
+		return fmt.Errorf("cloudevents: WriteEvent called before StartStream")
+	}
+
+	data, err := e.codec.Encode(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	if _, err := e.w.Write(data); err != nil {
+		return err
+	}
+	if _, err := e.w.Write([]byte("\n")); err != nil {
+		return err
+	}
+
+	if flusher, ok := e.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+
+	return nil
+}
+
+func (e *streamEncoder) EncodeStream(ctx context.Context, input <-chan events.Event, output io.Writer) error {
+	if err := e.StartStream(ctx, output); err != nil {
+		return err
+	}
+	for event := range input {
+		if err := e.WriteEvent(ctx, event); err != nil {
+			return err
+		}
+	}
+	return e.EndStream(ctx)
+}
+
+func (e *streamEncoder) EndStream(ctx context.Context) error {
+	e.w = nil
+	return nil
+}
+
+// streamDecoder is the StreamDecoder half of StreamCodec.
+type streamDecoder struct {
+	codec  *Codec
+	reader *bufio.Reader
+}
+
+func (d *streamDecoder) ContentType() string { return ContentTypeStructured }
+
+func (d *streamDecoder) StartStream(ctx context.Context, r io.Reader) error {
+	d.reader = bufio.NewReader(r)
+	return nil
+}
+
+func (d *streamDecoder) ReadEvent(ctx context.Context) (events.Event, error) {
+	if d.reader == nil {
+		return nil, fmt.Errorf("cloudevents: ReadEvent called before StartStream")
+	}
+
+	line, err := d.reader.ReadBytes('\n')
+	if len(line) == 0 {
+		return nil, err
+	}
+
+	event, decodeErr := d.codec.Decode(ctx, line)
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+	return event, nil
+}
+
+func (d *streamDecoder) DecodeStream(ctx context.Context, input io.Reader, output chan<- events.Event) error {
+	if err := d.StartStream(ctx, input); err != nil {
+		return err
+	}
+	for {
+		event, err := d.ReadEvent(ctx)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		select {
+		case output <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (d *streamDecoder) EndStream(ctx context.Context) error {
+	d.reader = nil
+	return nil
+}
@@ -0,0 +1,30 @@
+package codec
+
+import (
+	"context"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	agcbor "github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/cbor"
+)
+
+// cborCodec adapts cbor.CBORCodec to the context-free Codec interface.
+type cborCodec struct {
+	codec *agcbor.CBORCodec
+}
+
+// NewCBORCodec returns the Codec for "application/cbor".
+func NewCBORCodec() Codec {
+	return &cborCodec{codec: agcbor.NewDefaultCBORCodec()}
+}
+
+func (c *cborCodec) Encode(event events.Event) ([]byte, error) {
+	return c.codec.Encode(context.Background(), event)
+}
+
+func (c *cborCodec) Decode(data []byte) (events.Event, error) {
+	return c.codec.Decode(context.Background(), data)
+}
+
+func (c *cborCodec) ContentType() string {
+	return c.codec.ContentType()
+}
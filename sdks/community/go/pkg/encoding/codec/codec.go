@@ -0,0 +1,23 @@
+// Package codec provides a small, transport-agnostic wire-format codec
+// registry for AG-UI event payloads. It sits above pkg/encoding's
+// context-aware Encoder/Decoder interfaces: a Codec here has no context
+// parameter, since callers like sse.Client resolve one once per stream (by
+// Content-Type) and then apply it to every frame without per-call
+// cancellation needs.
+package codec
+
+import (
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// Codec encodes and decodes a single event to/from one wire format.
+type Codec interface {
+	// Encode serializes event into this codec's wire format.
+	Encode(event events.Event) ([]byte, error)
+	// Decode parses data, previously produced by Encode (or an
+	// interoperable server implementation), back into an Event.
+	Decode(data []byte) (events.Event, error)
+	// ContentType is the MIME type this codec reads and writes, used as
+	// the Registry lookup key.
+	ContentType() string
+}
@@ -0,0 +1,40 @@
+package codec
+
+import (
+	"context"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	agjson "github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/json"
+)
+
+// jsonCodec adapts json.JSONCodec to the context-free Codec interface.
+type jsonCodec struct {
+	codec       *agjson.JSONCodec
+	contentType string
+}
+
+// NewJSONCodec returns the Codec for "application/json", the format every
+// AG-UI server has historically emitted.
+func NewJSONCodec() Codec {
+	return &jsonCodec{codec: agjson.NewDefaultJSONCodec(), contentType: "application/json"}
+}
+
+// NewVendorJSONCodec returns the Codec for "application/vnd.ag-ui+json",
+// the AG-UI-specific JSON variant already registered in
+// pkg/encoding/negotiation. Its wire format is identical to NewJSONCodec's;
+// only the Content-Type differs.
+func NewVendorJSONCodec() Codec {
+	return &jsonCodec{codec: agjson.NewDefaultJSONCodec(), contentType: "application/vnd.ag-ui+json"}
+}
+
+func (c *jsonCodec) Encode(event events.Event) ([]byte, error) {
+	return c.codec.Encode(context.Background(), event)
+}
+
+func (c *jsonCodec) Decode(data []byte) (events.Event, error) {
+	return c.codec.Decode(context.Background(), data)
+}
+
+func (c *jsonCodec) ContentType() string {
+	return c.contentType
+}
@@ -0,0 +1,31 @@
+package codec
+
+import (
+	"context"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/protobuf"
+)
+
+// protobufCodec adapts protobuf.ProtobufCodec to the context-free Codec
+// interface.
+type protobufCodec struct {
+	codec *protobuf.ProtobufCodec
+}
+
+// NewProtobufCodec returns the Codec for "application/x-protobuf".
+func NewProtobufCodec() Codec {
+	return &protobufCodec{codec: protobuf.NewDefaultProtobufCodec()}
+}
+
+func (c *protobufCodec) Encode(event events.Event) ([]byte, error) {
+	return c.codec.Encode(context.Background(), event)
+}
+
+func (c *protobufCodec) Decode(data []byte) (events.Event, error) {
+	return c.codec.Decode(context.Background(), data)
+}
+
+func (c *protobufCodec) ContentType() string {
+	return c.codec.ContentType()
+}
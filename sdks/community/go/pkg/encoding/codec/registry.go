@@ -0,0 +1,74 @@
+package codec
+
+import (
+	"strings"
+	"sync"
+)
+
+// Registry maps a Content-Type to the Codec that reads and writes it.
+//
+// A Registry is safe for concurrent use.
+type Registry struct {
+	mu     sync.RWMutex
+	codecs map[string]Codec
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{codecs: make(map[string]Codec)}
+}
+
+// Register adds codec to the registry under codec.ContentType(), overwriting
+// any existing registration for that type.
+func (r *Registry) Register(codec Codec) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.codecs[normalizeContentType(codec.ContentType())] = codec
+}
+
+// Lookup returns the Codec registered for contentType, ignoring any
+// parameters (e.g. "; charset=utf-8").
+func (r *Registry) Lookup(contentType string) (Codec, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.codecs[normalizeContentType(contentType)]
+	return c, ok
+}
+
+// ContentTypes returns every registered Content-Type.
+func (r *Registry) ContentTypes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	types := make([]string, 0, len(r.codecs))
+	for ct := range r.codecs {
+		types = append(types, ct)
+	}
+	return types
+}
+
+func normalizeContentType(contentType string) string {
+	return strings.TrimSpace(strings.SplitN(contentType, ";", 2)[0])
+}
+
+// DefaultRegistry is pre-populated with the built-in JSON, Protobuf, CBOR,
+// and AG-UI JSON-variant codecs at package init. Register onto it directly
+// to add a custom format (e.g. MessagePack); sse.Client consults it by
+// default when Config.Codec isn't set.
+var DefaultRegistry = NewRegistry()
+
+func init() {
+	DefaultRegistry.Register(NewJSONCodec())
+	DefaultRegistry.Register(NewVendorJSONCodec())
+	DefaultRegistry.Register(NewProtobufCodec())
+	DefaultRegistry.Register(NewCBORCodec())
+}
+
+// Register adds codec to DefaultRegistry.
+func Register(c Codec) {
+	DefaultRegistry.Register(c)
+}
+
+// Lookup returns the Codec registered on DefaultRegistry for contentType.
+func Lookup(contentType string) (Codec, bool) {
+	return DefaultRegistry.Lookup(contentType)
+}
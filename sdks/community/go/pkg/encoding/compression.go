@@ -0,0 +1,407 @@
+package encoding
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// CompressionBackend implements one compression algorithm's streaming
+// writer/reader pair, so CompressingEncoder/CompressingDecoder and their
+// streaming counterparts can treat gzip, zstd, lz4, and deflate uniformly.
+type CompressionBackend interface {
+	// Name is the algorithm name, as used in EncodingOptions.Compression and
+	// reported by ContentEncoder.ContentEncoding.
+	Name() string
+
+	// NewWriter wraps w so writes to it are compressed.
+	NewWriter(w io.Writer) (io.WriteCloser, error)
+
+	// NewReader wraps r so reads from it are decompressed.
+	NewReader(r io.Reader) (io.ReadCloser, error)
+}
+
+// CompressionBackendFor returns the CompressionBackend for the named
+// algorithm. name must be one of the algorithms EncodingOptions.Validate
+// accepts: "gzip", "zstd", "lz4", "deflate".
+func CompressionBackendFor(name string) (CompressionBackend, error) {
+	switch name {
+	case "gzip":
+		return gzipBackend{}, nil
+	case "zstd":
+		return zstdBackend{}, nil
+	case "lz4":
+		return lz4Backend{}, nil
+	case "deflate":
+		return deflateBackend{}, nil
+	default:
+		return nil, fmt.Errorf("encoding: unsupported compression algorithm %q", name)
+	}
+}
+
+// ContentEncoder is implemented by encoders/decoders that additionally
+// transform their bytes through a Content-Encoding-style transport layer
+// (compression) on top of the content type ContentTypeProvider reports.
+type ContentEncoder interface {
+	// ContentEncoding returns the algorithm name suitable for an HTTP
+	// Content-Encoding header, e.g. "gzip".
+	ContentEncoding() string
+}
+
+type gzipBackend struct{}
+
+func (gzipBackend) Name() string { return "gzip" }
+
+func (gzipBackend) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return gzip.NewWriter(w), nil
+}
+
+func (gzipBackend) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return gzip.NewReader(r)
+}
+
+type zstdBackend struct{}
+
+func (zstdBackend) Name() string { return "zstd" }
+
+func (zstdBackend) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(w)
+}
+
+func (zstdBackend) NewReader(r io.Reader) (io.ReadCloser, error) {
+	dec, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return zstdReadCloser{dec}, nil
+}
+
+// zstdReadCloser adapts *zstd.Decoder, whose Close returns nothing, to
+// io.ReadCloser.
+type zstdReadCloser struct {
+	*zstd.Decoder
+}
+
+func (z zstdReadCloser) Close() error {
+	z.Decoder.Close()
+	return nil
+}
+
+type lz4Backend struct{}
+
+func (lz4Backend) Name() string { return "lz4" }
+
+func (lz4Backend) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return lz4.NewWriter(w), nil
+}
+
+func (lz4Backend) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return io.NopCloser(lz4.NewReader(r)), nil
+}
+
+type deflateBackend struct{}
+
+func (deflateBackend) Name() string { return "deflate" }
+
+func (deflateBackend) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return flate.NewWriter(w, flate.DefaultCompression)
+}
+
+func (deflateBackend) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return flate.NewReader(r), nil
+}
+
+// CompressingEncoder wraps a base Encoder so Encode/EncodeMultiple output is
+// compressed with the algorithm named by opts.Compression.
+type CompressingEncoder struct {
+	Encoder
+	backend CompressionBackend
+}
+
+// NewCompressingEncoder wraps base using the algorithm in opts.Compression.
+// opts.Compression must be non-empty; use base directly when no compression
+// is configured.
+func NewCompressingEncoder(base Encoder, opts *EncodingOptions) (*CompressingEncoder, error) {
+	if opts == nil || opts.Compression == "" {
+		return nil, fmt.Errorf("encoding: no compression algorithm configured")
+	}
+
+	backend, err := CompressionBackendFor(opts.Compression)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CompressingEncoder{Encoder: base, backend: backend}, nil
+}
+
+// ContentEncoding returns the wrapped algorithm's name.
+func (c *CompressingEncoder) ContentEncoding() string { return c.backend.Name() }
+
+// Encode encodes event with the base Encoder, then compresses the result.
+func (c *CompressingEncoder) Encode(ctx context.Context, event events.Event) ([]byte, error) {
+	data, err := c.Encoder.Encode(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	return c.compress(event, data)
+}
+
+// EncodeMultiple encodes evts with the base Encoder, then compresses the
+// result.
+func (c *CompressingEncoder) EncodeMultiple(ctx context.Context, evts []events.Event) ([]byte, error) {
+	data, err := c.Encoder.EncodeMultiple(ctx, evts)
+	if err != nil {
+		return nil, err
+	}
+	return c.compress(nil, data)
+}
+
+func (c *CompressingEncoder) compress(event events.Event, data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := c.backend.NewWriter(&buf)
+	if err != nil {
+		return nil, &EncodingError{Format: c.backend.Name(), Event: event, Message: "failed to create compression writer", Cause: err}
+	}
+	if _, err := w.Write(data); err != nil {
+		w.Close()
+		return nil, &EncodingError{Format: c.backend.Name(), Event: event, Message: "failed to compress data", Cause: err}
+	}
+	if err := w.Close(); err != nil {
+		return nil, &EncodingError{Format: c.backend.Name(), Event: event, Message: "failed to finalize compression", Cause: err}
+	}
+	return buf.Bytes(), nil
+}
+
+// CompressingDecoder wraps a base Decoder so Decode/DecodeMultiple input is
+// decompressed with algorithm before being handed to the base Decoder.
+type CompressingDecoder struct {
+	Decoder
+	backend CompressionBackend
+}
+
+// NewCompressingDecoder wraps base using the named compression algorithm.
+// algorithm must match whatever CompressingEncoder compressed the input
+// with, since that isn't recoverable from the compressed bytes themselves.
+func NewCompressingDecoder(base Decoder, algorithm string) (*CompressingDecoder, error) {
+	backend, err := CompressionBackendFor(algorithm)
+	if err != nil {
+		return nil, err
+	}
+	return &CompressingDecoder{Decoder: base, backend: backend}, nil
+}
+
+// ContentEncoding returns the wrapped algorithm's name.
+func (c *CompressingDecoder) ContentEncoding() string { return c.backend.Name() }
+
+// Decode decompresses data, then decodes the result with the base Decoder.
+func (c *CompressingDecoder) Decode(ctx context.Context, data []byte) (events.Event, error) {
+	decompressed, err := c.decompress(data)
+	if err != nil {
+		return nil, err
+	}
+	return c.Decoder.Decode(ctx, decompressed)
+}
+
+// DecodeMultiple decompresses data, then decodes the result with the base
+// Decoder.
+func (c *CompressingDecoder) DecodeMultiple(ctx context.Context, data []byte) ([]events.Event, error) {
+	decompressed, err := c.decompress(data)
+	if err != nil {
+		return nil, err
+	}
+	return c.Decoder.DecodeMultiple(ctx, decompressed)
+}
+
+func (c *CompressingDecoder) decompress(data []byte) ([]byte, error) {
+	r, err := c.backend.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, &DecodingError{Format: c.backend.Name(), Data: data, Message: "failed to create decompression reader", Cause: err}
+	}
+	defer r.Close()
+
+	decompressed, err := io.ReadAll(r)
+	if err != nil {
+		return nil, &DecodingError{Format: c.backend.Name(), Data: data, Message: "failed to decompress data", Cause: err}
+	}
+	return decompressed, nil
+}
+
+// CompressingCodec wraps a base Codec so both directions go through
+// CompressingEncoder and CompressingDecoder with the same algorithm, named
+// by opts.Compression.
+type CompressingCodec struct {
+	Codec
+	enc *CompressingEncoder
+	dec *CompressingDecoder
+}
+
+// NewCompressingCodec wraps base using the algorithm in opts.Compression.
+func NewCompressingCodec(base Codec, opts *EncodingOptions) (*CompressingCodec, error) {
+	enc, err := NewCompressingEncoder(base, opts)
+	if err != nil {
+		return nil, err
+	}
+	dec, err := NewCompressingDecoder(base, opts.Compression)
+	if err != nil {
+		return nil, err
+	}
+	return &CompressingCodec{Codec: base, enc: enc, dec: dec}, nil
+}
+
+// ContentEncoding returns the wrapped algorithm's name.
+func (c *CompressingCodec) ContentEncoding() string { return c.enc.ContentEncoding() }
+
+func (c *CompressingCodec) Encode(ctx context.Context, event events.Event) ([]byte, error) {
+	return c.enc.Encode(ctx, event)
+}
+
+func (c *CompressingCodec) EncodeMultiple(ctx context.Context, evts []events.Event) ([]byte, error) {
+	return c.enc.EncodeMultiple(ctx, evts)
+}
+
+func (c *CompressingCodec) Decode(ctx context.Context, data []byte) (events.Event, error) {
+	return c.dec.Decode(ctx, data)
+}
+
+func (c *CompressingCodec) DecodeMultiple(ctx context.Context, data []byte) ([]events.Event, error) {
+	return c.dec.DecodeMultiple(ctx, data)
+}
+
+// CompressingStreamEncoder wraps a base StreamEncoder so the io.Writer
+// passed to StartStream is itself wrapped in a compression writer; every
+// event written to the stream is therefore compressed incrementally rather
+// than all at once.
+type CompressingStreamEncoder struct {
+	StreamEncoder
+	backend CompressionBackend
+	wc      io.WriteCloser
+}
+
+// NewCompressingStreamEncoder wraps base using the algorithm in
+// opts.Compression.
+func NewCompressingStreamEncoder(base StreamEncoder, opts *EncodingOptions) (*CompressingStreamEncoder, error) {
+	if opts == nil || opts.Compression == "" {
+		return nil, fmt.Errorf("encoding: no compression algorithm configured")
+	}
+	backend, err := CompressionBackendFor(opts.Compression)
+	if err != nil {
+		return nil, err
+	}
+	return &CompressingStreamEncoder{StreamEncoder: base, backend: backend}, nil
+}
+
+// ContentEncoding returns the wrapped algorithm's name.
+func (c *CompressingStreamEncoder) ContentEncoding() string { return c.backend.Name() }
+
+// StartStream wraps output in a compression writer before delegating to the
+// base StreamEncoder.
+func (c *CompressingStreamEncoder) StartStream(ctx context.Context, output io.Writer) error {
+	wc, err := c.backend.NewWriter(output)
+	if err != nil {
+		return fmt.Errorf("encoding: failed to create compression writer: %w", err)
+	}
+	c.wc = wc
+	return c.StreamEncoder.StartStream(ctx, wc)
+}
+
+// EndStream ends the base stream, then flushes and closes the compression
+// writer so the final compressed bytes reach the underlying writer.
+func (c *CompressingStreamEncoder) EndStream(ctx context.Context) error {
+	if err := c.StreamEncoder.EndStream(ctx); err != nil {
+		return err
+	}
+	if c.wc == nil {
+		return nil
+	}
+	wc := c.wc
+	c.wc = nil
+	return wc.Close()
+}
+
+// EncodeStream starts a compressed stream on output, writes every event
+// from input, then ends the stream.
+func (c *CompressingStreamEncoder) EncodeStream(ctx context.Context, input <-chan events.Event, output io.Writer) error {
+	if err := c.StartStream(ctx, output); err != nil {
+		return err
+	}
+	for event := range input {
+		if err := c.WriteEvent(ctx, event); err != nil {
+			return err
+		}
+	}
+	return c.EndStream(ctx)
+}
+
+// CompressingStreamDecoder wraps a base StreamDecoder so the io.Reader
+// passed to StartStream is itself wrapped in a decompression reader.
+type CompressingStreamDecoder struct {
+	StreamDecoder
+	backend CompressionBackend
+	rc      io.ReadCloser
+}
+
+// NewCompressingStreamDecoder wraps base using the named compression
+// algorithm, which must match whatever compressed the stream.
+func NewCompressingStreamDecoder(base StreamDecoder, algorithm string) (*CompressingStreamDecoder, error) {
+	backend, err := CompressionBackendFor(algorithm)
+	if err != nil {
+		return nil, err
+	}
+	return &CompressingStreamDecoder{StreamDecoder: base, backend: backend}, nil
+}
+
+// ContentEncoding returns the wrapped algorithm's name.
+func (c *CompressingStreamDecoder) ContentEncoding() string { return c.backend.Name() }
+
+// StartStream wraps input in a decompression reader before delegating to
+// the base StreamDecoder.
+func (c *CompressingStreamDecoder) StartStream(ctx context.Context, input io.Reader) error {
+	rc, err := c.backend.NewReader(input)
+	if err != nil {
+		return fmt.Errorf("encoding: failed to create decompression reader: %w", err)
+	}
+	c.rc = rc
+	return c.StreamDecoder.StartStream(ctx, rc)
+}
+
+// EndStream closes the decompression reader, then ends the base stream.
+func (c *CompressingStreamDecoder) EndStream(ctx context.Context) error {
+	if c.rc != nil {
+		rc := c.rc
+		c.rc = nil
+		if err := rc.Close(); err != nil {
+			return err
+		}
+	}
+	return c.StreamDecoder.EndStream(ctx)
+}
+
+// DecodeStream starts a decompressed stream on input, reads events until
+// EOF, sending each to output, then ends the stream.
+func (c *CompressingStreamDecoder) DecodeStream(ctx context.Context, input io.Reader, output chan<- events.Event) error {
+	if err := c.StartStream(ctx, input); err != nil {
+		return err
+	}
+	for {
+		event, err := c.ReadEvent(ctx)
+		if err != nil {
+			if err == io.EOF {
+				return c.EndStream(ctx)
+			}
+			return err
+		}
+		select {
+		case output <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
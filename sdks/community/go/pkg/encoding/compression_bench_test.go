@@ -0,0 +1,59 @@
+package encoding_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+	agjson "github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/json"
+)
+
+// benchmarkCompressionEncode measures Encode throughput for algorithm
+// compressing a moderately sized state snapshot event, so the per-algorithm
+// cost can be compared against the uncompressed baseline.
+func benchmarkCompressionEncode(b *testing.B, algorithm string) {
+	ctx := context.Background()
+	enc, err := encoding.NewCompressingEncoder(agjson.NewJSONEncoder(nil), &encoding.EncodingOptions{Compression: algorithm})
+	if err != nil {
+		b.Fatalf("failed to create %s encoder: %v", algorithm, err)
+	}
+
+	event := events.NewStateSnapshotEvent(benchmarkSnapshot())
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := enc.Encode(ctx, event); err != nil {
+			b.Fatalf("encode: %v", err)
+		}
+	}
+}
+
+func BenchmarkCompressionEncodeBaseline(b *testing.B) {
+	ctx := context.Background()
+	enc := agjson.NewJSONEncoder(nil)
+	event := events.NewStateSnapshotEvent(benchmarkSnapshot())
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := enc.Encode(ctx, event); err != nil {
+			b.Fatalf("encode: %v", err)
+		}
+	}
+}
+
+func BenchmarkCompressionEncodeGzip(b *testing.B)    { benchmarkCompressionEncode(b, "gzip") }
+func BenchmarkCompressionEncodeZstd(b *testing.B)    { benchmarkCompressionEncode(b, "zstd") }
+func BenchmarkCompressionEncodeLZ4(b *testing.B)     { benchmarkCompressionEncode(b, "lz4") }
+func BenchmarkCompressionEncodeDeflate(b *testing.B) { benchmarkCompressionEncode(b, "deflate") }
+
+// benchmarkSnapshot builds a state snapshot with enough repeated structure
+// for compression ratios to be representative of real agent state.
+func benchmarkSnapshot() map[string]interface{} {
+	fields := make(map[string]interface{}, 50)
+	for i := 0; i < 50; i++ {
+		fields[fmt.Sprintf("field_%d", i)] = fmt.Sprintf("value number %d with some repeated padding text", i)
+	}
+	return fields
+}
@@ -3,18 +3,22 @@ package encoder
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/json"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/negotiation"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/protobuf"
 )
 
 // EventEncoder provides a high-level interface for encoding AG-UI events
 // This adapter bridges the Go SDK encoding package with example server needs
 type EventEncoder struct {
-	negotiator *negotiation.ContentNegotiator
-	jsonCodec  encoding.Codec
+	negotiator    *negotiation.ContentNegotiator
+	jsonCodec     encoding.Codec
+	protobufCodec encoding.Codec
+	ndjsonCodec   *json.StreamCodec
 }
 
 // NewEventEncoder creates a new event encoder with content negotiation support
@@ -23,8 +27,10 @@ func NewEventEncoder() *EventEncoder {
 	negotiator := negotiation.NewContentNegotiator("application/json")
 
 	return &EventEncoder{
-		negotiator: negotiator,
-		jsonCodec:  json.NewCodec(),
+		negotiator:    negotiator,
+		jsonCodec:     json.NewCodec(),
+		protobufCodec: protobuf.NewDefaultProtobufCodec(),
+		ndjsonCodec:   json.NewStreamCodec(nil, nil),
 	}
 }
 
@@ -39,25 +45,78 @@ func (e *EventEncoder) EncodeEvent(ctx context.Context, event events.Event, cont
 		return nil, fmt.Errorf("event validation failed: %w", err)
 	}
 
-	// For now, we only support JSON encoding as specified in the task
-	// Protobuf support can be added later
-	switch contentType {
-	case "application/json", "":
+	resolved, err := e.resolveContentType(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resolved {
+	case protobuf.ContentTypeProtobuf:
+		return e.protobufCodec.Encode(ctx, event)
+	default:
 		return e.jsonCodec.Encode(ctx, event)
+	}
+}
+
+// EncodeStream drains input, writing one frame per event to w, until input
+// closes or ctx is cancelled. For protobuf it writes gRPC-style
+// length-prefixed frames (a varint length followed by the event's ToProto
+// payload); for JSON it writes one compact event per line (NDJSON), via
+// json.StreamCodec. This lets the same encoder drive SSE, WebSocket, and
+// raw TCP transports without each caller reimplementing framing.
+func (e *EventEncoder) EncodeStream(ctx context.Context, input <-chan events.Event, w io.Writer, contentType string) error {
+	resolved, err := e.resolveContentType(contentType)
+	if err != nil {
+		return err
+	}
+
+	switch resolved {
+	case protobuf.ContentTypeProtobuf:
+		return e.encodeProtobufStream(ctx, input, w)
 	default:
-		// Try to negotiate to a supported type
-		supportedType, err := e.negotiator.Negotiate(contentType)
-		if err != nil {
-			return nil, fmt.Errorf("unsupported content type %q: %w", contentType, err)
-		}
+		return e.ndjsonCodec.EncodeStream(ctx, input, w)
+	}
+}
 
-		// For now, fallback to JSON
-		if supportedType == "application/json" {
-			return e.jsonCodec.Encode(ctx, event)
+// encodeProtobufStream writes each event from input to w as a
+// varint-length-prefixed protobuf frame, the same framing
+// protobuf.ProtobufEncoder.EncodeMultiple uses for a pre-collected slice.
+func (e *EventEncoder) encodeProtobufStream(ctx context.Context, input <-chan events.Event, w io.Writer) error {
+	for {
+		select {
+		case event, ok := <-input:
+			if !ok {
+				return nil
+			}
+			data, err := e.protobufCodec.Encode(ctx, event)
+			if err != nil {
+				return fmt.Errorf("encode protobuf frame: %w", err)
+			}
+			if err := writeVarintFrame(w, data); err != nil {
+				return fmt.Errorf("write protobuf frame: %w", err)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
 		}
+	}
+}
 
-		return nil, fmt.Errorf("content type %q not implemented yet", supportedType)
+// resolveContentType maps contentType - which may be empty, a canonical
+// content type, or an alias like "application/vnd.ag-ui+protobuf" - to the
+// canonical content type EncodeEvent/EncodeStream switch on.
+func (e *EventEncoder) resolveContentType(contentType string) (string, error) {
+	if contentType == "" {
+		return e.jsonCodec.ContentType(), nil
+	}
+	if capabilities, ok := e.negotiator.GetCapabilities(contentType); ok {
+		return capabilities.ContentType, nil
+	}
+
+	supportedType, err := e.negotiator.Negotiate(contentType)
+	if err != nil {
+		return "", fmt.Errorf("unsupported content type %q: %w", contentType, err)
 	}
+	return supportedType, nil
 }
 
 // NegotiateContentType performs content negotiation based on Accept header
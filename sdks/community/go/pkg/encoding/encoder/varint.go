@@ -0,0 +1,21 @@
+package encoder
+
+import "io"
+
+// writeVarintFrame writes data to w as a gRPC-style length-delimited
+// frame: a base-128 varint giving len(data), then data itself.
+func writeVarintFrame(w io.Writer, data []byte) error {
+	var lenBuf []byte
+	n := uint64(len(data))
+	for n >= 0x80 {
+		lenBuf = append(lenBuf, byte(n)|0x80)
+		n >>= 7
+	}
+	lenBuf = append(lenBuf, byte(n))
+
+	if _, err := w.Write(lenBuf); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
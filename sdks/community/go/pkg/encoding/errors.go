@@ -2,7 +2,6 @@ package encoding
 
 import (
 	"fmt"
-	"runtime"
 )
 
 // ==============================================================================
@@ -168,74 +167,59 @@ func (e *RegistryError) WithContext(key string, value interface{}) *RegistryErro
 
 // NewOperationError creates a new operation error with stack trace
 func NewOperationError(operation, component, message string, cause error) *OperationError {
-	stack := make([]uintptr, 10)
-	n := runtime.Callers(2, stack)
-
 	return &OperationError{
 		Operation: operation,
 		Component: component,
 		Message:   message,
 		Cause:     cause,
-		Stack:     stack[:n],
+		Stack:     captureStack(3),
 	}
 }
 
 // NewValidationError creates a new validation error with stack trace
 func NewValidationError(component, field, rule, message string, value interface{}) *ValidationError {
-	stack := make([]uintptr, 10)
-	n := runtime.Callers(2, stack)
-
 	return &ValidationError{
 		Component: component,
 		Field:     field,
 		Rule:      rule,
 		Message:   message,
 		Value:     value,
-		Stack:     stack[:n],
+		Stack:     captureStack(3),
 	}
 }
 
 // NewConfigurationError creates a new configuration error with stack trace
 func NewConfigurationError(component, setting, message string, value interface{}) *ConfigurationError {
-	stack := make([]uintptr, 10)
-	n := runtime.Callers(2, stack)
-
 	return &ConfigurationError{
 		Component: component,
 		Setting:   setting,
 		Message:   message,
 		Value:     value,
-		Stack:     stack[:n],
+		Stack:     captureStack(3),
 	}
 }
 
 // NewResourceError creates a new resource error with stack trace
 func NewResourceError(component, resource, message string, current, limit interface{}) *ResourceError {
-	stack := make([]uintptr, 10)
-	n := runtime.Callers(2, stack)
-
 	return &ResourceError{
 		Component: component,
 		Resource:  resource,
 		Message:   message,
 		Current:   current,
 		Limit:     limit,
-		Stack:     stack[:n],
+		Stack:     captureStack(3),
 	}
 }
 
 // NewRegistryError creates a new registry error with stack trace
 func NewRegistryError(registry, operation, key, message string, cause error) *RegistryError {
-	stack := make([]uintptr, 10)
-	n := runtime.Callers(2, stack)
-
 	return &RegistryError{
 		Registry:  registry,
 		Operation: operation,
 		Key:       key,
 		Message:   message,
 		Cause:     cause,
-		Stack:     stack[:n],
+		Stack:     captureStack(3),
 	}
 }
 
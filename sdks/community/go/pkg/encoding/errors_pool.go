@@ -0,0 +1,190 @@
+package encoding
+
+import (
+	"runtime"
+	"sync/atomic"
+)
+
+// ==============================================================================
+// STACK CAPTURE TOGGLE
+// ==============================================================================
+
+// captureStackEnabled gates whether the NewXxxError constructors call
+// runtime.Callers. It defaults to enabled; SetCaptureStack(false) turns it
+// off process-wide for hot paths (e.g. per-message validation on a
+// streaming decode loop) where the capture and later symbolication cost
+// outweighs the debugging value.
+var captureStackEnabled int32 = 1
+
+// SetCaptureStack enables or disables stack trace capture in the
+// NewXxxError constructors process-wide. Existing errors are unaffected;
+// only errors constructed after the call observe the new setting.
+func SetCaptureStack(enabled bool) {
+	v := int32(0)
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&captureStackEnabled, v)
+}
+
+// captureStack returns the current call stack as up to 10 PCs, or nil if
+// SetCaptureStack(false) is in effect. skip is forwarded to runtime.Callers
+// as-is, so callers account for captureStack's own frame the same way they
+// would for a direct runtime.Callers call.
+func captureStack(skip int) []uintptr {
+	if atomic.LoadInt32(&captureStackEnabled) == 0 {
+		return nil
+	}
+	stack := make([]uintptr, 10)
+	n := runtime.Callers(skip, stack)
+	return stack[:n]
+}
+
+// ==============================================================================
+// CLONE
+// ==============================================================================
+//
+// Clone deep-copies Context and Stack so that an error handed back to user
+// code (e.g. returned from a function, or retained past the point where the
+// original is Release'd back to its pool) is never affected by a later
+// Reset() overwriting the pooled instance's fields out from under it.
+// Cause is shared as-is: errors are conventionally immutable once
+// constructed, so aliasing the Cause interface value carries no such risk.
+
+func cloneContext(ctx map[string]interface{}) map[string]interface{} {
+	if ctx == nil {
+		return nil
+	}
+	clone := make(map[string]interface{}, len(ctx))
+	for k, v := range ctx {
+		clone[k] = v
+	}
+	return clone
+}
+
+func cloneStack(stack []uintptr) []uintptr {
+	if stack == nil {
+		return nil
+	}
+	clone := make([]uintptr, len(stack))
+	copy(clone, stack)
+	return clone
+}
+
+// Clone returns a deep copy of e, safe to retain past a Release of e back
+// to its pool.
+func (e *OperationError) Clone() *OperationError {
+	return &OperationError{
+		Operation: e.Operation,
+		Component: e.Component,
+		Message:   e.Message,
+		Cause:     e.Cause,
+		Context:   cloneContext(e.Context),
+		Stack:     cloneStack(e.Stack),
+	}
+}
+
+// Clone returns a deep copy of e, safe to retain past a Release of e back
+// to its pool.
+func (e *ValidationError) Clone() *ValidationError {
+	return &ValidationError{
+		Field:     e.Field,
+		Value:     e.Value,
+		Rule:      e.Rule,
+		Message:   e.Message,
+		Component: e.Component,
+		Context:   cloneContext(e.Context),
+		Stack:     cloneStack(e.Stack),
+	}
+}
+
+// Clone returns a deep copy of e, safe to retain past a Release of e back
+// to its pool.
+func (e *ConfigurationError) Clone() *ConfigurationError {
+	return &ConfigurationError{
+		Setting:   e.Setting,
+		Value:     e.Value,
+		Message:   e.Message,
+		Component: e.Component,
+		Context:   cloneContext(e.Context),
+		Stack:     cloneStack(e.Stack),
+	}
+}
+
+// Clone returns a deep copy of e, safe to retain past a Release of e back
+// to its pool.
+func (e *ResourceError) Clone() *ResourceError {
+	return &ResourceError{
+		Resource:  e.Resource,
+		Limit:     e.Limit,
+		Current:   e.Current,
+		Message:   e.Message,
+		Component: e.Component,
+		Context:   cloneContext(e.Context),
+		Stack:     cloneStack(e.Stack),
+	}
+}
+
+// Clone returns a deep copy of e, safe to retain past a Release of e back
+// to its pool.
+func (e *RegistryError) Clone() *RegistryError {
+	return &RegistryError{
+		Registry:  e.Registry,
+		Key:       e.Key,
+		Operation: e.Operation,
+		Message:   e.Message,
+		Cause:     e.Cause,
+		Context:   cloneContext(e.Context),
+		Stack:     cloneStack(e.Stack),
+	}
+}
+
+// ==============================================================================
+// ACQUIRE / RELEASE
+// ==============================================================================
+//
+// Acquire/Release are sync.Pool-backed equivalents of Get/Put above, named
+// to match the acquire/release convention callers on a hot path reach for
+// (construct via New, or Acquire to skip an allocation; always Release when
+// done unless the error is being returned to code outside the hot path, in
+// which case Clone it first).
+
+// AcquireOperationError retrieves a reset OperationError from the shared
+// pool instead of allocating one.
+func AcquireOperationError() *OperationError { return errorPool.GetOperationError() }
+
+// ReleaseOperationError returns e to the shared pool. e must not be used
+// afterwards unless it was Clone'd first.
+func ReleaseOperationError(e *OperationError) { errorPool.PutOperationError(e) }
+
+// AcquireValidationError retrieves a reset ValidationError from the shared
+// pool instead of allocating one.
+func AcquireValidationError() *ValidationError { return errorPool.GetValidationError() }
+
+// ReleaseValidationError returns e to the shared pool. e must not be used
+// afterwards unless it was Clone'd first.
+func ReleaseValidationError(e *ValidationError) { errorPool.PutValidationError(e) }
+
+// AcquireConfigurationError retrieves a reset ConfigurationError from the
+// shared pool instead of allocating one.
+func AcquireConfigurationError() *ConfigurationError { return errorPool.GetConfigurationError() }
+
+// ReleaseConfigurationError returns e to the shared pool. e must not be
+// used afterwards unless it was Clone'd first.
+func ReleaseConfigurationError(e *ConfigurationError) { errorPool.PutConfigurationError(e) }
+
+// AcquireResourceError retrieves a reset ResourceError from the shared pool
+// instead of allocating one.
+func AcquireResourceError() *ResourceError { return errorPool.GetResourceError() }
+
+// ReleaseResourceError returns e to the shared pool. e must not be used
+// afterwards unless it was Clone'd first.
+func ReleaseResourceError(e *ResourceError) { errorPool.PutResourceError(e) }
+
+// AcquireRegistryError retrieves a reset RegistryError from the shared pool
+// instead of allocating one.
+func AcquireRegistryError() *RegistryError { return errorPool.GetRegistryError() }
+
+// ReleaseRegistryError returns e to the shared pool. e must not be used
+// afterwards unless it was Clone'd first.
+func ReleaseRegistryError(e *RegistryError) { errorPool.PutRegistryError(e) }
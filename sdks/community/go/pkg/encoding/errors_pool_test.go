@@ -0,0 +1,159 @@
+package encoding
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSetCaptureStack_DisablesCapture(t *testing.T) {
+	defer SetCaptureStack(true)
+
+	SetCaptureStack(false)
+	err := NewOperationError("encode", "json", "boom", nil)
+	if err.Stack != nil {
+		t.Errorf("expected nil stack with capture disabled, got %d frames", len(err.Stack))
+	}
+
+	SetCaptureStack(true)
+	err = NewOperationError("encode", "json", "boom", nil)
+	if len(err.Stack) == 0 {
+		t.Error("expected a captured stack with capture enabled")
+	}
+}
+
+func TestOperationErrorClone_DoesNotAliasContext(t *testing.T) {
+	original := NewOperationError("encode", "json", "boom", nil)
+	original.WithContext("key", "original")
+
+	clone := original.Clone()
+	original.Context["key"] = "mutated"
+
+	if clone.Context["key"] != "original" {
+		t.Errorf("expected clone's context to be unaffected by original mutation, got %v", clone.Context["key"])
+	}
+}
+
+func TestOperationErrorPool_ConcurrentAcquireRelease(t *testing.T) {
+	const goroutines = 50
+	const iterations = 200
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				err := AcquireOperationError()
+				err.Operation = "op"
+				err.WithContext("goroutine", id)
+
+				if got := err.Context["goroutine"]; got != id {
+					t.Errorf("context aliased across recycled instances: expected %d, got %v", id, got)
+				}
+
+				ReleaseOperationError(err)
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func TestValidationErrorPool_ConcurrentAcquireRelease(t *testing.T) {
+	const goroutines = 50
+	const iterations = 200
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				err := AcquireValidationError()
+				err.Field = "field"
+				err.WithContext("goroutine", id)
+
+				if got := err.Context["goroutine"]; got != id {
+					t.Errorf("context aliased across recycled instances: expected %d, got %v", id, got)
+				}
+
+				ReleaseValidationError(err)
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func TestConfigurationErrorPool_ConcurrentAcquireRelease(t *testing.T) {
+	const goroutines = 50
+	const iterations = 200
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				err := AcquireConfigurationError()
+				err.Setting = "setting"
+				err.WithContext("goroutine", id)
+
+				if got := err.Context["goroutine"]; got != id {
+					t.Errorf("context aliased across recycled instances: expected %d, got %v", id, got)
+				}
+
+				ReleaseConfigurationError(err)
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func TestResourceErrorPool_ConcurrentAcquireRelease(t *testing.T) {
+	const goroutines = 50
+	const iterations = 200
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				err := AcquireResourceError()
+				err.Resource = "buffer"
+				err.WithContext("goroutine", id)
+
+				if got := err.Context["goroutine"]; got != id {
+					t.Errorf("context aliased across recycled instances: expected %d, got %v", id, got)
+				}
+
+				ReleaseResourceError(err)
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func TestRegistryErrorPool_ConcurrentAcquireRelease(t *testing.T) {
+	const goroutines = 50
+	const iterations = 200
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				err := AcquireRegistryError()
+				err.Registry = "codecs"
+				err.WithContext("goroutine", id)
+
+				if got := err.Context["goroutine"]; got != id {
+					t.Errorf("context aliased across recycled instances: expected %d, got %v", id, got)
+				}
+
+				ReleaseRegistryError(err)
+			}
+		}(g)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,345 @@
+package encoding
+
+import (
+	"encoding/json"
+	"fmt"
+	"runtime"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ==============================================================================
+// JSON MARSHALING
+// ==============================================================================
+//
+// The structured error types in errors.go are otherwise opaque once they
+// leave this process: Stack is a []uintptr valid only in the process that
+// captured it, and none of the types implement json.Marshaler, so encoding
+// them (e.g. into an SSE error frame or an AG-UI RunErrorEvent.Message)
+// falls back to Go's default struct reflection, which drops Cause entirely
+// (it's an interface) and leaks raw PCs. MarshalJSON below symbolicates
+// Stack via runtime.CallersFrames so a remote caller gets readable frames
+// instead of meaningless numbers; UnmarshalJSON reconstructs everything
+// except Stack, which cannot be turned back into valid PCs once it has
+// crossed a process boundary and is left nil.
+
+// errorCode identifies which structured error type a marshaled payload came
+// from, so a generic decoder (e.g. on the receiving side of an SSE/gRPC
+// connection) can dispatch without type-switching on the Go type.
+type errorCode string
+
+const (
+	errorCodeOperation     errorCode = "OPERATION_ERROR"
+	errorCodeValidation    errorCode = "VALIDATION_ERROR"
+	errorCodeConfiguration errorCode = "CONFIGURATION_ERROR"
+	errorCodeResource      errorCode = "RESOURCE_ERROR"
+	errorCodeRegistry      errorCode = "REGISTRY_ERROR"
+)
+
+// symbolicateStack resolves stack into human-readable "function (file:line)"
+// frames via runtime.CallersFrames, for embedding in a MarshalJSON payload
+// a remote caller can actually read.
+func symbolicateStack(stack []uintptr) []string {
+	if len(stack) == 0 {
+		return nil
+	}
+
+	frames := runtime.CallersFrames(stack)
+	var out []string
+	for {
+		frame, more := frames.Next()
+		out = append(out, fmt.Sprintf("%s (%s:%d)", frame.Function, frame.File, frame.Line))
+		if !more {
+			break
+		}
+	}
+	return out
+}
+
+type operationErrorJSON struct {
+	Code      errorCode              `json:"code"`
+	Component string                 `json:"component"`
+	Operation string                 `json:"operation"`
+	Message   string                 `json:"message"`
+	Cause     string                 `json:"cause,omitempty"`
+	Context   map[string]interface{} `json:"context,omitempty"`
+	Stack     []string               `json:"stack,omitempty"`
+}
+
+// MarshalJSON encodes e for transport across a process boundary, with Stack
+// symbolicated into readable frames.
+func (e *OperationError) MarshalJSON() ([]byte, error) {
+	wire := operationErrorJSON{
+		Code:      errorCodeOperation,
+		Component: e.Component,
+		Operation: e.Operation,
+		Message:   e.Message,
+		Context:   e.Context,
+		Stack:     symbolicateStack(e.Stack),
+	}
+	if e.Cause != nil {
+		wire.Cause = e.Cause.Error()
+	}
+	return json.Marshal(wire)
+}
+
+// UnmarshalJSON reconstructs e from a payload produced by MarshalJSON. Cause
+// is restored as a plain error carrying the original message; Stack is left
+// nil, since the symbolicated frames a remote peer sent cannot be turned
+// back into this process's program counters.
+func (e *OperationError) UnmarshalJSON(data []byte) error {
+	var wire operationErrorJSON
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	e.Component = wire.Component
+	e.Operation = wire.Operation
+	e.Message = wire.Message
+	e.Context = wire.Context
+	e.Stack = nil
+	if wire.Cause != "" {
+		e.Cause = fmt.Errorf("%s", wire.Cause)
+	}
+	return nil
+}
+
+// GRPCStatus maps e onto a gRPC status with code Internal, since an
+// OperationError represents an unexpected encode/decode failure rather than
+// a caller mistake. Context is attached as an errdetails.ErrorInfo so a
+// gRPC-aware client can recover it structurally instead of parsing Error().
+func (e *OperationError) GRPCStatus() *status.Status {
+	return withErrorInfo(status.New(codes.Internal, e.Error()), string(errorCodeOperation), e.Context)
+}
+
+type validationErrorJSON struct {
+	Code      errorCode              `json:"code"`
+	Component string                 `json:"component"`
+	Field     string                 `json:"field,omitempty"`
+	Value     interface{}            `json:"value,omitempty"`
+	Rule      string                 `json:"rule,omitempty"`
+	Message   string                 `json:"message"`
+	Context   map[string]interface{} `json:"context,omitempty"`
+	Stack     []string               `json:"stack,omitempty"`
+}
+
+// MarshalJSON encodes e for transport across a process boundary, with Stack
+// symbolicated into readable frames.
+func (e *ValidationError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(validationErrorJSON{
+		Code:      errorCodeValidation,
+		Component: e.Component,
+		Field:     e.Field,
+		Value:     e.Value,
+		Rule:      e.Rule,
+		Message:   e.Message,
+		Context:   e.Context,
+		Stack:     symbolicateStack(e.Stack),
+	})
+}
+
+// UnmarshalJSON reconstructs e from a payload produced by MarshalJSON; see
+// OperationError.UnmarshalJSON for why Stack is left nil.
+func (e *ValidationError) UnmarshalJSON(data []byte) error {
+	var wire validationErrorJSON
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	e.Component = wire.Component
+	e.Field = wire.Field
+	e.Value = wire.Value
+	e.Rule = wire.Rule
+	e.Message = wire.Message
+	e.Context = wire.Context
+	e.Stack = nil
+	return nil
+}
+
+// GRPCStatus maps e onto a gRPC status with code InvalidArgument, since a
+// ValidationError means the caller supplied bad input.
+func (e *ValidationError) GRPCStatus() *status.Status {
+	return withErrorInfo(status.New(codes.InvalidArgument, e.Error()), string(errorCodeValidation), e.Context)
+}
+
+type configurationErrorJSON struct {
+	Code      errorCode              `json:"code"`
+	Component string                 `json:"component"`
+	Setting   string                 `json:"setting,omitempty"`
+	Value     interface{}            `json:"value,omitempty"`
+	Message   string                 `json:"message"`
+	Context   map[string]interface{} `json:"context,omitempty"`
+	Stack     []string               `json:"stack,omitempty"`
+}
+
+// MarshalJSON encodes e for transport across a process boundary, with Stack
+// symbolicated into readable frames.
+func (e *ConfigurationError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(configurationErrorJSON{
+		Code:      errorCodeConfiguration,
+		Component: e.Component,
+		Setting:   e.Setting,
+		Value:     e.Value,
+		Message:   e.Message,
+		Context:   e.Context,
+		Stack:     symbolicateStack(e.Stack),
+	})
+}
+
+// UnmarshalJSON reconstructs e from a payload produced by MarshalJSON; see
+// OperationError.UnmarshalJSON for why Stack is left nil.
+func (e *ConfigurationError) UnmarshalJSON(data []byte) error {
+	var wire configurationErrorJSON
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	e.Component = wire.Component
+	e.Setting = wire.Setting
+	e.Value = wire.Value
+	e.Message = wire.Message
+	e.Context = wire.Context
+	e.Stack = nil
+	return nil
+}
+
+// GRPCStatus maps e onto a gRPC status with code FailedPrecondition, since a
+// ConfigurationError means the component cannot run in its current state.
+func (e *ConfigurationError) GRPCStatus() *status.Status {
+	return withErrorInfo(status.New(codes.FailedPrecondition, e.Error()), string(errorCodeConfiguration), e.Context)
+}
+
+type resourceErrorJSON struct {
+	Code      errorCode              `json:"code"`
+	Component string                 `json:"component"`
+	Resource  string                 `json:"resource,omitempty"`
+	Limit     interface{}            `json:"limit,omitempty"`
+	Current   interface{}            `json:"current,omitempty"`
+	Message   string                 `json:"message"`
+	Context   map[string]interface{} `json:"context,omitempty"`
+	Stack     []string               `json:"stack,omitempty"`
+}
+
+// MarshalJSON encodes e for transport across a process boundary, with Stack
+// symbolicated into readable frames.
+func (e *ResourceError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(resourceErrorJSON{
+		Code:      errorCodeResource,
+		Component: e.Component,
+		Resource:  e.Resource,
+		Limit:     e.Limit,
+		Current:   e.Current,
+		Message:   e.Message,
+		Context:   e.Context,
+		Stack:     symbolicateStack(e.Stack),
+	})
+}
+
+// UnmarshalJSON reconstructs e from a payload produced by MarshalJSON; see
+// OperationError.UnmarshalJSON for why Stack is left nil.
+func (e *ResourceError) UnmarshalJSON(data []byte) error {
+	var wire resourceErrorJSON
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	e.Component = wire.Component
+	e.Resource = wire.Resource
+	e.Limit = wire.Limit
+	e.Current = wire.Current
+	e.Message = wire.Message
+	e.Context = wire.Context
+	e.Stack = nil
+	return nil
+}
+
+// GRPCStatus maps e onto a gRPC status with code ResourceExhausted, since a
+// ResourceError means some limit (buffer, memory, connection count) was hit.
+func (e *ResourceError) GRPCStatus() *status.Status {
+	return withErrorInfo(status.New(codes.ResourceExhausted, e.Error()), string(errorCodeResource), e.Context)
+}
+
+type registryErrorJSON struct {
+	Code      errorCode              `json:"code"`
+	Registry  string                 `json:"component"`
+	Key       string                 `json:"key,omitempty"`
+	Operation string                 `json:"operation"`
+	Message   string                 `json:"message"`
+	Cause     string                 `json:"cause,omitempty"`
+	Context   map[string]interface{} `json:"context,omitempty"`
+	Stack     []string               `json:"stack,omitempty"`
+}
+
+// MarshalJSON encodes e for transport across a process boundary, with Stack
+// symbolicated into readable frames. Registry is reported as "component" to
+// line up with the other four error types' shape.
+func (e *RegistryError) MarshalJSON() ([]byte, error) {
+	wire := registryErrorJSON{
+		Code:      errorCodeRegistry,
+		Registry:  e.Registry,
+		Key:       e.Key,
+		Operation: e.Operation,
+		Message:   e.Message,
+		Context:   e.Context,
+		Stack:     symbolicateStack(e.Stack),
+	}
+	if e.Cause != nil {
+		wire.Cause = e.Cause.Error()
+	}
+	return json.Marshal(wire)
+}
+
+// UnmarshalJSON reconstructs e from a payload produced by MarshalJSON; see
+// OperationError.UnmarshalJSON for why Stack is left nil.
+func (e *RegistryError) UnmarshalJSON(data []byte) error {
+	var wire registryErrorJSON
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	e.Registry = wire.Registry
+	e.Key = wire.Key
+	e.Operation = wire.Operation
+	e.Message = wire.Message
+	e.Context = wire.Context
+	e.Stack = nil
+	if wire.Cause != "" {
+		e.Cause = fmt.Errorf("%s", wire.Cause)
+	}
+	return nil
+}
+
+// GRPCStatus maps e onto a gRPC status with code NotFound or AlreadyExists
+// depending on Operation: a failed "register" means the key was already
+// taken, while any other operation (lookup, unregister, ...) failing means
+// the key was missing.
+func (e *RegistryError) GRPCStatus() *status.Status {
+	code := codes.NotFound
+	if e.Operation == "register" {
+		code = codes.AlreadyExists
+	}
+	return withErrorInfo(status.New(code, e.Error()), string(errorCodeRegistry), e.Context)
+}
+
+// withErrorInfo attaches ctx to st as an errdetails.ErrorInfo so a gRPC
+// client can recover the structured context instead of re-parsing the
+// status message. Values are stringified with fmt.Sprintf since
+// ErrorInfo.Metadata is map[string]string; if attaching details fails, st
+// is returned unchanged rather than dropping the status entirely.
+func withErrorInfo(st *status.Status, reason string, ctx map[string]interface{}) *status.Status {
+	if len(ctx) == 0 {
+		return st
+	}
+
+	metadata := make(map[string]string, len(ctx))
+	for k, v := range ctx {
+		metadata[k] = fmt.Sprintf("%v", v)
+	}
+
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   "ag-ui",
+		Metadata: metadata,
+	})
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
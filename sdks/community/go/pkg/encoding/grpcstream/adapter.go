@@ -0,0 +1,93 @@
+package grpcstream
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+)
+
+// grpcStream is the subset of grpc.ServerStream and grpc.ClientStream that
+// GRPCStreamAdapter needs to move Event messages across the wire. Depending
+// on this narrower interface instead of either concrete type lets one
+// adapter wrap either side of the EventStream RPC.
+type grpcStream interface {
+	SendMsg(m interface{}) error
+	RecvMsg(m interface{}) error
+}
+
+var (
+	_ grpcStream = grpc.ServerStream(nil)
+	_ grpcStream = grpc.ClientStream(nil)
+)
+
+// GRPCStreamAdapter adapts one side of the bidirectional EventStream RPC
+// (the grpc.ServerStream handed to a service implementation, or the
+// grpc.ClientStream returned by an EventStream client) to the
+// encoding.StreamSessionManager and encoding.StreamEventProcessor
+// interfaces, so code written against those interfaces can use gRPC
+// transport interchangeably with HTTP/SSE.
+type GRPCStreamAdapter struct {
+	stream grpcStream
+}
+
+// NewGRPCStreamAdapter wraps an already-established gRPC stream.
+func NewGRPCStreamAdapter(stream grpcStream) *GRPCStreamAdapter {
+	return &GRPCStreamAdapter{stream: stream}
+}
+
+var (
+	_ encoding.StreamSessionManager = (*GRPCStreamAdapter)(nil)
+	_ encoding.StreamEventProcessor = (*GRPCStreamAdapter)(nil)
+)
+
+// StartEncodingSession begins an encoding session. The gRPC stream is
+// already open by the time the adapter is constructed, so w is accepted
+// only to satisfy encoding.StreamSessionManager and is otherwise unused.
+func (a *GRPCStreamAdapter) StartEncodingSession(ctx context.Context, w io.Writer) error {
+	if a.stream == nil {
+		return fmt.Errorf("grpcstream: adapter has no underlying stream")
+	}
+	return nil
+}
+
+// StartDecodingSession begins a decoding session; r is accepted only to
+// satisfy encoding.StreamSessionManager and is otherwise unused, for the
+// same reason as StartEncodingSession.
+func (a *GRPCStreamAdapter) StartDecodingSession(ctx context.Context, r io.Reader) error {
+	if a.stream == nil {
+		return fmt.Errorf("grpcstream: adapter has no underlying stream")
+	}
+	return nil
+}
+
+// EndSession finalizes the session. Closing the underlying gRPC stream is
+// the caller's responsibility (via grpc.ClientConn.Close, returning from the
+// service handler, etc.), so this is a no-op.
+func (a *GRPCStreamAdapter) EndSession(ctx context.Context) error {
+	return nil
+}
+
+// WriteEvent converts event to its wire representation and sends it on the
+// gRPC stream.
+func (a *GRPCStreamAdapter) WriteEvent(ctx context.Context, event events.Event) error {
+	msg, err := ToProto(event)
+	if err != nil {
+		return err
+	}
+	return a.stream.SendMsg(msg)
+}
+
+// ReadEvent receives the next wire Event from the gRPC stream and converts
+// it back into an events.Event.
+func (a *GRPCStreamAdapter) ReadEvent(ctx context.Context) (events.Event, error) {
+	msg := &Event{}
+	if err := a.stream.RecvMsg(msg); err != nil {
+		return nil, err
+	}
+	return FromProto(msg)
+}
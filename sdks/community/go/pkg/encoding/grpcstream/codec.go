@@ -0,0 +1,223 @@
+// Package grpcstream encodes and decodes AG-UI events for transport over a
+// gRPC bidirectional stream, as defined by eventstream.proto in this
+// package, so a gRPC transport can be used interchangeably with HTTP/SSE
+// through the encoding.StreamCodec abstraction.
+package grpcstream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+)
+
+// ContentType is the MIME type Codec reports, identifying the gRPC+proto
+// content type family.
+const ContentType = "application/grpc+proto"
+
+// Codec implements encoding.Codec for AG-UI events carried as the wire
+// Event message defined in eventstream.proto. Its non-streaming Encode/
+// Decode methods exist to satisfy encoding.Codec (required by
+// encoding.FullStreamCodec) but are not how events cross a real gRPC
+// stream: gRPC marshals *Event values itself via SendMsg/RecvMsg, so the
+// actual transport path is EncodeStream/DecodeStream below. Encode/Decode
+// instead JSON-marshal the wire struct, standing in for the real protobuf
+// wire format until protoc-gen-go can generate Marshal/Unmarshal for
+// eventstream.proto.
+type Codec struct{}
+
+// NewCodec creates a gRPC stream Codec.
+func NewCodec() *Codec {
+	return &Codec{}
+}
+
+var (
+	_ encoding.Encoder                     = (*Codec)(nil)
+	_ encoding.Decoder                     = (*Codec)(nil)
+	_ encoding.ContentTypeProvider         = (*Codec)(nil)
+	_ encoding.StreamingCapabilityProvider = (*Codec)(nil)
+	_ encoding.Codec                       = (*Codec)(nil)
+)
+
+// ContentType returns the gRPC+proto content type.
+func (c *Codec) ContentType() string { return ContentType }
+
+// SupportsStreaming reports that gRPC bidi streaming is available via the
+// stream methods below.
+func (c *Codec) SupportsStreaming() bool { return true }
+
+// Encode converts event to its wire Event message and JSON-marshals it.
+func (c *Codec) Encode(ctx context.Context, event events.Event) ([]byte, error) {
+	msg, err := ToProto(event)
+	if err != nil {
+		return nil, &encoding.EncodingError{
+			Format:  "grpcstream",
+			Event:   event,
+			Message: "failed to convert event to wire message",
+			Cause:   err,
+		}
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return nil, &encoding.EncodingError{
+			Format:  "grpcstream",
+			Event:   event,
+			Message: "failed to marshal wire message",
+			Cause:   err,
+		}
+	}
+
+	return data, nil
+}
+
+// EncodeMultiple encodes events as a JSON array of wire Event messages.
+func (c *Codec) EncodeMultiple(ctx context.Context, evts []events.Event) ([]byte, error) {
+	msgs := make([]*Event, 0, len(evts))
+	for _, event := range evts {
+		msg, err := ToProto(event)
+		if err != nil {
+			return nil, &encoding.EncodingError{
+				Format:  "grpcstream",
+				Event:   event,
+				Message: "failed to convert event to wire message",
+				Cause:   err,
+			}
+		}
+		msgs = append(msgs, msg)
+	}
+
+	data, err := json.Marshal(msgs)
+	if err != nil {
+		return nil, &encoding.EncodingError{
+			Format:  "grpcstream",
+			Message: "failed to marshal wire message batch",
+			Cause:   err,
+		}
+	}
+
+	return data, nil
+}
+
+// Decode unmarshals a wire Event message and converts it back to an
+// events.Event.
+func (c *Codec) Decode(ctx context.Context, data []byte) (events.Event, error) {
+	if len(data) == 0 {
+		return nil, &encoding.DecodingError{
+			Format:  "grpcstream",
+			Message: "empty data",
+		}
+	}
+
+	var msg Event
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, &encoding.DecodingError{
+			Format:  "grpcstream",
+			Data:    data,
+			Message: "failed to unmarshal wire message",
+			Cause:   err,
+		}
+	}
+
+	event, err := FromProto(&msg)
+	if err != nil {
+		return nil, &encoding.DecodingError{
+			Format:  "grpcstream",
+			Data:    data,
+			Message: "failed to convert wire message to event",
+			Cause:   err,
+		}
+	}
+
+	return event, nil
+}
+
+// DecodeMultiple unmarshals a JSON array of wire Event messages.
+func (c *Codec) DecodeMultiple(ctx context.Context, data []byte) ([]events.Event, error) {
+	if len(data) == 0 {
+		return nil, &encoding.DecodingError{
+			Format:  "grpcstream",
+			Message: "empty data",
+		}
+	}
+
+	var msgs []*Event
+	if err := json.Unmarshal(data, &msgs); err != nil {
+		return nil, &encoding.DecodingError{
+			Format:  "grpcstream",
+			Data:    data,
+			Message: "failed to unmarshal wire message batch",
+			Cause:   err,
+		}
+	}
+
+	result := make([]events.Event, 0, len(msgs))
+	for i, msg := range msgs {
+		event, err := FromProto(msg)
+		if err != nil {
+			return nil, fmt.Errorf("message %d: %w", i, err)
+		}
+		result = append(result, event)
+	}
+
+	return result, nil
+}
+
+// StreamCodec plays the FullStreamCodec role for gRPC transport, but its
+// EncodeStream/DecodeStream bind to a GRPCStreamAdapter instead of an
+// io.Writer/io.Reader as encoding.StreamCodec's do, since events cross a
+// gRPC stream as SendMsg/RecvMsg calls rather than bytes on a pipe.
+// EncodeStream drains a channel of events onto the adapter's WriteEvent,
+// and DecodeStream pumps the adapter's ReadEvent results onto a channel of
+// events. The non-streaming Codec is embedded for the basic encode/decode
+// operations.
+type StreamCodec struct {
+	*Codec
+}
+
+// NewStreamCodec creates a gRPC StreamCodec.
+func NewStreamCodec() *StreamCodec {
+	return &StreamCodec{Codec: NewCodec()}
+}
+
+// EncodeStream sends each event from input over stream until input closes
+// or ctx is cancelled.
+func (s *StreamCodec) EncodeStream(ctx context.Context, input <-chan events.Event, stream *GRPCStreamAdapter) error {
+	for {
+		select {
+		case event, ok := <-input:
+			if !ok {
+				return stream.EndSession(ctx)
+			}
+			if err := stream.WriteEvent(ctx, event); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// DecodeStream receives events from stream and sends each onto output until
+// the stream is exhausted (io.EOF from RecvMsg) or ctx is cancelled. It does
+// not close output; the caller owns that channel.
+func (s *StreamCodec) DecodeStream(ctx context.Context, stream *GRPCStreamAdapter, output chan<- events.Event) error {
+	for {
+		event, err := stream.ReadEvent(ctx)
+		if err != nil {
+			if err == io.EOF {
+				return stream.EndSession(ctx)
+			}
+			return err
+		}
+
+		select {
+		case output <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package grpcstream
+
+import (
+	"fmt"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// ToProto converts an events.Event into the wire Event message defined in
+// eventstream.proto, populating the matching field of the Payload oneof.
+// It returns an error for event types the EventStream service does not
+// carry.
+func ToProto(event events.Event) (*Event, error) {
+	if event == nil {
+		return nil, fmt.Errorf("grpcstream: cannot convert nil event")
+	}
+
+	var ts int64
+	if t := event.Timestamp(); t != nil {
+		ts = *t
+	}
+
+	switch e := event.(type) {
+	case *events.RunStartedEvent:
+		return &Event{Payload: &RunStartedEvent{
+			TimestampMs: ts,
+			ThreadID:    e.ThreadID(),
+			RunID:       e.RunID(),
+		}}, nil
+	case *events.RunFinishedEvent:
+		return &Event{Payload: &RunFinishedEvent{
+			TimestampMs: ts,
+			ThreadID:    e.ThreadID(),
+			RunID:       e.RunID(),
+			Result:      e.Result,
+		}}, nil
+	case *events.RunErrorEvent:
+		var code string
+		if e.Code != nil {
+			code = *e.Code
+		}
+		return &Event{Payload: &RunErrorEvent{
+			TimestampMs: ts,
+			Message:     e.Message,
+			Code:        code,
+		}}, nil
+	case *events.StepStartedEvent:
+		return &Event{Payload: &StepStartedEvent{
+			TimestampMs: ts,
+			StepName:    e.StepName,
+		}}, nil
+	case *events.StepFinishedEvent:
+		return &Event{Payload: &StepFinishedEvent{
+			TimestampMs: ts,
+			StepName:    e.StepName,
+		}}, nil
+	case *events.TextMessageStartEvent:
+		var role string
+		if e.Role != nil {
+			role = *e.Role
+		}
+		return &Event{Payload: &TextMessageStartEvent{
+			TimestampMs: ts,
+			MessageID:   e.MessageID,
+			Role:        role,
+		}}, nil
+	case *events.TextMessageContentEvent:
+		return &Event{Payload: &TextMessageContentEvent{
+			TimestampMs: ts,
+			MessageID:   e.MessageID,
+			Delta:       e.Delta,
+		}}, nil
+	case *events.TextMessageEndEvent:
+		return &Event{Payload: &TextMessageEndEvent{
+			TimestampMs: ts,
+			MessageID:   e.MessageID,
+		}}, nil
+	case *events.ToolCallStartEvent:
+		return &Event{Payload: &ToolCallStartEvent{
+			TimestampMs:  ts,
+			ToolCallID:   e.ToolCallID,
+			ToolCallName: e.ToolCallName,
+		}}, nil
+	case *events.ToolCallArgsEvent:
+		return &Event{Payload: &ToolCallArgsEvent{
+			TimestampMs: ts,
+			ToolCallID:  e.ToolCallID,
+			Delta:       e.Delta,
+		}}, nil
+	case *events.ToolCallEndEvent:
+		return &Event{Payload: &ToolCallEndEvent{
+			TimestampMs: ts,
+			ToolCallID:  e.ToolCallID,
+		}}, nil
+	case *events.StateSnapshotEvent:
+		return &Event{Payload: &StateSnapshotEvent{
+			TimestampMs: ts,
+			Snapshot:    e.Snapshot,
+		}}, nil
+	case *events.StateDeltaEvent:
+		delta := make([]interface{}, len(e.Delta))
+		for i, op := range e.Delta {
+			delta[i] = op
+		}
+		return &Event{Payload: &StateDeltaEvent{
+			TimestampMs: ts,
+			Delta:       delta,
+		}}, nil
+	case *events.ThinkingStartEvent:
+		var title string
+		if e.Title != nil {
+			title = *e.Title
+		}
+		return &Event{Payload: &ThinkingStartEvent{
+			TimestampMs: ts,
+			Title:       title,
+		}}, nil
+	case *events.ThinkingEndEvent:
+		return &Event{Payload: &ThinkingEndEvent{
+			TimestampMs: ts,
+		}}, nil
+	case *events.ThinkingTextMessageStartEvent:
+		return &Event{Payload: &ThinkingTextMessageStartEvent{
+			TimestampMs: ts,
+			StepID:      e.StepID,
+		}}, nil
+	case *events.ThinkingTextMessageContentEvent:
+		return &Event{Payload: &ThinkingTextMessageContentEvent{
+			TimestampMs: ts,
+			Delta:       e.Delta,
+			StepID:      e.StepID,
+		}}, nil
+	case *events.ThinkingTextMessageEndEvent:
+		return &Event{Payload: &ThinkingTextMessageEndEvent{
+			TimestampMs: ts,
+			StepID:      e.StepID,
+		}}, nil
+	default:
+		return nil, fmt.Errorf("grpcstream: unsupported event type %T", event)
+	}
+}
+
+// FromProto converts a wire Event message back into the concrete
+// events.Event it was built from. It returns an error if Payload is nil or
+// holds a type the EventStream service does not carry.
+func FromProto(msg *Event) (events.Event, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("grpcstream: cannot convert nil message")
+	}
+
+	switch p := msg.Payload.(type) {
+	case *RunStartedEvent:
+		event := events.NewRunStartedEvent(p.ThreadID, p.RunID)
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case *RunFinishedEvent:
+		event := events.NewRunFinishedEventWithOptions(p.ThreadID, p.RunID, events.WithResult(p.Result))
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case *RunErrorEvent:
+		opts := []events.RunErrorOption{}
+		if p.Code != "" {
+			opts = append(opts, events.WithErrorCode(p.Code))
+		}
+		event := events.NewRunErrorEvent(p.Message, opts...)
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case *StepStartedEvent:
+		event := events.NewStepStartedEvent(p.StepName)
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case *StepFinishedEvent:
+		event := events.NewStepFinishedEvent(p.StepName)
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case *TextMessageStartEvent:
+		event := events.NewTextMessageStartEvent(p.MessageID, events.WithRole(p.Role))
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case *TextMessageContentEvent:
+		event := events.NewTextMessageContentEvent(p.MessageID, p.Delta)
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case *TextMessageEndEvent:
+		event := events.NewTextMessageEndEvent(p.MessageID)
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case *ToolCallStartEvent:
+		event := events.NewToolCallStartEvent(p.ToolCallID, p.ToolCallName)
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case *ToolCallArgsEvent:
+		event := events.NewToolCallArgsEvent(p.ToolCallID, p.Delta)
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case *ToolCallEndEvent:
+		event := events.NewToolCallEndEvent(p.ToolCallID)
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case *StateSnapshotEvent:
+		event := events.NewStateSnapshotEvent(p.Snapshot)
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case *StateDeltaEvent:
+		ops := make([]events.JSONPatchOperation, 0, len(p.Delta))
+		for _, v := range p.Delta {
+			op, ok := v.(events.JSONPatchOperation)
+			if !ok {
+				return nil, fmt.Errorf("grpcstream: state delta entry is not a JSONPatchOperation: %T", v)
+			}
+			ops = append(ops, op)
+		}
+		event := events.NewStateDeltaEvent(ops)
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case *ThinkingStartEvent:
+		event := events.NewThinkingStartEvent()
+		if p.Title != "" {
+			event.WithTitle(p.Title)
+		}
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case *ThinkingEndEvent:
+		event := events.NewThinkingEndEvent()
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case *ThinkingTextMessageStartEvent:
+		event := events.NewThinkingTextMessageStartEvent()
+		if p.StepID != "" {
+			event.WithStepID(p.StepID)
+		}
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case *ThinkingTextMessageContentEvent:
+		event := events.NewThinkingTextMessageContentEvent(p.Delta)
+		if p.StepID != "" {
+			event.WithStepID(p.StepID)
+		}
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case *ThinkingTextMessageEndEvent:
+		event := events.NewThinkingTextMessageEndEvent()
+		if p.StepID != "" {
+			event.WithStepID(p.StepID)
+		}
+		event.SetTimestamp(p.TimestampMs)
+		return event, nil
+	case nil:
+		return nil, fmt.Errorf("grpcstream: Event.Payload is unset")
+	default:
+		return nil, fmt.Errorf("grpcstream: unsupported payload type %T", p)
+	}
+}
@@ -0,0 +1,65 @@
+package grpcstream
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+)
+
+// Factory creates gRPC codecs for the application/grpc content type family
+// (application/grpc, application/grpc+proto), implementing
+// encoding.FullCodecFactory.
+type Factory struct{}
+
+// NewFactory creates a gRPC codec Factory.
+func NewFactory() *Factory { return &Factory{} }
+
+var _ encoding.FullCodecFactory = (*Factory)(nil)
+
+// CreateCodec creates a Codec for contentType. decOptions is ignored since
+// the wire Event message has no configurable framing; encOptions is
+// consulted only for Compression, which wraps the returned codec in an
+// encoding.CompressingCodec.
+func (f *Factory) CreateCodec(ctx context.Context, contentType string, encOptions *encoding.EncodingOptions, decOptions *encoding.DecodingOptions) (encoding.Codec, error) {
+	if !f.CanHandle(contentType) {
+		return nil, fmt.Errorf("grpcstream: unsupported content type %q", contentType)
+	}
+
+	codec := encoding.Codec(NewCodec())
+	if encOptions != nil && encOptions.Compression != "" {
+		compressed, err := encoding.NewCompressingCodec(codec, encOptions)
+		if err != nil {
+			return nil, err
+		}
+		codec = compressed
+	}
+	return codec, nil
+}
+
+// CreateStreamCodec creates a StreamCodec for contentType. The returned
+// value satisfies encoding.Codec but, as documented on StreamCodec, its
+// streaming methods bind to a GRPCStreamAdapter rather than
+// encoding.StreamCodec's io.Writer/io.Reader, so callers that need the
+// gRPC-native streaming methods should use NewStreamCodec directly instead
+// of going through this factory method.
+func (f *Factory) CreateStreamCodec(ctx context.Context, contentType string, encOptions *encoding.EncodingOptions, decOptions *encoding.DecodingOptions) (encoding.StreamCodec, error) {
+	return nil, fmt.Errorf("grpcstream: %q streams bind to a gRPC stream, not an io.Writer/io.Reader; use grpcstream.NewStreamCodec directly", contentType)
+}
+
+// SupportsStreaming reports whether contentType supports gRPC streaming.
+func (f *Factory) SupportsStreaming(contentType string) bool {
+	return f.CanHandle(contentType)
+}
+
+// SupportedTypes returns the content types this factory handles.
+func (f *Factory) SupportedTypes() []string {
+	return []string{ContentType, "application/grpc"}
+}
+
+// CanHandle reports whether contentType belongs to the application/grpc
+// content type family.
+func (f *Factory) CanHandle(contentType string) bool {
+	return strings.HasPrefix(contentType, "application/grpc")
+}
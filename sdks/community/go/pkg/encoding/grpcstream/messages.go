@@ -0,0 +1,139 @@
+// Package grpcstream encodes and decodes AG-UI events for transport over a
+// gRPC bidirectional stream, as defined by eventstream.proto in this
+// package.
+//
+// This sandbox has no protoc/protoc-gen-go toolchain available, so the
+// message types below are hand-written mirrors of eventstream.proto rather
+// than generated code. They use a plain interface{} for the Event oneof
+// instead of the wrapper types protoc-gen-go would emit, to keep the
+// stand-in small. Once a real build pipeline can run protoc, this file
+// should be replaced by the generated aguigrpcpb package and the rest of
+// this package updated to use it; the conversion helpers in convert.go are
+// written against plain Go structs/fields so that swap should not ripple
+// further than this file.
+package grpcstream
+
+// Event mirrors the Event message in eventstream.proto. Payload holds
+// exactly one of the *Event structs below.
+type Event struct {
+	Payload interface{}
+}
+
+// RunStartedEvent mirrors the RunStartedEvent proto message.
+type RunStartedEvent struct {
+	TimestampMs int64
+	ThreadID    string
+	RunID       string
+}
+
+// RunFinishedEvent mirrors the RunFinishedEvent proto message.
+type RunFinishedEvent struct {
+	TimestampMs int64
+	ThreadID    string
+	RunID       string
+	Result      interface{}
+}
+
+// RunErrorEvent mirrors the RunErrorEvent proto message.
+type RunErrorEvent struct {
+	TimestampMs int64
+	Message     string
+	Code        string
+}
+
+// StepStartedEvent mirrors the StepStartedEvent proto message.
+type StepStartedEvent struct {
+	TimestampMs int64
+	StepName    string
+}
+
+// StepFinishedEvent mirrors the StepFinishedEvent proto message.
+type StepFinishedEvent struct {
+	TimestampMs int64
+	StepName    string
+}
+
+// TextMessageStartEvent mirrors the TextMessageStartEvent proto message.
+type TextMessageStartEvent struct {
+	TimestampMs int64
+	MessageID   string
+	Role        string
+}
+
+// TextMessageContentEvent mirrors the TextMessageContentEvent proto message.
+type TextMessageContentEvent struct {
+	TimestampMs int64
+	MessageID   string
+	Delta       string
+}
+
+// TextMessageEndEvent mirrors the TextMessageEndEvent proto message.
+type TextMessageEndEvent struct {
+	TimestampMs int64
+	MessageID   string
+}
+
+// ToolCallStartEvent mirrors the ToolCallStartEvent proto message.
+type ToolCallStartEvent struct {
+	TimestampMs  int64
+	ToolCallID   string
+	ToolCallName string
+}
+
+// ToolCallArgsEvent mirrors the ToolCallArgsEvent proto message.
+type ToolCallArgsEvent struct {
+	TimestampMs int64
+	ToolCallID  string
+	Delta       string
+}
+
+// ToolCallEndEvent mirrors the ToolCallEndEvent proto message.
+type ToolCallEndEvent struct {
+	TimestampMs int64
+	ToolCallID  string
+}
+
+// StateSnapshotEvent mirrors the StateSnapshotEvent proto message.
+type StateSnapshotEvent struct {
+	TimestampMs int64
+	Snapshot    interface{}
+}
+
+// StateDeltaEvent mirrors the StateDeltaEvent proto message.
+type StateDeltaEvent struct {
+	TimestampMs int64
+	Delta       []interface{}
+}
+
+// ThinkingStartEvent mirrors the ThinkingStartEvent proto message.
+type ThinkingStartEvent struct {
+	TimestampMs int64
+	Title       string
+}
+
+// ThinkingEndEvent mirrors the ThinkingEndEvent proto message.
+type ThinkingEndEvent struct {
+	TimestampMs int64
+}
+
+// ThinkingTextMessageStartEvent mirrors the ThinkingTextMessageStartEvent
+// proto message.
+type ThinkingTextMessageStartEvent struct {
+	TimestampMs int64
+	StepID      string
+}
+
+// ThinkingTextMessageContentEvent mirrors the
+// ThinkingTextMessageContentEvent proto message.
+type ThinkingTextMessageContentEvent struct {
+	TimestampMs int64
+	Delta       string
+	StepID      string
+}
+
+// ThinkingTextMessageEndEvent mirrors the ThinkingTextMessageEndEvent proto
+// message.
+type ThinkingTextMessageEndEvent struct {
+	TimestampMs int64
+	StepID      string
+}
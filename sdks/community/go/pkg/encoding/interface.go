@@ -203,6 +203,26 @@ type EncodingOptions struct {
 
 	// CrossSDKCompatibility ensures compatibility with other SDKs
 	CrossSDKCompatibility bool
+
+	// JSONBackend selects the JSON implementation json.JSONEncoder marshals
+	// with, by name (e.g. "sonic", "goccy"). Empty uses the stdlib
+	// encoding/json. Unregistered names (e.g. a backend whose build tag
+	// wasn't compiled in) fall back to the stdlib implementation rather
+	// than erroring.
+	JSONBackend string
+
+	// SafeCollections, when enabled, makes json.JSONEncoder marshal nil
+	// slice fields as "[]" and nil map fields as "{}" instead of "null",
+	// matching the MarshalSafeCollections convention TypeScript/JS AG-UI
+	// consumers expect (they treat null collections as errors). Off by
+	// default since it costs a reflective pre-encode pass per event.
+	SafeCollections bool
+
+	// CloudEventsSource, when non-empty, makes json.JSONEncoder wrap its
+	// output in a CloudEvents v1.0 structured-mode envelope (see
+	// json.CloudEventsEnvelope) stamped with this value as the "source"
+	// attribute, instead of emitting the native ag-ui event shape.
+	CloudEventsSource string
 }
 
 // Validate validates the encoding options
@@ -255,6 +275,14 @@ type DecodingOptions struct {
 
 	// ValidateEvents enables event validation after decoding
 	ValidateEvents bool
+
+	// AccumulateTypeErrors makes json.JSONDecoder best-effort: a field
+	// whose JSON value doesn't match its Go type is left at its zero value
+	// instead of aborting the whole decode, and every such mismatch is
+	// collected into an *errors.MultiTypeError returned alongside the
+	// otherwise-successfully-decoded event. Off by default since it costs
+	// a reflection-based decode path instead of encoding/json's.
+	AccumulateTypeErrors bool
 }
 
 // Validate validates the decoding options
@@ -301,13 +329,21 @@ type DecodingError struct {
 	Data    []byte
 	Message string
 	Cause   error
+	// Path is the JSON path of the field that failed validation, e.g.
+	// "$.threadId" or "$.messages[2].role". Empty when the error isn't
+	// attributable to a single field (e.g. malformed JSON).
+	Path string
 }
 
 func (e *DecodingError) Error() string {
+	msg := "decoding error: " + e.Message
+	if e.Path != "" {
+		msg += " at " + e.Path
+	}
 	if e.Cause != nil {
-		return "decoding error: " + e.Message + ": " + e.Cause.Error()
+		msg += ": " + e.Cause.Error()
 	}
-	return "decoding error: " + e.Message
+	return msg
 }
 
 func (e *DecodingError) Unwrap() error {
@@ -0,0 +1,91 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// JSONBackend abstracts the marshal/indent/encode primitives JSONEncoder
+// uses, so a high-performance drop-in (segmentio/encoding/json,
+// goccy/go-json, bytedance/sonic) can replace the stdlib encoding/json on
+// hot event-encoding paths without forking the encoder. Mirrors
+// encoding.CompressionBackend's pluggable-implementation shape.
+type JSONBackend interface {
+	// Marshal encodes v compactly.
+	Marshal(v interface{}) ([]byte, error)
+
+	// MarshalIndent encodes v with the given prefix and indent.
+	MarshalIndent(v interface{}, prefix, indent string) ([]byte, error)
+
+	// Indent re-indents the already-marshaled JSON in src into dst, as
+	// encoding/json.Indent does.
+	Indent(dst *bytes.Buffer, src []byte, prefix, indent string) error
+
+	// NewEncoder returns a JSONStreamEncoder writing to w.
+	NewEncoder(w io.Writer) JSONStreamEncoder
+
+	// Valid reports whether data is well-formed JSON.
+	Valid(data []byte) bool
+}
+
+// JSONStreamEncoder is the subset of *encoding/json.Encoder JSONBackend
+// implementations provide.
+type JSONStreamEncoder interface {
+	Encode(v interface{}) error
+	SetIndent(prefix, indent string)
+}
+
+// stdJSONBackend is the default JSONBackend, backed by encoding/json.
+type stdJSONBackend struct{}
+
+func (stdJSONBackend) Marshal(v interface{}) ([]byte, error) { return json.Marshal(v) }
+
+func (stdJSONBackend) MarshalIndent(v interface{}, prefix, indent string) ([]byte, error) {
+	return json.MarshalIndent(v, prefix, indent)
+}
+
+func (stdJSONBackend) Indent(dst *bytes.Buffer, src []byte, prefix, indent string) error {
+	return json.Indent(dst, src, prefix, indent)
+}
+
+func (stdJSONBackend) Valid(data []byte) bool { return json.Valid(data) }
+
+func (stdJSONBackend) NewEncoder(w io.Writer) JSONStreamEncoder {
+	return &stdStreamEncoder{enc: json.NewEncoder(w)}
+}
+
+type stdStreamEncoder struct{ enc *json.Encoder }
+
+func (s *stdStreamEncoder) Encode(v interface{}) error      { return s.enc.Encode(v) }
+func (s *stdStreamEncoder) SetIndent(prefix, indent string) { s.enc.SetIndent(prefix, indent) }
+
+var (
+	backendsMu sync.RWMutex
+	backends   = map[string]JSONBackend{
+		"":       stdJSONBackend{},
+		"stdlib": stdJSONBackend{},
+	}
+)
+
+// RegisterJSONBackend registers backend under name, so it can be selected
+// via EncodingOptions.JSONBackend. Build-tagged backend files (e.g.
+// backend_sonic.go under the "sonic" build tag) call this from an init().
+func RegisterJSONBackend(name string, backend JSONBackend) {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+	backends[name] = backend
+}
+
+// JSONBackendFor returns the JSONBackend registered under name, falling
+// back to the stdlib backend if name is empty or unregistered (e.g. its
+// build tag wasn't compiled in).
+func JSONBackendFor(name string) JSONBackend {
+	backendsMu.RLock()
+	defer backendsMu.RUnlock()
+	if backend, ok := backends[name]; ok {
+		return backend
+	}
+	return stdJSONBackend{}
+}
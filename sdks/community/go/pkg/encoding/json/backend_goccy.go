@@ -0,0 +1,40 @@
+//go:build goccy_json
+
+package json
+
+import (
+	"bytes"
+	"io"
+
+	goccyjson "github.com/goccy/go-json"
+)
+
+func init() {
+	RegisterJSONBackend("goccy", goccyJSONBackend{})
+}
+
+// goccyJSONBackend is a JSONBackend backed by goccy/go-json, built only
+// when the goccy_json build tag is set (the dependency is not otherwise
+// part of this module's build).
+type goccyJSONBackend struct{}
+
+func (goccyJSONBackend) Marshal(v interface{}) ([]byte, error) { return goccyjson.Marshal(v) }
+
+func (goccyJSONBackend) MarshalIndent(v interface{}, prefix, indent string) ([]byte, error) {
+	return goccyjson.MarshalIndent(v, prefix, indent)
+}
+
+func (goccyJSONBackend) Indent(dst *bytes.Buffer, src []byte, prefix, indent string) error {
+	return goccyjson.Indent(dst, src, prefix, indent)
+}
+
+func (goccyJSONBackend) Valid(data []byte) bool { return goccyjson.Valid(data) }
+
+func (goccyJSONBackend) NewEncoder(w io.Writer) JSONStreamEncoder {
+	return &goccyStreamEncoder{enc: goccyjson.NewEncoder(w)}
+}
+
+type goccyStreamEncoder struct{ enc *goccyjson.Encoder }
+
+func (s *goccyStreamEncoder) Encode(v interface{}) error      { return s.enc.Encode(v) }
+func (s *goccyStreamEncoder) SetIndent(prefix, indent string) { s.enc.SetIndent(prefix, indent) }
@@ -0,0 +1,40 @@
+//go:build segmentio_json
+
+package json
+
+import (
+	"bytes"
+	"io"
+
+	segjson "github.com/segmentio/encoding/json"
+)
+
+func init() {
+	RegisterJSONBackend("segmentio", segmentioJSONBackend{})
+}
+
+// segmentioJSONBackend is a JSONBackend backed by segmentio/encoding/json,
+// built only when the segmentio_json build tag is set (the dependency is
+// not otherwise part of this module's build).
+type segmentioJSONBackend struct{}
+
+func (segmentioJSONBackend) Marshal(v interface{}) ([]byte, error) { return segjson.Marshal(v) }
+
+func (segmentioJSONBackend) MarshalIndent(v interface{}, prefix, indent string) ([]byte, error) {
+	return segjson.MarshalIndent(v, prefix, indent)
+}
+
+func (segmentioJSONBackend) Indent(dst *bytes.Buffer, src []byte, prefix, indent string) error {
+	return segjson.Indent(dst, src, prefix, indent)
+}
+
+func (segmentioJSONBackend) Valid(data []byte) bool { return segjson.Valid(data) }
+
+func (segmentioJSONBackend) NewEncoder(w io.Writer) JSONStreamEncoder {
+	return &segmentioStreamEncoder{enc: segjson.NewEncoder(w)}
+}
+
+type segmentioStreamEncoder struct{ enc *segjson.Encoder }
+
+func (s *segmentioStreamEncoder) Encode(v interface{}) error      { return s.enc.Encode(v) }
+func (s *segmentioStreamEncoder) SetIndent(prefix, indent string) { s.enc.SetIndent(prefix, indent) }
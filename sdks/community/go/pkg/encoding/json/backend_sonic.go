@@ -0,0 +1,54 @@
+//go:build sonic_json
+
+package json
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/bytedance/sonic"
+)
+
+func init() {
+	RegisterJSONBackend("sonic", sonicJSONBackend{})
+}
+
+// sonicJSONBackend is a JSONBackend backed by bytedance/sonic, built only
+// when the sonic_json build tag is set (the dependency is not otherwise
+// part of this module's build, and sonic itself requires amd64/arm64 with
+// Go's assembler to get its speedup).
+type sonicJSONBackend struct{}
+
+func (sonicJSONBackend) Marshal(v interface{}) ([]byte, error) { return sonic.Marshal(v) }
+
+func (sonicJSONBackend) MarshalIndent(v interface{}, prefix, indent string) ([]byte, error) {
+	return sonic.MarshalIndent(v, prefix, indent)
+}
+
+// Indent re-indents already-marshaled JSON. sonic has no dedicated Indent
+// primitive, so this falls back to re-marshaling through sonic.Unmarshal
+// plus MarshalIndent rather than silently using the stdlib for this one
+// step.
+func (b sonicJSONBackend) Indent(dst *bytes.Buffer, src []byte, prefix, indent string) error {
+	var v interface{}
+	if err := sonic.Unmarshal(src, &v); err != nil {
+		return err
+	}
+	out, err := sonic.MarshalIndent(v, prefix, indent)
+	if err != nil {
+		return err
+	}
+	dst.Write(out)
+	return nil
+}
+
+func (sonicJSONBackend) Valid(data []byte) bool { return sonic.Valid(data) }
+
+func (sonicJSONBackend) NewEncoder(w io.Writer) JSONStreamEncoder {
+	return &sonicStreamEncoder{enc: sonic.ConfigDefault.NewEncoder(w)}
+}
+
+type sonicStreamEncoder struct{ enc sonic.Encoder }
+
+func (s *sonicStreamEncoder) Encode(v interface{}) error      { return s.enc.Encode(v) }
+func (s *sonicStreamEncoder) SetIndent(prefix, indent string) { s.enc.SetIndent(prefix, indent) }
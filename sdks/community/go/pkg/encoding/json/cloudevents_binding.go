@@ -0,0 +1,291 @@
+package json
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// CloudEventsContentType and CloudEventsBatchContentType are the CloudEvents
+// v1.0 structured-mode JSON media types JSONDecoder/JSONEncoder recognize
+// alongside the native ag-ui event shape (see negotiation.RegisterDefaultTypes).
+const (
+	CloudEventsContentType      = "application/cloudevents+json"
+	CloudEventsBatchContentType = "application/cloudevents-batch+json"
+
+	cloudEventsSpecVersion = "1.0"
+)
+
+// CloudEventsEnvelope is a CloudEvents v1.0 structured-mode JSON envelope
+// carrying an ag-ui event as its data payload. Unknown top-level attributes
+// ("extension attributes" in CE terms) round-trip through Extensions rather
+// than being dropped.
+type CloudEventsEnvelope struct {
+	SpecVersion     string
+	ID              string
+	Source          string
+	Type            string
+	Time            string
+	DataContentType string
+	Data            json.RawMessage
+	DataBase64      string
+	Extensions      map[string]any
+}
+
+var cloudEventsKnownFields = map[string]bool{
+	"specversion": true, "id": true, "source": true, "type": true,
+	"time": true, "datacontenttype": true, "data": true, "data_base64": true,
+}
+
+// MarshalJSON writes the envelope as a flat CloudEvents JSON object, folding
+// Extensions back in as top-level attributes.
+func (e *CloudEventsEnvelope) MarshalJSON() ([]byte, error) {
+	out := make(map[string]any, len(e.Extensions)+6)
+	for k, v := range e.Extensions {
+		out[k] = v
+	}
+	out["specversion"] = e.SpecVersion
+	out["id"] = e.ID
+	out["source"] = e.Source
+	out["type"] = e.Type
+	if e.Time != "" {
+		out["time"] = e.Time
+	}
+	if e.DataContentType != "" {
+		out["datacontenttype"] = e.DataContentType
+	}
+	if len(e.Data) > 0 {
+		out["data"] = e.Data
+	} else if e.DataBase64 != "" {
+		out["data_base64"] = e.DataBase64
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON reads a CloudEvents JSON object, splitting known attributes
+// from CloudEvents extension attributes.
+func (e *CloudEventsEnvelope) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	str := func(key string) (string, error) {
+		v, ok := raw[key]
+		if !ok {
+			return "", nil
+		}
+		var s string
+		if err := json.Unmarshal(v, &s); err != nil {
+			return "", fmt.Errorf("cloudevents: attribute %q must be a string: %w", key, err)
+		}
+		return s, nil
+	}
+
+	var err error
+	if e.SpecVersion, err = str("specversion"); err != nil {
+		return err
+	}
+	if e.ID, err = str("id"); err != nil {
+		return err
+	}
+	if e.Source, err = str("source"); err != nil {
+		return err
+	}
+	if e.Type, err = str("type"); err != nil {
+		return err
+	}
+	if e.Time, err = str("time"); err != nil {
+		return err
+	}
+	if e.DataContentType, err = str("datacontenttype"); err != nil {
+		return err
+	}
+	if e.DataBase64, err = str("data_base64"); err != nil {
+		return err
+	}
+	if d, ok := raw["data"]; ok {
+		e.Data = json.RawMessage(d)
+	}
+
+	for k, v := range raw {
+		if cloudEventsKnownFields[k] {
+			continue
+		}
+		var val any
+		if err := json.Unmarshal(v, &val); err != nil {
+			return fmt.Errorf("cloudevents: extension attribute %q: %w", k, err)
+		}
+		if e.Extensions == nil {
+			e.Extensions = make(map[string]any)
+		}
+		e.Extensions[k] = val
+	}
+
+	return nil
+}
+
+// LooksLikeCloudEvent reports whether data is plausibly a CloudEvents
+// structured-mode envelope rather than a native ag-ui event: it carries a
+// "specversion" attribute, which no ag-ui event does.
+func LooksLikeCloudEvent(data []byte) bool {
+	var probe struct {
+		SpecVersion string `json:"specversion"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return false
+	}
+	return probe.SpecVersion != ""
+}
+
+// cloudEventTypeSuffix maps an ag-ui EventType to the CloudEvents "type"
+// suffix used after the "io.agui." prefix, e.g. EventTypeRunStarted ->
+// "run.started". This is kept independent of pkg/encoding/cloudevents,
+// which already embeds a *JSONDecoder for its own envelope handling - that
+// dependency can't run the other way without a cycle.
+var cloudEventTypeSuffix = map[events.EventType]string{
+	events.EventTypeRunStarted:                 "run.started",
+	events.EventTypeRunFinished:                "run.finished",
+	events.EventTypeRunError:                   "run.error",
+	events.EventTypeStepStarted:                "step.started",
+	events.EventTypeStepFinished:               "step.finished",
+	events.EventTypeTextMessageStart:           "text_message.start",
+	events.EventTypeTextMessageContent:         "text_message.content",
+	events.EventTypeTextMessageEnd:             "text_message.end",
+	events.EventTypeTextMessageChunk:           "text_message.chunk",
+	events.EventTypeToolCallStart:              "tool_call.start",
+	events.EventTypeToolCallArgs:               "tool_call.args",
+	events.EventTypeToolCallEnd:                "tool_call.end",
+	events.EventTypeToolCallChunk:              "tool_call.chunk",
+	events.EventTypeToolCallResult:             "tool_call.result",
+	events.EventTypeStateSnapshot:              "state.snapshot",
+	events.EventTypeStateDelta:                 "state.delta",
+	events.EventTypeMessagesSnapshot:           "messages.snapshot",
+	events.EventTypeRaw:                        "raw",
+	events.EventTypeCustom:                     "custom",
+	events.EventTypeThinkingStart:              "thinking.start",
+	events.EventTypeThinkingEnd:                "thinking.end",
+	events.EventTypeThinkingTextMessageStart:   "thinking.text_message.start",
+	events.EventTypeThinkingTextMessageContent: "thinking.text_message.content",
+	events.EventTypeThinkingTextMessageEnd:     "thinking.text_message.end",
+	events.EventTypeThinkingStepStart:          "thinking.step.start",
+	events.EventTypeThinkingStepContent:        "thinking.step.content",
+	events.EventTypeThinkingStepEnd:            "thinking.step.end",
+	events.EventTypeThinkingToolCallStart:      "thinking.tool_call.start",
+	events.EventTypeThinkingToolCallEnd:        "thinking.tool_call.end",
+}
+
+var cloudEventSuffixToType = func() map[string]events.EventType {
+	m := make(map[string]events.EventType, len(cloudEventTypeSuffix))
+	for et, suffix := range cloudEventTypeSuffix {
+		m[suffix] = et
+	}
+	return m
+}()
+
+// CloudEventType returns the CloudEvents "type" attribute for an ag-ui
+// EventType, e.g. "io.agui.run.started".
+func CloudEventType(et events.EventType) (string, error) {
+	suffix, ok := cloudEventTypeSuffix[et]
+	if !ok {
+		return "", fmt.Errorf("cloudevents: no CE type mapping for event type %q", et)
+	}
+	return "io.agui." + suffix, nil
+}
+
+// EventTypeFromCloudEventType reverses CloudEventType, routing a CloudEvents
+// "type" attribute back to the concrete ag-ui EventType.
+func EventTypeFromCloudEventType(t string) (events.EventType, error) {
+	suffix := strings.TrimPrefix(t, "io.agui.")
+	et, ok := cloudEventSuffixToType[suffix]
+	if !ok {
+		return "", fmt.Errorf("cloudevents: unrecognized CE type %q", t)
+	}
+	return et, nil
+}
+
+// unwrapCloudEvent decodes a CloudEvents envelope and returns it along with
+// the event's own JSON payload (from "data" or "data_base64").
+func unwrapCloudEvent(data []byte) (*CloudEventsEnvelope, []byte, error) {
+	var env CloudEventsEnvelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, nil, fmt.Errorf("cloudevents: failed to decode envelope: %w", err)
+	}
+
+	if len(env.Data) > 0 {
+		return &env, env.Data, nil
+	}
+	if env.DataBase64 != "" {
+		decoded, err := base64.StdEncoding.DecodeString(env.DataBase64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cloudevents: failed to decode data_base64: %w", err)
+		}
+		return &env, decoded, nil
+	}
+	return &env, nil, fmt.Errorf("cloudevents: envelope has neither data nor data_base64")
+}
+
+// applyCloudEventAttributes stamps CloudEvents envelope attributes onto the
+// decoded event's BaseEvent: type->EventType via the CE mapping table,
+// id->event ID, time->timestamp, and any CE extension attributes.
+func applyCloudEventAttributes(event events.Event, env *CloudEventsEnvelope) error {
+	base := event.GetBaseEvent()
+	if base == nil {
+		return nil
+	}
+
+	et, err := EventTypeFromCloudEventType(env.Type)
+	if err != nil {
+		return err
+	}
+	base.EventType = et
+
+	if env.ID != "" {
+		base.IDValue = env.ID
+	}
+	if env.Time != "" {
+		if t, err := time.Parse(time.RFC3339Nano, env.Time); err == nil {
+			base.SetTimestamp(t.UnixMilli())
+		}
+	}
+	if len(env.Extensions) > 0 {
+		base.Extensions = env.Extensions
+	}
+
+	return nil
+}
+
+// wrapCloudEvent builds the CloudEvents envelope for event, carrying data
+// (the event's own already-encoded JSON form) as the envelope's "data".
+func wrapCloudEvent(event events.Event, source string, data []byte) (*CloudEventsEnvelope, error) {
+	ceType, err := CloudEventType(event.Type())
+	if err != nil {
+		return nil, fmt.Errorf("cloudevents: %w", err)
+	}
+
+	var id string
+	var ceTime string
+	var extensions map[string]any
+	if base := event.GetBaseEvent(); base != nil {
+		id = base.ID()
+		if ts := base.Timestamp(); ts != nil {
+			ceTime = time.UnixMilli(*ts).UTC().Format(time.RFC3339Nano)
+		}
+		extensions = base.Extensions
+	}
+
+	return &CloudEventsEnvelope{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              id,
+		Source:          source,
+		Type:            ceType,
+		Time:            ceTime,
+		DataContentType: "application/json",
+		Data:            json.RawMessage(data),
+		Extensions:      extensions,
+	}, nil
+}
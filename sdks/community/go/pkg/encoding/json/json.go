@@ -32,3 +32,12 @@ func NewDecoder() encoding.Decoder {
 func NewCodec() encoding.Codec {
 	return NewDefaultJSONCodec()
 }
+
+// NewStreamingCodec creates an NDJSON StreamCodec with default options, as
+// a first-class streaming counterpart to NewCodec's batch codec - pick this
+// one when events must be written or read incrementally (e.g. bridging an
+// SSE connection or replaying a large recorded run) instead of held as a
+// single in-memory []events.Event.
+func NewStreamingCodec() encoding.StreamCodec {
+	return NewStreamCodec(nil, nil)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+	perrors "github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/errors"
 )
 
 // Ensure JSONDecoder implements the focused interfaces
@@ -23,6 +24,17 @@ type JSONDecoder struct {
 	options          *encoding.DecodingOptions
 	activeOperations int32 // Track active decoding operations
 	maxConcurrent    int32 // Maximum concurrent operations
+	registry         *events.TypeRegistry
+}
+
+// WithRegistry swaps in a custom TypeRegistry - e.g. one extended with
+// application-specific event types, or shared with other codecs so adding
+// an event type is a single registration instead of an edit to every
+// format's decoder. Returns d for chaining. A decoder with no registry set
+// falls back to events.DefaultTypeRegistry.
+func (d *JSONDecoder) WithRegistry(registry *events.TypeRegistry) *JSONDecoder {
+	d.registry = registry
+	return d
 }
 
 // NewJSONDecoder creates a new JSON decoder with the given options
@@ -103,6 +115,15 @@ func (d *JSONDecoder) Decode(ctx context.Context, data []byte) (events.Event, er
 		}
 	}
 
+	// Transparently unwrap a CloudEvents v1.0 structured-mode envelope
+	// (application/cloudevents+json) instead of requiring callers to route
+	// through a separate codec: the payload it carries as "data" is decoded
+	// exactly like a native event, then envelope attributes are stamped
+	// onto the result (see applyCloudEventAttributes).
+	if LooksLikeCloudEvent(data) {
+		return d.decodeCloudEvent(data)
+	}
+
 	// First, decode just the type field without strict checking
 	var typeWrapper eventTypeWrapper
 	if err := json.Unmarshal(data, &typeWrapper); err != nil {
@@ -114,10 +135,18 @@ func (d *JSONDecoder) Decode(ctx context.Context, data []byte) (events.Event, er
 		}
 	}
 
-	// Create the appropriate event type based on the type field
+	// Create the appropriate event type based on the type field. In
+	// AccumulateTypeErrors mode createEvent returns a non-fatal
+	// *errors.MultiTypeError alongside a successfully-built event rather
+	// than aborting; every other error is fatal.
 	event, err := d.createEvent(events.EventType(typeWrapper.Type), data)
+	var typeErr error
 	if err != nil {
-		return nil, err
+		if mte, ok := err.(*perrors.MultiTypeError); ok {
+			typeErr = mte
+		} else {
+			return nil, err
+		}
 	}
 
 	// Validate the event if requested
@@ -132,7 +161,7 @@ func (d *JSONDecoder) Decode(ctx context.Context, data []byte) (events.Event, er
 		}
 	}
 
-	return event, nil
+	return event, typeErr
 }
 
 // DecodeMultiple decodes multiple events from JSON array data
@@ -191,167 +220,137 @@ func (d *JSONDecoder) DecodeMultiple(ctx context.Context, data []byte) ([]events
 		}
 	}
 
-	// Decode each event
+	// Decode each event. A *errors.MultiTypeError from an individual Decode
+	// is non-fatal (AccumulateTypeErrors mode) - its mismatches are
+	// collected into the batch's own MultiTypeError instead of aborting.
 	events := make([]events.Event, 0, len(rawEvents))
+	var typeMismatches []*perrors.TypeError
 	for i, rawEvent := range rawEvents {
 		event, err := d.Decode(ctx, rawEvent)
 		if err != nil {
-			// Enhance error with index information
-			if decErr, ok := err.(*encoding.DecodingError); ok {
-				decErr.Message = fmt.Sprintf("failed to decode event at index %d: %s", i, decErr.Message)
+			if mte, ok := err.(*perrors.MultiTypeError); ok {
+				typeMismatches = append(typeMismatches, mte.Errors...)
+			} else {
+				// Enhance error with index information
+				if decErr, ok := err.(*encoding.DecodingError); ok {
+					decErr.Message = fmt.Sprintf("failed to decode event at index %d: %s", i, decErr.Message)
+				}
+				return nil, err
 			}
-			return nil, err
 		}
 		events = append(events, event)
 	}
 
+	if len(typeMismatches) > 0 {
+		return events, &perrors.MultiTypeError{Errors: typeMismatches}
+	}
 	return events, nil
 }
 
-// createEvent creates the appropriate event type based on the type string
-func (d *JSONDecoder) createEvent(eventType events.EventType, data []byte) (events.Event, error) {
-	// Use buffer pooling for creating a byte reader
-	buf := encoding.GetBufferSafe(len(data))
-	if buf == nil {
+// decodeCloudEvent unwraps a CloudEvents envelope, decodes its "data"/
+// "data_base64" payload as a native event, stamps the envelope's attributes
+// onto it, and validates it exactly like Decode does for a native payload.
+func (d *JSONDecoder) decodeCloudEvent(data []byte) (events.Event, error) {
+	env, payload, err := unwrapCloudEvent(data)
+	if err != nil {
 		return nil, &encoding.DecodingError{
 			Format:  "json",
 			Data:    data,
-			Message: "failed to allocate buffer: resource limits exceeded",
+			Message: "failed to decode CloudEvents envelope",
+			Cause:   err,
 		}
 	}
-	defer encoding.PutBuffer(buf)
-
-	buf.Write(data)
-
-	decoder := json.NewDecoder(buf)
-	if d.options.Strict && !d.options.AllowUnknownFields {
-		decoder.DisallowUnknownFields()
-	}
-
-	var err error
-	var event events.Event
-
-	switch eventType {
-	case events.EventTypeTextMessageStart:
-		var e events.TextMessageStartEvent
-		err = decoder.Decode(&e)
-		if err == nil {
-			event = &e
-		}
-
-	case events.EventTypeTextMessageContent:
-		var e events.TextMessageContentEvent
-		err = decoder.Decode(&e)
-		if err == nil {
-			event = &e
-		}
-
-	case events.EventTypeTextMessageEnd:
-		var e events.TextMessageEndEvent
-		err = decoder.Decode(&e)
-		if err == nil {
-			event = &e
-		}
-
-	case events.EventTypeToolCallStart:
-		var e events.ToolCallStartEvent
-		err = decoder.Decode(&e)
-		if err == nil {
-			event = &e
-		}
 
-	case events.EventTypeToolCallArgs:
-		var e events.ToolCallArgsEvent
-		err = decoder.Decode(&e)
-		if err == nil {
-			event = &e
-		}
-
-	case events.EventTypeToolCallEnd:
-		var e events.ToolCallEndEvent
-		err = decoder.Decode(&e)
-		if err == nil {
-			event = &e
+	eventType, err := EventTypeFromCloudEventType(env.Type)
+	if err != nil {
+		return nil, &encoding.DecodingError{
+			Format:  "json",
+			Data:    data,
+			Message: "failed to route CloudEvents type to an ag-ui event",
+			Cause:   err,
 		}
+	}
 
-	case events.EventTypeStateSnapshot:
-		var e events.StateSnapshotEvent
-		err = decoder.Decode(&e)
-		if err == nil {
-			event = &e
+	event, err := d.createEvent(eventType, payload)
+	var typeErr error
+	if err != nil {
+		if mte, ok := err.(*perrors.MultiTypeError); ok {
+			typeErr = mte
+		} else {
+			return nil, err
 		}
+	}
 
-	case events.EventTypeStateDelta:
-		var e events.StateDeltaEvent
-		err = decoder.Decode(&e)
-		if err == nil {
-			event = &e
+	if err := applyCloudEventAttributes(event, env); err != nil {
+		return nil, &encoding.DecodingError{
+			Format:  "json",
+			Data:    data,
+			Message: "failed to apply CloudEvents attributes",
+			Cause:   err,
 		}
+	}
 
-	case events.EventTypeMessagesSnapshot:
-		var e events.MessagesSnapshotEvent
-		err = decoder.Decode(&e)
-		if err == nil {
-			event = &e
+	if d.options.ValidateEvents {
+		if err := event.Validate(); err != nil {
+			return nil, &encoding.DecodingError{
+				Format:  "json",
+				Data:    data,
+				Message: "event validation failed",
+				Cause:   err,
+			}
 		}
+	}
 
-	case events.EventTypeRaw:
-		var e events.RawEvent
-		err = decoder.Decode(&e)
-		if err == nil {
-			event = &e
-		}
+	return event, typeErr
+}
 
-	case events.EventTypeCustom:
-		var e events.CustomEvent
-		err = decoder.Decode(&e)
-		if err == nil {
-			event = &e
-		}
+// decodeInto decodes data into target. In AccumulateTypeErrors mode it
+// delegates to decodeBestEffort and returns per-field mismatches as a
+// non-fatal typeErr rather than aborting; otherwise it behaves as before,
+// returning any decode failure as a fatal err.
+func (d *JSONDecoder) decodeInto(data []byte, target events.Event) (typeErr error, err error) {
+	if d.options.AccumulateTypeErrors {
+		return decodeBestEffort(data, target)
+	}
 
-	case events.EventTypeRunStarted:
-		var e events.RunStartedEvent
-		err = decoder.Decode(&e)
-		if err == nil {
-			event = &e
+	// Use buffer pooling for creating a byte reader
+	buf := encoding.GetBufferSafe(len(data))
+	if buf == nil {
+		return nil, &encoding.DecodingError{
+			Format:  "json",
+			Data:    data,
+			Message: "failed to allocate buffer: resource limits exceeded",
 		}
+	}
+	defer encoding.PutBuffer(buf)
 
-	case events.EventTypeRunFinished:
-		var e events.RunFinishedEvent
-		err = decoder.Decode(&e)
-		if err == nil {
-			event = &e
-		}
+	buf.Write(data)
 
-	case events.EventTypeRunError:
-		var e events.RunErrorEvent
-		err = decoder.Decode(&e)
-		if err == nil {
-			event = &e
-		}
+	decoder := json.NewDecoder(buf)
+	if d.options.Strict && !d.options.AllowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
 
-	case events.EventTypeStepStarted:
-		var e events.StepStartedEvent
-		err = decoder.Decode(&e)
-		if err == nil {
-			event = &e
-		}
+	return nil, decoder.Decode(target)
+}
 
-	case events.EventTypeStepFinished:
-		var e events.StepFinishedEvent
-		err = decoder.Decode(&e)
-		if err == nil {
-			event = &e
-		}
+// createEvent creates the appropriate event type based on the type string
+func (d *JSONDecoder) createEvent(eventType events.EventType, data []byte) (events.Event, error) {
+	registry := d.registry
+	if registry == nil {
+		registry = events.DefaultTypeRegistry
+	}
 
-	default:
-		return nil, &encoding.DecodingError{
-			Format:  "json",
-			Data:    data,
-			Message: fmt.Sprintf("unknown event type: %s", eventType),
-		}
+	factory, ok := registry.Lookup(eventType)
+	if !ok {
+		// No factory registered for this type (e.g. a newer protocol
+		// version introduced it, or it's application-specific): fall back
+		// to CustomEvent instead of erroring, so the decode still succeeds.
+		factory = func() events.Event { return &events.CustomEvent{} }
 	}
 
+	event := factory()
+	typeErr, err := d.decodeInto(data, event)
 	if err != nil {
 		return nil, &encoding.DecodingError{
 			Format:  "json",
@@ -362,12 +361,11 @@ func (d *JSONDecoder) createEvent(eventType events.EventType, data []byte) (even
 	}
 
 	// Ensure the base event is properly initialized
-	if event != nil && event.GetBaseEvent() != nil {
-		baseEvent := event.GetBaseEvent()
+	if baseEvent := event.GetBaseEvent(); baseEvent != nil {
 		baseEvent.EventType = eventType
 	}
 
-	return event, nil
+	return event, typeErr
 }
 
 // ContentType returns the MIME type this decoder handles
@@ -21,6 +21,7 @@ var (
 // This encoder is stateless and thread-safe for concurrent use.
 type JSONEncoder struct {
 	options          *encoding.EncodingOptions
+	backend          JSONBackend
 	activeOperations int32 // Track active encoding operations
 	maxConcurrent    int32 // Maximum concurrent operations
 }
@@ -35,6 +36,7 @@ func NewJSONEncoder(options *encoding.EncodingOptions) *JSONEncoder {
 	}
 	return &JSONEncoder{
 		options:       options,
+		backend:       JSONBackendFor(options.JSONBackend),
 		maxConcurrent: 100, // Default limit of 100 concurrent operations
 	}
 }
@@ -49,6 +51,7 @@ func NewJSONEncoderWithConcurrencyLimit(options *encoding.EncodingOptions, maxCo
 	}
 	return &JSONEncoder{
 		options:       options,
+		backend:       JSONBackendFor(options.JSONBackend),
 		maxConcurrent: maxConcurrent,
 	}
 }
@@ -99,8 +102,12 @@ func (e *JSONEncoder) Encode(ctx context.Context, event events.Event) ([]byte, e
 		}
 	}
 
+	if e.options.SafeCollections {
+		event = applySafeCollections(event)
+	}
+
 	// Use the event's ToJSON method for cross-SDK compatibility
-	if e.options.CrossSDKCompatibility {
+	if e.options.CrossSDKCompatibility || e.options.CloudEventsSource != "" {
 		data, err := event.ToJSON()
 		if err != nil {
 			return nil, &encoding.EncodingError{
@@ -111,6 +118,27 @@ func (e *JSONEncoder) Encode(ctx context.Context, event events.Event) ([]byte, e
 			}
 		}
 
+		if e.options.CloudEventsSource != "" {
+			env, err := wrapCloudEvent(event, e.options.CloudEventsSource, data)
+			if err != nil {
+				return nil, &encoding.EncodingError{
+					Format:  "json",
+					Event:   event,
+					Message: "failed to wrap event as a CloudEvents envelope",
+					Cause:   err,
+				}
+			}
+			data, err = json.Marshal(env)
+			if err != nil {
+				return nil, &encoding.EncodingError{
+					Format:  "json",
+					Event:   event,
+					Message: "failed to marshal CloudEvents envelope",
+					Cause:   err,
+				}
+			}
+		}
+
 		// Pretty print if requested
 		if e.options.Pretty {
 			buf := encoding.GetBufferSafe(len(data) * 2) // Estimate 2x size for pretty printing
@@ -123,7 +151,7 @@ func (e *JSONEncoder) Encode(ctx context.Context, event events.Event) ([]byte, e
 			}
 			defer encoding.PutBuffer(buf)
 
-			if err := json.Indent(buf, data, "", "  "); err != nil {
+			if err := e.backend.Indent(buf, data, "", "  "); err != nil {
 				return nil, &encoding.EncodingError{
 					Format:  "json",
 					Event:   event,
@@ -164,7 +192,7 @@ func (e *JSONEncoder) Encode(ctx context.Context, event events.Event) ([]byte, e
 		}
 		defer encoding.PutBuffer(buf)
 
-		encoder := json.NewEncoder(buf)
+		encoder := e.backend.NewEncoder(buf)
 		encoder.SetIndent("", "  ")
 		err = encoder.Encode(event)
 		if err == nil {
@@ -184,7 +212,7 @@ func (e *JSONEncoder) Encode(ctx context.Context, event events.Event) ([]byte, e
 		}
 		defer encoding.PutBuffer(buf)
 
-		encoder := json.NewEncoder(buf)
+		encoder := e.backend.NewEncoder(buf)
 		err = encoder.Encode(event)
 		if err == nil {
 			// Remove trailing newline added by json.Encoder
@@ -296,10 +324,14 @@ func (e *JSONEncoder) EncodeMultiple(ctx context.Context, events []events.Event)
 			}
 		}
 
+		if e.options.SafeCollections {
+			event = applySafeCollections(event)
+		}
+
 		var data []byte
 		var err error
 
-		if e.options.CrossSDKCompatibility {
+		if e.options.CrossSDKCompatibility || e.options.CloudEventsSource != "" {
 			// Use ToJSON for cross-SDK compatibility
 			data, err = event.ToJSON()
 		} else {
@@ -315,7 +347,7 @@ func (e *JSONEncoder) EncodeMultiple(ctx context.Context, events []events.Event)
 			}
 
 			// Use the buffer and ensure it's returned to pool immediately after use
-			encoder := json.NewEncoder(eventBuf)
+			encoder := e.backend.NewEncoder(eventBuf)
 			err = encoder.Encode(event)
 			if err == nil {
 				// Remove trailing newline added by json.Encoder
@@ -340,6 +372,26 @@ func (e *JSONEncoder) EncodeMultiple(ctx context.Context, events []events.Event)
 			}
 		}
 
+		if e.options.CloudEventsSource != "" {
+			env, wrapErr := wrapCloudEvent(event, e.options.CloudEventsSource, data)
+			if wrapErr != nil {
+				return nil, &encoding.EncodingError{
+					Format:  "json",
+					Event:   event,
+					Message: fmt.Sprintf("failed to wrap event at index %d as a CloudEvents envelope", i),
+					Cause:   wrapErr,
+				}
+			}
+			if data, err = json.Marshal(env); err != nil {
+				return nil, &encoding.EncodingError{
+					Format:  "json",
+					Event:   event,
+					Message: fmt.Sprintf("failed to marshal CloudEvents envelope at index %d", i),
+					Cause:   err,
+				}
+			}
+		}
+
 		// Check cumulative size
 		totalSize += int64(len(data))
 		if i > 0 {
@@ -371,7 +423,7 @@ func (e *JSONEncoder) EncodeMultiple(ctx context.Context, events []events.Event)
 	defer encoding.PutBuffer(arrayBuf)
 
 	if e.options.Pretty {
-		encoder := json.NewEncoder(arrayBuf)
+		encoder := e.backend.NewEncoder(arrayBuf)
 		encoder.SetIndent("", "  ")
 		err = encoder.Encode(encodedEvents)
 		if err == nil {
@@ -384,7 +436,7 @@ func (e *JSONEncoder) EncodeMultiple(ctx context.Context, events []events.Event)
 			copy(result, bytes)
 		}
 	} else {
-		encoder := json.NewEncoder(arrayBuf)
+		encoder := e.backend.NewEncoder(arrayBuf)
 		err = encoder.Encode(encodedEvents)
 		if err == nil {
 			// Remove trailing newline added by json.Encoder
@@ -432,4 +484,5 @@ func (e *JSONEncoder) Reset(options *encoding.EncodingOptions) {
 		}
 	}
 	e.options = options
+	e.backend = JSONBackendFor(options.JSONBackend)
 }
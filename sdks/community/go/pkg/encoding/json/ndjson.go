@@ -0,0 +1,250 @@
+package json
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+)
+
+// ContentTypeNDJSON is the MIME type for newline-delimited JSON event
+// streams.
+const ContentTypeNDJSON = "application/x-ndjson"
+
+// Ensure StreamCodec implements the full streaming interface
+var (
+	_ encoding.StreamCodec   = (*StreamCodec)(nil)
+	_ encoding.StreamEncoder = (*ndjsonEncoder)(nil)
+	_ encoding.StreamDecoder = (*ndjsonDecoder)(nil)
+)
+
+// StreamCodec emits and consumes NDJSON event streams: one compact JSON
+// event per line, so a long-running event run can be pushed to an HTTP/SSE
+// consumer incrementally instead of buffering the whole run into one
+// []json.RawMessage array and enforcing a single MaxSize check at the end,
+// as JSONEncoder.EncodeMultiple does.
+type StreamCodec struct {
+	*JSONCodec
+
+	enc *ndjsonEncoder
+	dec *ndjsonDecoder
+}
+
+// NewStreamCodec creates an NDJSON StreamCodec with the given options.
+func NewStreamCodec(encOptions *encoding.EncodingOptions, decOptions *encoding.DecodingOptions) *StreamCodec {
+	codec := NewJSONCodec(encOptions, decOptions)
+	return &StreamCodec{
+		JSONCodec: codec,
+		enc:       &ndjsonEncoder{codec: codec},
+		dec:       &ndjsonDecoder{codec: codec},
+	}
+}
+
+// EncodeStream writes each event from input to output as one NDJSON line,
+// flushing after every event, until input closes or ctx is cancelled.
+func (s *StreamCodec) EncodeStream(ctx context.Context, input <-chan events.Event, output io.Writer) error {
+	if err := s.StartEncoding(ctx, output); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case event, ok := <-input:
+			if !ok {
+				return s.EndEncoding(ctx)
+			}
+			if err := s.WriteEvent(ctx, event); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// DecodeStream reads NDJSON lines from input, decoding each into an event
+// sent to output, until input is exhausted or ctx is cancelled. It does not
+// close output; the caller owns that channel.
+func (s *StreamCodec) DecodeStream(ctx context.Context, input io.Reader, output chan<- events.Event) error {
+	if err := s.StartDecoding(ctx, input); err != nil {
+		return err
+	}
+
+	for {
+		event, err := s.ReadEvent(ctx)
+		if err != nil {
+			if err == io.EOF {
+				return s.EndDecoding(ctx)
+			}
+			return err
+		}
+
+		select {
+		case output <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *StreamCodec) StartEncoding(ctx context.Context, w io.Writer) error {
+	return s.enc.StartStream(ctx, w)
+}
+
+func (s *StreamCodec) WriteEvent(ctx context.Context, event events.Event) error {
+	return s.enc.WriteEvent(ctx, event)
+}
+
+func (s *StreamCodec) EndEncoding(ctx context.Context) error {
+	return s.enc.EndStream(ctx)
+}
+
+func (s *StreamCodec) StartDecoding(ctx context.Context, r io.Reader) error {
+	return s.dec.StartStream(ctx, r)
+}
+
+func (s *StreamCodec) ReadEvent(ctx context.Context) (events.Event, error) {
+	return s.dec.ReadEvent(ctx)
+}
+
+func (s *StreamCodec) EndDecoding(ctx context.Context) error {
+	return s.dec.EndStream(ctx)
+}
+
+func (s *StreamCodec) GetStreamEncoder() encoding.StreamEncoder {
+	return s.enc
+}
+
+func (s *StreamCodec) GetStreamDecoder() encoding.StreamDecoder {
+	return s.dec
+}
+
+// ndjsonEncoder is the StreamEncoder half of StreamCodec. Unlike
+// JSONEncoder.EncodeMultiple, it writes each event to w as soon as it's
+// encoded rather than accumulating a []json.RawMessage array, so MaxSize (if
+// set) bounds each line instead of the whole run.
+type ndjsonEncoder struct {
+	codec *JSONCodec
+	w     io.Writer
+}
+
+func (e *ndjsonEncoder) ContentType() string { return ContentTypeNDJSON }
+
+func (e *ndjsonEncoder) StartStream(ctx context.Context, w io.Writer) error {
+	e.w = w
+	return nil
+}
+
+func (e *ndjsonEncoder) WriteEvent(ctx context.Context, event events.Event) error {
+	if e.w == nil {
+		return fmt.Errorf("json: WriteEvent called before StartStream")
+	}
+
+	data, err := e.codec.Encode(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	if _, err := e.w.Write(data); err != nil {
+		return err
+	}
+	if _, err := e.w.Write([]byte("\n")); err != nil {
+		return err
+	}
+
+	if flusher, ok := e.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+
+	return nil
+}
+
+func (e *ndjsonEncoder) EncodeStream(ctx context.Context, input <-chan events.Event, output io.Writer) error {
+	if err := e.StartStream(ctx, output); err != nil {
+		return err
+	}
+	for event := range input {
+		if err := e.WriteEvent(ctx, event); err != nil {
+			return err
+		}
+	}
+	return e.EndStream(ctx)
+}
+
+func (e *ndjsonEncoder) EndStream(ctx context.Context) error {
+	e.w = nil
+	return nil
+}
+
+// ndjsonDecoder is the StreamDecoder half of StreamCodec. It scans rather
+// than buffers the whole input, so a run with thousands of events can be
+// decoded without ever holding more than one line in memory at a time; the
+// scan buffer itself is bounded by the codec's DecodingOptions.BufferSize
+// (bufio.Scanner's own default if unset) so a malformed or adversarial
+// unbroken input can't grow it without limit.
+type ndjsonDecoder struct {
+	codec   *JSONCodec
+	scanner *bufio.Scanner
+}
+
+func (d *ndjsonDecoder) ContentType() string { return ContentTypeNDJSON }
+
+func (d *ndjsonDecoder) StartStream(ctx context.Context, r io.Reader) error {
+	d.scanner = bufio.NewScanner(r)
+
+	maxTokenSize := bufio.MaxScanTokenSize
+	if d.codec.JSONDecoder.options != nil && d.codec.JSONDecoder.options.BufferSize > 0 {
+		maxTokenSize = d.codec.JSONDecoder.options.BufferSize
+	}
+	d.scanner.Buffer(make([]byte, 0, 4096), maxTokenSize)
+
+	return nil
+}
+
+func (d *ndjsonDecoder) ReadEvent(ctx context.Context) (events.Event, error) {
+	if d.scanner == nil {
+		return nil, fmt.Errorf("json: ReadEvent called before StartStream")
+	}
+
+	for d.scanner.Scan() {
+		line := d.scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		return d.codec.Decode(ctx, line)
+	}
+
+	if err := d.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+func (d *ndjsonDecoder) EndStream(ctx context.Context) error {
+	d.scanner = nil
+	return nil
+}
+
+func (d *ndjsonDecoder) DecodeStream(ctx context.Context, input io.Reader, output chan<- events.Event) error {
+	if err := d.StartStream(ctx, input); err != nil {
+		return err
+	}
+	for {
+		event, err := d.ReadEvent(ctx)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		select {
+		case output <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
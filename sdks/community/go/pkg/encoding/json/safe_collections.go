@@ -0,0 +1,66 @@
+package json
+
+import (
+	"reflect"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// applySafeCollections clones event and recursively replaces nil slice and
+// map fields with empty ones, so that marshalling the clone produces "[]"
+// and "{}" instead of "null" for those fields. It returns event unchanged
+// if event isn't a pointer to a struct (nothing to swap). The original
+// event is never mutated - callers (and anything else holding a reference
+// to it) keep seeing its real nil collections.
+func applySafeCollections(event events.Event) events.Event {
+	v := reflect.ValueOf(event)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return event
+	}
+
+	clone := reflect.New(v.Elem().Type())
+	clone.Elem().Set(v.Elem())
+	emptyNilCollections(clone.Elem())
+
+	if cloned, ok := clone.Interface().(events.Event); ok {
+		return cloned
+	}
+	return event
+}
+
+// emptyNilCollections walks struct v in place, replacing nil slice/map
+// fields with empty ones and recursing into nested structs. Pointer fields
+// to structs (e.g. the embedded *BaseEvent) are deep-copied before
+// recursing so the swap never reaches back into the original event.
+func emptyNilCollections(v reflect.Value) {
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if !field.CanSet() {
+			continue
+		}
+
+		switch field.Kind() {
+		case reflect.Slice:
+			if field.IsNil() {
+				field.Set(reflect.MakeSlice(field.Type(), 0, 0))
+			}
+		case reflect.Map:
+			if field.IsNil() {
+				field.Set(reflect.MakeMap(field.Type()))
+			}
+		case reflect.Struct:
+			emptyNilCollections(field)
+		case reflect.Ptr:
+			if !field.IsNil() && field.Elem().Kind() == reflect.Struct {
+				nested := reflect.New(field.Elem().Type())
+				nested.Elem().Set(field.Elem())
+				emptyNilCollections(nested.Elem())
+				field.Set(nested)
+			}
+		}
+	}
+}
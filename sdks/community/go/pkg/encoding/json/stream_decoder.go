@@ -0,0 +1,222 @@
+package json
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+)
+
+// ContentTypeJSONSeq is the MIME type for RFC 7464 JSON text sequences.
+const ContentTypeJSONSeq = "application/json-seq"
+
+// jsonSeqRS is the RFC 7464 record separator (ASCII 0x1E) that precedes
+// every record in a JSON text sequence.
+const jsonSeqRS = 0x1E
+
+// StreamFraming selects how JSONStreamDecoder splits a byte stream into
+// individual event records.
+type StreamFraming int
+
+const (
+	// StreamFramingNDJSON treats the input as one JSON object per line
+	// (application/x-ndjson).
+	StreamFramingNDJSON StreamFraming = iota
+	// StreamFramingJSONSeq treats the input as RFC 7464 JSON text sequences:
+	// each record is preceded by a 0x1E record separator
+	// (application/json-seq).
+	StreamFramingJSONSeq
+	// StreamFramingArray treats the input as a single top-level JSON array,
+	// walked incrementally via json.Decoder.Token() instead of buffering the
+	// whole array into memory as JSONDecoder.DecodeMultiple does.
+	StreamFramingArray
+)
+
+// StreamResult is one record produced by JSONStreamDecoder.Stream: either a
+// decoded Event or the error encountered decoding it.
+type StreamResult struct {
+	Event events.Event
+	Err   error
+}
+
+// JSONStreamDecoder decodes events one at a time from an io.Reader instead
+// of requiring the whole feed to be buffered up front, as
+// JSONDecoder.DecodeMultiple does. It dispatches each framed record through
+// the same JSONDecoder.Decode used for single events, so per-record
+// DecodingOptions.MaxSize enforcement and the decoder's concurrency-limit
+// semantics apply exactly as they do there.
+//
+// A JSONStreamDecoder is not safe for concurrent use by multiple goroutines.
+type JSONStreamDecoder struct {
+	decoder *JSONDecoder
+	framing StreamFraming
+	skip    bool
+
+	reader  *bufio.Reader
+	jsonDec *json.Decoder // only used for StreamFramingArray
+	arrayOK bool          // StreamFramingArray: opening '[' has been consumed
+	done    bool
+}
+
+// NewJSONStreamDecoder creates a streaming decoder reading r with the given
+// framing. When skipMalformedFrames is true, a record that fails to decode
+// under NDJSON or JSON-Seq framing is dropped and Next resumes at the next
+// delimiter instead of returning the error; StreamFramingArray cannot
+// resynchronize mid-array, so a malformed element is always fatal there. A
+// nil options uses JSONDecoder's defaults.
+func NewJSONStreamDecoder(r io.Reader, framing StreamFraming, skipMalformedFrames bool, options *encoding.DecodingOptions) *JSONStreamDecoder {
+	d := &JSONStreamDecoder{
+		decoder: NewJSONDecoder(options),
+		framing: framing,
+		skip:    skipMalformedFrames,
+	}
+	if framing == StreamFramingArray {
+		d.jsonDec = json.NewDecoder(r)
+	} else {
+		d.reader = bufio.NewReader(r)
+	}
+	return d
+}
+
+// Next returns the next event in the stream, or io.EOF once the stream is
+// exhausted. It honors ctx cancellation between records.
+func (d *JSONStreamDecoder) Next(ctx context.Context) (events.Event, error) {
+	for {
+		if d.done {
+			return nil, io.EOF
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		record, err := d.nextRecord()
+		if err != nil {
+			if err == io.EOF {
+				d.done = true
+			}
+			return nil, err
+		}
+		if len(record) == 0 {
+			continue // blank NDJSON line
+		}
+
+		event, err := d.decoder.Decode(ctx, record)
+		if err != nil {
+			if d.skip && d.framing != StreamFramingArray {
+				continue
+			}
+			return nil, err
+		}
+		return event, nil
+	}
+}
+
+// Stream returns a channel of StreamResult fed from Next until ctx is
+// cancelled or the stream is exhausted, at which point the channel is
+// closed. A decode error is sent as a StreamResult with Err set; the stream
+// only stops after such an error if SkipMalformedFrames is off (Next itself
+// would have already returned it instead of looping).
+func (d *JSONStreamDecoder) Stream(ctx context.Context) <-chan StreamResult {
+	out := make(chan StreamResult)
+	go func() {
+		defer close(out)
+		for {
+			event, err := d.Next(ctx)
+			if err != nil {
+				if err != io.EOF {
+					select {
+					case out <- StreamResult{Err: err}:
+					case <-ctx.Done():
+					}
+				}
+				return
+			}
+			select {
+			case out <- StreamResult{Event: event}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// nextRecord returns the next raw JSON record for the configured framing,
+// or io.EOF once the underlying reader/array is exhausted.
+func (d *JSONStreamDecoder) nextRecord() ([]byte, error) {
+	switch d.framing {
+	case StreamFramingJSONSeq:
+		return d.nextJSONSeqRecord()
+	case StreamFramingArray:
+		return d.nextArrayRecord()
+	default:
+		return d.nextNDJSONRecord()
+	}
+}
+
+func (d *JSONStreamDecoder) nextNDJSONRecord() ([]byte, error) {
+	line, err := d.reader.ReadBytes('\n')
+	line = bytes.TrimSpace(line)
+	if err != nil {
+		if err == io.EOF && len(line) > 0 {
+			return line, nil
+		}
+		return nil, err
+	}
+	return line, nil
+}
+
+func (d *JSONStreamDecoder) nextJSONSeqRecord() ([]byte, error) {
+	// Skip to the next record separator, discarding anything before the
+	// first one (a stream need not start with one per RFC 7464 Section 3.2).
+	if _, err := d.reader.ReadBytes(jsonSeqRS); err != nil {
+		return nil, err
+	}
+
+	record, err := d.reader.ReadBytes(jsonSeqRS)
+	if err == io.EOF {
+		return bytes.TrimSpace(record), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Put the next record's separator back so the following call's leading
+	// ReadBytes(jsonSeqRS) finds it immediately.
+	if err := d.reader.UnreadByte(); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSpace(record[:len(record)-1]), nil
+}
+
+func (d *JSONStreamDecoder) nextArrayRecord() ([]byte, error) {
+	if !d.arrayOK {
+		tok, err := d.jsonDec.Token()
+		if err != nil {
+			return nil, err
+		}
+		if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			return nil, fmt.Errorf("json: stream does not start with a top-level array, got %v", tok)
+		}
+		d.arrayOK = true
+	}
+
+	if !d.jsonDec.More() {
+		// Consume the closing ']' so a caller reusing the reader afterward
+		// sees a clean EOF rather than a dangling token.
+		if _, err := d.jsonDec.Token(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+
+	var raw json.RawMessage
+	if err := d.jsonDec.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
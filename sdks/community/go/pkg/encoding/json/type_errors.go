@@ -0,0 +1,107 @@
+package json
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	perrors "github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/errors"
+)
+
+// decodeBestEffort decodes data into target field-by-field via reflection,
+// collecting every per-field type mismatch into a *perrors.MultiTypeError
+// instead of aborting on the first one, mirroring encoding/json's own
+// documented "decode what you can" behavior but surfacing every mismatch
+// instead of only the first (see encoding.DecodingOptions.AccumulateTypeErrors).
+// target must be a non-nil pointer to a struct.
+func decodeBestEffort(data []byte, target any) (typeErr error, fatalErr error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	var mismatches []*perrors.TypeError
+	if err := fillStruct(reflect.ValueOf(target).Elem(), raw, "", &mismatches); err != nil {
+		return nil, err
+	}
+
+	if len(mismatches) == 0 {
+		return nil, nil
+	}
+	return &perrors.MultiTypeError{Errors: mismatches}, nil
+}
+
+// fillStruct populates the exported fields of struct value rv from raw,
+// recursing into anonymous (embedded) struct/pointer-to-struct fields since
+// encoding/json flattens their JSON representation into the parent object.
+// A field whose raw value doesn't match its Go type is left at its zero
+// value and recorded in mismatches rather than aborting the rest.
+func fillStruct(rv reflect.Value, raw map[string]json.RawMessage, pathPrefix string, mismatches *[]*perrors.TypeError) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported
+		}
+
+		if field.Anonymous {
+			fv := rv.Field(i)
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					fv.Set(reflect.New(fv.Type().Elem()))
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				if err := fillStruct(fv, raw, pathPrefix, mismatches); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		name, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+
+		rawValue, ok := raw[name]
+		if !ok {
+			continue
+		}
+
+		fv := rv.Field(i)
+		if err := json.Unmarshal(rawValue, fv.Addr().Interface()); err != nil {
+			var ute *json.UnmarshalTypeError
+			if errors.As(err, &ute) {
+				*mismatches = append(*mismatches, &perrors.TypeError{
+					Path:     pathPrefix + "/" + name,
+					Expected: fv.Type().String(),
+					Found:    ute.Value,
+				})
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonFieldName returns the JSON field name field would be decoded from
+// under encoding/json's own tag rules, and whether it should be skipped
+// entirely (an explicit "-" tag).
+func jsonFieldName(field reflect.StructField) (name string, skip bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+	if tag == "" {
+		return field.Name, false
+	}
+	name, _, _ = strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	return name, false
+}
@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+)
+
+// Stats summarizes an InstrumentedEncoder's latency and payload-size
+// distribution as of the moment it was read.
+type Stats struct {
+	Count int64
+
+	LatencyP50 time.Duration
+	LatencyP90 time.Duration
+	LatencyP99 time.Duration
+
+	PayloadSizeP50 float64
+	PayloadSizeP90 float64
+	PayloadSizeP99 float64
+}
+
+// InstrumentedEncoder decorates an encoding.Encoder, recording per-call
+// encode latency and payload size into biased-quantile Estimators so
+// Stats() can report p50/p90/p99 without changing the wrapped encoder's
+// behavior. This scales with the 100-concurrent-op limit JSONEncoder
+// already enforces: bounded outstanding operations means a bounded rate of
+// inserts, and the Estimator itself is bounded regardless of how many
+// inserts it has seen.
+type InstrumentedEncoder struct {
+	encoding.Encoder
+	latency *Estimator
+	size    *Estimator
+}
+
+// NewInstrumentedEncoder wraps inner with latency/payload-size tracking at
+// the default p50/p90/p99 targets.
+func NewInstrumentedEncoder(inner encoding.Encoder) *InstrumentedEncoder {
+	return &InstrumentedEncoder{
+		Encoder: inner,
+		latency: NewEstimator(),
+		size:    NewEstimator(),
+	}
+}
+
+// Encode delegates to the wrapped encoder, recording latency and payload
+// size regardless of whether it succeeded (a failing encode's latency is
+// still useful SLO signal).
+func (e *InstrumentedEncoder) Encode(ctx context.Context, event events.Event) ([]byte, error) {
+	start := time.Now()
+	data, err := e.Encoder.Encode(ctx, event)
+	e.record(start, data)
+	return data, err
+}
+
+// EncodeMultiple delegates to the wrapped encoder, recording latency and
+// payload size for the batch as a whole.
+func (e *InstrumentedEncoder) EncodeMultiple(ctx context.Context, evts []events.Event) ([]byte, error) {
+	start := time.Now()
+	data, err := e.Encoder.EncodeMultiple(ctx, evts)
+	e.record(start, data)
+	return data, err
+}
+
+func (e *InstrumentedEncoder) record(start time.Time, data []byte) {
+	e.latency.Insert(float64(time.Since(start)))
+	e.size.Insert(float64(len(data)))
+}
+
+// Stats returns the current latency/payload-size distribution summary.
+func (e *InstrumentedEncoder) Stats() Stats {
+	return Stats{
+		Count:          e.latency.Count(),
+		LatencyP50:     time.Duration(e.latency.Query(0.5)),
+		LatencyP90:     time.Duration(e.latency.Query(0.9)),
+		LatencyP99:     time.Duration(e.latency.Query(0.99)),
+		PayloadSizeP50: e.size.Query(0.5),
+		PayloadSizeP90: e.size.Query(0.9),
+		PayloadSizeP99: e.size.Query(0.99),
+	}
+}
@@ -0,0 +1,203 @@
+// Package metrics implements low-memory streaming quantile tracking for
+// per-format encode-latency and payload-size telemetry, so an Encoder can
+// expose p50/p90/p99 SLO numbers (see InstrumentedEncoder.Stats) without
+// pulling in Prometheus as a hard dependency.
+package metrics
+
+import (
+	"math"
+	"sync"
+)
+
+// Target is one (quantile, epsilon) accuracy requirement an Estimator must
+// bound the rank error for - e.g. {0.99, 0.01} means p99 is accurate to
+// within 1% of rank.
+type Target struct {
+	Quantile float64
+	Epsilon  float64
+}
+
+// DefaultTargets returns the p50/p90/p99 targets at epsilon=0.01 that
+// InstrumentedEncoder tracks.
+func DefaultTargets() []Target {
+	return []Target{
+		{Quantile: 0.5, Epsilon: 0.01},
+		{Quantile: 0.9, Epsilon: 0.01},
+		{Quantile: 0.99, Epsilon: 0.01},
+	}
+}
+
+// sample is one (v, g, Δ) tuple in the estimator's ordered-by-value list:
+// value is the observed value, g is the number of ranks this tuple
+// represents relative to the tuple before it, and delta bounds the rank
+// uncertainty of value.
+type sample struct {
+	value float64
+	g     float64
+	delta float64
+}
+
+// compressInterval controls how often Insert triggers a compression pass;
+// compressing on every insert would make Insert O(len(samples)) instead of
+// amortized O(1).
+const compressInterval = 128
+
+// Estimator is a Cormode-Korn-Muthukrishnan biased quantiles estimator -
+// the technique behind github.com/beorn7/perks/quantile. It answers
+// Query(phi) for any configured Target while retaining only
+// O((1/epsilon) log(epsilon*n)) samples, rather than storing every
+// observation.
+type Estimator struct {
+	mu            sync.Mutex
+	targets       []Target
+	samples       []sample
+	n             float64 // total number of observations inserted
+	sinceCompress int
+}
+
+// NewEstimator creates an Estimator bounding rank error for each of
+// targets. With no targets it defaults to DefaultTargets().
+func NewEstimator(targets ...Target) *Estimator {
+	if len(targets) == 0 {
+		targets = DefaultTargets()
+	}
+	return &Estimator{targets: targets}
+}
+
+// Insert records a new observation.
+func (e *Estimator) Insert(v float64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	idx, rank := e.findInsertPos(v)
+
+	var delta float64
+	if idx > 0 && idx < len(e.samples) {
+		// Not the current min or max: bound its rank uncertainty. The
+		// min/max themselves keep delta=0 so Query can always return an
+		// exact minimum/maximum.
+		delta = e.invariant(rank)
+	}
+
+	e.samples = append(e.samples, sample{})
+	copy(e.samples[idx+1:], e.samples[idx:])
+	e.samples[idx] = sample{value: v, g: 1, delta: delta}
+	e.n++
+
+	e.sinceCompress++
+	if e.sinceCompress >= compressInterval {
+		e.compress()
+		e.sinceCompress = 0
+	}
+}
+
+// findInsertPos returns the index v should be inserted at to keep samples
+// sorted by value, and the rank (sum of g for every earlier sample) at that
+// position.
+func (e *Estimator) findInsertPos(v float64) (idx int, rank float64) {
+	lo, hi := 0, len(e.samples)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if e.samples[mid].value < v {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	for i := 0; i < lo; i++ {
+		rank += e.samples[i].g
+	}
+	return lo, rank
+}
+
+// invariant computes Δ at rank r as the floor of the tightest (smallest)
+// bound across every configured target (φ, ε): 2εr/φ when r ≤ ⌊φn⌋, else
+// 2ε(n-r)/(1-φ) - a retained sample must simultaneously satisfy all of them.
+func (e *Estimator) invariant(r float64) float64 {
+	best := math.Inf(1)
+	for _, t := range e.targets {
+		var f float64
+		if r <= math.Floor(t.Quantile*e.n) {
+			f = 2 * t.Epsilon * r / t.Quantile
+		} else {
+			f = 2 * t.Epsilon * (e.n - r) / (1 - t.Quantile)
+		}
+		if f < best {
+			best = f
+		}
+	}
+	if math.IsInf(best, 1) {
+		return 0
+	}
+	return math.Floor(best)
+}
+
+// compress merges adjacent tuples whenever doing so still satisfies the
+// invariant at the merged tuple's rank, bounding the estimator's memory
+// regardless of how many observations it has seen.
+func (e *Estimator) compress() {
+	if len(e.samples) < 2 {
+		return
+	}
+
+	rank := e.samples[0].g
+	for i := 1; i < len(e.samples)-1; {
+		merged := e.samples[i].g + e.samples[i+1].g + e.samples[i+1].delta
+		if merged <= e.invariant(rank+e.samples[i].g) {
+			e.samples[i].g = merged
+			e.samples = append(e.samples[:i+1], e.samples[i+2:]...)
+		} else {
+			rank += e.samples[i].g
+			i++
+		}
+	}
+}
+
+// Query returns the estimated value at quantile phi (e.g. 0.99 for p99),
+// or 0 if no observations have been inserted.
+func (e *Estimator) Query(phi float64) float64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if len(e.samples) == 0 {
+		return 0
+	}
+
+	threshold := math.Ceil(phi*e.n) + math.Floor(2*e.epsilonFor(phi)*e.n)/2
+
+	rank := 0.0
+	for _, s := range e.samples {
+		rank += s.g
+		if rank+s.delta > threshold {
+			return s.value
+		}
+	}
+	return e.samples[len(e.samples)-1].value
+}
+
+// epsilonFor returns the epsilon configured for phi, falling back to the
+// smallest configured epsilon if phi doesn't match a registered Target
+// exactly - Query can be called with any phi, not just the ones Insert's
+// invariant was computed for.
+func (e *Estimator) epsilonFor(phi float64) float64 {
+	best := math.Inf(1)
+	for _, t := range e.targets {
+		if t.Quantile == phi {
+			return t.Epsilon
+		}
+		if t.Epsilon < best {
+			best = t.Epsilon
+		}
+	}
+	if math.IsInf(best, 1) {
+		return 0.01
+	}
+	return best
+}
+
+// Count returns the number of observations inserted so far.
+func (e *Estimator) Count() int64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return int64(e.n)
+}
@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEstimator_UniformDistribution(t *testing.T) {
+	e := NewEstimator()
+	const n = 10000
+	for i := 1; i <= n; i++ {
+		e.Insert(float64(i))
+	}
+
+	cases := []struct {
+		phi  float64
+		want float64
+	}{
+		{0.5, n * 0.5},
+		{0.9, n * 0.9},
+		{0.99, n * 0.99},
+	}
+
+	for _, c := range cases {
+		got := e.Query(c.phi)
+		// epsilon=0.01 bounds rank error to 1% of n, and Query shifts its
+		// target rank up by another epsilon*n, so allow 2% of n on the
+		// returned value for this uniform distribution.
+		tolerance := 0.02 * n
+		if math.Abs(got-c.want) > tolerance {
+			t.Errorf("Query(%v) = %v, want within %v of %v", c.phi, got, tolerance, c.want)
+		}
+	}
+
+	if got := e.Count(); got != n {
+		t.Errorf("Count() = %d, want %d", got, n)
+	}
+}
+
+func TestEstimator_MinMaxExact(t *testing.T) {
+	e := NewEstimator()
+	values := []float64{5, 1, 9, 3, 7}
+	for _, v := range values {
+		e.Insert(v)
+	}
+
+	if got := e.Query(0); got != 1 {
+		t.Errorf("Query(0) = %v, want min 1", got)
+	}
+	if got := e.Query(1); got != 9 {
+		t.Errorf("Query(1) = %v, want max 9", got)
+	}
+}
+
+func TestEstimator_EmptyReturnsZero(t *testing.T) {
+	e := NewEstimator()
+	if got := e.Query(0.5); got != 0 {
+		t.Errorf("Query on empty estimator = %v, want 0", got)
+	}
+}
@@ -0,0 +1,80 @@
+package natsbinding
+
+import (
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/errors"
+)
+
+// JetStreamConsumerConfig configures durable, replayable consumption of a
+// JetStream stream, so an agent can resume a subscription where it left off
+// after a restart instead of only ever seeing new messages.
+type JetStreamConsumerConfig struct {
+	// StreamName is the JetStream stream to bind to.
+	StreamName string
+	// DurableName, if non-empty, names a durable consumer so the
+	// subscription's delivery position survives reconnects.
+	DurableName string
+	// DeliverPolicy selects where in the stream delivery starts. The zero
+	// value (nats.DeliverAllPolicy) replays the whole stream.
+	DeliverPolicy nats.DeliverPolicy
+	// OptStartSeq is the stream sequence to start at when DeliverPolicy is
+	// nats.DeliverByStartSequencePolicy.
+	OptStartSeq uint64
+	// OptStartTime is the time to start at when DeliverPolicy is
+	// nats.DeliverByStartTimePolicy.
+	OptStartTime time.Time
+	// AckPolicy selects how delivered messages are acknowledged. The zero
+	// value (nats.AckExplicitPolicy) requires every message to be acked.
+	AckPolicy nats.AckPolicy
+}
+
+// subOpts builds the nats.SubOpts cfg describes, for use with
+// nats.JetStreamContext.ChanSubscribe.
+func (cfg JetStreamConsumerConfig) subOpts() []nats.SubOpt {
+	opts := []nats.SubOpt{nats.BindStream(cfg.StreamName)}
+
+	switch cfg.DeliverPolicy {
+	case nats.DeliverLastPolicy:
+		opts = append(opts, nats.DeliverLast())
+	case nats.DeliverNewPolicy:
+		opts = append(opts, nats.DeliverNew())
+	case nats.DeliverByStartSequencePolicy:
+		opts = append(opts, nats.StartSequence(cfg.OptStartSeq))
+	case nats.DeliverByStartTimePolicy:
+		opts = append(opts, nats.StartTime(cfg.OptStartTime))
+	default:
+		opts = append(opts, nats.DeliverAll())
+	}
+
+	switch cfg.AckPolicy {
+	case nats.AckAllPolicy:
+		opts = append(opts, nats.AckAll())
+	case nats.AckNonePolicy:
+		opts = append(opts, nats.AckNone())
+	default:
+		opts = append(opts, nats.AckExplicit())
+	}
+
+	if cfg.DurableName != "" {
+		opts = append(opts, nats.Durable(cfg.DurableName))
+	}
+
+	return opts
+}
+
+// NewJetStreamStreamSubscriber creates a subscriber bound to a durable
+// JetStream consumer on subject, configured by cfg, using selector to pick a
+// decoder per message. Combined with ThreadPartitionedSubject, binding
+// subject to a single thread's subject preserves per-thread ordering across
+// restarts.
+func NewJetStreamStreamSubscriber(js nats.JetStreamContext, subject string, cfg JetStreamConsumerConfig, selector CodecSelector) (*NATSStreamSubscriber, error) {
+	msgs := make(chan *nats.Msg, 64)
+	sub, err := js.ChanSubscribe(subject, msgs, cfg.subOpts()...)
+	if err != nil {
+		return nil, errors.NewEncodingError(errors.CodeDecodingFailed, "failed to create JetStream channel subscription").WithOperation("new_jetstream_stream_subscriber").WithCause(err)
+	}
+	return newNATSStreamSubscriber(sub, msgs, selector), nil
+}
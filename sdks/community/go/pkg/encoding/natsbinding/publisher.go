@@ -0,0 +1,106 @@
+package natsbinding
+
+import (
+	"context"
+	"io"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+)
+
+// NATSStreamPublisher implements encoding.StreamEncoder by publishing each
+// WriteEvent as a NATS message instead of writing bytes to an io.Writer.
+// The real destination is the *nats.Conn (or JetStream context) given to
+// NewNATSStreamPublisher; the io.Writer StartStream/EncodeStream accept is
+// only there to satisfy encoding.StreamEncoder and is otherwise unused.
+type NATSStreamPublisher struct {
+	pub     publishFunc
+	codec   encoding.Codec
+	subject SubjectFunc
+}
+
+// publishFunc captures whichever connection a publisher publishes through,
+// so the same NATSStreamPublisher code works against both *nats.Conn.PublishMsg
+// and nats.JetStreamContext.PublishMsg (JetStream's Publish appends the
+// message to the stream for durable replay instead of at-most-once
+// delivery).
+type publishFunc func(*nats.Msg) error
+
+// NewNATSStreamPublisher creates a publisher that encodes events with codec
+// and publishes them on nc using subjectFunc (DefaultSubject if nil).
+func NewNATSStreamPublisher(nc *nats.Conn, codec encoding.Codec, subjectFunc SubjectFunc) *NATSStreamPublisher {
+	return newNATSStreamPublisher(nc.PublishMsg, codec, subjectFunc)
+}
+
+// NewJetStreamStreamPublisher creates a publisher that encodes events with
+// codec and publishes them through js, so published events are appended to
+// a JetStream stream instead of delivered at-most-once.
+func NewJetStreamStreamPublisher(js nats.JetStreamContext, codec encoding.Codec, subjectFunc SubjectFunc) *NATSStreamPublisher {
+	publish := func(msg *nats.Msg) error {
+		_, err := js.PublishMsg(msg)
+		return err
+	}
+	return newNATSStreamPublisher(publish, codec, subjectFunc)
+}
+
+func newNATSStreamPublisher(publish publishFunc, codec encoding.Codec, subjectFunc SubjectFunc) *NATSStreamPublisher {
+	if subjectFunc == nil {
+		subjectFunc = DefaultSubject
+	}
+	return &NATSStreamPublisher{pub: publish, codec: codec, subject: subjectFunc}
+}
+
+var _ encoding.StreamEncoder = (*NATSStreamPublisher)(nil)
+
+// ContentType returns the wrapped codec's content type.
+func (p *NATSStreamPublisher) ContentType() string { return p.codec.ContentType() }
+
+// StartStream is a no-op: the publish target is the connection given to
+// NewNATSStreamPublisher, not w.
+func (p *NATSStreamPublisher) StartStream(ctx context.Context, w io.Writer) error {
+	return nil
+}
+
+// WriteEvent encodes event with the wrapped codec and publishes it as a
+// NATS message on the subject SubjectFunc derives, with the codec's
+// ContentType copied into the HeaderContentType header.
+func (p *NATSStreamPublisher) WriteEvent(ctx context.Context, event events.Event) error {
+	data, err := p.codec.Encode(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	msg := nats.NewMsg(p.subject(event))
+	msg.Header.Set(HeaderContentType, p.codec.ContentType())
+	msg.Data = data
+
+	return p.pub(msg)
+}
+
+// EndStream is a no-op: there is no per-stream NATS resource to release.
+func (p *NATSStreamPublisher) EndStream(ctx context.Context) error {
+	return nil
+}
+
+// EncodeStream publishes each event from input until input closes or ctx is
+// cancelled.
+func (p *NATSStreamPublisher) EncodeStream(ctx context.Context, input <-chan events.Event, output io.Writer) error {
+	if err := p.StartStream(ctx, output); err != nil {
+		return err
+	}
+	for {
+		select {
+		case event, ok := <-input:
+			if !ok {
+				return p.EndStream(ctx)
+			}
+			if err := p.WriteEvent(ctx, event); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// Package natsbinding adapts an encoding.StreamCodec to publish and
+// subscribe over NATS, with optional JetStream durability, so AG-UI event
+// streams survive backend restarts and can be fanned out to multiple
+// agents without any HTTP-level glue.
+package natsbinding
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// HeaderContentType is the NATS message header carrying the publishing
+// codec's ContentType, so a NATSStreamSubscriber can pick a matching codec
+// per message via CodecSelector without a shared out-of-band schema.
+const HeaderContentType = "Ag-Ui-Content-Type"
+
+// SubjectFunc derives the NATS subject an event should publish on.
+type SubjectFunc func(event events.Event) string
+
+// DefaultSubject derives "agui.run.{runID}.{eventType}" from event, so a
+// subscriber can pattern-match a single run ("agui.run.abc123.>"), a single
+// event type across runs ("agui.run.*.RUN_ERROR"), or everything
+// ("agui.run.>").
+func DefaultSubject(event events.Event) string {
+	return fmt.Sprintf("agui.run.%s.%s", nonEmpty(event.RunID(), "unknown"), strings.ToLower(string(event.Type())))
+}
+
+// ThreadPartitionedSubject derives "agui.thread.{threadID}.{eventType}",
+// partitioning by thread instead of run so NATS/JetStream's per-subject
+// ordering guarantee keeps every event for a thread in order even across
+// multiple runs of that thread.
+func ThreadPartitionedSubject(event events.Event) string {
+	return fmt.Sprintf("agui.thread.%s.%s", nonEmpty(event.ThreadID(), "unknown"), strings.ToLower(string(event.Type())))
+}
+
+func nonEmpty(s, fallback string) string {
+	if s == "" {
+		return fallback
+	}
+	return s
+}
@@ -0,0 +1,133 @@
+package natsbinding
+
+import (
+	"context"
+	"io"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/errors"
+)
+
+// CodecSelector picks a decoder for an incoming message's content type, so a
+// NATSStreamSubscriber can decode messages published by different codecs on
+// the same subscription without a shared out-of-band schema.
+type CodecSelector func(contentType string) (encoding.Decoder, error)
+
+// SingleCodecSelector returns a CodecSelector that always selects codec,
+// ignoring the message's content type.
+func SingleCodecSelector(codec encoding.Decoder) CodecSelector {
+	return func(contentType string) (encoding.Decoder, error) {
+		return codec, nil
+	}
+}
+
+// NATSStreamSubscriber implements encoding.StreamDecoder by reading events
+// from a NATS subscription instead of an io.Reader. The real source is the
+// *nats.Subscription given to NewNATSStreamSubscriber; the io.Reader
+// StartStream/DecodeStream accept is only there to satisfy
+// encoding.StreamDecoder and is otherwise unused.
+type NATSStreamSubscriber struct {
+	sub      *nats.Subscription
+	msgs     chan *nats.Msg
+	selector CodecSelector
+	lastType string
+}
+
+// NewNATSStreamSubscriber creates a subscriber that decodes messages
+// received on subject via a NATS channel subscription on nc, using selector
+// to pick a decoder per message.
+func NewNATSStreamSubscriber(nc *nats.Conn, subject string, selector CodecSelector) (*NATSStreamSubscriber, error) {
+	msgs := make(chan *nats.Msg, 64)
+	sub, err := nc.ChanSubscribe(subject, msgs)
+	if err != nil {
+		return nil, errors.NewEncodingError(errors.CodeDecodingFailed, "failed to create NATS channel subscription").WithOperation("new_nats_stream_subscriber").WithCause(err)
+	}
+	return newNATSStreamSubscriber(sub, msgs, selector), nil
+}
+
+func newNATSStreamSubscriber(sub *nats.Subscription, msgs chan *nats.Msg, selector CodecSelector) *NATSStreamSubscriber {
+	return &NATSStreamSubscriber{sub: sub, msgs: msgs, selector: selector}
+}
+
+var _ encoding.StreamDecoder = (*NATSStreamSubscriber)(nil)
+
+// ContentType returns the content type of the most recently read message, or
+// "" before the first ReadEvent call.
+func (s *NATSStreamSubscriber) ContentType() string { return s.lastType }
+
+// StartStream is a no-op: the message source is the subscription given to
+// NewNATSStreamSubscriber, not r.
+func (s *NATSStreamSubscriber) StartStream(ctx context.Context, r io.Reader) error {
+	return nil
+}
+
+// ReadEvent blocks until the next message arrives on the subscription, ctx
+// is cancelled, or the subscription is drained/unsubscribed.
+func (s *NATSStreamSubscriber) ReadEvent(ctx context.Context) (events.Event, error) {
+	select {
+	case msg, ok := <-s.msgs:
+		if !ok {
+			return nil, io.EOF
+		}
+		return s.decode(ctx, msg)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// decode picks a decoder by content type and decodes msg, acking it on a
+// JetStream subscription with AckExplicitPolicy once decoding succeeds, or
+// nacking it on failure so JetStream redelivers it instead of silently
+// dropping an event the subscriber never processed. Ack/Nak are no-ops (and
+// their errors ignored) on a plain NATS message, which isn't bound to a
+// JetStream consumer.
+func (s *NATSStreamSubscriber) decode(ctx context.Context, msg *nats.Msg) (events.Event, error) {
+	contentType := msg.Header.Get(HeaderContentType)
+	s.lastType = contentType
+
+	decoder, err := s.selector(contentType)
+	if err != nil {
+		_ = msg.Nak()
+		return nil, errors.NewEncodingError(errors.CodeDecodingFailed, "no decoder for NATS message content type").WithOperation("decode").WithCause(err)
+	}
+
+	event, err := decoder.Decode(ctx, msg.Data)
+	if err != nil {
+		_ = msg.Nak()
+		return nil, err
+	}
+
+	_ = msg.Ack()
+	return event, nil
+}
+
+// EndStream drains the underlying NATS subscription, processing any
+// messages already in flight before unsubscribing.
+func (s *NATSStreamSubscriber) EndStream(ctx context.Context) error {
+	return s.sub.Drain()
+}
+
+// DecodeStream reads events from the subscription until ctx is cancelled or
+// the subscription ends, sending each to output.
+func (s *NATSStreamSubscriber) DecodeStream(ctx context.Context, input io.Reader, output chan<- events.Event) error {
+	if err := s.StartStream(ctx, input); err != nil {
+		return err
+	}
+	for {
+		event, err := s.ReadEvent(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		select {
+		case output <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
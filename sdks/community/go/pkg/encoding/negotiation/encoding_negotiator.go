@@ -0,0 +1,139 @@
+package negotiation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/errors"
+)
+
+// EncodingNegotiator parses Accept-Encoding headers and selects a
+// compression algorithm from those encoding.CompressionBackendFor
+// supports, mirroring ContentNegotiator's Accept-header negotiation but for
+// transport compression rather than content type.
+type EncodingNegotiator struct {
+	// supported lists algorithm names in server preference order (most
+	// preferred first).
+	supported []string
+}
+
+// NewEncodingNegotiator creates an EncodingNegotiator that offers supported
+// in server preference order. Each name is validated against
+// encoding.CompressionBackendFor.
+func NewEncodingNegotiator(supported ...string) (*EncodingNegotiator, error) {
+	for _, name := range supported {
+		if _, err := encoding.CompressionBackendFor(name); err != nil {
+			return nil, err
+		}
+	}
+	return &EncodingNegotiator{supported: supported}, nil
+}
+
+// SupportedEncodings returns the algorithms this negotiator offers, in
+// server preference order.
+func (en *EncodingNegotiator) SupportedEncodings() []string {
+	return en.supported
+}
+
+// Negotiate selects the best compression algorithm for acceptEncoding (the
+// value of an Accept-Encoding header). It returns "" when acceptEncoding is
+// empty, or when no supported algorithm is acceptable to the client -
+// either of which means the caller should fall back to no compression
+// (identity), never an error.
+func (en *EncodingNegotiator) Negotiate(acceptEncoding string) (string, error) {
+	if acceptEncoding == "" {
+		return "", nil
+	}
+
+	ranges, err := parseQualityRanges(acceptEncoding, "Accept-Encoding")
+	if err != nil {
+		return "", errors.NewEncodingError(errors.CodeNegotiationFailed, "invalid Accept-Encoding header").WithOperation("negotiate_encoding").WithCause(err)
+	}
+
+	quality := make(map[string]float64, len(ranges))
+	wildcard := -1.0
+	for _, r := range ranges {
+		if r.name == "*" {
+			wildcard = r.quality
+			continue
+		}
+		quality[r.name] = r.quality
+	}
+
+	best := ""
+	bestQuality := 0.0
+	for _, name := range en.supported {
+		q, explicit := quality[name]
+		if !explicit {
+			if wildcard < 0 {
+				continue
+			}
+			q = wildcard
+		}
+		if q <= 0 {
+			continue
+		}
+		if q > bestQuality {
+			bestQuality = q
+			best = name
+		}
+	}
+
+	return best, nil
+}
+
+// encodingRange is one comma-separated entry of a quality-weighted header
+// like Accept-Encoding or Accept-Language, e.g. "gzip;q=0.8".
+type encodingRange struct {
+	name    string
+	quality float64
+}
+
+// parseQualityRanges parses a header built from comma-separated
+// "name;q=value" ranges - Accept-Encoding and Accept-Language both use this
+// grammar, unlike Accept's "type/subtype" media ranges, so this is a
+// dedicated parser rather than a reuse of ParseAcceptHeader. headerName is
+// used only to label parse errors with the header that produced them.
+func parseQualityRanges(header, headerName string) ([]encodingRange, error) {
+	var ranges []encodingRange
+
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		segments := strings.Split(part, ";")
+		r := encodingRange{
+			name:    strings.ToLower(strings.TrimSpace(segments[0])),
+			quality: 1.0,
+		}
+		if r.name == "" {
+			return nil, errors.NewEncodingError(errors.CodeNegotiationFailed, fmt.Sprintf("empty range in %s", headerName)).WithOperation("parse_quality_ranges")
+		}
+
+		for _, segment := range segments[1:] {
+			segment = strings.TrimSpace(segment)
+			if !strings.HasPrefix(segment, "q=") {
+				continue
+			}
+
+			q, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimPrefix(segment, "q=")), 64)
+			if err != nil {
+				return nil, errors.NewEncodingError(errors.CodeNegotiationFailed, fmt.Sprintf("invalid q-value in %s", headerName)).WithOperation("parse_quality_ranges").WithCause(err)
+			}
+			if q < 0 {
+				q = 0
+			} else if q > 1 {
+				q = 1
+			}
+			r.quality = q
+		}
+
+		ranges = append(ranges, r)
+	}
+
+	return ranges, nil
+}
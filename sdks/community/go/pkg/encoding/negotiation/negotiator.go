@@ -28,6 +28,11 @@ type ContentNegotiator struct {
 	supportedTypes map[string]*TypeCapabilities
 	// preferredType is the default content type
 	preferredType string
+	// supportedLanguages lists the locale tags NegotiateAll will match
+	// Accept-Language against, in server preference order. Empty unless
+	// RegisterLanguage is called; a negotiator that doesn't localize
+	// simply never matches a language.
+	supportedLanguages []string
 	// mu protects concurrent access
 	mu sync.RWMutex
 }
@@ -46,6 +51,18 @@ type TypeCapabilities struct {
 	Extensions []string
 	// Aliases lists alternative names for this content type
 	Aliases []string
+	// PerformanceRating is a coarse benchmark tier for this format's
+	// encode/decode throughput, 0.0 (slowest) to 1.0 (fastest), used by
+	// FormatSelector.DefaultScoreFunc to prefer faster formats when
+	// SelectionCriteria.PreferPerformance is set. Zero (the default for
+	// types that don't set it) contributes no bonus.
+	PerformanceRating float64
+	// TypicalPayloadRatio is this format's expected serialized size
+	// relative to the equivalent JSON payload (e.g. 0.6 for a format that
+	// typically runs 40% smaller), used by FormatSelector.DefaultScoreFunc
+	// to reward compact formats when ClientCapabilities.MaxPayloadSize is
+	// tight. Zero (the default) is treated as parity with JSON (1.0).
+	TypicalPayloadRatio float64
 }
 
 // NewContentNegotiator creates a new content negotiator
@@ -65,22 +82,39 @@ func NewContentNegotiator(preferredType string) *ContentNegotiator {
 func (cn *ContentNegotiator) RegisterDefaultTypes() {
 	// JSON support
 	cn.RegisterType(&TypeCapabilities{
-		ContentType:        "application/json",
-		CanStream:          true,
-		CompressionSupport: []string{"gzip", "deflate"},
-		Priority:           0.9,
-		Extensions:         []string{".json"},
-		Aliases:            []string{"text/json"},
+		ContentType:         "application/json",
+		CanStream:           true,
+		CompressionSupport:  []string{"gzip", "deflate"},
+		Priority:            0.9,
+		Extensions:          []string{".json"},
+		Aliases:             []string{"text/json"},
+		PerformanceRating:   0.6,
+		TypicalPayloadRatio: 1.0,
 	})
 
 	// Protocol Buffers support
 	cn.RegisterType(&TypeCapabilities{
-		ContentType:        "application/x-protobuf",
-		CanStream:          true,
-		CompressionSupport: []string{"gzip", "snappy"},
-		Priority:           1.0,
-		Extensions:         []string{".pb", ".proto"},
-		Aliases:            []string{"application/protobuf", "application/vnd.google.protobuf"},
+		ContentType:         "application/x-protobuf",
+		CanStream:           true,
+		CompressionSupport:  []string{"gzip", "snappy"},
+		Priority:            1.0,
+		Extensions:          []string{".pb", ".proto"},
+		Aliases:             []string{"application/protobuf", "application/vnd.google.protobuf", "application/vnd.ag-ui+protobuf"},
+		PerformanceRating:   0.95,
+		TypicalPayloadRatio: 0.5,
+	})
+
+	// CBOR (RFC 8949), a binary format with real field-by-field encoding
+	// (see cbor.CBORCodec) rather than protobuf's JSON-in-envelope
+	// placeholder.
+	cn.RegisterType(&TypeCapabilities{
+		ContentType:         "application/cbor",
+		CanStream:           true,
+		CompressionSupport:  []string{"gzip"},
+		Priority:            0.95,
+		Extensions:          []string{".cbor"},
+		PerformanceRating:   0.85,
+		TypicalPayloadRatio: 0.6,
 	})
 
 	// AG-UI specific JSON variant
@@ -92,6 +126,51 @@ func (cn *ContentNegotiator) RegisterDefaultTypes() {
 		Extensions:         []string{".agui.json"},
 		Aliases:            []string{},
 	})
+
+	// Newline-delimited JSON, for pushing long event runs to a consumer
+	// incrementally instead of buffering the whole run (see
+	// json.StreamCodec).
+	cn.RegisterType(&TypeCapabilities{
+		ContentType:        "application/x-ndjson",
+		CanStream:          true,
+		CompressionSupport: []string{"gzip", "deflate"},
+		Priority:           0.9,
+		Extensions:         []string{".ndjson", ".jsonl"},
+		Aliases:            []string{"application/jsonlines", "application/jsonl"},
+	})
+
+	// RFC 7464 JSON text sequences, for consumers that frame records with a
+	// 0x1E record separator instead of newlines (see
+	// json.StreamFramingJSONSeq).
+	cn.RegisterType(&TypeCapabilities{
+		ContentType:        "application/json-seq",
+		CanStream:          true,
+		CompressionSupport: []string{"gzip", "deflate"},
+		Priority:           0.9,
+		Extensions:         []string{".json-seq"},
+	})
+
+	// CloudEvents v1.0 structured-mode JSON, for interop with CE-speaking
+	// middleware (NATS, Kafka, HTTP) without a hand-rolled translation layer
+	// (see json.CloudEventsEnvelope). CanStream is true because
+	// cloudevents.StreamCodec frames this same content type as NDJSON, one
+	// envelope per line.
+	cn.RegisterType(&TypeCapabilities{
+		ContentType:        "application/cloudevents+json",
+		CanStream:          true,
+		CompressionSupport: []string{"gzip", "deflate"},
+		Priority:           0.85,
+		Extensions:         []string{".cloudevents.json"},
+	})
+
+	// CloudEvents v1.0 structured-mode JSON batch.
+	cn.RegisterType(&TypeCapabilities{
+		ContentType:        "application/cloudevents-batch+json",
+		CanStream:          true,
+		CompressionSupport: []string{"gzip", "deflate"},
+		Priority:           0.85,
+		Extensions:         []string{".cloudevents-batch.json"},
+	})
 }
 
 // RegisterType registers a new content type with its capabilities
@@ -250,6 +329,25 @@ func (cn *ContentNegotiator) matchType(contentType string, acceptType AcceptType
 	return false, 0
 }
 
+// RegisterLanguage adds tag, in order, to the locale tags NegotiateAll
+// matches Accept-Language against. Order is server preference order: it
+// breaks ties when two registered tags match a range with equal quality.
+func (cn *ContentNegotiator) RegisterLanguage(tag string) {
+	cn.mu.Lock()
+	defer cn.mu.Unlock()
+	cn.supportedLanguages = append(cn.supportedLanguages, tag)
+}
+
+// SupportedLanguages returns the locale tags registered via
+// RegisterLanguage, in server preference order.
+func (cn *ContentNegotiator) SupportedLanguages() []string {
+	cn.mu.RLock()
+	defer cn.mu.RUnlock()
+	out := make([]string, len(cn.supportedLanguages))
+	copy(out, cn.supportedLanguages)
+	return out
+}
+
 // SupportedTypes returns a list of supported content types
 func (cn *ContentNegotiator) SupportedTypes() []string {
 	cn.mu.RLock()
@@ -0,0 +1,162 @@
+package negotiation
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/errors"
+)
+
+// NegotiateAll negotiates content type, compression, and locale from a
+// single request's headers in one pass, returning all three choices in a
+// NegotiationResult. Content type is negotiated exactly as
+// NegotiateWithDetails does; compression is then negotiated against that
+// selected type's own TypeCapabilities.CompressionSupport (not a global
+// algorithm list), so two content types with different CompressionSupport
+// can end up offering different encodings for the same Accept-Encoding
+// header; locale is negotiated independently against the negotiator's
+// registered languages.
+func (cn *ContentNegotiator) NegotiateAll(headers http.Header) (*NegotiationResult, error) {
+	result, err := cn.NegotiateWithDetails(headers.Get("Accept"))
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities, _ := cn.GetCapabilities(result.ContentType)
+	var compressionSupport []string
+	if capabilities != nil {
+		compressionSupport = capabilities.CompressionSupport
+	}
+
+	enc, err := negotiateEncoding(compressionSupport, headers.Get("Accept-Encoding"))
+	if err != nil {
+		return nil, err
+	}
+	result.Encoding = enc
+
+	result.Language = cn.negotiateLanguage(headers.Get("Accept-Language"))
+
+	return result, nil
+}
+
+// negotiateEncoding picks the best compression algorithm in supported (a
+// content type's CompressionSupport, listed in server preference order)
+// for acceptEncoding - the client's q-value is the only signal here, since
+// CompressionSupport carries no per-algorithm priority of its own. It
+// returns "" for identity (no compression) when acceptEncoding is empty,
+// nothing in supported is acceptable, or supported is empty. It returns
+// ErrNoAcceptableType only when the client has explicitly excluded identity
+// (identity;q=0, or "*;q=0" without an explicit identity override) and no
+// supported algorithm is acceptable either - the one case where falling
+// back to identity would violate RFC 7231 Section 5.3.4.
+func negotiateEncoding(supported []string, acceptEncoding string) (string, error) {
+	if acceptEncoding == "" {
+		return "", nil
+	}
+
+	ranges, err := parseQualityRanges(acceptEncoding, "Accept-Encoding")
+	if err != nil {
+		return "", errors.NewEncodingError(errors.CodeNegotiationFailed, "invalid Accept-Encoding header").WithOperation("negotiate_all").WithCause(err)
+	}
+
+	quality := make(map[string]float64, len(ranges))
+	wildcard := -1.0
+	for _, r := range ranges {
+		if r.name == "*" {
+			wildcard = r.quality
+			continue
+		}
+		quality[r.name] = r.quality
+	}
+
+	best := ""
+	bestQuality := 0.0
+	for _, name := range supported {
+		q, explicit := quality[name]
+		if !explicit {
+			if wildcard < 0 {
+				continue
+			}
+			q = wildcard
+		}
+		if q <= 0 {
+			continue
+		}
+		if q > bestQuality {
+			bestQuality = q
+			best = name
+		}
+	}
+	if best != "" {
+		return best, nil
+	}
+
+	// No supported algorithm is acceptable; fall back to identity unless
+	// the client explicitly excluded it.
+	if q, explicit := quality["identity"]; explicit && q == 0 {
+		return "", ErrNoAcceptableType
+	}
+	if _, identityListed := quality["identity"]; !identityListed && wildcard == 0 {
+		return "", ErrNoAcceptableType
+	}
+	return "", nil
+}
+
+// negotiateLanguage picks the best registered language for acceptLanguage,
+// or "" if none is registered, acceptLanguage is empty, or nothing
+// registered matches. See languageMatches for how a range matches a
+// registered tag.
+func (cn *ContentNegotiator) negotiateLanguage(acceptLanguage string) string {
+	supported := cn.SupportedLanguages()
+	if len(supported) == 0 || acceptLanguage == "" {
+		return ""
+	}
+
+	ranges, err := parseQualityRanges(acceptLanguage, "Accept-Language")
+	if err != nil {
+		return ""
+	}
+
+	best := ""
+	bestQuality := 0.0
+	for _, r := range ranges {
+		if r.quality <= 0 {
+			continue
+		}
+		for _, tag := range supported {
+			if languageMatches(tag, r.name) && r.quality > bestQuality {
+				bestQuality = r.quality
+				best = tag
+			}
+		}
+	}
+	return best
+}
+
+// languageMatches reports whether acceptRange (one range from an
+// Accept-Language header, already lowercased by parseQualityRanges) matches
+// a registered tag, per the basic filtering rules RFC 4647 Section 3.3.1
+// describes: either is a match if the other is a prefix of it down to a
+// "-" boundary (a registered "fr" matches a requested range "fr-ca", and a
+// registered "en-us" matches a requested range "en").
+func languageMatches(tag, acceptRange string) bool {
+	if acceptRange == "*" {
+		return true
+	}
+	lowerTag := strings.ToLower(tag)
+	if lowerTag == acceptRange {
+		return true
+	}
+	return strings.HasPrefix(acceptRange, lowerTag+"-") || strings.HasPrefix(lowerTag, acceptRange+"-")
+}
+
+// VaryHeaderValue is the Vary header value callers should emit alongside
+// any NegotiateAll-driven response, so caches key on every header the
+// response's representation actually varied by.
+const VaryHeaderValue = "Accept, Accept-Encoding, Accept-Language"
+
+// SetVaryHeader sets the Vary header on headers to VaryHeaderValue,
+// overwriting whatever Vary header was already present.
+func SetVaryHeader(headers http.Header) {
+	headers.Set("Vary", VaryHeaderValue)
+}
@@ -0,0 +1,98 @@
+package negotiation
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNegotiateAllSelectsEncodingFromSelectedTypeCompressionSupport(t *testing.T) {
+	cn := NewContentNegotiator("application/json")
+
+	headers := http.Header{}
+	headers.Set("Accept", "application/json")
+	headers.Set("Accept-Encoding", "br;q=1.0, gzip;q=0.5")
+
+	result, err := cn.NegotiateAll(headers)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ContentType != "application/json" {
+		t.Fatalf("expected application/json, got %q", result.ContentType)
+	}
+	// br isn't in application/json's CompressionSupport (gzip, deflate), so
+	// gzip should win even though br has the higher q-value.
+	if result.Encoding != "gzip" {
+		t.Errorf("expected gzip, got %q", result.Encoding)
+	}
+}
+
+func TestNegotiateAllFallsBackToIdentityWhenNothingSupportedMatches(t *testing.T) {
+	cn := NewContentNegotiator("application/json")
+
+	headers := http.Header{}
+	headers.Set("Accept", "application/json")
+	headers.Set("Accept-Encoding", "br;q=1.0")
+
+	result, err := cn.NegotiateAll(headers)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Encoding != "" {
+		t.Errorf("expected identity (\"\"), got %q", result.Encoding)
+	}
+}
+
+func TestNegotiateAllErrorsWhenIdentityExplicitlyExcludedAndNothingElseMatches(t *testing.T) {
+	cn := NewContentNegotiator("application/json")
+
+	headers := http.Header{}
+	headers.Set("Accept", "application/json")
+	headers.Set("Accept-Encoding", "br;q=1.0, identity;q=0")
+
+	if _, err := cn.NegotiateAll(headers); err == nil {
+		t.Error("expected an error when identity is excluded and no supported algorithm matches")
+	}
+}
+
+func TestNegotiateAllSelectsRegisteredLanguage(t *testing.T) {
+	cn := NewContentNegotiator("application/json")
+	cn.RegisterLanguage("en")
+	cn.RegisterLanguage("fr")
+
+	headers := http.Header{}
+	headers.Set("Accept", "application/json")
+	headers.Set("Accept-Language", "fr-CA;q=0.9, en;q=0.5")
+
+	result, err := cn.NegotiateAll(headers)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Language != "fr" {
+		t.Errorf("expected fr (matched via fr-CA's primary subtag), got %q", result.Language)
+	}
+}
+
+func TestNegotiateAllLanguageEmptyWhenNoneRegistered(t *testing.T) {
+	cn := NewContentNegotiator("application/json")
+
+	headers := http.Header{}
+	headers.Set("Accept", "application/json")
+	headers.Set("Accept-Language", "en;q=1.0")
+
+	result, err := cn.NegotiateAll(headers)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Language != "" {
+		t.Errorf("expected no language match, got %q", result.Language)
+	}
+}
+
+func TestSetVaryHeader(t *testing.T) {
+	headers := http.Header{}
+	SetVaryHeader(headers)
+
+	if got := headers.Get("Vary"); got != VaryHeaderValue {
+		t.Errorf("expected %q, got %q", VaryHeaderValue, got)
+	}
+}
@@ -0,0 +1,131 @@
+package negotiation
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/errors"
+)
+
+// NegotiationResult carries the outcome of NegotiateWithDetails: not just
+// the chosen content type, but which client Accept range matched it and the
+// quality value that drove the choice, so callers can log or debug why a
+// type was (or wasn't) selected.
+type NegotiationResult struct {
+	// ContentType is the selected content type.
+	ContentType string
+	// MatchedAccept is the client Accept range that matched ContentType.
+	// It is the zero value when nothing matched and PreferredType() was
+	// used as a fallback.
+	MatchedAccept AcceptType
+	// Quality is the computed server x client quality product: the
+	// server's registered Priority multiplied by the matched Accept
+	// range's q-value.
+	Quality float64
+	// Encoding is the compression algorithm NegotiateAll selected from
+	// ContentType's own CompressionSupport list, or "" for identity (no
+	// compression). Left unset by NegotiateWithDetails, which doesn't
+	// negotiate compression.
+	Encoding string
+	// Language is the locale tag NegotiateAll selected from the
+	// negotiator's registered languages, or "" if none matched or none are
+	// registered. Left unset by NegotiateWithDetails.
+	Language string
+}
+
+// NegotiateWithDetails behaves like Negotiate but, per RFC 7231 section
+// 5.3.2, also applies the specificity tiebreaker (an exact media type match
+// outranks a type/* match, which outranks */*) before falling back to
+// quality product, and reports which Accept range was matched and the
+// computed quality for debugging.
+func (cn *ContentNegotiator) NegotiateWithDetails(acceptHeader string) (*NegotiationResult, error) {
+	cn.mu.RLock()
+	defer cn.mu.RUnlock()
+
+	if len(cn.supportedTypes) == 0 {
+		return nil, ErrNoSupportedTypes
+	}
+
+	if acceptHeader == "" {
+		return &NegotiationResult{ContentType: cn.preferredType}, nil
+	}
+
+	acceptTypes, err := ParseAcceptHeader(acceptHeader)
+	if err != nil {
+		return nil, errors.NewEncodingError(errors.CodeNegotiationFailed, "invalid Accept header").WithOperation("negotiate_with_details").WithCause(err)
+	}
+
+	type candidate struct {
+		contentType string
+		accept      AcceptType
+		quality     float64
+		specificity int
+	}
+
+	var candidates []candidate
+	for contentType, capabilities := range cn.supportedTypes {
+		// Skip aliases; they're evaluated through their canonical type.
+		if contentType != capabilities.ContentType {
+			continue
+		}
+
+		var best *candidate
+		for _, acceptType := range acceptTypes {
+			matched, clientQuality := cn.matchType(contentType, acceptType)
+			if !matched || clientQuality == 0 {
+				continue
+			}
+
+			c := candidate{
+				contentType: contentType,
+				accept:      acceptType,
+				quality:     clientQuality * capabilities.Priority,
+				specificity: specificityOf(acceptType.Type),
+			}
+
+			if best == nil || c.specificity > best.specificity ||
+				(c.specificity == best.specificity && c.quality > best.quality) {
+				best = &c
+			}
+		}
+
+		if best != nil {
+			candidates = append(candidates, *best)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return &NegotiationResult{ContentType: cn.preferredType}, nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].specificity != candidates[j].specificity {
+			return candidates[i].specificity > candidates[j].specificity
+		}
+		if candidates[i].quality != candidates[j].quality {
+			return candidates[i].quality > candidates[j].quality
+		}
+		// Break remaining ties in favor of the preferred type.
+		return candidates[i].contentType == cn.preferredType
+	})
+
+	top := candidates[0]
+	return &NegotiationResult{
+		ContentType:   top.contentType,
+		MatchedAccept: top.accept,
+		Quality:       top.quality,
+	}, nil
+}
+
+// specificityOf ranks a media range by how specific it is: an exact type
+// outranks a type/* range, which outranks the */* wildcard.
+func specificityOf(acceptType string) int {
+	switch {
+	case acceptType == "*/*":
+		return 0
+	case strings.HasSuffix(acceptType, "/*"):
+		return 1
+	default:
+		return 2
+	}
+}
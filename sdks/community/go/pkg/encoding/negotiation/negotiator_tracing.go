@@ -0,0 +1,31 @@
+package negotiation
+
+import (
+	"context"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/observability/log"
+)
+
+// NegotiateWithContext behaves like Negotiate, additionally emitting a
+// log.Span covering the negotiation, annotated with the client's Accept
+// header, how many Accept-header candidates it presented, and the content
+// type ultimately selected - the fields an operator tracing a slow or
+// unexpectedly-chosen negotiation needs without reading this package's
+// source.
+func (cn *ContentNegotiator) NegotiateWithContext(ctx context.Context, acceptHeader string) (string, error) {
+	candidates := 0
+	if acceptHeader != "" {
+		if parsed, err := ParseAcceptHeader(acceptHeader); err == nil {
+			candidates = len(parsed)
+		}
+	}
+
+	_, span := log.StartSpan(ctx, "negotiation.negotiate",
+		"accept.header", acceptHeader,
+		"candidates.count", candidates,
+	)
+
+	selected, err := cn.Negotiate(acceptHeader)
+	span.End("selected.type", selected)
+	return selected, err
+}
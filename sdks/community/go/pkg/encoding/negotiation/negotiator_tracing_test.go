@@ -0,0 +1,20 @@
+package negotiation
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNegotiateWithContextMatchesNegotiate(t *testing.T) {
+	cn := NewContentNegotiator("application/json")
+
+	want, wantErr := cn.Negotiate("application/xml, application/json;q=0.9")
+	got, gotErr := cn.NegotiateWithContext(context.Background(), "application/xml, application/json;q=0.9")
+
+	if (wantErr == nil) != (gotErr == nil) {
+		t.Fatalf("error mismatch: Negotiate=%v NegotiateWithContext=%v", wantErr, gotErr)
+	}
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
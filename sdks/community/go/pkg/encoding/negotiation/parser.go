@@ -272,6 +272,31 @@ func needsQuoting(value string) bool {
 	return false
 }
 
+// MatchMediaTypeWithParams behaves like MatchMediaTypes but also compares
+// parameter maps (as returned by ParseMediaType), so a client range like
+// "application/json; profile=ag-ui-v2" can be told apart from a bare
+// "application/json" once the base types already match. It returns whether
+// the base types match at all, and separately whether every parameter in
+// acceptParams was satisfied by offeredParams (true when acceptParams is
+// empty, since an unparameterized range imposes no parameter constraint).
+func MatchMediaTypeWithParams(offeredType string, offeredParams map[string]string, acceptType string, acceptParams map[string]string) (matched bool, paramsMatched bool) {
+	if !MatchMediaTypes(offeredType, acceptType) {
+		return false, false
+	}
+
+	if len(acceptParams) == 0 {
+		return true, true
+	}
+
+	for key, value := range acceptParams {
+		if offeredParams[key] != value {
+			return true, false
+		}
+	}
+
+	return true, true
+}
+
 // MatchMediaTypes checks if two media types match (considering wildcards)
 func MatchMediaTypes(type1, type2 string) bool {
 	// Exact match
@@ -2,6 +2,7 @@ package negotiation
 
 import (
 	"sort"
+	"strings"
 )
 
 // SelectionCriteria defines the criteria for content type selection
@@ -34,6 +35,11 @@ type ClientCapabilities struct {
 type FormatSelector struct {
 	negotiator *ContentNegotiator
 	criteria   SelectionCriteria
+
+	// ScoreFunc ranks a candidate against the active criteria; higher
+	// wins. Defaults to DefaultScoreFunc. Set a custom ScoreFunc to
+	// override the built-in blend entirely.
+	ScoreFunc ScoreFunc
 }
 
 // NewFormatSelector creates a new format selector
@@ -43,6 +49,7 @@ func NewFormatSelector(negotiator *ContentNegotiator) *FormatSelector {
 		criteria: SelectionCriteria{
 			MinQuality: 0.1, // Default minimum quality
 		},
+		ScoreFunc: DefaultScoreFunc,
 	}
 }
 
@@ -158,26 +165,65 @@ func (fs *FormatSelector) checkClientCompatibility(candidate Candidate) bool {
 	return true
 }
 
-// selectByQuality selects the best candidate based on quality
+// selectByQuality selects the best candidate using fs.ScoreFunc.
 func (fs *FormatSelector) selectByQuality(candidates []Candidate) (string, error) {
-	if len(candidates) == 0 {
+	scored := fs.scoreCandidates(candidates)
+	if len(scored) == 0 {
 		return "", ErrNoAcceptableType
 	}
+	return scored[0].Candidate.ContentType, nil
+}
 
-	// Sort by quality, then server priority, then performance
-	sort.Slice(candidates, func(i, j int) bool {
-		// Quality is primary sort key
-		if candidates[i].Quality != candidates[j].Quality {
-			return candidates[i].Quality > candidates[j].Quality
-		}
-		// Server priority is secondary sort key
-		if candidates[i].Capabilities.Priority != candidates[j].Capabilities.Priority {
-			return candidates[i].Capabilities.Priority > candidates[j].Capabilities.Priority
+// scoreCandidates scores every candidate with fs.ScoreFunc (defaulting to
+// DefaultScoreFunc) and sorts them best-first.
+func (fs *FormatSelector) scoreCandidates(candidates []Candidate) []ScoredCandidate {
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	scoreFunc := fs.ScoreFunc
+	if scoreFunc == nil {
+		scoreFunc = DefaultScoreFunc
+	}
+
+	scored := make([]ScoredCandidate, len(candidates))
+	for i, c := range candidates {
+		scored[i] = ScoredCandidate{
+			Candidate: c,
+			Score:     scoreFunc(c, fs.criteria),
+			Breakdown: scoreBreakdown(c, fs.criteria),
 		}
-		return false
+	}
+
+	sort.Slice(scored, func(i, j int) bool {
+		return scored[i].Score > scored[j].Score
 	})
 
-	return candidates[0].ContentType, nil
+	return scored
+}
+
+// SelectFormatDetailed behaves like SelectFormat but returns every
+// candidate that survived filtering, scored and sorted best-first, so a
+// caller can see why one format beat the others.
+func (fs *FormatSelector) SelectFormatDetailed(acceptHeader string, criteria *SelectionCriteria) ([]ScoredCandidate, error) {
+	if criteria != nil {
+		fs.criteria = *criteria
+	}
+
+	acceptTypes, err := ParseAcceptHeader(acceptHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptTypes = fs.filterByQuality(acceptTypes)
+	candidates := fs.getCandidates(acceptTypes)
+
+	scored := fs.scoreCandidates(candidates)
+	if len(scored) == 0 {
+		return nil, ErrNoAcceptableType
+	}
+
+	return scored, nil
 }
 
 // matchType checks if a content type matches an accept type
@@ -186,6 +232,186 @@ func (fs *FormatSelector) matchType(contentType string, acceptType AcceptType) (
 	return fs.negotiator.matchType(contentType, acceptType)
 }
 
+// SelectBestMatch implements RFC 7231 §5.3.2 media-range specificity
+// selection directly over a list of offered server types, independent of
+// any ContentNegotiator registration - useful when several codecs
+// (JSON, NDJSON, protobuf, versioned profiles) need to be matched against
+// one parsed Accept header in a single call. For each offered type it finds
+// the most specific matching accept range, ranking matches as: exact
+// type+subtype+all parameters > exact type+subtype > type/* > */*, and
+// breaking ties first by the matched range's q-value, then by the range's
+// position in accept. It returns ok=false if nothing in offered is
+// acceptable.
+func SelectBestMatch(accept []AcceptType, offered []string) (chosen string, matchedQuality float64, matchedParams map[string]string, ok bool) {
+	type candidate struct {
+		offeredType string
+		accept      AcceptType
+		specificity int
+		order       int
+	}
+
+	var best *candidate
+	for _, off := range offered {
+		offeredBase, offeredParams, err := ParseMediaType(off)
+		if err != nil {
+			offeredBase, offeredParams = off, nil
+		}
+
+		for order, a := range accept {
+			if a.Quality <= 0 {
+				continue
+			}
+
+			specificity := mediaSpecificity(offeredBase, offeredParams, a)
+			if specificity < 0 {
+				continue
+			}
+
+			c := candidate{offeredType: off, accept: a, specificity: specificity, order: order}
+			if best == nil ||
+				c.specificity > best.specificity ||
+				(c.specificity == best.specificity && c.accept.Quality > best.accept.Quality) ||
+				(c.specificity == best.specificity && c.accept.Quality == best.accept.Quality && c.order < best.order) {
+				best = &c
+			}
+		}
+	}
+
+	if best == nil {
+		return "", 0, nil, false
+	}
+
+	return best.offeredType, best.accept.Quality, best.accept.Parameters, true
+}
+
+// mediaSpecificity ranks acceptType against an offered type (and its
+// parsed parameters) on the RFC 7231 §5.3.2 scale, or returns -1 if
+// acceptType doesn't match the offered type at all:
+//
+//	3 - exact type+subtype and every accept parameter is satisfied
+//	2 - exact type+subtype (no accept parameters, or they didn't match)
+//	1 - type/*
+//	0 - */*
+func mediaSpecificity(offeredBase string, offeredParams map[string]string, acceptType AcceptType) int {
+	matched, paramsMatched := MatchMediaTypeWithParams(offeredBase, offeredParams, acceptType.Type, acceptType.Parameters)
+	if !matched {
+		return -1
+	}
+
+	lowerAccept := strings.ToLower(acceptType.Type)
+	switch {
+	case lowerAccept == "*/*":
+		return 0
+	case strings.HasSuffix(lowerAccept, "/*"):
+		return 1
+	case paramsMatched && len(acceptType.Parameters) > 0:
+		return 3
+	default:
+		return 2
+	}
+}
+
+// ScoreFunc ranks a Candidate against the active SelectionCriteria; higher
+// scores win. See DefaultScoreFunc for the built-in blend.
+type ScoreFunc func(Candidate, SelectionCriteria) float64
+
+// ScoreBreakdown is the per-component contribution DefaultScoreFunc used to
+// reach a candidate's total Score, exposed via ScoredCandidate so a caller
+// can debug why one format beat another.
+type ScoreBreakdown struct {
+	// Quality is the client q-value contribution.
+	Quality float64
+	// Priority is the server-side TypeCapabilities.Priority contribution.
+	Priority float64
+	// Performance is TypeCapabilities.PerformanceRating's contribution,
+	// only applied when SelectionCriteria.PreferPerformance is set.
+	Performance float64
+	// CompressionBonus rewards a candidate whose CompressionSupport
+	// overlaps SelectionCriteria.PreferredCompression.
+	CompressionBonus float64
+	// StreamingBonus rewards a streaming-capable candidate when streaming
+	// wasn't required but the client supports it.
+	StreamingBonus float64
+	// SizePenalty is a negative contribution when the candidate's expected
+	// payload size (TypicalPayloadRatio) risks exceeding
+	// ClientCapabilities.MaxPayloadSize.
+	SizePenalty float64
+	// PreferredBonus rewards a candidate appearing in
+	// ClientCapabilities.PreferredFormats, earlier entries scoring higher.
+	PreferredBonus float64
+	// Total is the sum of every component above; DefaultScoreFunc returns
+	// this value.
+	Total float64
+}
+
+// ScoredCandidate pairs a Candidate with the score (and its breakdown) it
+// received during selection.
+type ScoredCandidate struct {
+	Candidate Candidate
+	Score     float64
+	Breakdown ScoreBreakdown
+}
+
+// DefaultScoreFunc is FormatSelector's default ScoreFunc. It blends the
+// client q-value and server priority (the original behavior) with
+// performance, compression, streaming, payload-size, and client-preference
+// signals that SelectionCriteria/ClientCapabilities already carry but the
+// original quality-then-priority sort ignored.
+func DefaultScoreFunc(c Candidate, criteria SelectionCriteria) float64 {
+	return scoreBreakdown(c, criteria).Total
+}
+
+// scoreBreakdown computes DefaultScoreFunc's component contributions.
+func scoreBreakdown(c Candidate, criteria SelectionCriteria) ScoreBreakdown {
+	b := ScoreBreakdown{
+		Quality:  c.Quality * 10,
+		Priority: c.Capabilities.Priority,
+	}
+
+	if criteria.PreferPerformance {
+		b.Performance = c.Capabilities.PerformanceRating
+	}
+
+	if len(criteria.PreferredCompression) > 0 {
+		for _, preferred := range criteria.PreferredCompression {
+			for _, supported := range c.Capabilities.CompressionSupport {
+				if preferred == supported {
+					b.CompressionBonus = 0.2
+				}
+			}
+		}
+	}
+
+	client := criteria.ClientCapabilities
+	if !criteria.RequireStreaming && client != nil && client.SupportsStreaming && c.Capabilities.CanStream {
+		b.StreamingBonus = 0.1
+	}
+
+	if client != nil && client.MaxPayloadSize > 0 {
+		ratio := c.Capabilities.TypicalPayloadRatio
+		if ratio <= 0 {
+			ratio = 1.0 // parity with JSON when a format hasn't set this
+		}
+		// A ratio approaching or exceeding 1 against a tight budget is
+		// penalized; compact formats (low ratio) incur little to none.
+		b.SizePenalty = -ratio * 0.2
+	}
+
+	if client != nil {
+		for i, preferred := range client.PreferredFormats {
+			if preferred == c.ContentType {
+				// Earlier entries score higher; caps at a handful of slots
+				// so a long list doesn't dwarf the other components.
+				b.PreferredBonus = 0.3 / float64(i+1)
+				break
+			}
+		}
+	}
+
+	b.Total = b.Quality + b.Priority + b.Performance + b.CompressionBonus + b.StreamingBonus + b.SizePenalty + b.PreferredBonus
+	return b
+}
+
 // SetCriteria updates the selection criteria
 func (fs *FormatSelector) SetCriteria(criteria SelectionCriteria) {
 	fs.criteria = criteria
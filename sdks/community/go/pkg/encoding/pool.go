@@ -2,6 +2,7 @@ package encoding
 
 import (
 	"bytes"
+	"context"
 	"sync"
 	"sync/atomic"
 )
@@ -13,6 +14,94 @@ type Pool[T any] interface {
 	Reset()
 }
 
+// ChannelPool is a Pool[T] backed by a fixed-capacity buffered channel
+// instead of sync.Pool. sync.Pool's contents can be dropped by the GC at
+// any time, which is fine when Get/Put bracket a single call but wrong
+// when a pooled object's reference outlives that scope - e.g. a streaming
+// handler that hands a buffer to a goroutine writing SSE events and only
+// calls Put once the stream closes. A ChannelPool instead holds exactly
+// capacity objects for the pool's whole lifetime: Get blocks once every
+// object is checked out instead of allocating past the cap, giving a hard
+// ceiling on outstanding objects rather than sync.Pool's best-effort reuse.
+//
+// Because objects live longer under this pattern, it is the caller's job
+// to stop using an object before calling Put - a goroutine that keeps
+// writing to a buffer after Put (or after another goroutine's Get returns
+// it) will race.
+type ChannelPool[T any] struct {
+	ch      chan T
+	newFunc func() T
+}
+
+// NewChannelPool creates a ChannelPool pre-filled with capacity objects
+// built by newFunc.
+func NewChannelPool[T any](capacity int, newFunc func() T) *ChannelPool[T] {
+	ch := make(chan T, capacity)
+	for i := 0; i < capacity; i++ {
+		ch <- newFunc()
+	}
+	return &ChannelPool[T]{ch: ch, newFunc: newFunc}
+}
+
+// Get returns a pooled object, blocking until one is returned via Put if
+// the pool is fully checked out. Prefer GetContext when the caller can't
+// afford to block indefinitely.
+func (p *ChannelPool[T]) Get() T {
+	return <-p.ch
+}
+
+// GetContext is Get with a deadline: it blocks until an object is
+// available or ctx is done, whichever comes first.
+func (p *ChannelPool[T]) GetContext(ctx context.Context) (T, error) {
+	select {
+	case v := <-p.ch:
+		return v, nil
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// TryGet is the non-blocking variant of Get: it returns (zero value, false)
+// immediately if the pool is fully checked out instead of waiting.
+func (p *ChannelPool[T]) TryGet() (T, bool) {
+	select {
+	case v := <-p.ch:
+		return v, true
+	default:
+		var zero T
+		return zero, false
+	}
+}
+
+// Put returns obj to the pool. The caller must not use obj after this
+// call - see the ChannelPool doc comment's race warning. If the pool is
+// already at capacity (Put called without a matching Get), obj is
+// discarded rather than blocking or growing the pool past its cap.
+func (p *ChannelPool[T]) Put(obj T) {
+	select {
+	case p.ch <- obj:
+	default:
+	}
+}
+
+// Reset discards every object currently held by the pool and refills it
+// back up to capacity with freshly built objects. Objects checked out via
+// Get at the time of the call are not recalled; a later Put for one of
+// them is simply discarded once the pool is back at capacity.
+func (p *ChannelPool[T]) Reset() {
+	for {
+		select {
+		case <-p.ch:
+		default:
+			for i := 0; i < cap(p.ch); i++ {
+				p.ch <- p.newFunc()
+			}
+			return
+		}
+	}
+}
+
 // BufferPool manages a pool of bytes.Buffer instances
 type BufferPool struct {
 	pool          sync.Pool
@@ -447,138 +536,100 @@ func (e *DecodingError) Reset() {
 	e.Cause = nil
 }
 
-// Global pools for common objects
+// Global pools for common objects. Each is a pluggable BufferPoolBackend /
+// SlicePoolBackend / ErrorPoolBackend (see pool_backend.go); the init()
+// in pool_backend_default.go or pool_backend_nopool.go (picked by the
+// encoding_nopool build tag) sets the initial backend, and
+// SetGlobalBufferPool and friends can swap it at runtime. Buffers and
+// slices default to a BucketedPool (see bucketed_pool.go) instead of a
+// fixed small/medium/large split, so e.g. a 6KB event doesn't share a pool
+// - and an eviction threshold - with a 900KB one.
 var (
-	// Buffer pools with different size limits and capacity limits (secure by default)
-	smallBufferPool  = NewBufferPoolWithOptions(4096, 500, true)   // 4KB max, 500 buffers, secure
-	mediumBufferPool = NewBufferPoolWithOptions(65536, 200, true)  // 64KB max, 200 buffers, secure
-	largeBufferPool  = NewBufferPoolWithOptions(1048576, 50, true) // 1MB max, 50 buffers, secure
-
-	// Slice pools for different sizes with capacity limits (secure by default)
-	smallSlicePool  = NewSlicePoolWithOptions(1024, 4096, 500, true)    // 1KB initial, 4KB max, 500 slices, secure
-	mediumSlicePool = NewSlicePoolWithOptions(4096, 65536, 200, true)   // 4KB initial, 64KB max, 200 slices, secure
-	largeSlicePool  = NewSlicePoolWithOptions(16384, 1048576, 50, true) // 16KB initial, 1MB max, 50 slices, secure
+	bufferPool BufferPoolBackend
+	slicePool  SlicePoolBackend
 
 	// Error pool
-	errorPool = NewErrorPool()
+	errorPool ErrorPoolBackend
 )
 
-// GetBuffer returns a buffer from the appropriate pool based on expected size
-// Returns nil if resource limits are exceeded
+// GetBuffer returns a buffer sized to fit at least expectedSize bytes from
+// the bucket it rounds up to.
 func GetBuffer(expectedSize int) *bytes.Buffer {
-	switch {
-	case expectedSize <= 4096:
-		return smallBufferPool.Get()
-	case expectedSize <= 65536:
-		return mediumBufferPool.Get()
-	default:
-		return largeBufferPool.Get()
-	}
+	return bufferPool.Get(expectedSize)
 }
 
 // GetBufferSafe returns a buffer from the appropriate pool or creates a new one if pool is exhausted
 func GetBufferSafe(expectedSize int) *bytes.Buffer {
-	buf := GetBuffer(expectedSize)
-	if buf == nil {
-		// Pool exhausted, create a new buffer but don't exceed reasonable limits
-		if expectedSize > 100*1024*1024 { // 100MB limit
-			return nil
-		}
-		return &bytes.Buffer{}
+	if expectedSize > 100*1024*1024 { // 100MB limit
+		return nil
 	}
-	return buf
+	return GetBuffer(expectedSize)
 }
 
-// PutBuffer returns a buffer to the appropriate pool
+// PutBuffer returns a buffer to the bucket its capacity exactly matches,
+// zeroing its contents first; a buffer whose capacity doesn't exactly
+// match a bucket boundary is discarded rather than pooled (see
+// BucketedPool.Put).
 func PutBuffer(buf *bytes.Buffer) {
 	if buf == nil {
 		return
 	}
-
-	// The individual pool's Put method will handle zeroing
-	switch {
-	case buf.Cap() <= 4096:
-		smallBufferPool.Put(buf)
-	case buf.Cap() <= 65536:
-		mediumBufferPool.Put(buf)
-	default:
-		largeBufferPool.Put(buf)
-	}
+	bufferPool.Put(buf)
 }
 
-// PutBufferSecure returns a buffer to the appropriate pool with secure zeroing
+// PutBufferSecure is an alias for PutBuffer: BucketedPool always zeroes an
+// object's contents before pooling it, so there is no separate "insecure"
+// path left to opt out of.
 func PutBufferSecure(buf *bytes.Buffer) {
-	if buf == nil {
-		return
-	}
-
-	switch {
-	case buf.Cap() <= 4096:
-		smallBufferPool.PutSecure(buf)
-	case buf.Cap() <= 65536:
-		mediumBufferPool.PutSecure(buf)
-	default:
-		largeBufferPool.PutSecure(buf)
-	}
+	PutBuffer(buf)
 }
 
-// GetSlice returns a slice from the appropriate pool based on expected size
-// Returns nil if resource limits are exceeded
+// GetSlice returns a slice sized to fit at least expectedSize bytes from
+// the bucket it rounds up to.
 func GetSlice(expectedSize int) []byte {
-	switch {
-	case expectedSize <= 4096:
-		return smallSlicePool.Get()
-	case expectedSize <= 65536:
-		return mediumSlicePool.Get()
-	default:
-		return largeSlicePool.Get()
-	}
+	return slicePool.Get(expectedSize)
 }
 
 // GetSliceSafe returns a slice from the appropriate pool or creates a new one if pool is exhausted
 func GetSliceSafe(expectedSize int) []byte {
-	slice := GetSlice(expectedSize)
-	if slice == nil {
-		// Pool exhausted, create a new slice but don't exceed reasonable limits
-		if expectedSize > 100*1024*1024 { // 100MB limit
-			return nil
-		}
-		return make([]byte, 0, expectedSize)
+	if expectedSize > 100*1024*1024 { // 100MB limit
+		return nil
 	}
-	return slice
+	return GetSlice(expectedSize)
 }
 
-// PutSlice returns a slice to the appropriate pool
+// PutSlice returns slice to the bucket its capacity exactly matches,
+// zeroing its contents first; see PutBuffer.
 func PutSlice(slice []byte) {
 	if slice == nil {
 		return
 	}
-
-	// The individual pool's Put method will handle zeroing
-	switch {
-	case cap(slice) <= 4096:
-		smallSlicePool.Put(slice)
-	case cap(slice) <= 65536:
-		mediumSlicePool.Put(slice)
-	default:
-		largeSlicePool.Put(slice)
-	}
+	slicePool.Put(slice)
 }
 
-// PutSliceSecure returns a slice to the appropriate pool with secure zeroing
+// PutSliceSecure is an alias for PutSlice; see PutBufferSecure.
 func PutSliceSecure(slice []byte) {
-	if slice == nil {
-		return
+	PutSlice(slice)
+}
+
+// BufferPoolStats returns a Stats snapshot of the package-level buffer
+// pool's buckets, in ascending boundary order, or nil if the current
+// backend isn't bucketed (e.g. after SetGlobalBufferPool(NewNopBufferPool())).
+func BufferPoolStats() []BucketStats {
+	if bp, ok := bufferPool.(interface{ Stats() []BucketStats }); ok {
+		return bp.Stats()
 	}
+	return nil
+}
 
-	switch {
-	case cap(slice) <= 4096:
-		smallSlicePool.PutSecure(slice)
-	case cap(slice) <= 65536:
-		mediumSlicePool.PutSecure(slice)
-	default:
-		largeSlicePool.PutSecure(slice)
+// SlicePoolStats returns a Stats snapshot of the package-level slice pool's
+// buckets, in ascending boundary order, or nil if the current backend isn't
+// bucketed; see BufferPoolStats.
+func SlicePoolStats() []BucketStats {
+	if sp, ok := slicePool.(interface{ Stats() []BucketStats }); ok {
+		return sp.Stats()
 	}
+	return nil
 }
 
 // GetEncodingError returns an encoding error from the pool
@@ -653,12 +704,8 @@ func PutRegistryError(err *RegistryError) {
 
 // ResetAllPools resets all global pools
 func ResetAllPools() {
-	smallBufferPool.Reset()
-	mediumBufferPool.Reset()
-	largeBufferPool.Reset()
-	smallSlicePool.Reset()
-	mediumSlicePool.Reset()
-	largeSlicePool.Reset()
+	bufferPool.Reset()
+	slicePool.Reset()
 	errorPool.Reset()
 }
 
@@ -666,6 +713,13 @@ func ResetAllPools() {
 type PoolManager struct {
 	pools map[string]interface{}
 	mu    sync.RWMutex
+
+	// hwMu guards highWater and reaperEvictionsByName, both populated only
+	// once Start's reaper goroutine is running.
+	hwMu                  sync.RWMutex
+	highWater             map[string]int64
+	reaperEvictionsByName map[string]int64
+	reaperEvictions       int64
 }
 
 // NewPoolManager creates a new pool manager
@@ -0,0 +1,176 @@
+package encoding
+
+import (
+	"bytes"
+	"time"
+)
+
+// BufferPoolBackend is the pluggable backend behind GetBuffer/PutBuffer and
+// friends. The default backend is a BucketedPool (see bucketed_pool.go);
+// SetGlobalBufferPool swaps in a different one, e.g. NewNopBufferPool for
+// A/B benchmarking pool wins/losses or for catching use-after-Put bugs in
+// tests, mirroring grpc-go's swappableBufferPool/NopBufferPool design.
+type BufferPoolBackend interface {
+	Get(expectedSize int) *bytes.Buffer
+	Put(buf *bytes.Buffer)
+	Reset()
+}
+
+// SlicePoolBackend is the BufferPoolBackend equivalent for GetSlice/PutSlice.
+type SlicePoolBackend interface {
+	Get(expectedSize int) []byte
+	Put(slice []byte)
+	Reset()
+}
+
+// ErrorPoolBackend is the pluggable backend behind GetEncodingError and the
+// rest of the package-level typed error pool accessors. *ErrorPool already
+// satisfies this interface; NewNopErrorPool provides a non-pooling
+// alternative.
+type ErrorPoolBackend interface {
+	GetEncodingError() *EncodingError
+	PutEncodingError(*EncodingError)
+	GetDecodingError() *DecodingError
+	PutDecodingError(*DecodingError)
+	GetOperationError() *OperationError
+	PutOperationError(*OperationError)
+	GetValidationError() *ValidationError
+	PutValidationError(*ValidationError)
+	GetConfigurationError() *ConfigurationError
+	PutConfigurationError(*ConfigurationError)
+	GetResourceError() *ResourceError
+	PutResourceError(*ResourceError)
+	GetRegistryError() *RegistryError
+	PutRegistryError(*RegistryError)
+	Reset()
+}
+
+// bucketedBufferPool adapts a *BucketedPool (which traffics in interface{})
+// to the typed BufferPoolBackend surface.
+type bucketedBufferPool struct {
+	p *BucketedPool
+}
+
+func (b *bucketedBufferPool) Get(expectedSize int) *bytes.Buffer {
+	return b.p.Get(expectedSize).(*bytes.Buffer)
+}
+
+func (b *bucketedBufferPool) Put(buf *bytes.Buffer) {
+	if buf == nil {
+		return
+	}
+	b.p.Put(buf)
+}
+
+func (b *bucketedBufferPool) Reset() { b.p.Reset() }
+
+// Stats returns the underlying BucketedPool's per-bucket counters.
+func (b *bucketedBufferPool) Stats() []BucketStats { return b.p.Stats() }
+
+// LiveBytes, LastUsed, and CumulativeStats delegate to the underlying
+// BucketedPool, making bucketedBufferPool satisfy Reapable and
+// cumulativeStatsPool for PoolManager's reaper and Metrics.
+func (b *bucketedBufferPool) LiveBytes() int64    { return b.p.LiveBytes() }
+func (b *bucketedBufferPool) LastUsed() time.Time { return b.p.LastUsed() }
+func (b *bucketedBufferPool) CumulativeStats() (gets, puts, evictions, liveBytes int64) {
+	return b.p.CumulativeStats()
+}
+
+// bucketedSlicePool is the SlicePoolBackend equivalent of bucketedBufferPool.
+type bucketedSlicePool struct {
+	p *BucketedPool
+}
+
+func (s *bucketedSlicePool) Get(expectedSize int) []byte {
+	return s.p.Get(expectedSize).([]byte)
+}
+
+func (s *bucketedSlicePool) Put(slice []byte) {
+	if slice == nil {
+		return
+	}
+	s.p.Put(slice)
+}
+
+func (s *bucketedSlicePool) Reset() { s.p.Reset() }
+
+// Stats returns the underlying BucketedPool's per-bucket counters.
+func (s *bucketedSlicePool) Stats() []BucketStats { return s.p.Stats() }
+
+// LiveBytes, LastUsed, and CumulativeStats delegate to the underlying
+// BucketedPool; see bucketedBufferPool.
+func (s *bucketedSlicePool) LiveBytes() int64    { return s.p.LiveBytes() }
+func (s *bucketedSlicePool) LastUsed() time.Time { return s.p.LastUsed() }
+func (s *bucketedSlicePool) CumulativeStats() (gets, puts, evictions, liveBytes int64) {
+	return s.p.CumulativeStats()
+}
+
+// NopBufferPool is a BufferPoolBackend that allocates a fresh buffer on
+// every Get and drops every Put on the floor. Swapping it in via
+// SetGlobalBufferPool turns every package-level buffer pooling call site
+// into a plain allocation without editing any of them - useful for
+// measuring whether pooling is actually paying for itself in a given
+// workload, or for making a use-after-Put bug reproduce reliably (a pooled
+// buffer handed back out masks the bug; a fresh one doesn't).
+type NopBufferPool struct{}
+
+// NewNopBufferPool creates a NopBufferPool.
+func NewNopBufferPool() *NopBufferPool { return &NopBufferPool{} }
+
+func (*NopBufferPool) Get(expectedSize int) *bytes.Buffer {
+	return bytes.NewBuffer(make([]byte, 0, expectedSize))
+}
+
+func (*NopBufferPool) Put(*bytes.Buffer) {}
+
+func (*NopBufferPool) Reset() {}
+
+// NopSlicePool is the NopBufferPool equivalent for SlicePoolBackend.
+type NopSlicePool struct{}
+
+// NewNopSlicePool creates a NopSlicePool.
+func NewNopSlicePool() *NopSlicePool { return &NopSlicePool{} }
+
+func (*NopSlicePool) Get(expectedSize int) []byte { return make([]byte, 0, expectedSize) }
+
+func (*NopSlicePool) Put([]byte) {}
+
+func (*NopSlicePool) Reset() {}
+
+// NopErrorPool is an ErrorPoolBackend that allocates a fresh error of the
+// requested type on every Get and drops every Put on the floor; see
+// NopBufferPool.
+type NopErrorPool struct{}
+
+// NewNopErrorPool creates a NopErrorPool.
+func NewNopErrorPool() *NopErrorPool { return &NopErrorPool{} }
+
+func (*NopErrorPool) GetEncodingError() *EncodingError           { return &EncodingError{} }
+func (*NopErrorPool) PutEncodingError(*EncodingError)            {}
+func (*NopErrorPool) GetDecodingError() *DecodingError           { return &DecodingError{} }
+func (*NopErrorPool) PutDecodingError(*DecodingError)            {}
+func (*NopErrorPool) GetOperationError() *OperationError         { return &OperationError{} }
+func (*NopErrorPool) PutOperationError(*OperationError)          {}
+func (*NopErrorPool) GetValidationError() *ValidationError       { return &ValidationError{} }
+func (*NopErrorPool) PutValidationError(*ValidationError)        {}
+func (*NopErrorPool) GetConfigurationError() *ConfigurationError { return &ConfigurationError{} }
+func (*NopErrorPool) PutConfigurationError(*ConfigurationError)  {}
+func (*NopErrorPool) GetResourceError() *ResourceError           { return &ResourceError{} }
+func (*NopErrorPool) PutResourceError(*ResourceError)            {}
+func (*NopErrorPool) GetRegistryError() *RegistryError           { return &RegistryError{} }
+func (*NopErrorPool) PutRegistryError(*RegistryError)            {}
+func (*NopErrorPool) Reset()                                     {}
+
+// SetGlobalBufferPool swaps the backend behind GetBuffer/PutBuffer and
+// friends. Safe to call before any concurrent use of the package-level
+// buffer pool begins; it is not safe to call concurrently with GetBuffer or
+// PutBuffer.
+func SetGlobalBufferPool(p BufferPoolBackend) { bufferPool = p }
+
+// SetGlobalSlicePool swaps the backend behind GetSlice/PutSlice and
+// friends; see SetGlobalBufferPool.
+func SetGlobalSlicePool(p SlicePoolBackend) { slicePool = p }
+
+// SetGlobalErrorPool swaps the backend behind GetEncodingError and the rest
+// of the typed error pool accessors; see SetGlobalBufferPool.
+func SetGlobalErrorPool(p ErrorPoolBackend) { errorPool = p }
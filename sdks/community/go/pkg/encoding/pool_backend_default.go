@@ -0,0 +1,13 @@
+//go:build !encoding_nopool
+
+package encoding
+
+// By default the package-level buffer, slice, and error pools are backed by
+// real pooling (BucketedPool / ErrorPool). Build with -tags encoding_nopool
+// to flip every default to a Nop*Pool instead, without touching a call
+// site; see pool_backend_nopool.go.
+func init() {
+	bufferPool = &bucketedBufferPool{p: newBufferBucketedPool()}
+	slicePool = &bucketedSlicePool{p: newSliceBucketedPool()}
+	errorPool = NewErrorPool()
+}
@@ -0,0 +1,14 @@
+//go:build encoding_nopool
+
+package encoding
+
+// With the encoding_nopool build tag set, every package-level pool defaults
+// to a Nop*Pool: every Get allocates fresh and every Put is dropped. Useful
+// for A/B benchmarking pooling's actual win on a workload, or for making a
+// use-after-Put bug reproduce reliably in tests, without editing any call
+// site; see pool_backend_default.go for the normal default.
+func init() {
+	bufferPool = NewNopBufferPool()
+	slicePool = NewNopSlicePool()
+	errorPool = NewNopErrorPool()
+}
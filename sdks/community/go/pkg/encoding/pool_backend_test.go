@@ -0,0 +1,47 @@
+package encoding
+
+import "testing"
+
+func TestNopBufferPoolNeverReturnsAPooledObject(t *testing.T) {
+	p := NewNopBufferPool()
+
+	buf := p.Get(64)
+	buf.WriteString("sensitive")
+	p.Put(buf)
+
+	again := p.Get(64)
+	if again.Len() != 0 {
+		t.Errorf("expected a fresh buffer from NopBufferPool, got one carrying %q", again.String())
+	}
+	if again == buf {
+		t.Error("expected NopBufferPool.Get to never return a Put object")
+	}
+}
+
+func TestSetGlobalBufferPoolSwapsTheBackend(t *testing.T) {
+	original := bufferPool
+	defer func() { bufferPool = original }()
+
+	SetGlobalBufferPool(NewNopBufferPool())
+
+	buf := GetBuffer(128)
+	buf.WriteString("data")
+	PutBuffer(buf)
+
+	if stats := BufferPoolStats(); stats != nil {
+		t.Errorf("expected BufferPoolStats to report nil for a non-bucketed backend, got %+v", stats)
+	}
+}
+
+func TestNopErrorPoolAllocatesFreshAndDropsPut(t *testing.T) {
+	p := NewNopErrorPool()
+
+	err := p.GetEncodingError()
+	err.Message = "boom"
+	p.PutEncodingError(err)
+
+	again := p.GetEncodingError()
+	if again.Message != "" {
+		t.Errorf("expected a fresh EncodingError from NopErrorPool, got message %q", again.Message)
+	}
+}
@@ -0,0 +1,225 @@
+package encoding
+
+import (
+	"context"
+	"math"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// Reapable is implemented by pools PoolManager's reaper can inspect and
+// evict from. BucketedPool satisfies it; a pool registered with
+// PoolManager that doesn't implement it is tracked by name but never
+// reaped or reported on by Metrics.
+type Reapable interface {
+	// LiveBytes estimates the pool's current footprint in bytes.
+	LiveBytes() int64
+	// LastUsed returns the time of the pool's most recent Get or Put.
+	LastUsed() time.Time
+	// Reset discards every object the pool is currently holding.
+	Reset()
+}
+
+// cumulativeStatsPool is implemented by Reapable pools that also track
+// lifetime Get/Put/eviction counts; BucketedPool does. A Reapable that
+// doesn't implement it still gets reaped, just without gets/puts/evictions
+// in its Metrics entry.
+type cumulativeStatsPool interface {
+	CumulativeStats() (gets, puts, evictions, liveBytes int64)
+}
+
+// ReaperOptions configures PoolManager.Start.
+type ReaperOptions struct {
+	// Interval is how often the reaper inspects registered pools. Defaults
+	// to one minute if zero or negative.
+	Interval time.Duration
+	// IdleTTL is how long a pool may go without a Get or Put before the
+	// reaper calls Reset on it. Zero disables idle eviction.
+	IdleTTL time.Duration
+	// TargetLiveBytes is a soft cap on the combined LiveBytes of every
+	// registered Reapable pool. When exceeded, the reaper resets pools
+	// largest-LiveBytes-first until back under the target. Zero disables
+	// this check (memory-pressure-driven eviction via runtime.MemStats
+	// still applies regardless).
+	TargetLiveBytes int64
+}
+
+// PoolMetrics is one named pool's Prometheus-style counters, as returned
+// by PoolManager.Metrics.
+type PoolMetrics struct {
+	// GetsTotal is the pool_gets_total counter: cumulative successful Get calls.
+	GetsTotal int64
+	// PutsTotal is the pool_puts_total counter: cumulative Put calls, accepted or not.
+	PutsTotal int64
+	// EvictionsTotal is the pool_evictions_total counter: cumulative Put
+	// calls the pool declined to pool, plus every reaper-triggered Reset.
+	EvictionsTotal int64
+	// LiveBytes is the pool_live_bytes gauge: the pool's estimated current footprint.
+	LiveBytes int64
+	// HighWaterBytes is the largest LiveBytes the reaper has observed for
+	// this pool since it was registered.
+	HighWaterBytes int64
+}
+
+// Start launches a background goroutine that periodically inspects every
+// pool registered with RegisterPool, evicting ones that have been idle
+// longer than opts.IdleTTL and, under memory pressure, resetting the
+// largest pools to relieve it. The goroutine exits when ctx is done; Start
+// must not be called again on the same PoolManager until that happens.
+func (pm *PoolManager) Start(ctx context.Context, opts ReaperOptions) {
+	if opts.Interval <= 0 {
+		opts.Interval = time.Minute
+	}
+
+	go func() {
+		ticker := time.NewTicker(opts.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				pm.reapOnce(opts)
+			}
+		}
+	}()
+}
+
+// reapOnce runs one reaper pass: idle eviction, then memory-pressure
+// eviction if the combined live footprint warrants it.
+func (pm *PoolManager) reapOnce(opts ReaperOptions) {
+	pm.mu.RLock()
+	named := make(map[string]Reapable, len(pm.pools))
+	for name, p := range pm.pools {
+		if r, ok := p.(Reapable); ok {
+			named[name] = r
+		}
+	}
+	pm.mu.RUnlock()
+
+	var totalLive int64
+	for name, r := range named {
+		live := r.LiveBytes()
+		totalLive += live
+		pm.recordHighWater(name, live)
+
+		if opts.IdleTTL > 0 && time.Since(r.LastUsed()) > opts.IdleTTL {
+			r.Reset()
+			atomic.AddInt64(&pm.reaperEvictions, 1)
+			pm.recordReaperEviction(name)
+		}
+	}
+
+	if opts.TargetLiveBytes > 0 && totalLive > opts.TargetLiveBytes {
+		pm.evictLargestUntil(named, opts.TargetLiveBytes)
+	} else if memoryUnderPressure() {
+		pm.evictLargestUntil(named, 0)
+	}
+}
+
+// evictLargestUntil resets the largest-LiveBytes pools in named, one at a
+// time, until the combined remaining LiveBytes is at or below target (0
+// meaning "reset the single largest pool", the proactive response to
+// memory pressure when no explicit target is configured).
+func (pm *PoolManager) evictLargestUntil(named map[string]Reapable, target int64) {
+	type entry struct {
+		name string
+		pool Reapable
+		live int64
+	}
+	entries := make([]entry, 0, len(named))
+	var total int64
+	for name, r := range named {
+		live := r.LiveBytes()
+		entries = append(entries, entry{name, r, live})
+		total += live
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].live > entries[j].live })
+
+	for _, e := range entries {
+		if total <= target {
+			break
+		}
+		e.pool.Reset()
+		atomic.AddInt64(&pm.reaperEvictions, 1)
+		pm.recordReaperEviction(e.name)
+		total -= e.live
+		if target == 0 {
+			break
+		}
+	}
+}
+
+// memoryUnderPressure reports whether the process is close to its
+// configured soft memory limit (GOMEMLIMIT / debug.SetMemoryLimit). It
+// returns false if no limit has been configured, since "close to an
+// unbounded limit" is meaningless.
+func memoryUnderPressure() bool {
+	limit := debug.SetMemoryLimit(-1) // query without changing the limit
+	if limit <= 0 || limit == math.MaxInt64 {
+		return false
+	}
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return int64(stats.HeapAlloc) > limit*9/10
+}
+
+// recordHighWater updates name's high-water LiveBytes mark if live exceeds
+// the previously recorded value.
+func (pm *PoolManager) recordHighWater(name string, live int64) {
+	pm.hwMu.Lock()
+	defer pm.hwMu.Unlock()
+	if pm.highWater == nil {
+		pm.highWater = make(map[string]int64)
+	}
+	if live > pm.highWater[name] {
+		pm.highWater[name] = live
+	}
+}
+
+func (pm *PoolManager) recordReaperEviction(name string) {
+	pm.hwMu.Lock()
+	defer pm.hwMu.Unlock()
+	if pm.reaperEvictionsByName == nil {
+		pm.reaperEvictionsByName = make(map[string]int64)
+	}
+	pm.reaperEvictionsByName[name]++
+}
+
+// Metrics returns a Prometheus-style snapshot of every registered pool
+// that implements Reapable: pool_gets_total, pool_puts_total,
+// pool_evictions_total, and the pool_live_bytes gauge, keyed by the name
+// it was registered under.
+func (pm *PoolManager) Metrics() map[string]PoolMetrics {
+	pm.mu.RLock()
+	pools := make(map[string]interface{}, len(pm.pools))
+	for name, p := range pm.pools {
+		pools[name] = p
+	}
+	pm.mu.RUnlock()
+
+	out := make(map[string]PoolMetrics, len(pools))
+	for name, p := range pools {
+		r, ok := p.(Reapable)
+		if !ok {
+			continue
+		}
+
+		m := PoolMetrics{LiveBytes: r.LiveBytes()}
+		if cs, ok := p.(cumulativeStatsPool); ok {
+			gets, puts, evictions, liveBytes := cs.CumulativeStats()
+			m.GetsTotal, m.PutsTotal, m.EvictionsTotal, m.LiveBytes = gets, puts, evictions, liveBytes
+		}
+
+		pm.hwMu.RLock()
+		m.HighWaterBytes = pm.highWater[name]
+		m.EvictionsTotal += pm.reaperEvictionsByName[name]
+		pm.hwMu.RUnlock()
+
+		out[name] = m
+	}
+	return out
+}
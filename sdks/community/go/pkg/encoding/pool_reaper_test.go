@@ -0,0 +1,72 @@
+package encoding
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestPoolManagerIdleEvictionResetsAStalePool(t *testing.T) {
+	pm := NewPoolManager()
+	bp := &bucketedBufferPool{p: newBufferBucketedPool()}
+	pm.RegisterPool("buffers", bp)
+
+	buf := bp.Get(100)
+	bp.Put(buf)
+	if bp.p.LiveBytes() == 0 {
+		t.Fatal("expected a live buffer after Put")
+	}
+
+	pm.reapOnce(ReaperOptions{IdleTTL: time.Nanosecond})
+	time.Sleep(time.Millisecond)
+	pm.reapOnce(ReaperOptions{IdleTTL: time.Nanosecond})
+
+	if bp.p.LiveBytes() != 0 {
+		t.Errorf("expected idle eviction to reset the pool, still has %d live bytes", bp.p.LiveBytes())
+	}
+}
+
+func TestPoolManagerMetricsReportsRegisteredPools(t *testing.T) {
+	pm := NewPoolManager()
+	bp := &bucketedBufferPool{p: newBufferBucketedPool()}
+	pm.RegisterPool("buffers", bp)
+
+	buf := bp.Get(100)
+	bp.Put(buf)
+
+	metrics := pm.Metrics()
+	m, ok := metrics["buffers"]
+	if !ok {
+		t.Fatal("expected a metrics entry for the registered \"buffers\" pool")
+	}
+	if m.GetsTotal != 1 || m.PutsTotal != 1 {
+		t.Errorf("expected 1 get and 1 put, got %+v", m)
+	}
+	if m.LiveBytes == 0 {
+		t.Errorf("expected non-zero live bytes after a Put, got %+v", m)
+	}
+}
+
+func TestPoolManagerStartStopsOnContextCancel(t *testing.T) {
+	pm := NewPoolManager()
+	bp := &bucketedBufferPool{p: newBufferBucketedPool()}
+	pm.RegisterPool("buffers", bp)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pm.Start(ctx, ReaperOptions{Interval: time.Millisecond, IdleTTL: time.Nanosecond})
+
+	buf := bp.Get(100)
+	bp.Put(buf)
+
+	deadline := time.After(time.Second)
+	for bp.p.LiveBytes() != 0 {
+		select {
+		case <-deadline:
+			cancel()
+			t.Fatal("expected the background reaper to idle-evict the pool")
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+	cancel()
+}
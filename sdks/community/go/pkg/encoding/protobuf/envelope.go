@@ -0,0 +1,66 @@
+package protobuf
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// unwrapEnvelope reads field 1 (bytes, wire type 2) from the minimal
+// envelope events.ToProtoEnvelope writes and returns its contents - the
+// event's JSON form.
+func unwrapEnvelope(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty protobuf message")
+	}
+
+	tag, n, err := readVarint(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid field tag: %w", err)
+	}
+	rest := data[n:]
+
+	fieldNum := tag >> 3
+	wireType := tag & 0x7
+	if fieldNum != 1 || wireType != 2 {
+		return nil, fmt.Errorf("unexpected field tag %d (field %d, wire type %d), want field 1 wire type 2", tag, fieldNum, wireType)
+	}
+
+	length, n, err := readVarint(rest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid field length: %w", err)
+	}
+	rest = rest[n:]
+
+	if uint64(len(rest)) < length {
+		return nil, fmt.Errorf("truncated field: expected %d bytes, have %d", length, len(rest))
+	}
+
+	return rest[:length], nil
+}
+
+// writeVarint writes v as a protobuf-style base-128 varint to buf.
+func writeVarint(buf *bytes.Buffer, v uint64) {
+	for v >= 0x80 {
+		buf.WriteByte(byte(v) | 0x80)
+		v >>= 7
+	}
+	buf.WriteByte(byte(v))
+}
+
+// readVarint reads a base-128 varint from the start of data, returning the
+// decoded value and the number of bytes consumed.
+func readVarint(data []byte) (uint64, int, error) {
+	var result uint64
+	var shift uint
+	for i, b := range data {
+		if i >= 10 {
+			return 0, 0, fmt.Errorf("varint too long")
+		}
+		result |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return result, i + 1, nil
+		}
+		shift += 7
+	}
+	return 0, 0, fmt.Errorf("unexpected end of input reading varint")
+}
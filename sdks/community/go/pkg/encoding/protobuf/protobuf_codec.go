@@ -0,0 +1,75 @@
+package protobuf
+
+import (
+	"context"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+)
+
+// ProtobufCodec composes ProtobufEncoder and ProtobufDecoder into the
+// Codec interface, mirroring json.JSONCodec.
+type ProtobufCodec struct {
+	*ProtobufEncoder
+	*ProtobufDecoder
+}
+
+// Ensure ProtobufCodec implements the core interfaces
+var (
+	_ encoding.Encoder             = (*ProtobufCodec)(nil)
+	_ encoding.Decoder             = (*ProtobufCodec)(nil)
+	_ encoding.ContentTypeProvider = (*ProtobufCodec)(nil)
+	_ encoding.Codec               = (*ProtobufCodec)(nil)
+)
+
+// NewProtobufCodec creates a new protobuf codec with the given options
+func NewProtobufCodec(encOptions *encoding.EncodingOptions, decOptions *encoding.DecodingOptions) *ProtobufCodec {
+	return &ProtobufCodec{
+		ProtobufEncoder: NewProtobufEncoder(encOptions),
+		ProtobufDecoder: NewProtobufDecoder(decOptions),
+	}
+}
+
+// NewDefaultProtobufCodec creates a new protobuf codec with default options
+func NewDefaultProtobufCodec() *ProtobufCodec {
+	return NewProtobufCodec(
+		&encoding.EncodingOptions{
+			CrossSDKCompatibility: true,
+			ValidateOutput:        true,
+		},
+		&encoding.DecodingOptions{
+			Strict:         true,
+			ValidateEvents: true,
+		},
+	)
+}
+
+// Encode delegates to the encoder
+func (c *ProtobufCodec) Encode(ctx context.Context, event events.Event) ([]byte, error) {
+	return c.ProtobufEncoder.Encode(ctx, event)
+}
+
+// EncodeMultiple delegates to the encoder
+func (c *ProtobufCodec) EncodeMultiple(ctx context.Context, evts []events.Event) ([]byte, error) {
+	return c.ProtobufEncoder.EncodeMultiple(ctx, evts)
+}
+
+// Decode delegates to the decoder
+func (c *ProtobufCodec) Decode(ctx context.Context, data []byte) (events.Event, error) {
+	return c.ProtobufDecoder.Decode(ctx, data)
+}
+
+// DecodeMultiple delegates to the decoder
+func (c *ProtobufCodec) DecodeMultiple(ctx context.Context, data []byte) ([]events.Event, error) {
+	return c.ProtobufDecoder.DecodeMultiple(ctx, data)
+}
+
+// ContentType returns the MIME type for protobuf
+func (c *ProtobufCodec) ContentType() string {
+	return ContentTypeProtobuf
+}
+
+// SupportsStreaming indicates that protobuf codec supports streaming
+func (c *ProtobufCodec) SupportsStreaming() bool {
+	return true
+}
@@ -0,0 +1,143 @@
+package protobuf
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/json"
+)
+
+// Ensure ProtobufDecoder implements the focused interfaces
+var (
+	_ encoding.Decoder                     = (*ProtobufDecoder)(nil)
+	_ encoding.ContentTypeProvider         = (*ProtobufDecoder)(nil)
+	_ encoding.StreamingCapabilityProvider = (*ProtobufDecoder)(nil)
+)
+
+// ProtobufDecoder implements the Decoder interface for protobuf format,
+// unwrapping the minimal envelope ProtobufEncoder writes: field 1 (bytes)
+// carries the event's JSON form, which is decoded through json.JSONDecoder's
+// concrete-type dispatch rather than duplicating it here.
+type ProtobufDecoder struct {
+	options *encoding.DecodingOptions
+	inner   *json.JSONDecoder
+}
+
+// NewProtobufDecoder creates a new protobuf decoder with the given options
+func NewProtobufDecoder(options *encoding.DecodingOptions) *ProtobufDecoder {
+	if options == nil {
+		options = &encoding.DecodingOptions{}
+	}
+	return &ProtobufDecoder{options: options, inner: json.NewJSONDecoder(options)}
+}
+
+// Decode decodes a single protobuf-framed event
+func (d *ProtobufDecoder) Decode(ctx context.Context, data []byte) (events.Event, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, &encoding.DecodingError{
+			Format:  "protobuf",
+			Message: "context cancelled",
+			Cause:   err,
+		}
+	}
+
+	if d.options.MaxSize > 0 && int64(len(data)) > d.options.MaxSize {
+		return nil, &encoding.DecodingError{
+			Format:  "protobuf",
+			Message: fmt.Sprintf("input exceeds max size of %d bytes", d.options.MaxSize),
+		}
+	}
+
+	payload, err := unwrapEnvelope(data)
+	if err != nil {
+		return nil, &encoding.DecodingError{
+			Format:  "protobuf",
+			Data:    data,
+			Message: "failed to unwrap protobuf envelope",
+			Cause:   err,
+		}
+	}
+
+	// d.inner already applies ValidateEvents/Strict from the shared options.
+	event, err := d.inner.Decode(ctx, payload)
+	if err != nil {
+		return nil, &encoding.DecodingError{
+			Format:  "protobuf",
+			Data:    data,
+			Message: "failed to decode envelope payload",
+			Cause:   err,
+		}
+	}
+
+	return event, nil
+}
+
+// DecodeMultiple decodes a sequence of varint-length-prefixed protobuf
+// messages, the framing EncodeMultiple writes.
+func (d *ProtobufDecoder) DecodeMultiple(ctx context.Context, data []byte) ([]events.Event, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, &encoding.DecodingError{
+			Format:  "protobuf",
+			Message: "context cancelled",
+			Cause:   err,
+		}
+	}
+
+	if len(data) == 0 {
+		return []events.Event{}, nil
+	}
+
+	var result []events.Event
+	rest := data
+	for i := 0; len(rest) > 0; i++ {
+		if i%100 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, &encoding.DecodingError{
+					Format:  "protobuf",
+					Message: "context cancelled during decoding",
+					Cause:   err,
+				}
+			}
+		}
+
+		length, n, err := readVarint(rest)
+		if err != nil {
+			return nil, &encoding.DecodingError{
+				Format:  "protobuf",
+				Data:    data,
+				Message: fmt.Sprintf("failed to read length prefix for message %d", i),
+				Cause:   err,
+			}
+		}
+		rest = rest[n:]
+
+		if uint64(len(rest)) < length {
+			return nil, &encoding.DecodingError{
+				Format:  "protobuf",
+				Data:    data,
+				Message: fmt.Sprintf("truncated message %d: expected %d bytes, have %d", i, length, len(rest)),
+			}
+		}
+
+		event, err := d.Decode(ctx, rest[:length])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, event)
+		rest = rest[length:]
+	}
+
+	return result, nil
+}
+
+// ContentType returns the MIME type for protobuf
+func (d *ProtobufDecoder) ContentType() string {
+	return ContentTypeProtobuf
+}
+
+// SupportsStreaming indicates that protobuf decoder supports streaming
+func (d *ProtobufDecoder) SupportsStreaming() bool {
+	return true
+}
@@ -0,0 +1,267 @@
+// Package protobuf implements the encoding.Encoder contract for
+// application/x-protobuf, as a binary sibling to json.JSONEncoder for
+// callers that need to close the perf gap for high-rate event traffic.
+//
+// The SDK does not yet generate typed protobuf messages per events.Event
+// type (see eventstream.proto in pkg/encoding/grpcstream for the closest
+// thing to a schema today), so ProtobufEncoder relies on events.Event's
+// ToProto method, which wraps the event's JSON form in a minimal
+// length-delimited envelope. This is forward-compatible: once a real
+// protoc pipeline generates typed messages, ToProto's implementation can
+// switch to real field-by-field encoding without this package's callers
+// noticing.
+package protobuf
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+)
+
+// ContentTypeProtobuf is the MIME type for protobuf-encoded events.
+const ContentTypeProtobuf = "application/x-protobuf"
+
+// Ensure ProtobufEncoder implements the focused interfaces
+var (
+	_ encoding.Encoder                     = (*ProtobufEncoder)(nil)
+	_ encoding.ContentTypeProvider         = (*ProtobufEncoder)(nil)
+	_ encoding.StreamingCapabilityProvider = (*ProtobufEncoder)(nil)
+)
+
+// ProtobufEncoder implements the Encoder interface for protobuf format.
+// This encoder is stateless and thread-safe for concurrent use, mirroring
+// JSONEncoder's structure including its concurrency guard.
+type ProtobufEncoder struct {
+	options          *encoding.EncodingOptions
+	activeOperations int32 // Track active encoding operations
+	maxConcurrent    int32 // Maximum concurrent operations
+}
+
+// NewProtobufEncoder creates a new protobuf encoder with the given options
+func NewProtobufEncoder(options *encoding.EncodingOptions) *ProtobufEncoder {
+	if options == nil {
+		options = &encoding.EncodingOptions{
+			CrossSDKCompatibility: true,
+			ValidateOutput:        true,
+		}
+	}
+	return &ProtobufEncoder{
+		options:       options,
+		maxConcurrent: 100, // Default limit of 100 concurrent operations
+	}
+}
+
+// NewProtobufEncoderWithConcurrencyLimit creates a new protobuf encoder with specified concurrency limit
+func NewProtobufEncoderWithConcurrencyLimit(options *encoding.EncodingOptions, maxConcurrent int32) *ProtobufEncoder {
+	if options == nil {
+		options = &encoding.EncodingOptions{
+			CrossSDKCompatibility: true,
+			ValidateOutput:        true,
+		}
+	}
+	return &ProtobufEncoder{
+		options:       options,
+		maxConcurrent: maxConcurrent,
+	}
+}
+
+// Encode encodes a single event to protobuf
+func (e *ProtobufEncoder) Encode(ctx context.Context, event events.Event) ([]byte, error) {
+	// Check context cancellation
+	if err := ctx.Err(); err != nil {
+		return nil, &encoding.EncodingError{
+			Format:  "protobuf",
+			Message: "context cancelled",
+			Cause:   err,
+		}
+	}
+
+	// Check concurrency limits atomically to avoid race condition
+	if e.maxConcurrent > 0 {
+		current := atomic.AddInt32(&e.activeOperations, 1)
+		if current > e.maxConcurrent {
+			atomic.AddInt32(&e.activeOperations, -1)
+			return nil, &encoding.EncodingError{
+				Format:  "protobuf",
+				Message: fmt.Sprintf("encoding concurrency limit exceeded: %d", e.maxConcurrent),
+			}
+		}
+		defer atomic.AddInt32(&e.activeOperations, -1)
+	}
+
+	if event == nil {
+		return nil, &encoding.EncodingError{
+			Format:  "protobuf",
+			Message: "cannot encode nil event",
+		}
+	}
+
+	// Validate the event before encoding if requested
+	if e.options.ValidateOutput {
+		if err := event.Validate(); err != nil {
+			return nil, &encoding.EncodingError{
+				Format:  "protobuf",
+				Event:   event,
+				Message: "event validation failed",
+				Cause:   err,
+			}
+		}
+	}
+
+	data, err := e.marshal(event)
+	if err != nil {
+		return nil, &encoding.EncodingError{
+			Format:  "protobuf",
+			Event:   event,
+			Message: "failed to encode event",
+			Cause:   err,
+		}
+	}
+
+	if e.options.MaxSize > 0 && int64(len(data)) > e.options.MaxSize {
+		return nil, &encoding.EncodingError{
+			Format:  "protobuf",
+			Event:   event,
+			Message: fmt.Sprintf("encoded event exceeds max size of %d bytes", e.options.MaxSize),
+		}
+	}
+
+	return data, nil
+}
+
+// marshal produces the protobuf encoding for a single event via
+// event.ToProto, the same CrossSDKCompatibility hook JSONEncoder uses with
+// event.ToJSON. Unlike JSON, there is no stdlib struct-tag marshaller to
+// fall back to when CrossSDKCompatibility is off, so this is the only path.
+func (e *ProtobufEncoder) marshal(event events.Event) ([]byte, error) {
+	return event.ToProto()
+}
+
+// EncodeMultiple encodes multiple events as a stream of length-delimited
+// protobuf messages, varint-prefixed per message the way protobuf streams
+// conventionally frame a sequence of otherwise-unbounded messages (there is
+// no repeated top-level message to lean on here, since each event already
+// maps to a distinct message type via the oneof in ToProto's envelope).
+func (e *ProtobufEncoder) EncodeMultiple(ctx context.Context, evts []events.Event) ([]byte, error) {
+	// Check context cancellation
+	if err := ctx.Err(); err != nil {
+		return nil, &encoding.EncodingError{
+			Format:  "protobuf",
+			Message: "context cancelled",
+			Cause:   err,
+		}
+	}
+
+	// Check concurrency limits atomically to avoid race condition
+	if e.maxConcurrent > 0 {
+		current := atomic.AddInt32(&e.activeOperations, 1)
+		if current > e.maxConcurrent {
+			atomic.AddInt32(&e.activeOperations, -1)
+			return nil, &encoding.EncodingError{
+				Format:  "protobuf",
+				Message: fmt.Sprintf("encoding concurrency limit exceeded: %d", e.maxConcurrent),
+			}
+		}
+		defer atomic.AddInt32(&e.activeOperations, -1)
+	}
+
+	if len(evts) == 0 {
+		return []byte{}, nil
+	}
+
+	if e.options.ValidateOutput {
+		for i, event := range evts {
+			if event == nil {
+				return nil, &encoding.EncodingError{
+					Format:  "protobuf",
+					Message: fmt.Sprintf("cannot encode nil event at index %d", i),
+				}
+			}
+			if err := event.Validate(); err != nil {
+				return nil, &encoding.EncodingError{
+					Format:  "protobuf",
+					Event:   event,
+					Message: fmt.Sprintf("event validation failed at index %d", i),
+					Cause:   err,
+				}
+			}
+		}
+	}
+
+	estimatedSize := encoding.GetOptimalBufferSizeForMultiple(evts)
+	buf := encoding.GetBufferSafe(estimatedSize)
+	if buf == nil {
+		return nil, &encoding.EncodingError{
+			Format:  "protobuf",
+			Message: "failed to allocate buffer: resource limits exceeded",
+		}
+	}
+	defer encoding.PutBuffer(buf)
+
+	totalSize := int64(0)
+	for i, event := range evts {
+		if i%100 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, &encoding.EncodingError{
+					Format:  "protobuf",
+					Message: "context cancelled during encoding",
+					Cause:   err,
+				}
+			}
+		}
+
+		data, err := e.marshal(event)
+		if err != nil {
+			return nil, &encoding.EncodingError{
+				Format:  "protobuf",
+				Event:   event,
+				Message: fmt.Sprintf("failed to encode event at index %d", i),
+				Cause:   err,
+			}
+		}
+
+		totalSize += int64(len(data))
+		if e.options.MaxSize > 0 && totalSize > e.options.MaxSize {
+			return nil, &encoding.EncodingError{
+				Format:  "protobuf",
+				Message: fmt.Sprintf("encoded events exceed max size of %d bytes", e.options.MaxSize),
+			}
+		}
+
+		writeVarint(buf, uint64(len(data)))
+		buf.Write(data)
+	}
+
+	result := make([]byte, buf.Len())
+	copy(result, buf.Bytes())
+	return result, nil
+}
+
+// ContentType returns the MIME type for protobuf
+func (e *ProtobufEncoder) ContentType() string {
+	return ContentTypeProtobuf
+}
+
+// CanStream indicates that protobuf encoder supports streaming (backward compatibility)
+func (e *ProtobufEncoder) CanStream() bool {
+	return true
+}
+
+// SupportsStreaming indicates that protobuf encoder supports streaming
+func (e *ProtobufEncoder) SupportsStreaming() bool {
+	return true
+}
+
+// Reset resets the encoder with new options (for pooling)
+func (e *ProtobufEncoder) Reset(options *encoding.EncodingOptions) {
+	if options == nil {
+		options = &encoding.EncodingOptions{
+			CrossSDKCompatibility: true,
+			ValidateOutput:        true,
+		}
+	}
+	e.options = options
+}
@@ -0,0 +1,83 @@
+package encoding
+
+import (
+	"bytes"
+	"sync/atomic"
+)
+
+// refBufferDebug enables use-after-release panics in RefBuffer, at the
+// cost of an atomic load on every Bytes()/Buffer() call. It defaults to
+// off and flips on with the encoding_refdebug build tag (see
+// ref_buffer_debug.go) - pair it with `go test -race` to catch both a data
+// race and the logical use-after-release bug that caused it in the same run.
+var refBufferDebug = false
+
+// RefBuffer wraps a pooled *bytes.Buffer with an atomic reference count,
+// so a single encoded payload can be handed off to multiple independent
+// consumers - e.g. a broadcast SSE hub fanning one encoded event out to
+// several client connections - each Release-ing it on its own schedule,
+// with the buffer only returned to the pool once every reference has let go.
+//
+// This replaces BufferPool's "caller promises not to touch it after Put"
+// convention with an explicit, checkable one for the case where a pooled
+// buffer's reference genuinely outlives the Get/Put call that produced it:
+// Retain before handing a RefBuffer to another goroutine or consumer,
+// Release when done with it, and - with the encoding_refdebug build tag -
+// any Bytes()/Buffer() call after the count reaches zero panics instead of
+// silently reading (or racing on) a buffer some other Get has since reused.
+type RefBuffer struct {
+	buf  *bytes.Buffer
+	refs int32
+}
+
+// NewRefBuffer returns a RefBuffer over a pooled buffer sized to fit at
+// least expectedSize bytes, with an initial reference count of 1.
+func NewRefBuffer(expectedSize int) *RefBuffer {
+	return &RefBuffer{buf: GetBuffer(expectedSize), refs: 1}
+}
+
+// Retain increments the reference count and returns r, for handing the
+// same RefBuffer to an additional consumer that will call Release on its
+// own once it's done - e.g. each subscriber in a broadcast fan-out.
+func (r *RefBuffer) Retain() *RefBuffer {
+	atomic.AddInt32(&r.refs, 1)
+	return r
+}
+
+// Release decrements the reference count, returning the underlying buffer
+// to the pool once it reaches zero. Calling Release more times than the
+// buffer has been Retained (including the implicit first reference from
+// NewRefBuffer) is a caller bug; encoding_refdebug builds panic rather than
+// silently corrupting the count.
+func (r *RefBuffer) Release() {
+	n := atomic.AddInt32(&r.refs, -1)
+	switch {
+	case n == 0:
+		buf := r.buf
+		r.buf = nil
+		PutBuffer(buf)
+	case n < 0 && refBufferDebug:
+		panic("encoding: RefBuffer.Release called more times than Retain")
+	}
+}
+
+// Bytes returns the buffer's current contents. In encoding_refdebug
+// builds, panics if called after the reference count has reached zero.
+func (r *RefBuffer) Bytes() []byte {
+	r.checkLive()
+	return r.buf.Bytes()
+}
+
+// Buffer returns the underlying *bytes.Buffer, e.g. to write an encoded
+// payload into before the first Release. See Bytes for the
+// encoding_refdebug use-after-release check.
+func (r *RefBuffer) Buffer() *bytes.Buffer {
+	r.checkLive()
+	return r.buf
+}
+
+func (r *RefBuffer) checkLive() {
+	if refBufferDebug && atomic.LoadInt32(&r.refs) <= 0 {
+		panic("encoding: RefBuffer used after Release")
+	}
+}
@@ -0,0 +1,12 @@
+//go:build encoding_refdebug
+
+package encoding
+
+// With the encoding_refdebug build tag set, RefBuffer panics on any
+// Bytes()/Buffer() call after its reference count has reached zero, and on
+// a Release past zero - catching use-after-release bugs that would
+// otherwise only show up as a data race under `go test -race`. See
+// ref_buffer.go.
+func init() {
+	refBufferDebug = true
+}
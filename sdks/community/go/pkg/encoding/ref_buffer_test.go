@@ -0,0 +1,43 @@
+package encoding
+
+import "testing"
+
+func TestRefBuffer_ReleaseReturnsBufferToPoolAtZero(t *testing.T) {
+	ResetAllPools()
+
+	rb := NewRefBuffer(100)
+	rb.Buffer().WriteString("hello")
+	rb.Release()
+
+	if stats := BufferPoolStats(); stats[0].Live != 1 {
+		t.Fatalf("expected the released buffer back in the pool, got %+v", stats[0])
+	}
+}
+
+func TestRefBuffer_RetainKeepsBufferAliveUntilEveryReleaseHappens(t *testing.T) {
+	ResetAllPools()
+
+	rb := NewRefBuffer(100)
+	rb.Buffer().WriteString("hello")
+	rb.Retain()
+
+	rb.Release() // 2 -> 1, still held
+	if stats := BufferPoolStats(); stats[0].Live != 0 {
+		t.Fatalf("expected the buffer still checked out after one of two Releases, got %+v", stats[0])
+	}
+
+	rb.Release() // 1 -> 0, returned to the pool
+	if stats := BufferPoolStats(); stats[0].Live != 1 {
+		t.Fatalf("expected the buffer back in the pool after the final Release, got %+v", stats[0])
+	}
+}
+
+func TestRefBuffer_Bytes(t *testing.T) {
+	rb := NewRefBuffer(16)
+	rb.Buffer().WriteString("payload")
+
+	if got := string(rb.Bytes()); got != "payload" {
+		t.Errorf("expected %q, got %q", "payload", got)
+	}
+	rb.Release()
+}
@@ -0,0 +1,40 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	"github.com/hamba/avro/v2"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+)
+
+// AvroValidator validates event payloads against Avro schemas, for codecs
+// that encode events as compact Avro binary instead of JSON. schema.Raw is
+// the Avro schema's JSON definition; data is the Avro-encoded bytes.
+type AvroValidator struct{}
+
+// NewAvroValidator creates an AvroValidator.
+func NewAvroValidator() *AvroValidator {
+	return &AvroValidator{}
+}
+
+var _ encoding.SchemaValidator = (*AvroValidator)(nil)
+
+// Validate parses schema.Raw as an Avro schema and confirms data decodes
+// against it. Avro's binary encoding carries no self-describing field
+// names, so unlike JSONSchemaValidator this can only report that decoding
+// failed, not which field caused it; encoding.DecodingError.Path is left
+// empty for Avro validation failures.
+func (v *AvroValidator) Validate(data []byte, schema encoding.Schema) error {
+	avroSchema, err := avro.Parse(string(schema.Raw))
+	if err != nil {
+		return fmt.Errorf("failed to parse Avro schema for %s v%s: %w", schema.EventType, schema.Version, err)
+	}
+
+	var decoded interface{}
+	if err := avro.Unmarshal(avroSchema, data, &decoded); err != nil {
+		return &encoding.SchemaValidationError{Err: fmt.Errorf("payload does not match Avro schema for %s v%s: %w", schema.EventType, schema.Version, err)}
+	}
+
+	return nil
+}
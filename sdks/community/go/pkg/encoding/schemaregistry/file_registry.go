@@ -0,0 +1,45 @@
+package schemaregistry
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/errors"
+)
+
+// FileRegistry resolves schemas from files laid out as
+// {Dir}/{eventType}/{version}.{Ext} (e.g. schemas/RUN_STARTED/1.json), for
+// local development and tests where running a schema registry service is
+// unnecessary overhead.
+type FileRegistry struct {
+	// Dir is the root directory schemas are read from.
+	Dir string
+	// Ext is the file extension schema documents are stored with,
+	// including the leading dot (e.g. ".json", ".avsc"). Defaults to
+	// ".json" if empty.
+	Ext string
+}
+
+// NewFileRegistry creates a FileRegistry rooted at dir, reading files with
+// extension ext (".json" if empty).
+func NewFileRegistry(dir, ext string) *FileRegistry {
+	if ext == "" {
+		ext = ".json"
+	}
+	return &FileRegistry{Dir: dir, Ext: ext}
+}
+
+var _ encoding.SchemaRegistry = (*FileRegistry)(nil)
+
+// GetSchema reads {Dir}/{eventType}/{version}{Ext}.
+func (r *FileRegistry) GetSchema(ctx context.Context, eventType, version string) (encoding.Schema, error) {
+	path := filepath.Join(r.Dir, eventType, version+r.Ext)
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return encoding.Schema{}, errors.NewEncodingError(errors.CodeDecodingFailed, fmt.Sprintf("failed to read schema file %s", path)).WithOperation("get_schema").WithCause(err)
+	}
+	return encoding.Schema{EventType: eventType, Version: version, Raw: raw}, nil
+}
@@ -0,0 +1,87 @@
+package schemaregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/errors"
+)
+
+// HTTPRegistry resolves schemas from a Confluent Schema Registry-compatible
+// HTTP service, fetching GET {BaseURL}/subjects/{eventType}/versions/{version}.
+// Subject names are the event type verbatim (e.g. "RUN_STARTED"); version
+// may be a specific schema version number or "latest".
+type HTTPRegistry struct {
+	// BaseURL is the schema registry's base URL, without a trailing slash
+	// (e.g. "https://schema-registry.internal:8081").
+	BaseURL string
+	// Client is the HTTP client used for requests. http.DefaultClient is
+	// used if nil.
+	Client *http.Client
+}
+
+// NewHTTPRegistry creates an HTTPRegistry against baseURL using
+// http.DefaultClient.
+func NewHTTPRegistry(baseURL string) *HTTPRegistry {
+	return &HTTPRegistry{BaseURL: baseURL}
+}
+
+var _ encoding.SchemaRegistry = (*HTTPRegistry)(nil)
+
+// confluentSchemaResponse is the body of a Confluent Schema Registry
+// GET /subjects/{subject}/versions/{version} response.
+type confluentSchemaResponse struct {
+	Subject string `json:"subject"`
+	Version int    `json:"version"`
+	ID      int    `json:"id"`
+	Schema  string `json:"schema"`
+}
+
+// GetSchema fetches GET {BaseURL}/subjects/{eventType}/versions/{version}.
+func (r *HTTPRegistry) GetSchema(ctx context.Context, eventType, version string) (encoding.Schema, error) {
+	if version == "" {
+		version = "latest"
+	}
+
+	client := r.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	endpoint := fmt.Sprintf("%s/subjects/%s/versions/%s", r.BaseURL, url.PathEscape(eventType), url.PathEscape(version))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return encoding.Schema{}, errors.NewEncodingError(errors.CodeDecodingFailed, "failed to build schema registry request").WithOperation("get_schema").WithCause(err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return encoding.Schema{}, errors.NewEncodingError(errors.CodeDecodingFailed, "schema registry request failed").WithOperation("get_schema").WithCause(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return encoding.Schema{}, errors.NewEncodingError(errors.CodeDecodingFailed, "failed to read schema registry response").WithOperation("get_schema").WithCause(err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return encoding.Schema{}, errors.NewEncodingError(errors.CodeDecodingFailed, fmt.Sprintf("schema registry returned %d for %s v%s", resp.StatusCode, eventType, version)).WithOperation("get_schema")
+	}
+
+	var parsed confluentSchemaResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return encoding.Schema{}, errors.NewEncodingError(errors.CodeDecodingFailed, "failed to parse schema registry response").WithOperation("get_schema").WithCause(err)
+	}
+
+	return encoding.Schema{
+		EventType: eventType,
+		Version:   fmt.Sprintf("%d", parsed.Version),
+		Raw:       []byte(parsed.Schema),
+	}, nil
+}
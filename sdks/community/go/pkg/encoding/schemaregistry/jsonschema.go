@@ -0,0 +1,108 @@
+// Package schemaregistry provides SchemaRegistry and SchemaValidator
+// implementations for encoding.SchemaValidatingCodec: JSON Schema and Avro
+// validators, and file-backed and HTTP-backed (Confluent-style) registries.
+package schemaregistry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+)
+
+// JSONSchemaValidator validates event payloads against JSON Schema
+// documents using santhosh-tekuri/jsonschema.
+type JSONSchemaValidator struct{}
+
+// NewJSONSchemaValidator creates a JSONSchemaValidator.
+func NewJSONSchemaValidator() *JSONSchemaValidator {
+	return &JSONSchemaValidator{}
+}
+
+var _ encoding.SchemaValidator = (*JSONSchemaValidator)(nil)
+
+// Validate compiles schema.Raw as a JSON Schema document and validates data
+// against it. The schema is compiled on every call rather than cached,
+// since compilation cost is dominated by registry lookup latency for the
+// HTTP-backed registry; callers validating at high volume should wrap this
+// validator with their own compiled-schema cache.
+func (v *JSONSchemaValidator) Validate(data []byte, schema encoding.Schema) error {
+	compiler := jsonschema.NewCompiler()
+	resourceName := fmt.Sprintf("%s-v%s.json", schema.EventType, schema.Version)
+	if err := compiler.AddResource(resourceName, bytes.NewReader(schema.Raw)); err != nil {
+		return fmt.Errorf("failed to load JSON schema for %s v%s: %w", schema.EventType, schema.Version, err)
+	}
+
+	compiled, err := compiler.Compile(resourceName)
+	if err != nil {
+		return fmt.Errorf("failed to compile JSON schema for %s v%s: %w", schema.EventType, schema.Version, err)
+	}
+
+	var instance interface{}
+	if err := json.Unmarshal(data, &instance); err != nil {
+		return fmt.Errorf("failed to parse event payload as JSON: %w", err)
+	}
+
+	if err := compiled.Validate(instance); err != nil {
+		if valErr, ok := err.(*jsonschema.ValidationError); ok {
+			return &encoding.SchemaValidationError{Path: jsonSchemaPath(valErr), Err: err}
+		}
+		return &encoding.SchemaValidationError{Err: err}
+	}
+
+	return nil
+}
+
+// jsonSchemaPath converts a jsonschema.ValidationError's instance location -
+// a single RFC 6901 JSON pointer such as "/field/nested/0" - into the
+// "$.field.nested[0]" path format encoding.DecodingError.Path uses.
+func jsonSchemaPath(err *jsonschema.ValidationError) string {
+	leaf := err
+	for len(leaf.Causes) > 0 {
+		leaf = leaf.Causes[0]
+	}
+
+	var b strings.Builder
+	b.WriteString("$")
+	for _, segment := range strings.Split(leaf.InstanceLocation, "/") {
+		if segment == "" {
+			continue
+		}
+		segment = unescapeJSONPointerSegment(segment)
+		if isArrayIndex(segment) {
+			fmt.Fprintf(&b, "[%s]", segment)
+		} else {
+			b.WriteString(".")
+			b.WriteString(segment)
+		}
+	}
+	return b.String()
+}
+
+// unescapeJSONPointerSegment reverses the "~1" -> "/" and "~0" -> "~"
+// escaping RFC 6901 requires for pointer segments containing those
+// characters.
+func unescapeJSONPointerSegment(segment string) string {
+	segment = strings.ReplaceAll(segment, "~1", "/")
+	segment = strings.ReplaceAll(segment, "~0", "~")
+	return segment
+}
+
+// isArrayIndex reports whether segment looks like a JSON array index, so
+// jsonSchemaPath can render it as "[0]" instead of ".0".
+func isArrayIndex(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	for _, r := range segment {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
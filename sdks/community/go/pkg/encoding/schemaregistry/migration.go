@@ -0,0 +1,61 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
+)
+
+// StepMigrationFunc upgrades data from one schema version to the very next
+// version (e.g. "1" to "2"), never further.
+type StepMigrationFunc func(data []byte) ([]byte, error)
+
+// Migrator chains single-step migrations registered via RegisterStep into
+// an encoding.MigrationFunc that walks from an old version to the current
+// one, so SchemaValidatingCodec.Decode can upgrade payloads produced by any
+// past schema version without a migration for every (old, new) pair.
+type Migrator struct {
+	// steps maps a version to the function that migrates *from* it to the
+	// next version.
+	steps map[string]StepMigrationFunc
+	// next maps a version to the version its step migrates to.
+	next map[string]string
+}
+
+// NewMigrator creates an empty Migrator.
+func NewMigrator() *Migrator {
+	return &Migrator{
+		steps: make(map[string]StepMigrationFunc),
+		next:  make(map[string]string),
+	}
+}
+
+// RegisterStep registers a migration from fromVersion to toVersion.
+func (m *Migrator) RegisterStep(fromVersion, toVersion string, step StepMigrationFunc) {
+	m.steps[fromVersion] = step
+	m.next[fromVersion] = toVersion
+}
+
+// MigrationFunc returns an encoding.MigrationFunc that walks the registered
+// steps from fromVersion to toVersion, applying each intermediate step in
+// turn.
+func (m *Migrator) MigrationFunc() encoding.MigrationFunc {
+	return func(data []byte, fromVersion, toVersion string) ([]byte, error) {
+		version := fromVersion
+		for version != toVersion {
+			step, ok := m.steps[version]
+			if !ok {
+				return nil, fmt.Errorf("no migration registered from schema version %q toward %q", version, toVersion)
+			}
+
+			migrated, err := step(data)
+			if err != nil {
+				return nil, fmt.Errorf("migration from version %q failed: %w", version, err)
+			}
+
+			data = migrated
+			version = m.next[version]
+		}
+		return data, nil
+	}
+}
@@ -0,0 +1,226 @@
+package sse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// errDroppedForBackpressure is the error BatchingSSEWriter's OnDrop
+// callback receives for an event discarded under back-pressure.
+var errDroppedForBackpressure = errors.New("sse: event dropped under back-pressure")
+
+// BatchingSSEWriterOptions configures a BatchingSSEWriter.
+type BatchingSSEWriterOptions struct {
+	// MaxBytes flushes the buffer once its pending frame bytes reach this
+	// size. Defaults to 16KB; negative disables the byte threshold.
+	MaxBytes int
+	// MaxEvents flushes the buffer once this many events are pending.
+	// Defaults to 100; negative disables the count threshold.
+	MaxEvents int
+	// MaxLatency flushes the buffer this long after its first pending
+	// event, regardless of size. Defaults to 20ms; negative disables the
+	// time threshold.
+	MaxLatency time.Duration
+	// HighWaterMark is the pending event count above which the writer
+	// enters lossy mode: appending one more event drops the oldest
+	// pending event of the same events.Type() (see OnDrop) instead of
+	// growing the buffer further. Zero or negative disables lossy mode.
+	HighWaterMark int
+	// OnDrop, if set, is called for every event the writer discards while
+	// in lossy mode.
+	OnDrop func(events.Event, error)
+}
+
+type pendingFrame struct {
+	event events.Event
+	frame []byte
+}
+
+// BatchingSSEWriter wraps an SSEWriter, coalescing WriteEvent/
+// WriteEventWithType calls into a single write (and flush) once a
+// MaxBytes/MaxEvents/MaxLatency threshold is crossed, so a high-frequency
+// token stream doesn't cost one syscall per event. A given
+// BatchingSSEWriter instance is meant for one connection at a time - like
+// SSEWriter itself it takes writer per call rather than owning one, but
+// its MaxLatency timer fires against whichever writer was passed to the
+// call that started it, so concurrent callers passing different writers
+// to the same instance would race.
+type BatchingSSEWriter struct {
+	inner *SSEWriter
+	opts  BatchingSSEWriterOptions
+
+	mu      sync.Mutex
+	pending []pendingFrame
+	bytes   int
+	timer   *time.Timer
+}
+
+// NewBatchingSSEWriter creates a BatchingSSEWriter wrapping inner with the
+// given options, filling in defaults for any zero-valued threshold.
+func NewBatchingSSEWriter(inner *SSEWriter, opts BatchingSSEWriterOptions) *BatchingSSEWriter {
+	if opts.MaxBytes == 0 {
+		opts.MaxBytes = 16 * 1024
+	}
+	if opts.MaxEvents == 0 {
+		opts.MaxEvents = 100
+	}
+	if opts.MaxLatency == 0 {
+		opts.MaxLatency = 20 * time.Millisecond
+	}
+	return &BatchingSSEWriter{inner: inner, opts: opts}
+}
+
+// WriteEvent buffers event for a later coalesced flush; see
+// WriteEventWithType.
+func (b *BatchingSSEWriter) WriteEvent(ctx context.Context, writer io.Writer, event events.Event) error {
+	return b.WriteEventWithType(ctx, writer, event, "")
+}
+
+// WriteEventWithType buffers event, tagged with eventType, for a later
+// coalesced flush. It flushes immediately once MaxBytes or MaxEvents is
+// reached, starts (if not already running) a MaxLatency timer that flushes
+// on its own once it fires, and flushes immediately - returning ctx's
+// error - if ctx is already done by the time this call would otherwise
+// return without flushing, so a canceled stream's last partial batch isn't
+// silently dropped.
+func (b *BatchingSSEWriter) WriteEventWithType(ctx context.Context, writer io.Writer, event events.Event, eventType string) error {
+	if event == nil {
+		return fmt.Errorf("event cannot be nil")
+	}
+	if writer == nil {
+		return fmt.Errorf("writer cannot be nil")
+	}
+
+	// ctx may already be done - encode against a background context in that
+	// case so this event still reaches the pending buffer and the
+	// cancellation flush below, instead of EncodeEvent's own ctx.Err()
+	// check short-circuiting before anything is ever appended.
+	canceled := ctx.Err()
+	encodeCtx := ctx
+	if canceled != nil {
+		encodeCtx = context.Background()
+	}
+
+	jsonData, err := b.inner.encoder.EncodeEvent(encodeCtx, event, "application/json")
+	if err != nil {
+		return fmt.Errorf("event encoding failed: %w", err)
+	}
+	frameBuf, err := b.inner.createSSEFrameBuf(jsonData, eventType, event)
+	if err != nil {
+		return fmt.Errorf("SSE frame creation failed: %w", err)
+	}
+	frame := append([]byte(nil), frameBuf.Bytes()...)
+	frameBuf.Release()
+
+	b.mu.Lock()
+	b.appendLocked(event, frame)
+	flushNow := b.shouldFlushLocked()
+	if !flushNow && b.timer == nil && b.opts.MaxLatency > 0 {
+		b.timer = time.AfterFunc(b.opts.MaxLatency, func() {
+			_ = b.Flush(context.Background(), writer)
+		})
+	}
+	b.mu.Unlock()
+
+	if canceled != nil {
+		_ = b.Flush(context.Background(), writer)
+		return canceled
+	}
+
+	if flushNow {
+		return b.Flush(ctx, writer)
+	}
+	return nil
+}
+
+// appendLocked adds frame to the pending buffer. Once len(pending)
+// exceeds HighWaterMark, it enters lossy mode: the oldest pending event
+// sharing event's Type() is dropped (reported via OnDrop) instead of
+// letting the buffer grow further, so a client's view of high-frequency
+// deltas - e.g. successive TEXT_MESSAGE_CONTENT chunks - collapses to the
+// latest instead of stalling behind a backlog.
+func (b *BatchingSSEWriter) appendLocked(event events.Event, frame []byte) {
+	b.pending = append(b.pending, pendingFrame{event: event, frame: frame})
+	b.bytes += len(frame)
+
+	if b.opts.HighWaterMark <= 0 || len(b.pending) <= b.opts.HighWaterMark {
+		return
+	}
+
+	for i := 0; i < len(b.pending)-1; i++ {
+		if b.pending[i].event.Type() != event.Type() {
+			continue
+		}
+		dropped := b.pending[i]
+		b.bytes -= len(dropped.frame)
+		b.pending = append(b.pending[:i], b.pending[i+1:]...)
+		if b.opts.OnDrop != nil {
+			b.opts.OnDrop(dropped.event, errDroppedForBackpressure)
+		}
+		return
+	}
+}
+
+func (b *BatchingSSEWriter) shouldFlushLocked() bool {
+	if b.opts.MaxEvents > 0 && len(b.pending) >= b.opts.MaxEvents {
+		return true
+	}
+	if b.opts.MaxBytes > 0 && b.bytes >= b.opts.MaxBytes {
+		return true
+	}
+	return false
+}
+
+// Flush writes every pending frame to writer as a single write (and a
+// single Flush, if writer supports it) in original order, then clears the
+// buffer and stops any running MaxLatency timer.
+func (b *BatchingSSEWriter) Flush(ctx context.Context, writer io.Writer) error {
+	b.mu.Lock()
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	pending := b.pending
+	b.pending = nil
+	b.bytes = 0
+	b.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	var combined []byte
+	for _, p := range pending {
+		combined = append(combined, p.frame...)
+	}
+
+	b.inner.writeMu.Lock()
+	defer b.inner.writeMu.Unlock()
+
+	if _, err := writer.Write(combined); err != nil {
+		b.inner.logger.ErrorContext(ctx, "Failed to write batched SSE frames", "error", err)
+		return fmt.Errorf("batched SSE write failed: %w", err)
+	}
+	if flusher, ok := writer.(flusher); ok {
+		if err := flusher.Flush(); err != nil {
+			b.inner.logger.ErrorContext(ctx, "Failed to flush batched SSE frames", "error", err)
+			return fmt.Errorf("batched SSE flush failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// Close flushes any remaining buffered frames to writer and stops the
+// MaxLatency timer. Callers typically defer this at the end of a stream,
+// using a fresh context rather than the stream's own (already canceled by
+// the time a deferred call runs), so the last partial batch still reaches
+// the client.
+func (b *BatchingSSEWriter) Close(writer io.Writer) error {
+	return b.Flush(context.Background(), writer)
+}
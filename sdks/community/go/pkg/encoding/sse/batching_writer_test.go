@@ -0,0 +1,183 @@
+package sse
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// countingWriter records how many times Write was called, so tests can
+// assert a BatchingSSEWriter coalesces many buffered events into far
+// fewer underlying writes.
+type countingWriter struct {
+	bytes.Buffer
+	writes int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	return w.Buffer.Write(p)
+}
+
+func TestBatchingSSEWriter_CoalescesFlushes(t *testing.T) {
+	ctx := context.Background()
+	batched := NewBatchingSSEWriter(NewSSEWriter(), BatchingSSEWriterOptions{
+		MaxBytes:  8 * 1024,
+		MaxEvents: 50,
+	})
+
+	writer := &countingWriter{}
+	const n = 5000
+	for i := 0; i < n; i++ {
+		event := &mockEvent{
+			BaseEvent:  events.BaseEvent{EventType: events.EventTypeCustom},
+			customJSON: []byte(fmt.Sprintf(`{"n":%d}`, i)),
+		}
+		if err := batched.WriteEvent(ctx, writer, event); err != nil {
+			t.Fatalf("unexpected error at event %d: %v", i, err)
+		}
+	}
+	if err := batched.Close(writer); err != nil {
+		t.Fatalf("unexpected error on close: %v", err)
+	}
+
+	if writer.writes == 0 || writer.writes >= n/10 {
+		t.Errorf("expected far fewer than %d writes for %d events, got %d", n/10, n, writer.writes)
+	}
+}
+
+func TestBatchingSSEWriter_LosslessReconstructsSequence(t *testing.T) {
+	ctx := context.Background()
+	batched := NewBatchingSSEWriter(NewSSEWriter(), BatchingSSEWriterOptions{
+		MaxBytes:  4 * 1024,
+		MaxEvents: 25,
+	})
+
+	writer := &countingWriter{}
+	const n = 3000
+	for i := 0; i < n; i++ {
+		event := &mockEvent{
+			BaseEvent:  events.BaseEvent{EventType: events.EventTypeCustom},
+			customJSON: []byte(fmt.Sprintf(`{"n":%d}`, i)),
+		}
+		if err := batched.WriteEvent(ctx, writer, event); err != nil {
+			t.Fatalf("unexpected error at event %d: %v", i, err)
+		}
+	}
+	if err := batched.Close(writer); err != nil {
+		t.Fatalf("unexpected error on close: %v", err)
+	}
+
+	var got []int
+	for _, line := range strings.Split(writer.String(), "\n") {
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+		payload := strings.TrimPrefix(line, "data: ")
+		var v struct {
+			N int `json:"n"`
+		}
+		if err := json.Unmarshal([]byte(payload), &v); err != nil {
+			t.Fatalf("unexpected malformed data line %q: %v", payload, err)
+		}
+		got = append(got, v.N)
+	}
+
+	if len(got) != n {
+		t.Fatalf("expected %d reconstructed events, got %d", n, len(got))
+	}
+	for i, v := range got {
+		if v != i {
+			t.Fatalf("expected sequence to be in order, got %d at position %d", v, i)
+		}
+	}
+}
+
+func TestBatchingSSEWriter_LossyModeDropsUnderBackpressure(t *testing.T) {
+	ctx := context.Background()
+	var dropped []events.Event
+	batched := NewBatchingSSEWriter(NewSSEWriter(), BatchingSSEWriterOptions{
+		MaxBytes:      -1,
+		MaxEvents:     -1,
+		MaxLatency:    -1,
+		HighWaterMark: 5,
+		OnDrop: func(event events.Event, err error) {
+			dropped = append(dropped, event)
+		},
+	})
+
+	writer := &countingWriter{}
+	const n = 200
+	for i := 0; i < n; i++ {
+		event := &mockEvent{
+			BaseEvent:  events.BaseEvent{EventType: events.EventTypeCustom},
+			customJSON: []byte(fmt.Sprintf(`{"n":%d}`, i)),
+		}
+		if err := batched.WriteEvent(ctx, writer, event); err != nil {
+			t.Fatalf("unexpected error at event %d: %v", i, err)
+		}
+	}
+
+	if len(dropped) == 0 {
+		t.Fatal("expected OnDrop to fire at least once under a constrained buffer")
+	}
+	if len(dropped) >= n {
+		t.Errorf("expected some events to survive to the buffer, all %d were dropped", n)
+	}
+
+	if err := batched.Close(writer); err != nil {
+		t.Fatalf("unexpected error on close: %v", err)
+	}
+	survivors := strings.Count(writer.String(), "\"n\":")
+	if survivors != n-len(dropped) {
+		t.Errorf("expected %d surviving events in the flushed output, got %d", n-len(dropped), survivors)
+	}
+}
+
+func TestBatchingSSEWriter_FlushesOnContextCancellation(t *testing.T) {
+	batched := NewBatchingSSEWriter(NewSSEWriter(), BatchingSSEWriterOptions{
+		MaxBytes:  1 << 20,
+		MaxEvents: 1 << 20,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	writer := &countingWriter{}
+	event := &mockEvent{customJSON: []byte(`{"n":1}`)}
+	err := batched.WriteEvent(ctx, writer, event)
+	if err == nil {
+		t.Fatal("expected ctx.Err() to be returned")
+	}
+	if !strings.Contains(writer.String(), `{"n":1}`) {
+		t.Errorf("expected the pending event to be flushed despite cancellation, got %q", writer.String())
+	}
+}
+
+func TestBatchingSSEWriter_FlushesOnMaxLatency(t *testing.T) {
+	batched := NewBatchingSSEWriter(NewSSEWriter(), BatchingSSEWriterOptions{
+		MaxBytes:   1 << 20,
+		MaxEvents:  1 << 20,
+		MaxLatency: 5 * time.Millisecond,
+	})
+
+	writer := &countingWriter{}
+	event := &mockEvent{customJSON: []byte(`{"n":1}`)}
+	if err := batched.WriteEvent(context.Background(), writer, event); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	deadline := time.Now().Add(200 * time.Millisecond)
+	for writer.writes == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if writer.writes == 0 {
+		t.Fatal("expected the MaxLatency timer to flush the pending event")
+	}
+}
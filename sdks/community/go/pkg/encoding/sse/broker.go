@@ -0,0 +1,229 @@
+package sse
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// SlowConsumerPolicy controls what a Broker does when a subscriber's channel
+// is full and a new event needs to be dispatched to it.
+type SlowConsumerPolicy int
+
+const (
+	// DropOldest discards the oldest buffered event to make room for the new one
+	DropOldest SlowConsumerPolicy = iota
+	// DropNewest discards the event that triggered the overflow
+	DropNewest
+	// DisconnectSlow unsubscribes the slow subscriber entirely
+	DisconnectSlow
+)
+
+// BrokerMetrics receives observations from a Broker so callers can wire
+// Prometheus, OTel, or any other telemetry backend.
+type BrokerMetrics interface {
+	// SubscriberCount reports the current number of subscribers
+	SubscriberCount(count int)
+	// EventDropped reports an event being dropped for a slow subscriber
+	EventDropped()
+	// SubscriberDisconnected reports a subscriber being force-disconnected for being slow
+	SubscriberDisconnected()
+	// PublishLatency reports how long Publish took to dispatch to all subscribers
+	PublishLatency(d time.Duration)
+}
+
+// NopBrokerMetrics is a BrokerMetrics implementation that discards all observations
+type NopBrokerMetrics struct{}
+
+func (NopBrokerMetrics) SubscriberCount(int)          {}
+func (NopBrokerMetrics) EventDropped()                {}
+func (NopBrokerMetrics) SubscriberDisconnected()       {}
+func (NopBrokerMetrics) PublishLatency(time.Duration) {}
+
+// BrokerOptions configures a Broker
+type BrokerOptions struct {
+	// SubscriberBufferSize is the channel capacity given to each subscriber
+	SubscriberBufferSize int
+	// SlowConsumerPolicy controls behavior when a subscriber's channel is full
+	SlowConsumerPolicy SlowConsumerPolicy
+	// Metrics receives broker observations; defaults to NopBrokerMetrics
+	Metrics BrokerMetrics
+	// Writer is used to render events to SSE frames for ServeHTTP; defaults to NewSSEWriter()
+	Writer *SSEWriter
+	// RetryInterval, if non-zero, is sent as a "retry:" field when a client connects
+	RetryInterval time.Duration
+}
+
+type subscriber struct {
+	ch     chan events.Event
+	cancel context.CancelFunc
+}
+
+// Broker fans a single published event stream out to many subscribers, each
+// with its own bounded channel so a slow subscriber cannot block the others.
+type Broker struct {
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+	opts        BrokerOptions
+}
+
+// NewBroker creates a Broker with the given options, filling in defaults for
+// any zero-valued fields.
+func NewBroker(opts BrokerOptions) *Broker {
+	if opts.SubscriberBufferSize <= 0 {
+		opts.SubscriberBufferSize = 16
+	}
+	if opts.Metrics == nil {
+		opts.Metrics = NopBrokerMetrics{}
+	}
+	if opts.Writer == nil {
+		opts.Writer = NewSSEWriter()
+	}
+	return &Broker{
+		subscribers: make(map[*subscriber]struct{}),
+		opts:        opts,
+	}
+}
+
+// Subscribe registers a new subscriber and returns a channel of events along
+// with an unsubscribe function that must be called to release resources.
+// The channel is closed once unsubscribe runs or ctx is done.
+func (b *Broker) Subscribe(ctx context.Context) (<-chan events.Event, func()) {
+	subCtx, cancel := context.WithCancel(ctx)
+	sub := &subscriber{
+		ch:     make(chan events.Event, b.opts.SubscriberBufferSize),
+		cancel: cancel,
+	}
+
+	b.mu.Lock()
+	b.subscribers[sub] = struct{}{}
+	count := len(b.subscribers)
+	b.mu.Unlock()
+	b.opts.Metrics.SubscriberCount(count)
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			if _, ok := b.subscribers[sub]; ok {
+				delete(b.subscribers, sub)
+				close(sub.ch)
+			}
+			count := len(b.subscribers)
+			b.mu.Unlock()
+			b.opts.Metrics.SubscriberCount(count)
+			cancel()
+		})
+	}
+
+	go func() {
+		<-subCtx.Done()
+		unsubscribe()
+	}()
+
+	return sub.ch, unsubscribe
+}
+
+// Publish dispatches event to every current subscriber, applying the
+// configured SlowConsumerPolicy to any subscriber whose channel is full.
+func (b *Broker) Publish(ctx context.Context, event events.Event) error {
+	if event == nil {
+		return fmt.Errorf("event cannot be nil")
+	}
+
+	start := time.Now()
+	defer func() { b.opts.Metrics.PublishLatency(time.Since(start)) }()
+
+	b.mu.Lock()
+	subs := make([]*subscriber, 0, len(b.subscribers))
+	for s := range b.subscribers {
+		subs = append(subs, s)
+	}
+	b.mu.Unlock()
+
+	for _, sub := range subs {
+		b.dispatch(sub, event)
+	}
+
+	return nil
+}
+
+func (b *Broker) dispatch(sub *subscriber, event events.Event) {
+	select {
+	case sub.ch <- event:
+		return
+	default:
+	}
+
+	switch b.opts.SlowConsumerPolicy {
+	case DropNewest:
+		b.opts.Metrics.EventDropped()
+	case DisconnectSlow:
+		b.opts.Metrics.SubscriberDisconnected()
+		sub.cancel()
+	default: // DropOldest
+		select {
+		case <-sub.ch:
+			b.opts.Metrics.EventDropped()
+		default:
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			// Another goroutine raced us and refilled the channel; give up rather than block.
+			b.opts.Metrics.EventDropped()
+		}
+	}
+}
+
+// ServeHTTP upgrades the request to an SSE stream, subscribes the connection
+// to the broker, optionally replays missed events via Last-Event-ID, and
+// pumps published events to the client until it disconnects.
+func (b *Broker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	ctx := r.Context()
+	writer := b.opts.Writer
+
+	// Fall back to the writer's own default (see SSEWriter.WithRetryInterval)
+	// when the Broker itself wasn't configured with one.
+	retryInterval := b.opts.RetryInterval
+	if retryInterval == 0 {
+		retryInterval = writer.RetryInterval()
+	}
+	if retryInterval > 0 {
+		if err := writer.WriteRetry(ctx, w, retryInterval); err != nil {
+			return
+		}
+	}
+
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" && writer.replay != nil {
+		if _, err := writer.ResumeFrom(ctx, w, lastEventID); err != nil {
+			return
+		}
+	}
+
+	ch, unsubscribe := b.Subscribe(ctx)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-ch:
+			if !ok {
+				return
+			}
+			if err := writer.WriteEvent(ctx, w, event); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package sse
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+func TestBroker_SubscribePublish(t *testing.T) {
+	broker := NewBroker(BrokerOptions{SubscriberBufferSize: 4})
+	ctx := context.Background()
+
+	ch, unsubscribe := broker.Subscribe(ctx)
+	defer unsubscribe()
+
+	event := &mockEvent{BaseEvent: events.BaseEvent{EventType: events.EventTypeCustom}}
+	if err := broker.Publish(ctx, event); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	select {
+	case got := <-ch:
+		if got != events.Event(event) {
+			t.Error("expected to receive published event")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+}
+
+func TestBroker_Unsubscribe(t *testing.T) {
+	broker := NewBroker(BrokerOptions{})
+	ch, unsubscribe := broker.Subscribe(context.Background())
+
+	unsubscribe()
+
+	if _, ok := <-ch; ok {
+		t.Error("expected channel to be closed after unsubscribe")
+	}
+}
+
+func TestBroker_SlowConsumerPolicy_DropOldest(t *testing.T) {
+	broker := NewBroker(BrokerOptions{SubscriberBufferSize: 1, SlowConsumerPolicy: DropOldest})
+	ctx := context.Background()
+	ch, unsubscribe := broker.Subscribe(ctx)
+	defer unsubscribe()
+
+	first := &mockEvent{customJSON: []byte(`{"n":1}`)}
+	second := &mockEvent{customJSON: []byte(`{"n":2}`)}
+
+	_ = broker.Publish(ctx, first)
+	_ = broker.Publish(ctx, second)
+
+	got := <-ch
+	if got != events.Event(second) {
+		t.Error("expected the newest event to survive under DropOldest")
+	}
+}
+
+func TestBroker_SlowConsumerPolicy_Disconnect(t *testing.T) {
+	broker := NewBroker(BrokerOptions{SubscriberBufferSize: 1, SlowConsumerPolicy: DisconnectSlow})
+	ctx := context.Background()
+	ch, unsubscribe := broker.Subscribe(ctx)
+	defer unsubscribe()
+
+	event := &mockEvent{}
+	_ = broker.Publish(ctx, event)
+	_ = broker.Publish(ctx, event) // channel is full -> subscriber disconnected
+
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatal("expected channel to eventually close after disconnect")
+		}
+	}
+}
+
+func TestBroker_ServeHTTP(t *testing.T) {
+	broker := NewBroker(BrokerOptions{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req := httptest.NewRequest(http.MethodGet, "/stream", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+
+	done := make(chan struct{})
+	go func() {
+		broker.ServeHTTP(rec, req)
+		close(done)
+	}()
+
+	time.Sleep(5 * time.Millisecond)
+	_ = broker.Publish(context.Background(), &mockEvent{customJSON: []byte(`{"n":1}`)})
+
+	<-done
+
+	if rec.Header().Get("Content-Type") != "text/event-stream" {
+		t.Error("expected text/event-stream content type")
+	}
+}
+
+func TestBroker_ServeHTTP_FallsBackToWriterRetryInterval(t *testing.T) {
+	writer := NewSSEWriter().WithRetryInterval(4 * time.Second)
+	broker := NewBroker(BrokerOptions{Writer: writer})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	req := httptest.NewRequest(http.MethodGet, "/stream", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+
+	broker.ServeHTTP(rec, req)
+
+	if !strings.Contains(rec.Body.String(), "retry: 4000\n\n") {
+		t.Errorf("expected writer's default retry interval to be sent, got %q", rec.Body.String())
+	}
+}
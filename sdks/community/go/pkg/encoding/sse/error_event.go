@@ -0,0 +1,149 @@
+package sse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// ErrorCategory classifies an ErrorEvent so clients can decide whether to
+// retry, surface the error to the user, or re-authenticate.
+type ErrorCategory string
+
+const (
+	// ErrorCategoryTransient indicates a temporary failure that is safe to retry
+	ErrorCategoryTransient ErrorCategory = "transient"
+	// ErrorCategoryFatal indicates a non-recoverable failure
+	ErrorCategoryFatal ErrorCategory = "fatal"
+	// ErrorCategoryAuth indicates an authentication/authorization failure
+	ErrorCategoryAuth ErrorCategory = "auth"
+	// ErrorCategoryRateLimited indicates the caller has been rate limited
+	ErrorCategoryRateLimited ErrorCategory = "rate_limited"
+	// ErrorCategoryValidation indicates invalid input was supplied
+	ErrorCategoryValidation ErrorCategory = "validation"
+)
+
+// RateLimitError is returned by producers to signal that an operation was
+// rejected due to rate limiting; WriteError recognizes it via errors.As and
+// reports it as ErrorCategoryRateLimited with RetryAfter populated.
+type RateLimitError struct {
+	// RetryAfter suggests how long the client should wait before retrying
+	RetryAfter time.Duration
+	// Message is a human-readable description of the rate limit
+	Message string
+}
+
+// Error implements the error interface
+func (e *RateLimitError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return fmt.Sprintf("rate limited, retry after %s", e.RetryAfter)
+}
+
+// ErrorEvent is a structured AG-UI CUSTOM event carrying machine-readable
+// error information, parallel to CustomEvent but with typed fields instead
+// of a free-form data map.
+type ErrorEvent struct {
+	events.BaseEvent
+
+	// Code is a machine-readable error code
+	Code string
+	// Category classifies the error for client handling
+	Category ErrorCategory
+	// Message is a human-readable error message
+	Message string
+	// RetryAfter suggests when the client should retry, if applicable
+	RetryAfter *time.Duration
+	// RequestID correlates the error with the request that caused it
+	RequestID string
+	// Details carries additional structured context
+	Details map[string]any
+}
+
+// ThreadID returns empty string for error events
+func (e *ErrorEvent) ThreadID() string { return "" }
+
+// RunID returns empty string for error events
+func (e *ErrorEvent) RunID() string { return "" }
+
+// Validate validates the error event
+func (e *ErrorEvent) Validate() error {
+	if e.Code == "" && e.Message == "" {
+		return fmt.Errorf("error event must have a code or message")
+	}
+	return nil
+}
+
+// ToJSON serializes the error event to JSON
+func (e *ErrorEvent) ToJSON() ([]byte, error) {
+	data := map[string]interface{}{
+		"error":      true,
+		"code":       e.Code,
+		"category":   e.Category,
+		"message":    e.Message,
+		"request_id": e.RequestID,
+	}
+	if e.RetryAfter != nil {
+		data["retry_after_ms"] = e.RetryAfter.Milliseconds()
+	}
+	if len(e.Details) > 0 {
+		data["details"] = e.Details
+	}
+
+	eventData := map[string]interface{}{
+		"type": events.EventTypeCustom,
+		"data": data,
+	}
+	if e.TimestampMs != nil {
+		eventData["timestamp"] = *e.TimestampMs
+	}
+
+	return jsonMarshal(eventData)
+}
+
+// classifyError extracts an ErrorEvent from a plain Go error by inspecting
+// known sentinel and typed errors. Unrecognized errors are treated as fatal.
+func classifyError(err error, requestID string) *ErrorEvent {
+	ee := &ErrorEvent{
+		BaseEvent: events.BaseEvent{EventType: events.EventTypeCustom},
+		Category:  ErrorCategoryFatal,
+		Message:   err.Error(),
+		RequestID: requestID,
+		Code:      "internal_error",
+	}
+	ee.SetTimestamp(getCurrentTimestamp())
+
+	var rateLimitErr *RateLimitError
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		ee.Category = ErrorCategoryTransient
+		ee.Code = "context_done"
+	case errors.As(err, &rateLimitErr):
+		ee.Category = ErrorCategoryRateLimited
+		ee.Code = "rate_limited"
+		retryAfter := rateLimitErr.RetryAfter
+		ee.RetryAfter = &retryAfter
+	}
+
+	return ee
+}
+
+// WriteError is the ergonomic entry point for reporting an error over SSE:
+// it classifies err into a structured ErrorEvent and writes it, emitting a
+// "retry:" field as well when the error is rate limited with a RetryAfter.
+func (w *SSEWriter) WriteError(ctx context.Context, writer io.Writer, err error, requestID string) error {
+	errorEvent := classifyError(err, requestID)
+
+	if errorEvent.Category == ErrorCategoryRateLimited && errorEvent.RetryAfter != nil {
+		if err := w.WriteRetry(ctx, writer, *errorEvent.RetryAfter); err != nil {
+			return err
+		}
+	}
+
+	return w.WriteEventWithType(ctx, writer, errorEvent, "error")
+}
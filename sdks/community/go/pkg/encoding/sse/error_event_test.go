@@ -0,0 +1,76 @@
+package sse
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClassifyError(t *testing.T) {
+	t.Run("context canceled is transient", func(t *testing.T) {
+		ee := classifyError(context.Canceled, "req-1")
+		if ee.Category != ErrorCategoryTransient {
+			t.Errorf("expected transient category, got %s", ee.Category)
+		}
+	})
+
+	t.Run("rate limit error extracted", func(t *testing.T) {
+		rle := &RateLimitError{RetryAfter: 2 * time.Second, Message: "slow down"}
+		ee := classifyError(rle, "req-2")
+		if ee.Category != ErrorCategoryRateLimited {
+			t.Errorf("expected rate_limited category, got %s", ee.Category)
+		}
+		if ee.RetryAfter == nil || *ee.RetryAfter != 2*time.Second {
+			t.Errorf("expected RetryAfter 2s, got %v", ee.RetryAfter)
+		}
+	})
+
+	t.Run("wrapped rate limit error extracted", func(t *testing.T) {
+		wrapped := errors.New("outer: " + (&RateLimitError{RetryAfter: time.Second}).Error())
+		ee := classifyError(wrapped, "req-3")
+		if ee.Category != ErrorCategoryFatal {
+			t.Errorf("expected plain wrapped string error to be fatal, got %s", ee.Category)
+		}
+	})
+
+	t.Run("unknown error is fatal", func(t *testing.T) {
+		ee := classifyError(errors.New("boom"), "req-4")
+		if ee.Category != ErrorCategoryFatal {
+			t.Errorf("expected fatal category, got %s", ee.Category)
+		}
+	})
+}
+
+func TestSSEWriter_WriteError(t *testing.T) {
+	ctx := context.Background()
+	writer := NewSSEWriter()
+	var buf bytes.Buffer
+
+	rle := &RateLimitError{RetryAfter: 500 * time.Millisecond}
+	if err := writer.WriteError(ctx, &buf, rle, "req-5"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := buf.String()
+	if !strings.Contains(output, "retry: 500") {
+		t.Errorf("expected retry field for rate limited error, got %q", output)
+	}
+	if !strings.Contains(output, `"category":"rate_limited"`) {
+		t.Errorf("expected rate_limited category in payload, got %q", output)
+	}
+}
+
+func TestErrorEvent_Validate(t *testing.T) {
+	ee := &ErrorEvent{}
+	if err := ee.Validate(); err == nil {
+		t.Error("expected validation error when code and message are empty")
+	}
+
+	ee.Code = "boom"
+	if err := ee.Validate(); err != nil {
+		t.Errorf("unexpected validation error: %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+package sse
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+func TestNewFrameEncoderRegistry(t *testing.T) {
+	reg := NewFrameEncoderRegistry()
+
+	for _, ct := range []string{
+		"text/event-stream",
+		"text/event-stream+protobuf",
+		"application/vnd.amazon.eventstream",
+	} {
+		if _, ok := reg.Get(ct); !ok {
+			t.Errorf("expected registry to have encoder for %s", ct)
+		}
+	}
+
+	if _, ok := reg.Get("application/xml"); ok {
+		t.Error("expected no encoder for unregistered content type")
+	}
+}
+
+func TestJSONFrameEncoder_EncodeFrame(t *testing.T) {
+	enc := &JSONFrameEncoder{}
+	event := &mockEvent{
+		BaseEvent: events.BaseEvent{EventType: events.EventTypeCustom},
+	}
+
+	frame, err := enc.EncodeFrame(event, "custom", "123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := string(frame)
+	if !strings.HasPrefix(out, "event: custom\nid: 123\n") {
+		t.Errorf("unexpected frame header: %q", out)
+	}
+	if !strings.Contains(out, "data: ") {
+		t.Error("expected data line")
+	}
+}
+
+func TestProtobufFrameEncoder_EncodeFrame(t *testing.T) {
+	enc := &ProtobufFrameEncoder{}
+	event := &mockEvent{
+		BaseEvent:  events.BaseEvent{EventType: events.EventTypeCustom},
+		customJSON: []byte(`{"type":"CUSTOM"}`),
+	}
+
+	frame, err := enc.EncodeFrame(event, "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := string(frame)
+	if !strings.HasPrefix(out, "data: ") {
+		t.Fatalf("expected data line, got %q", out)
+	}
+	encoded := strings.TrimSuffix(strings.TrimPrefix(out, "data: "), "\n\n")
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatalf("expected valid base64: %v", err)
+	}
+	if !strings.Contains(string(decoded), `"type":"CUSTOM"`) {
+		t.Errorf("expected embedded JSON payload, got %q", decoded)
+	}
+}
+
+func TestEventStreamFrameEncoder_EncodeFrame(t *testing.T) {
+	enc := &EventStreamFrameEncoder{}
+	event := &mockEvent{
+		BaseEvent:  events.BaseEvent{EventType: events.EventTypeCustom},
+		customJSON: []byte(`{"type":"CUSTOM"}`),
+	}
+
+	frame, err := enc.EncodeFrame(event, "custom", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(frame) < 12 {
+		t.Fatalf("frame too short: %d bytes", len(frame))
+	}
+
+	totalLength := binary.BigEndian.Uint32(frame[0:4])
+	if int(totalLength) != len(frame) {
+		t.Errorf("total_length %d does not match frame size %d", totalLength, len(frame))
+	}
+
+	if !strings.Contains(string(frame), `"type":"CUSTOM"`) {
+		t.Error("expected payload to contain event JSON")
+	}
+}
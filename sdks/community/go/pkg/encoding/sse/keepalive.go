@@ -0,0 +1,53 @@
+package sse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ErrClientGone wraps the error KeepAlive encounters when a ping write to
+// its writer fails, indicating the underlying connection is no longer
+// accepting data. Higher layers can check for it with errors.Is to decide
+// whether to tear down the run associated with the stream.
+var ErrClientGone = errors.New("sse: client disconnected")
+
+// KeepAlive starts a goroutine that writes a ": ping <unix-ms>" comment
+// line to writer every interval - a comment per the EventSource spec, so
+// conforming clients ignore it - to stop proxies and load balancers from
+// closing an SSE connection that otherwise goes quiet. Pings go through
+// WriteComment, so they share the writer's writeMu with every other write
+// this SSEWriter makes and can never land in the middle of a concurrently
+// written multi-line frame.
+//
+// The goroutine exits, closing the returned channel, when ctx is done or
+// when a ping write fails; in the latter case the channel receives one
+// error wrapping ErrClientGone before closing, so a caller can select on it
+// alongside its own event loop and tear down the run once it fires.
+func (w *SSEWriter) KeepAlive(ctx context.Context, writer io.Writer, interval time.Duration) <-chan error {
+	gone := make(chan error, 1)
+
+	go func() {
+		defer close(gone)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				ping := fmt.Sprintf("ping %d", time.Now().UnixMilli())
+				if err := w.WriteComment(ctx, writer, ping); err != nil {
+					gone <- fmt.Errorf("%w: %v", ErrClientGone, err)
+					return
+				}
+			}
+		}
+	}()
+
+	return gone
+}
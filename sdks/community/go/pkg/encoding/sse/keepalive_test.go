@@ -0,0 +1,121 @@
+package sse
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSSEWriter_KeepAlive_WritesPings(t *testing.T) {
+	writer := NewSSEWriter()
+	var buf bytes.Buffer
+
+	ctx, cancel := context.WithTimeout(context.Background(), 25*time.Millisecond)
+	defer cancel()
+
+	gone := writer.KeepAlive(ctx, &buf, 5*time.Millisecond)
+
+	err, ok := <-gone
+	if ok {
+		t.Fatalf("expected the channel to close without an error after ctx.Done, got %v", err)
+	}
+
+	if !strings.Contains(buf.String(), ": ping ") {
+		t.Errorf("expected at least one ping comment, got %q", buf.String())
+	}
+}
+
+func TestSSEWriter_KeepAlive_StopsOnContextDone(t *testing.T) {
+	writer := NewSSEWriter()
+	var buf bytes.Buffer
+
+	ctx, cancel := context.WithCancel(context.Background())
+	gone := writer.KeepAlive(ctx, &buf, time.Millisecond)
+	cancel()
+
+	select {
+	case _, ok := <-gone:
+		if ok {
+			t.Error("expected channel to close, not deliver a value, on context cancellation")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected KeepAlive's goroutine to exit promptly after ctx cancellation")
+	}
+}
+
+func TestSSEWriter_KeepAlive_SurfacesErrClientGone(t *testing.T) {
+	writer := NewSSEWriter()
+	sinkErr := errors.New("broken pipe")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	gone := writer.KeepAlive(ctx, &errorWriter{err: sinkErr}, time.Millisecond)
+
+	select {
+	case err, ok := <-gone:
+		if !ok {
+			t.Fatal("expected an error before the channel closed")
+		}
+		if !errors.Is(err, ErrClientGone) {
+			t.Errorf("expected error to wrap ErrClientGone, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected KeepAlive to report the write failure")
+	}
+}
+
+// reentrancyDetectingWriter records whether two Write calls were ever in
+// flight at once, so tests can tell whether SSEWriter's writeMu actually
+// serialized a KeepAlive ping against a concurrent WriteEvent - a plain
+// bytes.Buffer would silently corrupt itself instead of failing loudly.
+type reentrancyDetectingWriter struct {
+	mu        sync.Mutex
+	buf       bytes.Buffer
+	active    atomic.Int32
+	reentered atomic.Bool
+}
+
+func (w *reentrancyDetectingWriter) Write(p []byte) (int, error) {
+	if w.active.Add(1) > 1 {
+		w.reentered.Store(true)
+	}
+	defer w.active.Add(-1)
+
+	time.Sleep(time.Millisecond)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.buf.Write(p)
+}
+
+func TestSSEWriter_KeepAlive_SerializesWithConcurrentWriteEvent(t *testing.T) {
+	writer := NewSSEWriter()
+	sink := &reentrancyDetectingWriter{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Millisecond)
+	defer cancel()
+
+	gone := writer.KeepAlive(ctx, sink, 2*time.Millisecond)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 40; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			event := &mockEvent{customJSON: []byte(fmt.Sprintf(`{"n":%d}`, i))}
+			_ = writer.WriteEvent(ctx, sink, event)
+		}(i)
+	}
+	wg.Wait()
+	<-gone
+
+	if sink.reentered.Load() {
+		t.Error("expected KeepAlive pings and concurrent WriteEvent calls to never overlap a Write call")
+	}
+}
@@ -0,0 +1,153 @@
+package sse
+
+import (
+	"fmt"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// PayloadCodec encodes an event's payload for WriteEventWithNegotiation,
+// selected by Accept-header content negotiation the same way
+// selectFrameEncoder picks a FrameEncoder. Unlike a FrameEncoder, a
+// PayloadCodec only produces the bytes embedded in a frame's "data:"
+// lines - event:/id: prefixing, escaping, and (for binary codecs)
+// base64-wrapping stay the writer's job, so every codec gets them for
+// free instead of reimplementing framing itself.
+type PayloadCodec interface {
+	// ContentType returns the MIME type this codec produces.
+	ContentType() string
+
+	// Encode renders event's payload in this codec's wire format.
+	Encode(event events.Event) ([]byte, error)
+}
+
+// PayloadCodecRegistry holds the set of known PayloadCodecs, selected by
+// content type.
+type PayloadCodecRegistry struct {
+	codecs map[string]PayloadCodec
+}
+
+// NewPayloadCodecRegistry creates a registry pre-populated with the JSON,
+// MessagePack, and CBOR codecs.
+func NewPayloadCodecRegistry() *PayloadCodecRegistry {
+	r := &PayloadCodecRegistry{codecs: make(map[string]PayloadCodec)}
+	r.Register(&JSONPayloadCodec{})
+	r.Register(&MsgpackPayloadCodec{})
+	r.Register(&CBORPayloadCodec{})
+	return r
+}
+
+// Register adds or replaces a PayloadCodec under its ContentType.
+func (r *PayloadCodecRegistry) Register(codec PayloadCodec) {
+	r.codecs[codec.ContentType()] = codec
+}
+
+// Get returns the codec registered for contentType, if any.
+func (r *PayloadCodecRegistry) Get(contentType string) (PayloadCodec, bool) {
+	c, ok := r.codecs[contentType]
+	return c, ok
+}
+
+// JSONPayloadCodec encodes an event via its own ToJSON.
+type JSONPayloadCodec struct{}
+
+// ContentType returns application/json.
+func (c *JSONPayloadCodec) ContentType() string { return "application/json" }
+
+// Encode returns event.ToJSON().
+func (c *JSONPayloadCodec) Encode(event events.Event) ([]byte, error) {
+	return event.ToJSON()
+}
+
+// msgpackMarshaler is implemented by events with their own tag-driven
+// MessagePack encoding - the shape a tinylib/msgp-generated method would
+// have. Events without it fall back to MsgpackPayloadCodec wrapping their
+// JSON form as a MessagePack bin value, so every event stays encodable.
+type msgpackMarshaler interface {
+	MarshalMsgpack() ([]byte, error)
+}
+
+// MsgpackPayloadCodec encodes events to MessagePack.
+type MsgpackPayloadCodec struct{}
+
+// ContentType returns application/msgpack.
+func (c *MsgpackPayloadCodec) ContentType() string { return "application/msgpack" }
+
+// Encode calls event's own MarshalMsgpack if implemented, otherwise wraps
+// its JSON form as a MessagePack bin value.
+func (c *MsgpackPayloadCodec) Encode(event events.Event) ([]byte, error) {
+	if m, ok := event.(msgpackMarshaler); ok {
+		return m.MarshalMsgpack()
+	}
+	jsonData, err := event.ToJSON()
+	if err != nil {
+		return nil, fmt.Errorf("json encode failed: %w", err)
+	}
+	return msgpackBin(jsonData), nil
+}
+
+// msgpackBin wraps data in a MessagePack bin8/bin16/bin32 value - the
+// generic fallback shape MsgpackPayloadCodec uses for an event with no
+// MarshalMsgpack of its own.
+func msgpackBin(data []byte) []byte {
+	n := len(data)
+	switch {
+	case n <= 0xff:
+		return append([]byte{0xc4, byte(n)}, data...)
+	case n <= 0xffff:
+		return append([]byte{0xc5, byte(n >> 8), byte(n)}, data...)
+	default:
+		return append([]byte{0xc6, byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n)}, data...)
+	}
+}
+
+// cborMarshaler is implemented by events with their own CBOR encoding.
+// Events without it fall back to CBORPayloadCodec wrapping their JSON
+// form as a CBOR byte string, so every event stays encodable.
+type cborMarshaler interface {
+	MarshalCBOR() ([]byte, error)
+}
+
+// CBORPayloadCodec encodes events to CBOR.
+type CBORPayloadCodec struct{}
+
+// ContentType returns application/cbor.
+func (c *CBORPayloadCodec) ContentType() string { return "application/cbor" }
+
+// Encode calls event's own MarshalCBOR if implemented, otherwise wraps its
+// JSON form as a CBOR byte string.
+func (c *CBORPayloadCodec) Encode(event events.Event) ([]byte, error) {
+	if m, ok := event.(cborMarshaler); ok {
+		return m.MarshalCBOR()
+	}
+	jsonData, err := event.ToJSON()
+	if err != nil {
+		return nil, fmt.Errorf("json encode failed: %w", err)
+	}
+	return cborByteString(jsonData), nil
+}
+
+// cborByteString wraps data in a CBOR major-type-2 byte string - the
+// generic fallback shape CBORPayloadCodec uses for an event with no
+// MarshalCBOR of its own.
+func cborByteString(data []byte) []byte {
+	n := len(data)
+	switch {
+	case n < 24:
+		return append([]byte{0x40 | byte(n)}, data...)
+	case n <= 0xff:
+		return append([]byte{0x58, byte(n)}, data...)
+	case n <= 0xffff:
+		return append([]byte{0x59, byte(n >> 8), byte(n)}, data...)
+	default:
+		return append([]byte{0x5a, byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n)}, data...)
+	}
+}
+
+// payloadNeedsBase64 reports whether contentType's payload bytes must be
+// base64-encoded before framing, since SSE's line-oriented "data:" format
+// can't carry raw binary data. JSON is the only registered codec whose
+// output is already line-safe text.
+func payloadNeedsBase64(contentType string) bool {
+	return contentType != "application/json"
+}
@@ -0,0 +1,179 @@
+package sse
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// plainEvent implements events.Event via ToJSON alone, with no
+// MarshalMsgpack/MarshalCBOR of its own, so tests can exercise
+// MsgpackPayloadCodec/CBORPayloadCodec's generic JSON-wrapping fallback.
+type plainEvent struct {
+	events.BaseEvent
+	json []byte
+}
+
+func (e *plainEvent) ThreadID() string        { return "" }
+func (e *plainEvent) RunID() string           { return "" }
+func (e *plainEvent) Validate() error         { return nil }
+func (e *plainEvent) ToJSON() ([]byte, error) { return e.json, nil }
+
+func TestNewPayloadCodecRegistry(t *testing.T) {
+	reg := NewPayloadCodecRegistry()
+
+	for _, ct := range []string{"application/json", "application/msgpack", "application/cbor"} {
+		if _, ok := reg.Get(ct); !ok {
+			t.Errorf("expected registry to have codec for %s", ct)
+		}
+	}
+
+	if _, ok := reg.Get("application/xml"); ok {
+		t.Error("expected no codec for unregistered content type")
+	}
+}
+
+func TestJSONPayloadCodec_Encode(t *testing.T) {
+	event := &plainEvent{json: []byte(`{"n":1}`)}
+	got, err := (&JSONPayloadCodec{}).Encode(event)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(got) != `{"n":1}` {
+		t.Errorf("unexpected payload: %s", got)
+	}
+}
+
+func TestMsgpackPayloadCodec_Encode(t *testing.T) {
+	t.Run("uses event's own MarshalMsgpack", func(t *testing.T) {
+		event := &mockEvent{msgpackValue: []byte{0xc4, 0x01, 0x2a}}
+		got, err := (&MsgpackPayloadCodec{}).Encode(event)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !bytes.Equal(got, event.msgpackValue) {
+			t.Errorf("expected event's own MarshalMsgpack output, got %x", got)
+		}
+	})
+
+	t.Run("falls back to wrapping JSON as a bin value", func(t *testing.T) {
+		event := &plainEvent{json: []byte(`{"n":1}`)}
+		got, err := (&MsgpackPayloadCodec{}).Encode(event)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := append([]byte{0xc4, byte(len(event.json))}, event.json...)
+		if !bytes.Equal(got, want) {
+			t.Errorf("expected %x, got %x", want, got)
+		}
+	})
+}
+
+func TestCBORPayloadCodec_Encode(t *testing.T) {
+	t.Run("uses event's own MarshalCBOR", func(t *testing.T) {
+		event := &mockEvent{cborValue: []byte{0x43, 0x01, 0x02, 0x03}}
+		got, err := (&CBORPayloadCodec{}).Encode(event)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !bytes.Equal(got, event.cborValue) {
+			t.Errorf("expected event's own MarshalCBOR output, got %x", got)
+		}
+	})
+
+	t.Run("falls back to wrapping JSON as a byte string", func(t *testing.T) {
+		event := &plainEvent{json: []byte(`{"n":1}`)}
+		got, err := (&CBORPayloadCodec{}).Encode(event)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := append([]byte{0x40 | byte(len(event.json))}, event.json...)
+		if !bytes.Equal(got, want) {
+			t.Errorf("expected %x, got %x", want, got)
+		}
+	})
+}
+
+func TestSSEWriter_WriteEventWithNegotiation_PayloadCodecs(t *testing.T) {
+	cases := []struct {
+		name        string
+		accept      string
+		wantBase64  bool
+		wantCodecCT string
+	}{
+		{name: "json stays verbatim", accept: "application/json", wantBase64: false, wantCodecCT: "application/json"},
+		{name: "msgpack is base64-wrapped", accept: "application/msgpack", wantBase64: true, wantCodecCT: "application/msgpack"},
+		{name: "cbor is base64-wrapped", accept: "application/cbor", wantBase64: true, wantCodecCT: "application/cbor"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			writer := NewSSEWriter()
+			event := &plainEvent{json: []byte(`{"n":1}`)}
+
+			var buf bytes.Buffer
+			if err := writer.WriteEventWithNegotiation(ctx, &buf, event, tc.accept); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			output := buf.String()
+			if !strings.Contains(output, "event-codec: "+tc.wantCodecCT) {
+				t.Errorf("expected event-codec comment for %s, got %q", tc.wantCodecCT, output)
+			}
+
+			dataLine := ""
+			for _, line := range strings.Split(output, "\n") {
+				if strings.HasPrefix(line, "data: ") {
+					dataLine = strings.TrimPrefix(line, "data: ")
+					break
+				}
+			}
+			if dataLine == "" {
+				t.Fatalf("expected a data: line, got %q", output)
+			}
+
+			if tc.wantBase64 {
+				if _, err := base64.StdEncoding.DecodeString(dataLine); err != nil {
+					t.Errorf("expected base64-encoded payload, got %q: %v", dataLine, err)
+				}
+			} else if dataLine != `{"n":1}` {
+				t.Errorf("expected verbatim JSON payload, got %q", dataLine)
+			}
+		})
+	}
+}
+
+func TestSSEWriter_WriteEventWithNegotiation_AnnouncesCodecOnceUntilChanged(t *testing.T) {
+	ctx := context.Background()
+	writer := NewSSEWriter()
+	event := &plainEvent{json: []byte(`{"n":1}`)}
+
+	var first bytes.Buffer
+	if err := writer.WriteEventWithNegotiation(ctx, &first, event, "application/json"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Count(first.String(), "event-codec:") != 1 {
+		t.Errorf("expected exactly one event-codec announcement, got %q", first.String())
+	}
+
+	var second bytes.Buffer
+	if err := writer.WriteEventWithNegotiation(ctx, &second, event, "application/json"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(second.String(), "event-codec:") {
+		t.Errorf("expected no re-announcement for an unchanged codec, got %q", second.String())
+	}
+
+	var third bytes.Buffer
+	if err := writer.WriteEventWithNegotiation(ctx, &third, event, "application/msgpack"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(third.String(), "event-codec: application/msgpack") {
+		t.Errorf("expected re-announcement after the negotiated codec changed, got %q", third.String())
+	}
+}
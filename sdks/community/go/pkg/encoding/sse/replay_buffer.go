@@ -0,0 +1,202 @@
+package sse
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// replayedFrame is a single frame retained by a ReplayBuffer for resumption
+type replayedFrame struct {
+	seq   int64
+	bytes []byte
+}
+
+// ReplayBuffer is a bounded ring buffer of previously emitted SSE frames,
+// keyed by a strictly increasing sequence number, so a client reconnecting
+// with a Last-Event-ID header can resume the stream without gaps.
+type ReplayBuffer struct {
+	mu       sync.Mutex
+	frames   []replayedFrame
+	maxCount int
+	maxBytes int64
+	curBytes int64
+}
+
+// NewReplayBuffer creates a ReplayBuffer holding at most maxCount frames
+// (0 for unbounded by count) and at most maxBytes total frame bytes (0 for
+// unbounded by size).
+func NewReplayBuffer(maxCount int, maxBytes int64) *ReplayBuffer {
+	return &ReplayBuffer{
+		maxCount: maxCount,
+		maxBytes: maxBytes,
+	}
+}
+
+// Add appends a frame to the buffer, evicting the oldest frames once the
+// count or byte-size bound is exceeded.
+func (b *ReplayBuffer) Add(seq int64, frame []byte) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.frames = append(b.frames, replayedFrame{seq: seq, bytes: frame})
+	b.curBytes += int64(len(frame))
+
+	for b.overLocked() && len(b.frames) > 0 {
+		removed := b.frames[0]
+		b.frames = b.frames[1:]
+		b.curBytes -= int64(len(removed.bytes))
+	}
+}
+
+func (b *ReplayBuffer) overLocked() bool {
+	if b.maxCount > 0 && len(b.frames) > b.maxCount {
+		return true
+	}
+	if b.maxBytes > 0 && b.curBytes > b.maxBytes {
+		return true
+	}
+	return false
+}
+
+// Since returns the buffered frames with sequence numbers strictly greater
+// than afterSeq, in order.
+func (b *ReplayBuffer) Since(afterSeq int64) [][]byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var out [][]byte
+	for _, f := range b.frames {
+		if f.seq > afterSeq {
+			out = append(out, f.bytes)
+		}
+	}
+	return out
+}
+
+// writerNonce uniquely identifies an SSEWriter instance so that event IDs
+// remain unique across process restarts even when the sequence counter
+// resets to zero.
+func newWriterNonce() string {
+	return strconv.FormatInt(time.Now().UnixNano(), 36)
+}
+
+// NewSSEWriterWithReplay creates an SSEWriter backed by a ReplayBuffer
+// holding up to size frames, enabling Last-Event-ID resumption via
+// ResumeFrom.
+func NewSSEWriterWithReplay(size int) *SSEWriter {
+	w := NewSSEWriter()
+	w.replay = NewReplayBuffer(size, 0)
+	w.nonce = newWriterNonce()
+	return w
+}
+
+// nextEventID returns the next strictly increasing event ID for this writer,
+// formatted as "<nonce>-<seq>" so IDs are unique across writer restarts.
+func (w *SSEWriter) nextEventID() (string, int64) {
+	seq := atomic.AddInt64(&w.seq, 1)
+	return fmt.Sprintf("%s-%d", w.nonce, seq), seq
+}
+
+// ResumeFrom replays all frames buffered since lastEventID to writer, then
+// returns so the caller can continue with live streaming. It returns the
+// number of frames replayed. A lastEventID from a different writer instance
+// (for example after a process restart) causes the entire buffer to be
+// replayed, since the client cannot know which of it has already seen.
+func (w *SSEWriter) ResumeFrom(ctx context.Context, writer io.Writer, lastEventID string) (int, error) {
+	if w.replay == nil {
+		return 0, fmt.Errorf("replay buffer not enabled: use NewSSEWriterWithReplay")
+	}
+
+	afterSeq := int64(0)
+	if lastEventID != "" {
+		nonce, seq, ok := parseEventID(lastEventID)
+		if ok && nonce == w.nonce {
+			afterSeq = seq
+		}
+		// Unknown or foreign nonce: afterSeq stays 0, replaying everything buffered.
+	}
+
+	frames := w.replay.Since(afterSeq)
+	for _, frame := range frames {
+		if _, err := writer.Write(frame); err != nil {
+			w.logger.ErrorContext(ctx, "Failed to write replayed frame", "error", err)
+			return 0, fmt.Errorf("replay write failed: %w", err)
+		}
+	}
+	if flusher, ok := writer.(flusher); ok {
+		if err := flusher.Flush(); err != nil {
+			w.logger.ErrorContext(ctx, "Failed to flush replayed frames", "error", err)
+			return len(frames), fmt.Errorf("replay flush failed: %w", err)
+		}
+	}
+
+	w.logger.DebugContext(ctx, "Replayed buffered frames", "last_event_id", lastEventID, "missed", len(frames))
+	return len(frames), nil
+}
+
+// ResumeFromStream writes every event from eventStream to writer using w's
+// normal framing, skipping any whose id (per w.eventID - respecting a
+// SetEventIDFunc override, or the default type_timestamp scheme) sorts
+// lexically less than or equal to lastEventID. Unlike ResumeFrom, it
+// doesn't require w's own ReplayBuffer: it drains any live source of
+// events.Event whose IDs the caller owns - for example a per-thread
+// events.ReplayBuffer - so lastEventID comparison happens against the
+// scheme that produced it rather than this writer's nonce-seq one. It
+// returns the number of events written, stopping early with ctx's error if
+// ctx is done before eventStream closes.
+func (w *SSEWriter) ResumeFromStream(ctx context.Context, writer io.Writer, lastEventID string, eventStream <-chan events.Event) (int, error) {
+	written := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return written, ctx.Err()
+		case event, ok := <-eventStream:
+			if !ok {
+				return written, nil
+			}
+			if lastEventID != "" && w.eventID(event) <= lastEventID {
+				continue
+			}
+			if err := w.WriteEvent(ctx, writer, event); err != nil {
+				return written, err
+			}
+			written++
+		}
+	}
+}
+
+// parseEventID splits an event ID of the form "<nonce>-<seq>" produced by
+// nextEventID.
+func parseEventID(id string) (nonce string, seq int64, ok bool) {
+	idx := strings.LastIndex(id, "-")
+	if idx < 0 {
+		return "", 0, false
+	}
+	seq, err := strconv.ParseInt(id[idx+1:], 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return id[:idx], seq, true
+}
+
+// LastEventIDMiddleware wraps next, extracting the Last-Event-ID header (or
+// the "lastEventId" query parameter, used by some EventSource polyfills) and
+// passing it to handler alongside the request.
+func LastEventIDMiddleware(handler func(w http.ResponseWriter, r *http.Request, lastEventID string)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		lastEventID := r.Header.Get("Last-Event-ID")
+		if lastEventID == "" {
+			lastEventID = r.URL.Query().Get("lastEventId")
+		}
+		handler(w, r, lastEventID)
+	}
+}
@@ -0,0 +1,153 @@
+package sse
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+func TestReplayBuffer_AddAndSince(t *testing.T) {
+	buf := NewReplayBuffer(0, 0)
+	buf.Add(1, []byte("a"))
+	buf.Add(2, []byte("b"))
+	buf.Add(3, []byte("c"))
+
+	got := buf.Since(1)
+	if len(got) != 2 || string(got[0]) != "b" || string(got[1]) != "c" {
+		t.Fatalf("unexpected frames: %v", got)
+	}
+
+	if got := buf.Since(3); len(got) != 0 {
+		t.Errorf("expected no frames after last seq, got %d", len(got))
+	}
+}
+
+func TestReplayBuffer_BoundedByCount(t *testing.T) {
+	buf := NewReplayBuffer(2, 0)
+	buf.Add(1, []byte("a"))
+	buf.Add(2, []byte("b"))
+	buf.Add(3, []byte("c"))
+
+	got := buf.Since(0)
+	if len(got) != 2 || string(got[0]) != "b" || string(got[1]) != "c" {
+		t.Fatalf("expected oldest frame evicted, got %v", got)
+	}
+}
+
+func TestSSEWriter_ResumeFrom(t *testing.T) {
+	ctx := context.Background()
+	writer := NewSSEWriterWithReplay(10)
+
+	event := &mockEvent{customJSON: []byte(`{"n":1}`)}
+	var sink bytes.Buffer
+	for i := 0; i < 3; i++ {
+		if err := writer.WriteEvent(ctx, &sink, event); err != nil {
+			t.Fatalf("unexpected error writing event %d: %v", i, err)
+		}
+	}
+
+	firstFrame := strings.SplitN(sink.String(), "\n\n", 2)[0] + "\n\n"
+	idLine := strings.SplitN(firstFrame, "\n", 2)[0]
+	firstID := strings.TrimPrefix(idLine, "id: ")
+
+	var resumed bytes.Buffer
+	missed, err := writer.ResumeFrom(ctx, &resumed, firstID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if missed != 2 {
+		t.Errorf("expected 2 missed frames, got %d", missed)
+	}
+
+	missedAll, err := writer.ResumeFrom(ctx, &resumed, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if missedAll != 3 {
+		t.Errorf("expected all 3 frames replayed for empty Last-Event-ID, got %d", missedAll)
+	}
+}
+
+func TestSSEWriter_ResumeFrom_NoReplayBuffer(t *testing.T) {
+	writer := NewSSEWriter()
+	var buf bytes.Buffer
+	if _, err := writer.ResumeFrom(context.Background(), &buf, "x-1"); err == nil {
+		t.Error("expected error when replay buffer is not enabled")
+	}
+}
+
+func TestSSEWriter_ResumeFromStream(t *testing.T) {
+	ctx := context.Background()
+	writer := NewSSEWriter()
+
+	stream := make(chan events.Event, 3)
+	stream <- &mockEvent{customJSON: []byte(`{"n":1}`), dataValue: map[string]interface{}{}}
+	stream <- &mockEvent{customJSON: []byte(`{"n":2}`), dataValue: map[string]interface{}{}}
+	close(stream)
+
+	var buf bytes.Buffer
+	written, err := writer.ResumeFromStream(ctx, &buf, "", stream)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if written != 2 {
+		t.Errorf("expected 2 events written, got %d", written)
+	}
+}
+
+func TestSSEWriter_ResumeFromStream_FiltersByLastEventID(t *testing.T) {
+	ctx := context.Background()
+	writer := NewSSEWriter().SetEventIDFunc(func(event events.Event) string {
+		return event.(*mockEvent).id
+	})
+
+	stream := make(chan events.Event, 3)
+	stream <- &mockEvent{customJSON: []byte(`{"n":1}`), id: "1"}
+	stream <- &mockEvent{customJSON: []byte(`{"n":2}`), id: "2"}
+	stream <- &mockEvent{customJSON: []byte(`{"n":3}`), id: "3"}
+	close(stream)
+
+	var buf bytes.Buffer
+	written, err := writer.ResumeFromStream(ctx, &buf, "1", stream)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if written != 2 {
+		t.Errorf("expected events after id 1 only, got %d written", written)
+	}
+}
+
+func TestSSEWriter_ResumeFromStream_StopsOnContextDone(t *testing.T) {
+	writer := NewSSEWriter()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	stream := make(chan events.Event)
+	var buf bytes.Buffer
+	_, err := writer.ResumeFromStream(ctx, &buf, "", stream)
+	if err == nil {
+		t.Error("expected context error")
+	}
+}
+
+func TestLastEventIDMiddleware(t *testing.T) {
+	var captured string
+	handler := LastEventIDMiddleware(func(w http.ResponseWriter, r *http.Request, lastEventID string) {
+		captured = lastEventID
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/stream", nil)
+	req.Header.Set("Last-Event-ID", "abc-5")
+	rec := httptest.NewRecorder()
+
+	handler(rec, req)
+
+	if captured != "abc-5" {
+		t.Errorf("expected captured ID abc-5, got %q", captured)
+	}
+}
@@ -2,6 +2,7 @@ package sse
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -11,20 +12,62 @@ import (
 	"time"
 
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding"
 	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/encoder"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/negotiation"
 )
 
 // SSEWriter provides utilities for writing Server-Sent Events with proper framing
 type SSEWriter struct {
-	encoder *encoder.EventEncoder
-	logger  *slog.Logger
+	encoder  *encoder.EventEncoder
+	logger   *slog.Logger
+	encoders *FrameEncoderRegistry
+
+	// replay, nonce, and seq back Last-Event-ID resumption; they are only
+	// populated for writers created via NewSSEWriterWithReplay.
+	replay *ReplayBuffer
+	nonce  string
+	seq    int64
+
+	// eventIDFunc, if set via SetEventIDFunc, overrides the default
+	// type_timestamp id: scheme for writers without a replay buffer.
+	eventIDFunc func(events.Event) string
+
+	// retryInterval is the writer's default reconnection hint, set via
+	// WithRetryInterval; callers that start a new connection without one
+	// of their own (see Broker.ServeHTTP) send this instead.
+	retryInterval time.Duration
+
+	// codecs holds the PayloadCodecs WriteEventWithNegotiation negotiates
+	// over, alongside the FrameEncoders in encoders.
+	codecs *PayloadCodecRegistry
+
+	// codecMu guards lastAnnouncedCodec.
+	codecMu sync.Mutex
+	// lastAnnouncedCodec is the content type of the last PayloadCodec
+	// WriteEventWithNegotiation announced via an "event-codec:" comment;
+	// starts empty so the first call always announces.
+	lastAnnouncedCodec string
+
+	// writeMu serializes every frame this writer sends to an underlying
+	// io.Writer, so a KeepAlive ping can never land in the middle of a
+	// multi-line data: frame written concurrently by WriteEvent and
+	// friends. Because a single SSEWriter is commonly shared across many
+	// concurrent connections (see Broker), this also serializes unrelated
+	// connections' writes against each other; each write is held only for
+	// the duration of one frame, so the added contention is small relative
+	// to the alternative of tracking per-connection state this writer
+	// doesn't otherwise keep.
+	writeMu sync.Mutex
 }
 
 // NewSSEWriter creates a new SSE writer
 func NewSSEWriter() *SSEWriter {
 	return &SSEWriter{
-		encoder: encoder.NewEventEncoder(),
-		logger:  slog.Default(),
+		encoder:  encoder.NewEventEncoder(),
+		logger:   slog.Default(),
+		encoders: NewFrameEncoderRegistry(),
+		codecs:   NewPayloadCodecRegistry(),
 	}
 }
 
@@ -34,6 +77,56 @@ func (w *SSEWriter) WithLogger(logger *slog.Logger) *SSEWriter {
 	return w
 }
 
+// SetEventIDFunc overrides how new frames' "id:" field is generated, in
+// place of the default type_timestamp scheme. A writer with a replay
+// buffer keeps its own nonce-seq scheme regardless, since ResumeFrom
+// depends on matching it. Use this when a caller wants comparable,
+// collision-free IDs - for example a monotonic per-thread sequence number
+// - without routing events through a ReplayBuffer of their own.
+func (w *SSEWriter) SetEventIDFunc(fn func(events.Event) string) *SSEWriter {
+	w.eventIDFunc = fn
+	return w
+}
+
+// WithRetryInterval configures the writer's default SSE reconnection
+// hint. Broker.ServeHTTP sends it, once, as the first thing written to a
+// new connection, whenever the Broker's own BrokerOptions.RetryInterval
+// isn't set.
+func (w *SSEWriter) WithRetryInterval(d time.Duration) *SSEWriter {
+	w.retryInterval = d
+	return w
+}
+
+// RetryInterval returns the writer's configured default retry interval, or
+// zero if WithRetryInterval was never called.
+func (w *SSEWriter) RetryInterval() time.Duration {
+	return w.retryInterval
+}
+
+// RegisterCodec adds or replaces a PayloadCodec available to
+// WriteEventWithNegotiation, alongside the built-in JSON, MessagePack, and
+// CBOR codecs.
+func (w *SSEWriter) RegisterCodec(codec PayloadCodec) {
+	w.codecs.Register(codec)
+}
+
+// eventID returns the SSE "id:" value a fresh frame for event would carry:
+// eventIDFunc's result if one is configured, otherwise the default
+// type_timestamp scheme. It ignores a replay buffer, since that scheme's
+// nonce-seq IDs can only be produced by consuming the buffer's own
+// sequence counter (see nextEventID) - ResumeFromStream, the one caller of
+// this method, is therefore only meaningful for writers without one; a
+// replay-backed writer should resume via ResumeFrom instead.
+func (w *SSEWriter) eventID(event events.Event) string {
+	if w.eventIDFunc != nil {
+		return w.eventIDFunc(event)
+	}
+	if event != nil && event.Timestamp() != nil {
+		return fmt.Sprintf("%s_%d", event.Type(), *event.Timestamp())
+	}
+	return ""
+}
+
 // WriteEvent writes a single event as SSE format to the writer with proper framing
 // Format: data: <json>\n\n with proper escaping and flushing
 func (w *SSEWriter) WriteEvent(ctx context.Context, writer io.Writer, event events.Event) error {
@@ -42,17 +135,21 @@ func (w *SSEWriter) WriteEvent(ctx context.Context, writer io.Writer, event even
 
 // WriteBytes writes an event
 func (w *SSEWriter) WriteBytes(ctx context.Context, writer io.Writer, event []byte) error {
-
-	// Create SSE frame
-	sseFrame, err := w.createSSEFrame(event, "", nil)
+	frame, err := w.createSSEFrameBuf(event, "", nil)
 	if err != nil {
 		w.logger.ErrorContext(ctx, "Failed to create SSE frame",
 			"error", err)
 		return fmt.Errorf("SSE frame creation failed: %w", err)
 	}
+	defer frame.Release()
 
-	// Write the SSE frame
-	_, err = writer.Write([]byte(sseFrame))
+	// Write straight from the pooled buffer's contents - no intermediate
+	// string or copy - then release it back to the pool once the HTTP
+	// write path (and any flush) is done with it.
+	w.writeMu.Lock()
+	defer w.writeMu.Unlock()
+
+	_, err = writer.Write(frame.Bytes())
 	if err != nil {
 		w.logger.ErrorContext(ctx, "Failed to write SSE frame",
 			"error", err)
@@ -89,17 +186,23 @@ func (w *SSEWriter) WriteEventWithType(ctx context.Context, writer io.Writer, ev
 		return fmt.Errorf("event encoding failed: %w", err)
 	}
 
-	// Create SSE frame
-	sseFrame, err := w.createSSEFrame(jsonData, eventType, event)
+	// Create SSE frame in a pooled, refcounted buffer so it can be handed
+	// off to the HTTP write path and released after Flush without a
+	// defensive copy.
+	frame, err := w.createSSEFrameBuf(jsonData, eventType, event)
 	if err != nil {
 		w.logger.ErrorContext(ctx, "Failed to create SSE frame",
 			"error", err,
 			"event_type", event.Type())
 		return fmt.Errorf("SSE frame creation failed: %w", err)
 	}
+	defer frame.Release()
 
 	// Write the SSE frame
-	_, err = writer.Write([]byte(sseFrame))
+	w.writeMu.Lock()
+	defer w.writeMu.Unlock()
+
+	_, err = writer.Write(frame.Bytes())
 	if err != nil {
 		w.logger.ErrorContext(ctx, "Failed to write SSE frame",
 			"error", err,
@@ -120,42 +223,226 @@ func (w *SSEWriter) WriteEventWithType(ctx context.Context, writer io.Writer, ev
 	return nil
 }
 
-// WriteEventWithNegotiation writes an event after performing content negotiation
-func (w *SSEWriter) WriteEventWithNegotiation(ctx context.Context, writer io.Writer, event events.Event, acceptHeader string) error {
-	// Perform content negotiation
-	_, err := w.encoder.NegotiateContentType(acceptHeader)
+// WriteEventWithID writes event using the given custom SSE id instead of
+// the writer's own id-generation scheme (event.Type()_timestamp, or the
+// replay nonce-seq scheme for a NewSSEWriterWithReplay writer). Callers
+// that maintain their own sequence numbering - for example a per-thread
+// events.ReplayBuffer - use this so a client's Last-Event-ID round-trips
+// through the scheme the caller owns rather than this writer's own.
+func (w *SSEWriter) WriteEventWithID(ctx context.Context, writer io.Writer, event events.Event, id string) error {
+	if event == nil {
+		return fmt.Errorf("event cannot be nil")
+	}
+	if writer == nil {
+		return fmt.Errorf("writer cannot be nil")
+	}
+
+	jsonData, err := w.encoder.EncodeEvent(ctx, event, "application/json")
 	if err != nil {
-		w.logger.WarnContext(ctx, "Content negotiation failed, using JSON",
+		w.logger.ErrorContext(ctx, "Failed to encode event",
+			"error", err,
+			"event_type", event.Type())
+		return fmt.Errorf("event encoding failed: %w", err)
+	}
+
+	var frame strings.Builder
+	frame.WriteString(fmt.Sprintf("id: %s\n", id))
+	data := strings.ReplaceAll(string(jsonData), "\r\n", "\n")
+	for _, line := range strings.Split(data, "\n") {
+		frame.WriteString("data: ")
+		frame.WriteString(line)
+		frame.WriteString("\n")
+	}
+	frame.WriteString("\n")
+
+	w.writeMu.Lock()
+	defer w.writeMu.Unlock()
+
+	if _, err := writer.Write([]byte(frame.String())); err != nil {
+		w.logger.ErrorContext(ctx, "Failed to write SSE frame",
 			"error", err,
-			"accept_header", acceptHeader)
-		// Continue with JSON fallback
+			"event_type", event.Type())
+		return fmt.Errorf("SSE write failed: %w", err)
+	}
+
+	if flusher, ok := writer.(flusher); ok {
+		if err := flusher.Flush(); err != nil {
+			w.logger.ErrorContext(ctx, "Failed to flush SSE frame",
+				"error", err,
+				"event_type", event.Type())
+			return fmt.Errorf("SSE flush failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// WriteEventWithNegotiation writes an event after performing content
+// negotiation over the registered PayloadCodecs and FrameEncoders, picking
+// the highest q-valued Accept entry that matches a registered content
+// type. A matched PayloadCodec's payload is base64-wrapped if binary (see
+// payloadNeedsBase64) and framed through the same escape/split pipeline as
+// the default JSON path (see createSSEFrameBuf), with an "event-codec:
+// <mime>" comment line sent first whenever the negotiated codec differs
+// from the one this writer last announced - including the very first
+// call. A matched FrameEncoder, by contrast, renders the whole frame
+// itself. Unknown or unmatched Accept headers fall back to the JSON SSE
+// encoder.
+func (w *SSEWriter) WriteEventWithNegotiation(ctx context.Context, writer io.Writer, event events.Event, acceptHeader string) error {
+	if event == nil {
+		return fmt.Errorf("event cannot be nil")
+	}
+	if writer == nil {
+		return fmt.Errorf("writer cannot be nil")
+	}
+
+	if codec, ok := w.selectPayloadCodec(ctx, acceptHeader); ok {
+		return w.writeWithPayloadCodec(ctx, writer, event, codec)
+	}
+
+	enc := w.selectFrameEncoder(ctx, acceptHeader)
+
+	frame, err := enc.EncodeFrame(event, "", "")
+	if err != nil {
+		w.logger.ErrorContext(ctx, "Failed to encode negotiated frame",
+			"error", err, "content_type", enc.ContentType())
+		return fmt.Errorf("negotiated frame encoding failed: %w", err)
 	}
 
-	// For now, we only support JSON, so we use JSON regardless of negotiated type
-	return w.WriteEvent(ctx, writer, event)
+	w.writeMu.Lock()
+	defer w.writeMu.Unlock()
+
+	if _, err := writer.Write(frame); err != nil {
+		w.logger.ErrorContext(ctx, "Failed to write negotiated frame", "error", err)
+		return fmt.Errorf("SSE write failed: %w", err)
+	}
+
+	if flusher, ok := writer.(flusher); ok {
+		if err := flusher.Flush(); err != nil {
+			w.logger.ErrorContext(ctx, "Failed to flush negotiated frame", "error", err)
+			return fmt.Errorf("SSE flush failed: %w", err)
+		}
+	}
+	return nil
 }
 
-// WriteErrorEvent writes an error as an SSE event
-func (w *SSEWriter) WriteErrorEvent(ctx context.Context, writer io.Writer, err error, requestID string) error {
-	// Create a custom error event
-	errorEvent := &CustomEvent{
-		BaseEvent: events.BaseEvent{
-			EventType: events.EventTypeCustom,
-		},
+// selectPayloadCodec picks the highest-ranked registered PayloadCodec for
+// acceptHeader, honoring q-values the same way selectFrameEncoder does for
+// FrameEncoders. ok is false for an empty or wildcard-only header, which
+// keeps WriteEventWithNegotiation's original FrameEncoder-based default
+// instead of silently preferring a codec.
+func (w *SSEWriter) selectPayloadCodec(ctx context.Context, acceptHeader string) (codec PayloadCodec, ok bool) {
+	if acceptHeader == "" {
+		return nil, false
 	}
-	errorEvent.SetData(map[string]interface{}{
-		"error":      true,
-		"message":    err.Error(),
-		"request_id": requestID,
-	})
 
-	// Set timestamp
-	errorEvent.SetTimestamp(getCurrentTimestamp())
+	acceptTypes, err := negotiation.ParseAcceptHeader(acceptHeader)
+	if err != nil {
+		w.logger.WarnContext(ctx, "Invalid Accept header, skipping payload codec negotiation",
+			"error", err, "accept_header", acceptHeader)
+		return nil, false
+	}
 
-	return w.WriteEventWithType(ctx, writer, errorEvent, "error")
+	for _, at := range acceptTypes {
+		if at.Quality <= 0 || at.Type == "*/*" {
+			continue
+		}
+		if c, found := w.codecs.Get(at.Type); found {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// writeWithPayloadCodec encodes event via codec and writes the result as
+// the body of WriteEventWithNegotiation's selected frame.
+func (w *SSEWriter) writeWithPayloadCodec(ctx context.Context, writer io.Writer, event events.Event, codec PayloadCodec) error {
+	payload, err := codec.Encode(event)
+	if err != nil {
+		w.logger.ErrorContext(ctx, "Failed to encode event payload",
+			"error", err, "content_type", codec.ContentType())
+		return fmt.Errorf("payload codec encoding failed: %w", err)
+	}
+
+	if payloadNeedsBase64(codec.ContentType()) {
+		payload = []byte(base64.StdEncoding.EncodeToString(payload))
+	}
+
+	w.codecMu.Lock()
+	announce := w.lastAnnouncedCodec != codec.ContentType()
+	w.lastAnnouncedCodec = codec.ContentType()
+	w.codecMu.Unlock()
+
+	if announce {
+		if err := w.WriteComment(ctx, writer, "event-codec: "+codec.ContentType()); err != nil {
+			return err
+		}
+	}
+
+	frame, err := w.createSSEFrameBuf(payload, "", event)
+	if err != nil {
+		w.logger.ErrorContext(ctx, "Failed to create SSE frame",
+			"error", err, "content_type", codec.ContentType())
+		return fmt.Errorf("SSE frame creation failed: %w", err)
+	}
+	defer frame.Release()
+
+	w.writeMu.Lock()
+	defer w.writeMu.Unlock()
+
+	if _, err := writer.Write(frame.Bytes()); err != nil {
+		w.logger.ErrorContext(ctx, "Failed to write SSE frame", "error", err, "content_type", codec.ContentType())
+		return fmt.Errorf("SSE write failed: %w", err)
+	}
+
+	if flusher, ok := writer.(flusher); ok {
+		if err := flusher.Flush(); err != nil {
+			w.logger.ErrorContext(ctx, "Failed to flush SSE frame", "error", err, "content_type", codec.ContentType())
+			return fmt.Errorf("SSE flush failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// selectFrameEncoder picks the best registered FrameEncoder for acceptHeader,
+// falling back to the JSON encoder when negotiation fails or no registered
+// type is accepted.
+func (w *SSEWriter) selectFrameEncoder(ctx context.Context, acceptHeader string) FrameEncoder {
+	fallback, _ := w.encoders.Get("text/event-stream")
+
+	acceptTypes, err := negotiation.ParseAcceptHeader(acceptHeader)
+	if err != nil {
+		w.logger.WarnContext(ctx, "Invalid Accept header, using JSON",
+			"error", err, "accept_header", acceptHeader)
+		return fallback
+	}
+
+	for _, at := range acceptTypes {
+		if at.Quality <= 0 {
+			continue
+		}
+		if at.Type == "*/*" {
+			return fallback
+		}
+		if enc, ok := w.encoders.Get(at.Type); ok {
+			return enc
+		}
+	}
+
+	return fallback
+}
+
+// WriteErrorEvent writes an error as an SSE event. Deprecated: kept for
+// compatibility; prefer WriteError, which emits a structured ErrorEvent with
+// a typed category and retry hints instead of a free-form data map.
+func (w *SSEWriter) WriteErrorEvent(ctx context.Context, writer io.Writer, err error, requestID string) error {
+	return w.WriteError(ctx, writer, err, requestID)
 }
 
 // createSSEFrame creates a properly formatted SSE frame
+//
+// Per the SSE specification, a multi-line payload must be split into one
+// "data:" line per line of input rather than escaped into a single line -
+// escaping would corrupt JSON payloads that contain embedded newlines.
 func (w *SSEWriter) createSSEFrame(jsonData []byte, eventType string, event events.Event) (string, error) {
 	var frame strings.Builder
 
@@ -164,22 +451,158 @@ func (w *SSEWriter) createSSEFrame(jsonData []byte, eventType string, event even
 		frame.WriteString(fmt.Sprintf("event: %s\n", eventType))
 	}
 
-	// Add event ID if available
-	if event != nil && event.Timestamp() != nil {
+	// Add event ID. Writers with a replay buffer use a strictly increasing
+	// "<nonce>-<seq>" ID so reconnecting clients can resume via
+	// ResumeFrom; writers with a SetEventIDFunc use that instead; other
+	// writers keep the simpler type_timestamp scheme (see eventID).
+	var seq int64
+	switch {
+	case w.replay != nil:
+		var id string
+		id, seq = w.nextEventID()
+		frame.WriteString(fmt.Sprintf("id: %s\n", id))
+	case w.eventIDFunc != nil:
+		if id := w.eventIDFunc(event); id != "" {
+			frame.WriteString(fmt.Sprintf("id: %s\n", id))
+		}
+	case event != nil && event.Timestamp() != nil:
 		frame.WriteString(fmt.Sprintf("id: %s_%d\n", event.Type(), *event.Timestamp()))
 	}
 
-	// Escape newlines in JSON data to maintain SSE format integrity
-	escapedData := strings.ReplaceAll(string(jsonData), "\n", "\\n")
-	escapedData = strings.ReplaceAll(escapedData, "\r", "\\r")
-
-	// Write data line
-	frame.WriteString(fmt.Sprintf("data: %s\n", escapedData))
+	// Split the payload on newlines and emit one data: line per segment;
+	// clients reassemble them by joining with "\n" as per the SSE spec.
+	data := strings.ReplaceAll(string(jsonData), "\r\n", "\n")
+	for _, line := range strings.Split(data, "\n") {
+		frame.WriteString("data: ")
+		frame.WriteString(line)
+		frame.WriteString("\n")
+	}
 
 	// End with empty line to complete the SSE event
 	frame.WriteString("\n")
 
-	return frame.String(), nil
+	frameStr := frame.String()
+	if w.replay != nil {
+		w.replay.Add(seq, []byte(frameStr))
+	}
+
+	return frameStr, nil
+}
+
+// createSSEFrameBuf is createSSEFrame's zero-copy counterpart: it builds
+// the same frame into a pooled, refcounted encoding.RefBuffer instead of a
+// strings.Builder, so the caller can write straight from it and Release it
+// once the write (and flush) completes instead of discarding a throwaway
+// string. The replay buffer, which outlives a single write, still gets its
+// own copy of the bytes - its retention lifetime is independent of the
+// RefBuffer's.
+func (w *SSEWriter) createSSEFrameBuf(jsonData []byte, eventType string, event events.Event) (*encoding.RefBuffer, error) {
+	frame := encoding.NewRefBuffer(len(jsonData) + 64)
+	buf := frame.Buffer()
+
+	if eventType != "" {
+		fmt.Fprintf(buf, "event: %s\n", eventType)
+	}
+
+	var seq int64
+	switch {
+	case w.replay != nil:
+		var id string
+		id, seq = w.nextEventID()
+		fmt.Fprintf(buf, "id: %s\n", id)
+	case w.eventIDFunc != nil:
+		if id := w.eventIDFunc(event); id != "" {
+			fmt.Fprintf(buf, "id: %s\n", id)
+		}
+	case event != nil && event.Timestamp() != nil:
+		fmt.Fprintf(buf, "id: %s_%d\n", event.Type(), *event.Timestamp())
+	}
+
+	data := strings.ReplaceAll(string(jsonData), "\r\n", "\n")
+	for _, line := range strings.Split(data, "\n") {
+		buf.WriteString("data: ")
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte('\n')
+
+	if w.replay != nil {
+		w.replay.Add(seq, append([]byte(nil), buf.Bytes()...))
+	}
+
+	return frame, nil
+}
+
+// WriteComment writes an SSE comment line (": <text>\n\n"). Browsers and
+// proxies treat comment lines as keep-alive pings without surfacing them
+// to the client's event handlers.
+func (w *SSEWriter) WriteComment(ctx context.Context, writer io.Writer, text string) error {
+	if writer == nil {
+		return fmt.Errorf("writer cannot be nil")
+	}
+
+	text = strings.ReplaceAll(text, "\n", " ")
+	frame := fmt.Sprintf(": %s\n\n", text)
+
+	w.writeMu.Lock()
+	defer w.writeMu.Unlock()
+
+	if _, err := writer.Write([]byte(frame)); err != nil {
+		w.logger.ErrorContext(ctx, "Failed to write SSE comment", "error", err)
+		return fmt.Errorf("SSE comment write failed: %w", err)
+	}
+
+	if flusher, ok := writer.(flusher); ok {
+		if err := flusher.Flush(); err != nil {
+			w.logger.ErrorContext(ctx, "Failed to flush SSE comment", "error", err)
+			return fmt.Errorf("SSE flush failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// WriteRetry writes a "retry:" field advising the client how long to wait
+// before reconnecting after the stream is interrupted.
+func (w *SSEWriter) WriteRetry(ctx context.Context, writer io.Writer, d time.Duration) error {
+	if writer == nil {
+		return fmt.Errorf("writer cannot be nil")
+	}
+
+	frame := fmt.Sprintf("retry: %d\n\n", d.Milliseconds())
+
+	w.writeMu.Lock()
+	defer w.writeMu.Unlock()
+
+	if _, err := writer.Write([]byte(frame)); err != nil {
+		w.logger.ErrorContext(ctx, "Failed to write SSE retry", "error", err)
+		return fmt.Errorf("SSE retry write failed: %w", err)
+	}
+
+	if flusher, ok := writer.(flusher); ok {
+		if err := flusher.Flush(); err != nil {
+			w.logger.ErrorContext(ctx, "Failed to flush SSE retry", "error", err)
+			return fmt.Errorf("SSE flush failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// Heartbeat writes periodic comment pings to writer every interval until
+// ctx is done, preventing idle proxies from closing the connection.
+func (w *SSEWriter) Heartbeat(ctx context.Context, writer io.Writer, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := w.WriteComment(ctx, writer, "heartbeat"); err != nil {
+				return err
+			}
+		}
+	}
 }
 
 // flusher interface for writers that support flushing
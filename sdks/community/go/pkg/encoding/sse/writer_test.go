@@ -21,6 +21,32 @@ type mockEvent struct {
 	validateError error
 	toJSONError   error
 	customJSON    []byte
+	// id is read by tests exercising SetEventIDFunc, which index into it
+	// via an event-type-aware callback rather than a real ID scheme.
+	id string
+
+	// msgpackValue/msgpackErr and cborValue/cborErr back mockEvent's own
+	// MarshalMsgpack/MarshalCBOR, so payload_codec_test.go's table tests
+	// can assert MsgpackPayloadCodec/CBORPayloadCodec round-trip through
+	// an event's own marshaler instead of only the generic fallback.
+	msgpackValue []byte
+	msgpackErr   error
+	cborValue    []byte
+	cborErr      error
+}
+
+func (m *mockEvent) MarshalMsgpack() ([]byte, error) {
+	if m.msgpackErr != nil {
+		return nil, m.msgpackErr
+	}
+	return m.msgpackValue, nil
+}
+
+func (m *mockEvent) MarshalCBOR() ([]byte, error) {
+	if m.cborErr != nil {
+		return nil, m.cborErr
+	}
+	return m.cborValue, nil
 }
 
 func (m *mockEvent) Data() map[string]interface{} {
@@ -202,8 +228,8 @@ func TestSSEWriter_WriteBytes(t *testing.T) {
 			writer:        &bytes.Buffer{},
 			expectedError: false,
 			validateSSE: func(t *testing.T, output string) {
-				if !strings.Contains(output, `line1\nline2\rline3`) {
-					t.Error("expected newlines to be escaped")
+				if !strings.Contains(output, "data: line1\n") || !strings.Contains(output, "data: line2\rline3\n") {
+					t.Error("expected one data: line per input line")
 				}
 			},
 		},
@@ -635,13 +661,14 @@ func TestCreateSSEFrame(t *testing.T) {
 			},
 		},
 		{
-			name:      "frame with newlines escaped",
+			name:      "frame with newlines split into multiple data lines",
 			jsonData:  []byte("line1\nline2\rline3"),
 			eventType: "",
 			event:     nil,
 			validate: func(t *testing.T, frame string) {
-				if !strings.Contains(frame, `line1\nline2\rline3`) {
-					t.Error("expected newlines to be escaped")
+				expected := "data: line1\ndata: line2\rline3\n\n"
+				if frame != expected {
+					t.Errorf("expected frame:\n%q\ngot:\n%q", expected, frame)
 				}
 			},
 		},
@@ -660,6 +687,106 @@ func TestCreateSSEFrame(t *testing.T) {
 	}
 }
 
+func TestSSEWriter_WriteComment(t *testing.T) {
+	ctx := context.Background()
+	writer := NewSSEWriter()
+	var buf bytes.Buffer
+
+	if err := writer.WriteComment(ctx, &buf, "ping"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != ": ping\n\n" {
+		t.Errorf("unexpected comment frame: %q", buf.String())
+	}
+
+	if err := writer.WriteComment(ctx, nil, "ping"); err == nil {
+		t.Error("expected error for nil writer")
+	}
+
+	if err := writer.WriteComment(ctx, &errorWriter{err: errors.New("write failed")}, "ping"); err == nil {
+		t.Error("expected error on write failure")
+	}
+}
+
+func TestSSEWriter_WriteRetry(t *testing.T) {
+	ctx := context.Background()
+	writer := NewSSEWriter()
+	var buf bytes.Buffer
+
+	if err := writer.WriteRetry(ctx, &buf, 3*time.Second); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "retry: 3000\n\n" {
+		t.Errorf("unexpected retry frame: %q", buf.String())
+	}
+
+	if err := writer.WriteRetry(ctx, nil, time.Second); err == nil {
+		t.Error("expected error for nil writer")
+	}
+}
+
+func TestSSEWriter_SetEventIDFunc(t *testing.T) {
+	ctx := context.Background()
+	writer := NewSSEWriter().SetEventIDFunc(func(event events.Event) string {
+		return "custom-id"
+	})
+
+	var buf bytes.Buffer
+	event := &mockEvent{customJSON: []byte(`{"n":1}`)}
+	if err := writer.WriteEvent(ctx, &buf, event); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.HasPrefix(buf.String(), "id: custom-id\n") {
+		t.Errorf("expected id from eventIDFunc, got %q", buf.String())
+	}
+}
+
+func TestSSEWriter_SetEventIDFuncIgnoredWithReplayBuffer(t *testing.T) {
+	ctx := context.Background()
+	writer := NewSSEWriterWithReplay(10).SetEventIDFunc(func(event events.Event) string {
+		return "custom-id"
+	})
+
+	var buf bytes.Buffer
+	event := &mockEvent{customJSON: []byte(`{"n":1}`)}
+	if err := writer.WriteEvent(ctx, &buf, event); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if strings.Contains(buf.String(), "id: custom-id\n") {
+		t.Error("expected the replay buffer's nonce-seq id to take precedence over SetEventIDFunc")
+	}
+}
+
+func TestSSEWriter_RetryInterval(t *testing.T) {
+	writer := NewSSEWriter()
+	if got := writer.RetryInterval(); got != 0 {
+		t.Errorf("expected zero value before WithRetryInterval, got %v", got)
+	}
+
+	writer.WithRetryInterval(2 * time.Second)
+	if got := writer.RetryInterval(); got != 2*time.Second {
+		t.Errorf("expected 2s, got %v", got)
+	}
+}
+
+func TestSSEWriter_Heartbeat(t *testing.T) {
+	writer := NewSSEWriter()
+	var buf bytes.Buffer
+
+	ctx, cancel := context.WithTimeout(context.Background(), 25*time.Millisecond)
+	defer cancel()
+
+	if err := writer.Heartbeat(ctx, &buf, 5*time.Millisecond); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if count := strings.Count(buf.String(), ": heartbeat\n\n"); count == 0 {
+		t.Error("expected at least one heartbeat ping")
+	}
+}
+
 func ptr[T any](v T) *T {
 	return &v
 }
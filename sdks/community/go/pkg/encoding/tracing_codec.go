@@ -0,0 +1,53 @@
+package encoding
+
+import (
+	"context"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/observability/log"
+)
+
+// TracingCodec wraps a Codec, emitting a log.Span around every Encode/Decode
+// call annotated with the event's type and its
+// GetOptimalBufferSizeForEvent-estimated size, so a slow or oversized
+// encode/decode shows up in request tracing without instrumenting every
+// codec implementation individually.
+type TracingCodec struct {
+	Codec
+}
+
+// NewTracingCodec wraps codec with per-call tracing spans.
+func NewTracingCodec(codec Codec) *TracingCodec {
+	return &TracingCodec{Codec: codec}
+}
+
+// Encode spans the wrapped Codec's Encode call.
+func (c *TracingCodec) Encode(ctx context.Context, event events.Event) ([]byte, error) {
+	var eventType string
+	if event != nil {
+		eventType = string(event.Type())
+	}
+
+	_, span := log.StartSpan(ctx, "encoding.encode",
+		"event.type", eventType,
+		"event.bytes", GetOptimalBufferSizeForEvent(event),
+	)
+	defer span.End()
+
+	return c.Codec.Encode(ctx, event)
+}
+
+// Decode spans the wrapped Codec's Decode call, annotating the span with the
+// decoded event's type once decoding succeeds.
+func (c *TracingCodec) Decode(ctx context.Context, data []byte) (events.Event, error) {
+	spanCtx, span := log.StartSpan(ctx, "encoding.decode", "data.bytes", len(data))
+
+	event, err := c.Codec.Decode(spanCtx, data)
+	if event != nil {
+		span.End("event.type", string(event.Type()))
+	} else {
+		span.End()
+	}
+
+	return event, err
+}
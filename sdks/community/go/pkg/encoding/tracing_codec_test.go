@@ -0,0 +1,57 @@
+package encoding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// stubCodec is a minimal Codec that returns canned values, for exercising
+// TracingCodec without pulling in a real encoding format.
+type stubCodec struct {
+	encoded []byte
+	decoded events.Event
+}
+
+func (c *stubCodec) Encode(ctx context.Context, event events.Event) ([]byte, error) {
+	return c.encoded, nil
+}
+func (c *stubCodec) EncodeMultiple(ctx context.Context, evts []events.Event) ([]byte, error) {
+	return c.encoded, nil
+}
+func (c *stubCodec) Decode(ctx context.Context, data []byte) (events.Event, error) {
+	return c.decoded, nil
+}
+func (c *stubCodec) DecodeMultiple(ctx context.Context, data []byte) ([]events.Event, error) {
+	return []events.Event{c.decoded}, nil
+}
+func (c *stubCodec) ContentType() string     { return "application/stub" }
+func (c *stubCodec) SupportsStreaming() bool { return false }
+
+func TestTracingCodecEncodeDelegatesToWrappedCodec(t *testing.T) {
+	stub := &stubCodec{encoded: []byte("payload")}
+	tc := NewTracingCodec(stub)
+
+	event := events.NewRunStartedEvent("thread-1", "run-1")
+	data, err := tc.Encode(context.Background(), event)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != "payload" {
+		t.Errorf("expected the wrapped codec's output, got %q", data)
+	}
+}
+
+func TestTracingCodecDecodeDelegatesToWrappedCodec(t *testing.T) {
+	stub := &stubCodec{decoded: events.NewRunStartedEvent("thread-1", "run-1")}
+	tc := NewTracingCodec(stub)
+
+	event, err := tc.Decode(context.Background(), []byte("{}"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Type() != events.EventTypeRunStarted {
+		t.Errorf("expected the wrapped codec's decoded event, got %v", event.Type())
+	}
+}
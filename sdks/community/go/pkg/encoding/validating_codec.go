@@ -0,0 +1,167 @@
+package encoding
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// Schema is a single versioned schema for one event type, as resolved by a
+// SchemaRegistry. Raw holds the schema document in whatever form the paired
+// SchemaValidator expects (JSON Schema text, an Avro schema, etc.).
+type Schema struct {
+	EventType string
+	Version   string
+	Raw       []byte
+}
+
+// SchemaRegistry resolves the schema a (eventType, version) pair was
+// encoded against. Implementations include a file-backed and an
+// HTTP-backed (Confluent-style) registry in the schemaregistry subpackage.
+type SchemaRegistry interface {
+	GetSchema(ctx context.Context, eventType, version string) (Schema, error)
+}
+
+// SchemaValidator checks encoded bytes against a resolved Schema. This is
+// pluggable rather than hard-coded to one schema language because different
+// SchemaRegistry backends pair with different wire formats: JSON Schema for
+// the JSON-based codecs in this package, Avro for compact binary encoding.
+type SchemaValidator interface {
+	Validate(data []byte, schema Schema) error
+}
+
+// MigrationFunc upgrades data encoded against fromVersion to the shape
+// toVersion (normally the current schema version) expects, so
+// SchemaValidatingCodec.Decode can return an event built from the current Go
+// struct definitions regardless of which schema version produced data.
+type MigrationFunc func(data []byte, fromVersion, toVersion string) ([]byte, error)
+
+// schemaEnvelope extracts the (type, schemaVersion) pair common to every
+// event this package encodes, without needing a full Decode.
+type schemaEnvelope struct {
+	Type          string `json:"type"`
+	SchemaVersion string `json:"schemaVersion"`
+}
+
+// SchemaValidationError reports the JSON path of the field that failed
+// schema validation, when the SchemaValidator can determine one.
+// encoding.DecodingError.Path is populated from it when present.
+type SchemaValidationError struct {
+	Path string
+	Err  error
+}
+
+func (e *SchemaValidationError) Error() string {
+	if e.Path != "" {
+		return fmt.Sprintf("schema validation failed at %s: %v", e.Path, e.Err)
+	}
+	return fmt.Sprintf("schema validation failed: %v", e.Err)
+}
+
+func (e *SchemaValidationError) Unwrap() error {
+	return e.Err
+}
+
+// SchemaValidatingCodec wraps a Codec with schema validation backed by a
+// SchemaRegistry, implementing the ValidatingCodec interface. Decode looks
+// up the schema for the decoded event's (type, schemaVersion), validating
+// and, if Migrate is set, upgrading the payload before handing it to the
+// wrapped Codec, whenever DecodingOptions.Strict is set.
+type SchemaValidatingCodec struct {
+	Codec
+
+	// Registry resolves schemas by (eventType, version).
+	Registry SchemaRegistry
+
+	// Validator checks encoded bytes against a resolved Schema.
+	Validator SchemaValidator
+
+	// Migrate upgrades payloads encoded against an older schema version, if
+	// set. CurrentVersion supplies the version to migrate to; when either is
+	// nil, Decode skips migration and passes the payload through unchanged.
+	Migrate        MigrationFunc
+	CurrentVersion func(eventType string) string
+
+	decOptions *DecodingOptions
+}
+
+// NewSchemaValidatingCodec wraps codec with schema validation using registry
+// and validator. decOptions controls whether Decode enforces strict
+// validation; a nil decOptions behaves like one with Strict false.
+func NewSchemaValidatingCodec(codec Codec, registry SchemaRegistry, validator SchemaValidator, decOptions *DecodingOptions) *SchemaValidatingCodec {
+	return &SchemaValidatingCodec{
+		Codec:      codec,
+		Registry:   registry,
+		Validator:  validator,
+		decOptions: decOptions,
+	}
+}
+
+var _ ValidatingCodec = (*SchemaValidatingCodec)(nil)
+
+// ValidateOutput resolves the schema for data's (type, schemaVersion) and
+// validates data against it.
+func (c *SchemaValidatingCodec) ValidateOutput(ctx context.Context, data []byte) error {
+	return c.validate(ctx, data)
+}
+
+// ValidateInput resolves the schema for data's (type, schemaVersion) and
+// validates data against it.
+func (c *SchemaValidatingCodec) ValidateInput(ctx context.Context, data []byte) error {
+	return c.validate(ctx, data)
+}
+
+func (c *SchemaValidatingCodec) validate(ctx context.Context, data []byte) error {
+	var env schemaEnvelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return &DecodingError{Format: c.ContentType(), Data: data, Message: "failed to read event envelope for schema validation", Cause: err}
+	}
+
+	schema, err := c.Registry.GetSchema(ctx, env.Type, env.SchemaVersion)
+	if err != nil {
+		return &DecodingError{Format: c.ContentType(), Data: data, Message: fmt.Sprintf("no schema for %s version %q", env.Type, env.SchemaVersion), Cause: err}
+	}
+
+	if err := c.Validator.Validate(data, schema); err != nil {
+		decErr := &DecodingError{Format: c.ContentType(), Data: data, Message: "schema validation failed", Cause: err}
+		var svErr *SchemaValidationError
+		if errors.As(err, &svErr) {
+			decErr.Path = svErr.Path
+		}
+		return decErr
+	}
+
+	return nil
+}
+
+// Decode decodes data with the wrapped Codec. When decOptions.Strict is
+// set, it first validates data against the registered schema for its
+// (type, schemaVersion), then, if Migrate and CurrentVersion are set and
+// the payload's version is out of date, migrates data to CurrentVersion
+// before decoding.
+func (c *SchemaValidatingCodec) Decode(ctx context.Context, data []byte) (events.Event, error) {
+	if c.decOptions != nil && c.decOptions.Strict {
+		if err := c.ValidateInput(ctx, data); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Migrate != nil && c.CurrentVersion != nil {
+		var env schemaEnvelope
+		if err := json.Unmarshal(data, &env); err == nil {
+			target := c.CurrentVersion(env.Type)
+			if target != "" && env.SchemaVersion != "" && target != env.SchemaVersion {
+				migrated, err := c.Migrate(data, env.SchemaVersion, target)
+				if err != nil {
+					return nil, &DecodingError{Format: c.ContentType(), Data: data, Message: fmt.Sprintf("failed to migrate %s from v%s to v%s", env.Type, env.SchemaVersion, target), Cause: err}
+				}
+				data = migrated
+			}
+		}
+	}
+
+	return c.Codec.Decode(ctx, data)
+}
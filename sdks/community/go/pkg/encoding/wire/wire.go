@@ -0,0 +1,97 @@
+// Package wire provides length-prefixed framing for streaming AG-UI events
+// over a raw connection (TCP, WebSocket) instead of SSE's line-delimited
+// text framing, plus Protobuf marshal/unmarshal convenience functions for
+// the high-throughput path. It builds on pkg/encoding/codec.Codec rather
+// than inventing its own encode/decode contract, so WriteFrame/ReadFrame
+// work with either the JSON codec or the Protobuf one.
+//
+// The SDK does not yet generate typed per-event-type Protobuf messages (see
+// pkg/encoding/protobuf's package doc); MarshalProto/UnmarshalProto use the
+// same minimal length-delimited JSON-in-protobuf envelope as events.Event's
+// ToProto today. Even so, framing TOOL_CALL_ARGS/TEXT_MESSAGE_CONTENT chunks
+// this way already skips the per-frame SSE/HTTP overhead that dominates
+// their byte count in a real agent run; switching the envelope to
+// field-by-field encoding later is transparent to WriteFrame/ReadFrame.
+package wire
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/codec"
+)
+
+// Codec is pkg/encoding/codec.Codec, re-exported so a caller can pick a wire
+// format - codec.NewJSONCodec, codec.NewProtobufCodec, or a custom one
+// registered on codec.DefaultRegistry - without an extra import.
+type Codec = codec.Codec
+
+// maxFrameSize bounds the length prefix ReadFrame will accept, guarding
+// against a corrupt or hostile stream claiming an unbounded frame.
+const maxFrameSize = 64 << 20 // 64 MiB
+
+// MarshalProto encodes event with the Protobuf codec (codec.NewProtobufCodec).
+func MarshalProto(event events.Event) ([]byte, error) {
+	return codec.NewProtobufCodec().Encode(event)
+}
+
+// UnmarshalProto decodes data, previously produced by MarshalProto, back
+// into an Event.
+func UnmarshalProto(data []byte) (events.Event, error) {
+	return codec.NewProtobufCodec().Decode(data)
+}
+
+// WriteFrame writes event to w as a 4-byte big-endian length prefix
+// followed by c.Encode(event), so a reader on the other end of a raw
+// connection knows where one event ends and the next begins without
+// relying on SSE's newline-delimited framing.
+func WriteFrame(w io.Writer, event events.Event, c Codec) error {
+	data, err := c.Encode(event)
+	if err != nil {
+		return fmt.Errorf("wire: failed to encode frame: %w", err)
+	}
+
+	if len(data) > maxFrameSize {
+		return fmt.Errorf("wire: frame of %d bytes exceeds max frame size of %d", len(data), maxFrameSize)
+	}
+
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(data)))
+
+	if _, err := w.Write(length[:]); err != nil {
+		return fmt.Errorf("wire: failed to write frame length: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("wire: failed to write frame body: %w", err)
+	}
+
+	return nil
+}
+
+// ReadFrame reads one length-prefixed frame written by WriteFrame and
+// decodes it with c.
+func ReadFrame(r io.Reader, c Codec) (events.Event, error) {
+	var length [4]byte
+	if _, err := io.ReadFull(r, length[:]); err != nil {
+		return nil, fmt.Errorf("wire: failed to read frame length: %w", err)
+	}
+
+	n := binary.BigEndian.Uint32(length[:])
+	if n > maxFrameSize {
+		return nil, fmt.Errorf("wire: frame of %d bytes exceeds max frame size of %d", n, maxFrameSize)
+	}
+
+	data := make([]byte, n)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, fmt.Errorf("wire: failed to read frame body: %w", err)
+	}
+
+	event, err := c.Decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("wire: failed to decode frame: %w", err)
+	}
+
+	return event, nil
+}
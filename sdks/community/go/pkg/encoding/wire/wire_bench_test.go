@@ -0,0 +1,108 @@
+package wire_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/codec"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/wire"
+)
+
+// TestFrameRoundTrip verifies WriteFrame/ReadFrame agree with each other
+// for every wire format before the benchmarks below compare their cost.
+func TestFrameRoundTrip(t *testing.T) {
+	for _, c := range []wire.Codec{codec.NewJSONCodec(), codec.NewProtobufCodec(), codec.NewCBORCodec()} {
+		event := events.NewToolCallArgsEvent("tool-1", `{"query":"weather in Boston"}`)
+
+		var buf bytes.Buffer
+		if err := wire.WriteFrame(&buf, event, c); err != nil {
+			t.Fatalf("%s: WriteFrame: %v", c.ContentType(), err)
+		}
+
+		decoded, err := wire.ReadFrame(&buf, c)
+		if err != nil {
+			t.Fatalf("%s: ReadFrame: %v", c.ContentType(), err)
+		}
+
+		if decoded.Type() != events.EventTypeToolCallArgs {
+			t.Errorf("%s: expected %s, got %s", c.ContentType(), events.EventTypeToolCallArgs, decoded.Type())
+		}
+	}
+}
+
+// benchmarkToolCallArgs and benchmarkTextMessageContent exercise the two
+// event types the request calls out as dominating real agent run byte
+// counts, for BenchmarkMarshalProto/BenchmarkMarshalJSON below to compare.
+func toolCallArgsEvent() events.Event {
+	return events.NewToolCallArgsEvent("tool-1", `{"query":"`+strings.Repeat("weather forecast for Boston, MA ", 8)+`"}`)
+}
+
+func textMessageContentEvent() events.Event {
+	return events.NewTextMessageContentEvent("msg-1", strings.Repeat("The quick brown fox jumps over the lazy dog. ", 8))
+}
+
+func BenchmarkMarshalProto_ToolCallArgs(b *testing.B) {
+	event := toolCallArgsEvent()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := wire.MarshalProto(event); err != nil {
+			b.Fatalf("MarshalProto: %v", err)
+		}
+	}
+}
+
+func BenchmarkMarshalJSON_ToolCallArgs(b *testing.B) {
+	jsonCodec := codec.NewJSONCodec()
+	event := toolCallArgsEvent()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := jsonCodec.Encode(event); err != nil {
+			b.Fatalf("Encode: %v", err)
+		}
+	}
+}
+
+func BenchmarkMarshalProto_TextMessageContent(b *testing.B) {
+	event := textMessageContentEvent()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := wire.MarshalProto(event); err != nil {
+			b.Fatalf("MarshalProto: %v", err)
+		}
+	}
+}
+
+func BenchmarkMarshalJSON_TextMessageContent(b *testing.B) {
+	jsonCodec := codec.NewJSONCodec()
+	event := textMessageContentEvent()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := jsonCodec.Encode(event); err != nil {
+			b.Fatalf("Encode: %v", err)
+		}
+	}
+}
+
+func BenchmarkMarshalCBOR_ToolCallArgs(b *testing.B) {
+	cborCodec := codec.NewCBORCodec()
+	event := toolCallArgsEvent()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := cborCodec.Encode(event); err != nil {
+			b.Fatalf("Encode: %v", err)
+		}
+	}
+}
+
+func BenchmarkMarshalCBOR_TextMessageContent(b *testing.B) {
+	cborCodec := codec.NewCBORCodec()
+	event := textMessageContentEvent()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := cborCodec.Encode(event); err != nil {
+			b.Fatalf("Encode: %v", err)
+		}
+	}
+}
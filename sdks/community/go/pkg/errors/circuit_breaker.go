@@ -0,0 +1,253 @@
+package errors
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// State is one of the three states a CircuitBreaker can be in.
+type State int32
+
+const (
+	// StateClosed is the normal operating state: calls pass through and
+	// failures are counted toward FailureThreshold.
+	StateClosed State = iota
+	// StateOpen fails every call fast with ErrCircuitOpen without invoking
+	// the wrapped function, until OpenTimeout elapses.
+	StateOpen
+	// StateHalfOpen lets a single trial call through to decide whether to
+	// return to StateClosed or back off to StateOpen.
+	StateHalfOpen
+)
+
+// String returns the lowercase, hyphenated name used in OnStateChange logs.
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// ErrCircuitOpen is returned by ExecuteWithBreaker when the breaker is open
+// and the call fails fast without invoking fn. It is a *BaseError with
+// Retryable left false, so IsRetryable (and therefore the default
+// RetryConfig.RetryIf) already treats it as non-retryable - retrying it
+// would just hammer a breaker that's deliberately shedding load.
+var ErrCircuitOpen = NewBaseError("CIRCUIT_OPEN", "circuit breaker is open")
+
+// CircuitBreakerConfig configures a CircuitBreaker.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive tripping failures in
+	// StateClosed that opens the breaker.
+	FailureThreshold int
+
+	// SuccessThreshold is the number of consecutive successes required in
+	// StateHalfOpen to close the breaker.
+	SuccessThreshold int
+
+	// OpenTimeout is how long the breaker stays open before letting a
+	// single trial call through in StateHalfOpen.
+	OpenTimeout time.Duration
+
+	// ShouldTrip decides whether an error counts toward FailureThreshold /
+	// reopening the breaker. Defaults to IsRetryable: an error the caller
+	// wouldn't retry anyway (a validation error, say) shouldn't trip the
+	// breaker either.
+	ShouldTrip func(error) bool
+
+	// OnStateChange, if set, is called after every state transition with
+	// the state transitioned from and to. Called outside the breaker's
+	// internal lock, so it may safely call back into the breaker.
+	OnStateChange func(from, to State)
+}
+
+// DefaultCircuitBreakerConfig returns a CircuitBreakerConfig with
+// conservative defaults.
+func DefaultCircuitBreakerConfig() *CircuitBreakerConfig {
+	return &CircuitBreakerConfig{
+		FailureThreshold: 5,
+		SuccessThreshold: 2,
+		OpenTimeout:      30 * time.Second,
+		ShouldTrip:       IsRetryable,
+	}
+}
+
+// CircuitBreaker is a failure-isolation guard that composes with Retry: wrap
+// a call with ExecuteWithBreaker so repeated failures stop being attempted
+// at all for a cooldown period, instead of retried with backoff forever.
+//
+// A CircuitBreaker is safe for concurrent use.
+type CircuitBreaker struct {
+	config *CircuitBreakerConfig
+
+	mu        sync.Mutex
+	state     State
+	failures  int
+	successes int
+	openedAt  time.Time
+	probing   bool // StateHalfOpen: a trial call is already in flight
+}
+
+// NewCircuitBreaker creates a CircuitBreaker with the given config. A nil
+// config uses DefaultCircuitBreakerConfig; a nil ShouldTrip defaults to
+// IsRetryable.
+func NewCircuitBreaker(config *CircuitBreakerConfig) *CircuitBreaker {
+	if config == nil {
+		config = DefaultCircuitBreakerConfig()
+	}
+	if config.ShouldTrip == nil {
+		config.ShouldTrip = IsRetryable
+	}
+	return &CircuitBreaker{config: config}
+}
+
+// State returns the breaker's current state.
+func (cb *CircuitBreaker) State() State {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.state
+}
+
+// ExecuteWithBreaker runs fn through cb: a call is rejected with
+// ErrCircuitOpen without running fn while the breaker is open, otherwise fn
+// runs and its result feeds back into the breaker's state machine.
+func ExecuteWithBreaker(ctx context.Context, cb *CircuitBreaker, fn func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if !cb.allow() {
+		return ErrCircuitOpen
+	}
+
+	err := fn()
+	cb.recordResult(err)
+	return err
+}
+
+// allow reports whether a call may proceed, transitioning StateOpen to
+// StateHalfOpen once OpenTimeout has elapsed and claiming the single probe
+// slot a half-open breaker grants.
+func (cb *CircuitBreaker) allow() bool {
+	cb.mu.Lock()
+
+	switch cb.state {
+	case StateClosed:
+		cb.mu.Unlock()
+		return true
+
+	case StateOpen:
+		if time.Since(cb.openedAt) < cb.config.OpenTimeout {
+			cb.mu.Unlock()
+			return false
+		}
+		from := cb.state
+		cb.state = StateHalfOpen
+		cb.successes = 0
+		cb.probing = true
+		cb.mu.Unlock()
+		cb.notify(from, StateHalfOpen)
+		return true
+
+	case StateHalfOpen:
+		if cb.probing {
+			cb.mu.Unlock()
+			return false
+		}
+		cb.probing = true
+		cb.mu.Unlock()
+		return true
+
+	default:
+		cb.mu.Unlock()
+		return false
+	}
+}
+
+// recordResult feeds a completed call's result back into the state machine.
+func (cb *CircuitBreaker) recordResult(err error) {
+	if err == nil {
+		cb.recordSuccess()
+		return
+	}
+	if !cb.config.ShouldTrip(err) {
+		// Doesn't count as a circuit failure; just release the half-open
+		// probe slot so the next call can try again.
+		cb.mu.Lock()
+		cb.probing = false
+		cb.mu.Unlock()
+		return
+	}
+	cb.recordFailure()
+}
+
+func (cb *CircuitBreaker) recordSuccess() {
+	cb.mu.Lock()
+
+	switch cb.state {
+	case StateClosed:
+		cb.failures = 0
+		cb.mu.Unlock()
+
+	case StateHalfOpen:
+		cb.probing = false
+		cb.successes++
+		if cb.successes < cb.config.SuccessThreshold {
+			cb.mu.Unlock()
+			return
+		}
+		from := cb.state
+		cb.state = StateClosed
+		cb.failures = 0
+		cb.successes = 0
+		cb.mu.Unlock()
+		cb.notify(from, StateClosed)
+
+	default:
+		cb.mu.Unlock()
+	}
+}
+
+func (cb *CircuitBreaker) recordFailure() {
+	cb.mu.Lock()
+
+	switch cb.state {
+	case StateClosed:
+		cb.failures++
+		if cb.failures < cb.config.FailureThreshold {
+			cb.mu.Unlock()
+			return
+		}
+		from := cb.state
+		cb.state = StateOpen
+		cb.openedAt = time.Now()
+		cb.failures = 0
+		cb.mu.Unlock()
+		cb.notify(from, StateOpen)
+
+	case StateHalfOpen:
+		cb.probing = false
+		from := cb.state
+		cb.state = StateOpen
+		cb.openedAt = time.Now()
+		cb.mu.Unlock()
+		cb.notify(from, StateOpen)
+
+	default:
+		cb.mu.Unlock()
+	}
+}
+
+// notify calls OnStateChange, if set. Always called with cb.mu released.
+func (cb *CircuitBreaker) notify(from, to State) {
+	if cb.config.OnStateChange != nil {
+		cb.config.OnStateChange(from, to)
+	}
+}
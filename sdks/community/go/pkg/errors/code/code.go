@@ -0,0 +1,90 @@
+// Package code defines a structured numeric error code scheme usable
+// across the SDK, so errors can carry a stable, switchable identifier
+// instead of requiring callers to parse error strings.
+//
+// A Code packs three decimal fields into a single uint32: a Scope (which
+// SDK subsystem raised it - encoding, state, transport, agent, security,
+// ...), a Category (the kind of failure - input, db, resource, grpc, auth,
+// system, pubsub, ...), and a Detail (the specific error within that
+// scope/category). Code.String formats this as "AGUI-<scope><category
+// zero-padded to 2 digits><detail zero-padded to 2 digits>", e.g.
+// "AGUI-20304" for scope 2, category 3, detail 4.
+package code
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Code is a structured numeric error code; see the package doc.
+type Code uint32
+
+const (
+	categoryMultiplier = 100
+	scopeMultiplier    = 10000
+)
+
+// New packs scope, category, and detail into a single Code.
+func New(scope, category, detail uint32) Code {
+	return Code(scope*scopeMultiplier + category*categoryMultiplier + detail)
+}
+
+// Scope returns the code's scope field.
+func (c Code) Scope() uint32 {
+	return uint32(c) / scopeMultiplier
+}
+
+// Category returns the code's category field.
+func (c Code) Category() uint32 {
+	return uint32(c) / categoryMultiplier % 100
+}
+
+// Detail returns the code's detail field.
+func (c Code) Detail() uint32 {
+	return uint32(c) % categoryMultiplier
+}
+
+// String formats the code as "AGUI-<n>", e.g. "AGUI-20304".
+func (c Code) String() string {
+	return fmt.Sprintf("AGUI-%d", uint32(c))
+}
+
+// Scopes identify which SDK subsystem raised a Code.
+const (
+	ScopeEncoding uint32 = iota + 1
+	ScopeState
+	ScopeTransport
+	ScopeAgent
+	ScopeSecurity
+)
+
+// Categories identify the kind of failure within a scope.
+const (
+	CategoryInput uint32 = iota + 1
+	CategoryDB
+	CategoryResource
+	CategoryGRPC
+	CategoryAuth
+	CategorySystem
+	CategoryPubSub
+)
+
+var (
+	mu       sync.RWMutex
+	messages = make(map[Code]string)
+)
+
+// Register associates c with a human-readable message, overwriting any
+// existing registration for that code.
+func Register(c Code, message string) {
+	mu.Lock()
+	defer mu.Unlock()
+	messages[c] = message
+}
+
+// Message returns the message registered for c, or "" if none was.
+func Message(c Code) string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return messages[c]
+}
@@ -0,0 +1,57 @@
+package code
+
+// Codes for the error families the SDK's sentinel errors (see
+// pkg/errors.ErrStateInvalid, ErrSecurityViolation, etc.) and BaseError
+// derivatives (StateError, EncodingError, SecurityError, ValidationError,
+// ConflictError) currently raise, so clients/gateways can switch on a
+// stable Code instead of parsing error strings.
+var (
+	// Encoding family (pkg/errors.EncodingError and its sentinels)
+	EncodingNotSupported  = New(ScopeEncoding, CategoryInput, 1)
+	DecodingFailed        = New(ScopeEncoding, CategoryInput, 2)
+	EncodingFailed        = New(ScopeEncoding, CategoryInput, 3)
+	InvalidMimeType       = New(ScopeEncoding, CategoryInput, 4)
+	StreamingNotSupported = New(ScopeEncoding, CategoryInput, 5)
+	ChunkingFailed        = New(ScopeEncoding, CategoryInput, 6)
+	CompressionFailed     = New(ScopeEncoding, CategoryInput, 7)
+	FormatNotRegistered   = New(ScopeEncoding, CategorySystem, 1)
+	CompatibilityCheck    = New(ScopeEncoding, CategorySystem, 2)
+	NegotiationFailed     = New(ScopeEncoding, CategorySystem, 3)
+
+	// Security family (pkg/errors.SecurityError and its sentinel)
+	SecurityViolation = New(ScopeSecurity, CategoryAuth, 1)
+
+	// State family (pkg/errors.StateError and its sentinel)
+	StateInvalid = New(ScopeState, CategoryResource, 1)
+
+	// Validation family (pkg/errors.ValidationError and its sentinel)
+	ValidationFailed = New(ScopeState, CategoryInput, 1)
+
+	// Conflict family (pkg/errors.ConflictError and its sentinel)
+	Conflict = New(ScopeState, CategoryResource, 2)
+
+	// Agent family (pkg/errors.AgentError and remaining sentinels)
+	RetryExhausted        = New(ScopeAgent, CategorySystem, 1)
+	ContextMissing        = New(ScopeAgent, CategoryInput, 1)
+	OperationNotPermitted = New(ScopeAgent, CategoryAuth, 1)
+)
+
+func init() {
+	Register(EncodingNotSupported, "encoding format not supported")
+	Register(DecodingFailed, "decoding failed")
+	Register(EncodingFailed, "encoding failed")
+	Register(InvalidMimeType, "invalid MIME type")
+	Register(StreamingNotSupported, "streaming not supported")
+	Register(ChunkingFailed, "chunking failed")
+	Register(CompressionFailed, "compression failed")
+	Register(FormatNotRegistered, "format not registered")
+	Register(CompatibilityCheck, "compatibility check failed")
+	Register(NegotiationFailed, "negotiation failed")
+	Register(SecurityViolation, "security violation")
+	Register(StateInvalid, "invalid state")
+	Register(ValidationFailed, "validation failed")
+	Register(Conflict, "operation conflict")
+	Register(RetryExhausted, "retry attempts exhausted")
+	Register(ContextMissing, "required context missing")
+	Register(OperationNotPermitted, "operation not permitted")
+}
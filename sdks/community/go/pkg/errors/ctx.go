@@ -0,0 +1,174 @@
+package errors
+
+import (
+	"context"
+	"sync"
+)
+
+// ctxKeyType is an unexported type so Context's key can't collide with
+// keys set by other packages using context.WithValue.
+type ctxKeyType struct{}
+
+var ctxKey = ctxKeyType{}
+
+// ctxBag is the mutable annotation bag Context attaches to a context.
+// It's stored behind a pointer, getlantern/ops-style, so that WithValue
+// and BeginOp calls made anywhere a ctx derived from it is threaded are
+// visible to every NewXxxErrorCtx call sharing that bag - not just to
+// contexts derived after the call, the way plain context.WithValue would
+// require.
+type ctxBag struct {
+	mu      sync.Mutex
+	values  map[string]interface{}
+	opStack []string
+}
+
+// snapshot copies out the bag's current values and operation stack, so a
+// newly constructed error gets its own independent copy.
+func (b *ctxBag) snapshot() (map[string]interface{}, []string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	values := make(map[string]interface{}, len(b.values))
+	for k, v := range b.values {
+		values[k] = v
+	}
+	ops := make([]string, len(b.opStack))
+	copy(ops, b.opStack)
+	return values, ops
+}
+
+// Context returns a context carrying a mutable bag of annotations that
+// WithValue and BeginOp write into and that NewXxxErrorCtx constructors
+// read from, so middleware (an HTTP handler, an event dispatcher, an
+// agent runner) can annotate a ctx once with a user ID, request ID, or
+// agent name, and every error built downstream from it automatically
+// carries that context - no manual WithDetail call at each construction
+// site. Calling Context on a ctx that already carries a bag returns it
+// unchanged.
+func Context(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(ctxKey).(*ctxBag); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxKey, &ctxBag{values: make(map[string]interface{})})
+}
+
+// WithValue adds a key/value annotation to ctx's bag, calling Context
+// first if ctx doesn't carry one yet. Returns the (possibly new) context,
+// composing like context.WithValue.
+func WithValue(ctx context.Context, key string, value interface{}) context.Context {
+	ctx = Context(ctx)
+	bag := ctx.Value(ctxKey).(*ctxBag)
+	bag.mu.Lock()
+	bag.values[key] = value
+	bag.mu.Unlock()
+	return ctx
+}
+
+// BeginOp pushes name onto ctx's operation stack, calling Context first if
+// ctx doesn't carry a bag yet. It returns the (possibly new) context and a
+// function that pops name back off; callers defer the returned function.
+// Any error constructed (via a NewXxxErrorCtx function) from a context
+// sharing this bag while the op is active has name in its snapshotted op
+// chain.
+func BeginOp(ctx context.Context, name string) (context.Context, func()) {
+	ctx = Context(ctx)
+	bag := ctx.Value(ctxKey).(*ctxBag)
+	bag.mu.Lock()
+	bag.opStack = append(bag.opStack, name)
+	bag.mu.Unlock()
+	return ctx, func() {
+		bag.mu.Lock()
+		defer bag.mu.Unlock()
+		if len(bag.opStack) > 0 {
+			bag.opStack = bag.opStack[:len(bag.opStack)-1]
+		}
+	}
+}
+
+// ctxAnnotations reads ctx's annotation bag, if any, returning nil, nil
+// when ctx is nil or carries none.
+func ctxAnnotations(ctx context.Context) (map[string]interface{}, []string) {
+	if ctx == nil {
+		return nil, nil
+	}
+	bag, ok := ctx.Value(ctxKey).(*ctxBag)
+	if !ok {
+		return nil, nil
+	}
+	return bag.snapshot()
+}
+
+// applyCtx merges ctx's annotation bag into be: its values into Details
+// and its operation stack onto the front of be's op chain, so the errors
+// read oldest-first the same way Op does.
+func applyCtx(be *BaseError, ctx context.Context) {
+	values, ops := ctxAnnotations(ctx)
+	if len(values) > 0 {
+		if be.Details == nil {
+			be.Details = make(map[string]interface{}, len(values))
+		}
+		for k, v := range values {
+			be.Details[k] = v
+		}
+	}
+	if len(ops) > 0 {
+		be.opChain = append(append([]string{}, ops...), be.opChain...)
+	}
+}
+
+// NewBaseErrorCtx creates a new base error carrying ctx's annotations; see
+// Context, WithValue, and BeginOp.
+func NewBaseErrorCtx(ctx context.Context, code, message string) *BaseError {
+	e := NewBaseError(code, message)
+	applyCtx(e, ctx)
+	return e
+}
+
+// NewStateErrorCtx creates a new state error carrying ctx's annotations;
+// see Context, WithValue, and BeginOp.
+func NewStateErrorCtx(ctx context.Context, code, message string) *StateError {
+	e := NewStateError(code, message)
+	applyCtx(e.BaseError, ctx)
+	return e
+}
+
+// NewValidationErrorCtx creates a new validation error carrying ctx's
+// annotations; see Context, WithValue, and BeginOp.
+func NewValidationErrorCtx(ctx context.Context, code, message string) *ValidationError {
+	e := NewValidationError(code, message)
+	applyCtx(e.BaseError, ctx)
+	return e
+}
+
+// NewConflictErrorCtx creates a new conflict error carrying ctx's
+// annotations; see Context, WithValue, and BeginOp.
+func NewConflictErrorCtx(ctx context.Context, code, message string) *ConflictError {
+	e := NewConflictError(code, message)
+	applyCtx(e.BaseError, ctx)
+	return e
+}
+
+// NewEncodingErrorCtx creates a new encoding error carrying ctx's
+// annotations; see Context, WithValue, and BeginOp.
+func NewEncodingErrorCtx(ctx context.Context, code, message string) *EncodingError {
+	e := NewEncodingError(code, message)
+	applyCtx(e.BaseError, ctx)
+	return e
+}
+
+// NewSecurityErrorCtx creates a new security error carrying ctx's
+// annotations; see Context, WithValue, and BeginOp.
+func NewSecurityErrorCtx(ctx context.Context, code, message string) *SecurityError {
+	e := NewSecurityError(code, message)
+	applyCtx(e.BaseError, ctx)
+	return e
+}
+
+// NewAgentErrorCtx creates a new agent error carrying ctx's annotations;
+// see Context, WithValue, and BeginOp.
+func NewAgentErrorCtx(ctx context.Context, errorType ErrorType, message, agent string) *AgentError {
+	e := NewAgentError(errorType, message, agent)
+	applyCtx(e.BaseError, ctx)
+	return e
+}
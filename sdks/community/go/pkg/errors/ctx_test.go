@@ -0,0 +1,48 @@
+package errors
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNewBaseErrorCtxCarriesAnnotations(t *testing.T) {
+	ctx := WithValue(context.Background(), "user_id", "u-1")
+	ctx, end := BeginOp(ctx, "handler.Serve")
+	defer end()
+
+	err := NewBaseErrorCtx(ctx, "FAILED", "something broke")
+
+	if err.Details["user_id"] != "u-1" {
+		t.Fatalf("expected user_id detail, got %+v", err.Details)
+	}
+	if len(err.opChain) != 1 || err.opChain[0] != "handler.Serve" {
+		t.Fatalf("expected op chain [handler.Serve], got %v", err.opChain)
+	}
+}
+
+func TestBeginOpPopsOnEnd(t *testing.T) {
+	ctx, end := BeginOp(context.Background(), "outer")
+	inner := NewBaseErrorCtx(ctx, "INNER", "still inside outer")
+	if len(inner.opChain) != 1 || inner.opChain[0] != "outer" {
+		t.Fatalf("expected op chain [outer] while active, got %v", inner.opChain)
+	}
+	end()
+
+	after := NewBaseErrorCtx(ctx, "AFTER", "outer has ended")
+	if len(after.opChain) != 0 {
+		t.Fatalf("expected empty op chain after end(), got %v", after.opChain)
+	}
+}
+
+func TestWithValueSharesBagAcrossDerivedContexts(t *testing.T) {
+	base := Context(context.Background())
+	WithValue(base, "request_id", "r-1")
+
+	derived, end := BeginOp(base, "agent.Run")
+	defer end()
+
+	err := NewAgentErrorCtx(derived, ErrorTypeTimeout, "timed out", "agent-1")
+	if err.Details["request_id"] != "r-1" {
+		t.Fatalf("expected request_id annotation set on the shared bag to propagate, got %+v", err.Details)
+	}
+}
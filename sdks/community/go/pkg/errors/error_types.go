@@ -5,7 +5,12 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"io"
+	"runtime"
+	"strings"
 	"time"
+
+	errcode "github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/errors/code"
 )
 
 // Common sentinel errors
@@ -155,14 +160,125 @@ type BaseError struct {
 
 	// RetryAfter suggests when to retry (if retryable)
 	RetryAfter *time.Duration
+
+	// NumericCode is a structured numeric error code (see pkg/errors/code)
+	// a client or gateway can switch on without parsing Message strings.
+	NumericCode errcode.Code
+
+	// stack holds the caller PCs captured at construction time, for the
+	// %+v verbose format; see captureStack.
+	stack []uintptr
+
+	// opChain records the named operations pushed by Op, in the order
+	// they were pushed. Errors are otherwise immutable once created, so
+	// this is a plain slice append with no locking.
+	opChain []string
+}
+
+// maxStackDepth bounds how many caller frames captureStack records.
+const maxStackDepth = 32
+
+// captureStack records the caller's program counters, skipping the frames
+// for captureStack itself and its direct caller (one of the NewXxxError
+// constructors), so the first recorded frame is the code that called the
+// constructor.
+func captureStack() []uintptr {
+	const skip = 3 // runtime.Callers, captureStack, NewXxxError
+	pcs := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(skip, pcs)
+	return pcs[:n]
+}
+
+// Op pushes a named operation frame onto the error's operation chain,
+// describing the call path that produced it (e.g. "config.Load",
+// "state.Apply"). Returns the receiver so calls can chain with the other
+// With* builders.
+func (e *BaseError) Op(name string) *BaseError {
+	e.opChain = append(e.opChain, name)
+	return e
+}
+
+// Format implements fmt.Formatter. The verbose form (%+v) appends the
+// operation chain and a symbolicated stack trace to the normal error
+// message, then walks the Cause chain so a wrapped error's own operation
+// chain and stack trace print too.
+func (e *BaseError) Format(f fmt.State, verb rune) {
+	formatError(f, verb, e.Error(), e.opChain, e.stack, e.Cause)
+}
+
+// formatError implements the shared fmt.Formatter body for every error
+// type in this package; each type's own Format method calls this with its
+// own Error() string so the verbose output reflects that type's fields.
+func formatError(f fmt.State, verb rune, message string, opChain []string, stack []uintptr, cause error) {
+	switch verb {
+	case 'v':
+		io.WriteString(f, message)
+		if f.Flag('+') {
+			writeVerboseTrace(f, opChain, stack, cause)
+		}
+	case 's':
+		io.WriteString(f, message)
+	case 'q':
+		fmt.Fprintf(f, "%q", message)
+	}
+}
+
+// writeVerboseTrace writes opChain and stack, then walks cause - and any
+// *BaseError it wraps - doing the same for each, so nested errors each
+// print their own operation chain and stack trace.
+func writeVerboseTrace(f fmt.State, opChain []string, stack []uintptr, cause error) {
+	if len(opChain) > 0 {
+		fmt.Fprintf(f, "\nops: %s", strings.Join(opChain, " -> "))
+	}
+	writeStackFrames(f, stack)
+
+	for cause != nil {
+		fmt.Fprintf(f, "\ncaused by: %s", cause.Error())
+
+		var be *BaseError
+		if !errors.As(cause, &be) {
+			break
+		}
+		if len(be.opChain) > 0 {
+			fmt.Fprintf(f, "\nops: %s", strings.Join(be.opChain, " -> "))
+		}
+		writeStackFrames(f, be.stack)
+		cause = be.Cause
+	}
+}
+
+// writeStackFrames symbolicates pcs via runtime.CallersFrames and writes
+// one "function\n\tfile:line" entry per frame.
+func writeStackFrames(f fmt.State, pcs []uintptr) {
+	if len(pcs) == 0 {
+		return
+	}
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(f, "\n\t%s\n\t\t%s:%d", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
 }
 
 // Error implements the error interface
 func (e *BaseError) Error() string {
+	prefix := fmt.Sprintf("[%s]", e.Severity)
+	if e.NumericCode != 0 {
+		prefix = fmt.Sprintf("%s %s", prefix, e.NumericCode)
+	}
 	if e.Cause != nil {
-		return fmt.Sprintf("[%s] %s: %s (caused by: %v)", e.Severity, e.Code, e.Message, e.Cause)
+		return fmt.Sprintf("%s %s: %s (caused by: %v)", prefix, e.Code, e.Message, e.Cause)
 	}
-	return fmt.Sprintf("[%s] %s: %s", e.Severity, e.Code, e.Message)
+	return fmt.Sprintf("%s %s: %s", prefix, e.Code, e.Message)
+}
+
+// WithNumericCode sets the structured numeric error code
+func (e *BaseError) WithNumericCode(c errcode.Code) *BaseError {
+	e.NumericCode = c
+	return e
 }
 
 // Unwrap returns the underlying error
@@ -213,15 +329,22 @@ type StateError struct {
 func NewStateError(code, message string) *StateError {
 	return &StateError{
 		BaseError: &BaseError{
-			Code:      code,
-			Message:   message,
-			Severity:  SeverityError,
-			Timestamp: time.Now(),
-			Details:   make(map[string]interface{}),
+			Code:        code,
+			Message:     message,
+			Severity:    SeverityError,
+			Timestamp:   time.Now(),
+			Details:     make(map[string]interface{}),
+			NumericCode: errcode.StateInvalid,
+			stack:       captureStack(),
 		},
 	}
 }
 
+// Format implements fmt.Formatter; see BaseError.Format.
+func (e *StateError) Format(f fmt.State, verb rune) {
+	formatError(f, verb, e.Error(), e.BaseError.opChain, e.BaseError.stack, e.BaseError.Cause)
+}
+
 // Error implements the error interface with state-specific details
 func (e *StateError) Error() string {
 	base := e.BaseError.Error()
@@ -253,6 +376,35 @@ func (e *StateError) WithTransition(transition string) *StateError {
 	return e
 }
 
+// WithDetail adds a detail to the state error and returns the StateError
+func (e *StateError) WithDetail(key string, value interface{}) *StateError {
+	if e.BaseError.Details == nil {
+		e.BaseError.Details = make(map[string]interface{})
+	}
+	e.BaseError.Details[key] = value
+	return e
+}
+
+// WithCause adds an underlying cause to the state error and returns the StateError
+func (e *StateError) WithCause(cause error) *StateError {
+	e.BaseError.Cause = cause
+	return e
+}
+
+// WithRetry marks the state error as retryable with a suggested retry time
+// and returns the StateError.
+func (e *StateError) WithRetry(after time.Duration) *StateError {
+	e.BaseError.Retryable = true
+	e.BaseError.RetryAfter = &after
+	return e
+}
+
+// WithNumericCode sets the state error's numeric code and returns the StateError
+func (e *StateError) WithNumericCode(c errcode.Code) *StateError {
+	e.BaseError.NumericCode = c
+	return e
+}
+
 // ValidationError represents validation-related errors
 type ValidationError struct {
 	*BaseError
@@ -274,16 +426,23 @@ type ValidationError struct {
 func NewValidationError(code, message string) *ValidationError {
 	return &ValidationError{
 		BaseError: &BaseError{
-			Code:      code,
-			Message:   message,
-			Severity:  SeverityWarning,
-			Timestamp: time.Now(),
-			Details:   make(map[string]interface{}),
+			Code:        code,
+			Message:     message,
+			Severity:    SeverityWarning,
+			Timestamp:   time.Now(),
+			Details:     make(map[string]interface{}),
+			NumericCode: errcode.ValidationFailed,
+			stack:       captureStack(),
 		},
 		FieldErrors: make(map[string][]string),
 	}
 }
 
+// Format implements fmt.Formatter; see BaseError.Format.
+func (e *ValidationError) Format(f fmt.State, verb rune) {
+	formatError(f, verb, e.Error(), e.BaseError.opChain, e.BaseError.stack, e.BaseError.Cause)
+}
+
 // Error implements the error interface with validation-specific details
 func (e *ValidationError) Error() string {
 	base := e.BaseError.Error()
@@ -335,6 +494,20 @@ func (e *ValidationError) WithDetail(key string, value interface{}) *ValidationE
 	return e
 }
 
+// WithRetry marks the validation error as retryable with a suggested retry
+// time and returns the ValidationError.
+func (e *ValidationError) WithRetry(after time.Duration) *ValidationError {
+	e.BaseError.Retryable = true
+	e.BaseError.RetryAfter = &after
+	return e
+}
+
+// WithNumericCode sets the validation error's numeric code and returns the ValidationError
+func (e *ValidationError) WithNumericCode(c errcode.Code) *ValidationError {
+	e.BaseError.NumericCode = c
+	return e
+}
+
 // ConflictError represents conflict-related errors
 type ConflictError struct {
 	*BaseError
@@ -356,15 +529,22 @@ type ConflictError struct {
 func NewConflictError(code, message string) *ConflictError {
 	return &ConflictError{
 		BaseError: &BaseError{
-			Code:      code,
-			Message:   message,
-			Severity:  SeverityError,
-			Timestamp: time.Now(),
-			Details:   make(map[string]interface{}),
+			Code:        code,
+			Message:     message,
+			Severity:    SeverityError,
+			Timestamp:   time.Now(),
+			Details:     make(map[string]interface{}),
+			NumericCode: errcode.Conflict,
+			stack:       captureStack(),
 		},
 	}
 }
 
+// Format implements fmt.Formatter; see BaseError.Format.
+func (e *ConflictError) Format(f fmt.State, verb rune) {
+	formatError(f, verb, e.Error(), e.BaseError.opChain, e.BaseError.stack, e.BaseError.Cause)
+}
+
 // Error implements the error interface with conflict-specific details
 func (e *ConflictError) Error() string {
 	base := e.BaseError.Error()
@@ -396,6 +576,35 @@ func (e *ConflictError) WithResolution(strategy string) *ConflictError {
 	return e
 }
 
+// WithDetail adds a detail to the conflict error and returns the ConflictError
+func (e *ConflictError) WithDetail(key string, value interface{}) *ConflictError {
+	if e.BaseError.Details == nil {
+		e.BaseError.Details = make(map[string]interface{})
+	}
+	e.BaseError.Details[key] = value
+	return e
+}
+
+// WithCause adds an underlying cause to the conflict error and returns the ConflictError
+func (e *ConflictError) WithCause(cause error) *ConflictError {
+	e.BaseError.Cause = cause
+	return e
+}
+
+// WithRetry marks the conflict error as retryable with a suggested retry
+// time and returns the ConflictError.
+func (e *ConflictError) WithRetry(after time.Duration) *ConflictError {
+	e.BaseError.Retryable = true
+	e.BaseError.RetryAfter = &after
+	return e
+}
+
+// WithNumericCode sets the conflict error's numeric code and returns the ConflictError
+func (e *ConflictError) WithNumericCode(c errcode.Code) *ConflictError {
+	e.BaseError.NumericCode = c
+	return e
+}
+
 // IsRetryable checks if an error is retryable
 func IsRetryable(err error) bool {
 	if err == nil {
@@ -412,6 +621,14 @@ func IsRetryable(err error) bool {
 		return e.BaseError.Retryable
 	case *ConflictError:
 		return e.BaseError.Retryable
+	case *EncodingError:
+		return e.BaseError.Retryable
+	case *SecurityError:
+		return e.BaseError.Retryable
+	case *AgentError:
+		return e.BaseError.Retryable
+	case *MultiError:
+		return e.Retryable()
 	}
 
 	// Check wrapped errors
@@ -443,6 +660,10 @@ func GetSeverity(err error) Severity {
 		return e.BaseError.Severity
 	case *SecurityError:
 		return e.BaseError.Severity
+	case *AgentError:
+		return e.BaseError.Severity
+	case *MultiError:
+		return e.Severity()
 	}
 
 	// Check wrapped errors
@@ -475,6 +696,10 @@ func GetRetryAfter(err error) *time.Duration {
 		return e.BaseError.RetryAfter
 	case *SecurityError:
 		return e.BaseError.RetryAfter
+	case *AgentError:
+		return e.BaseError.RetryAfter
+	case *MultiError:
+		return e.RetryAfter()
 	}
 
 	// Check wrapped errors
@@ -490,8 +715,8 @@ func GetRetryAfter(err error) *time.Duration {
 type EncodingError struct {
 	*BaseError
 
-	// Format identifies the encoding format
-	Format string
+	// EncodingFormat identifies the encoding format
+	EncodingFormat string
 
 	// Operation describes the operation that failed (encode/decode/validate)
 	Operation string
@@ -510,23 +735,34 @@ type EncodingError struct {
 func NewEncodingError(code, message string) *EncodingError {
 	return &EncodingError{
 		BaseError: &BaseError{
-			Code:      code,
-			Message:   message,
-			Severity:  SeverityError,
-			Timestamp: time.Now(),
-			Details:   make(map[string]interface{}),
+			Code:        code,
+			Message:     message,
+			Severity:    SeverityError,
+			Timestamp:   time.Now(),
+			Details:     make(map[string]interface{}),
+			NumericCode: errcode.EncodingFailed,
+			stack:       captureStack(),
 		},
 	}
 }
 
+// Format implements fmt.Formatter; see BaseError.Format.
+func (e *EncodingError) Format(f fmt.State, verb rune) {
+	formatError(f, verb, e.Error(), e.BaseError.opChain, e.BaseError.stack, e.BaseError.Cause)
+}
+
 // Error implements the error interface with encoding-specific details
 func (e *EncodingError) Error() string {
 	// Start with base message without cause
-	base := fmt.Sprintf("[%s] %s: %s", e.Severity, e.Code, e.Message)
+	base := fmt.Sprintf("[%s]", e.Severity)
+	if e.NumericCode != 0 {
+		base = fmt.Sprintf("%s %s", base, e.NumericCode)
+	}
+	base = fmt.Sprintf("%s %s: %s", base, e.Code, e.Message)
 
 	// Add encoding-specific details first
-	if e.Format != "" {
-		base = fmt.Sprintf("%s (format: %s)", base, e.Format)
+	if e.EncodingFormat != "" {
+		base = fmt.Sprintf("%s (format: %s)", base, e.EncodingFormat)
 	}
 	if e.Operation != "" {
 		base = fmt.Sprintf("%s (operation: %s)", base, e.Operation)
@@ -548,7 +784,7 @@ func (e *EncodingError) Error() string {
 
 // WithFormat sets the encoding format
 func (e *EncodingError) WithFormat(format string) *EncodingError {
-	e.Format = format
+	e.EncodingFormat = format
 	return e
 }
 
@@ -582,6 +818,20 @@ func (e *EncodingError) WithCause(cause error) *EncodingError {
 	return e
 }
 
+// WithRetry marks the encoding error as retryable with a suggested retry
+// time and returns the EncodingError.
+func (e *EncodingError) WithRetry(after time.Duration) *EncodingError {
+	e.BaseError.Retryable = true
+	e.BaseError.RetryAfter = &after
+	return e
+}
+
+// WithNumericCode sets the encoding error's numeric code and returns the EncodingError
+func (e *EncodingError) WithNumericCode(c errcode.Code) *EncodingError {
+	e.BaseError.NumericCode = c
+	return e
+}
+
 // SecurityError represents security-related errors
 type SecurityError struct {
 	*BaseError
@@ -603,15 +853,22 @@ type SecurityError struct {
 func NewSecurityError(code, message string) *SecurityError {
 	return &SecurityError{
 		BaseError: &BaseError{
-			Code:      code,
-			Message:   message,
-			Severity:  SeverityCritical,
-			Timestamp: time.Now(),
-			Details:   make(map[string]interface{}),
+			Code:        code,
+			Message:     message,
+			Severity:    SeverityCritical,
+			Timestamp:   time.Now(),
+			Details:     make(map[string]interface{}),
+			NumericCode: errcode.SecurityViolation,
+			stack:       captureStack(),
 		},
 	}
 }
 
+// Format implements fmt.Formatter; see BaseError.Format.
+func (e *SecurityError) Format(f fmt.State, verb rune) {
+	formatError(f, verb, e.Error(), e.BaseError.opChain, e.BaseError.stack, e.BaseError.Cause)
+}
+
 // Error implements the error interface with security-specific details
 func (e *SecurityError) Error() string {
 	base := e.BaseError.Error()
@@ -669,6 +926,20 @@ func (e *SecurityError) WithCause(cause error) *SecurityError {
 	return e
 }
 
+// WithRetry marks the security error as retryable with a suggested retry
+// time and returns the SecurityError.
+func (e *SecurityError) WithRetry(after time.Duration) *SecurityError {
+	e.BaseError.Retryable = true
+	e.BaseError.RetryAfter = &after
+	return e
+}
+
+// WithNumericCode sets the security error's numeric code and returns the SecurityError
+func (e *SecurityError) WithNumericCode(c errcode.Code) *SecurityError {
+	e.BaseError.NumericCode = c
+	return e
+}
+
 // AgentError represents errors specific to agent operations
 type AgentError struct {
 	*BaseError
@@ -692,12 +963,18 @@ func NewAgentError(errorType ErrorType, message, agent string) *AgentError {
 			Severity:  SeverityError,
 			Timestamp: time.Now(),
 			Details:   make(map[string]interface{}),
+			stack:     captureStack(),
 		},
 		Type:  errorType,
 		Agent: agent,
 	}
 }
 
+// Format implements fmt.Formatter; see BaseError.Format.
+func (e *AgentError) Format(f fmt.State, verb rune) {
+	formatError(f, verb, e.Error(), e.BaseError.opChain, e.BaseError.stack, e.BaseError.Cause)
+}
+
 // Error implements the error interface with agent-specific details
 func (e *AgentError) Error() string {
 	base := e.BaseError.Error()
@@ -722,6 +999,35 @@ func (e *AgentError) WithEventID(eventID string) *AgentError {
 	return e
 }
 
+// WithDetail adds a detail to the agent error and returns the AgentError
+func (e *AgentError) WithDetail(key string, value interface{}) *AgentError {
+	if e.BaseError.Details == nil {
+		e.BaseError.Details = make(map[string]interface{})
+	}
+	e.BaseError.Details[key] = value
+	return e
+}
+
+// WithCause adds an underlying cause to the agent error and returns the AgentError
+func (e *AgentError) WithCause(cause error) *AgentError {
+	e.BaseError.Cause = cause
+	return e
+}
+
+// WithRetry marks the agent error as retryable with a suggested retry time
+// and returns the AgentError.
+func (e *AgentError) WithRetry(after time.Duration) *AgentError {
+	e.BaseError.Retryable = true
+	e.BaseError.RetryAfter = &after
+	return e
+}
+
+// WithNumericCode sets the agent error's numeric code and returns the AgentError
+func (e *AgentError) WithNumericCode(c errcode.Code) *AgentError {
+	e.BaseError.NumericCode = c
+	return e
+}
+
 // OperationError represents errors that occur during specific operations with context preservation
 type OperationError struct {
 	Op      string                 // Operation that failed
@@ -220,6 +220,7 @@ func NewBaseError(code, message string) *BaseError {
 		Severity:  SeverityError,
 		Timestamp: time.Now(),
 		Details:   make(map[string]interface{}),
+		stack:     captureStack(),
 	}
 }
 
@@ -0,0 +1,174 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MultiError aggregates errors produced by a batch operation - validating
+// many fields, fanning an encode out across parallel workers, applying a
+// batch of state transitions - so the caller gets back one error value
+// instead of having to choose which single error to return.
+type MultiError struct {
+	errs []error
+}
+
+// NewMultiError creates an empty MultiError ready for Append.
+func NewMultiError() *MultiError {
+	return &MultiError{}
+}
+
+// Append adds err to the aggregate, ignoring nil.
+func (m *MultiError) Append(err error) {
+	if err == nil {
+		return
+	}
+	m.errs = append(m.errs, err)
+}
+
+// Len returns the number of aggregated errors.
+func (m *MultiError) Len() int {
+	return len(m.errs)
+}
+
+// Errors returns the aggregated errors, in the order they were appended.
+func (m *MultiError) Errors() []error {
+	return m.errs
+}
+
+// Error implements the error interface, joining every child's message.
+func (m *MultiError) Error() string {
+	switch len(m.errs) {
+	case 0:
+		return "no errors"
+	case 1:
+		return m.errs[0].Error()
+	default:
+		var b strings.Builder
+		fmt.Fprintf(&b, "%d errors occurred:", len(m.errs))
+		for _, err := range m.errs {
+			fmt.Fprintf(&b, "\n\t* %s", err.Error())
+		}
+		return b.String()
+	}
+}
+
+// Unwrap returns the aggregated errors per Go 1.20's multi-error Unwrap
+// convention, so the standard library's errors.Is/errors.As already
+// tree-walk a MultiError without needing the Is/As methods below; those
+// are provided directly too since callers may hold a *MultiError and want
+// to delegate without going through the errors package.
+func (m *MultiError) Unwrap() []error {
+	return m.errs
+}
+
+// Is reports whether any child error matches target.
+func (m *MultiError) Is(target error) bool {
+	for _, err := range m.errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first child error matching target in the sense of
+// errors.As, and if found, assigns it to target.
+func (m *MultiError) As(target interface{}) bool {
+	for _, err := range m.errs {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter returns a new MultiError containing only the children for which
+// pred returns true.
+func (m *MultiError) Filter(pred func(error) bool) *MultiError {
+	filtered := NewMultiError()
+	for _, err := range m.errs {
+		if pred(err) {
+			filtered.Append(err)
+		}
+	}
+	return filtered
+}
+
+// GroupByType buckets the aggregated errors by AgentError.Type, so an
+// agent loop can decide e.g. "retry the rate-limited subset now, surface
+// the validation subset to the user". An error that isn't an AgentError
+// and doesn't wrap one is grouped under the empty ErrorType.
+func (m *MultiError) GroupByType() map[ErrorType][]error {
+	groups := make(map[ErrorType][]error)
+	for _, err := range m.errs {
+		errType := ErrorType("")
+		var agentErr *AgentError
+		if errors.As(err, &agentErr) {
+			errType = agentErr.Type
+		}
+		groups[errType] = append(groups[errType], err)
+	}
+	return groups
+}
+
+// Severity returns the highest-severity among the aggregated errors, or
+// SeverityInfo if there are none; GetSeverity dispatches here for a
+// *MultiError.
+func (m *MultiError) Severity() Severity {
+	severity := SeverityInfo
+	for _, err := range m.errs {
+		if s := GetSeverity(err); s > severity {
+			severity = s
+		}
+	}
+	return severity
+}
+
+// Retryable reports whether every aggregated error is retryable; an empty
+// MultiError is not retryable. GetRetryable/IsRetryable dispatches here
+// for a *MultiError.
+func (m *MultiError) Retryable() bool {
+	if len(m.errs) == 0 {
+		return false
+	}
+	for _, err := range m.errs {
+		if !IsRetryable(err) {
+			return false
+		}
+	}
+	return true
+}
+
+// RetryAfter returns the longest suggested retry delay among the
+// aggregated errors, or nil if none suggest one. GetRetryAfter dispatches
+// here for a *MultiError.
+func (m *MultiError) RetryAfter() *time.Duration {
+	var longest *time.Duration
+	for _, err := range m.errs {
+		d := GetRetryAfter(err)
+		if d == nil {
+			continue
+		}
+		if longest == nil || *d > *longest {
+			longest = d
+		}
+	}
+	return longest
+}
+
+// ToMultiError converts FieldErrors into a MultiError of one
+// *ValidationError per field error, so validation-heavy call sites can use
+// the unified aggregation API (Filter, GroupByType, ...) instead of
+// walking the FieldErrors map by hand.
+func (e *ValidationError) ToMultiError() *MultiError {
+	multi := NewMultiError()
+	for field, messages := range e.FieldErrors {
+		for _, message := range messages {
+			multi.Append(NewValidationError(e.Code, message).WithField(field, nil))
+		}
+	}
+	return multi
+}
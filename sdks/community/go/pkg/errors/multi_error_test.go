@@ -0,0 +1,70 @@
+package errors
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMultiErrorSeverityAndRetryRollup(t *testing.T) {
+	multi := NewMultiError()
+	multi.Append(NewValidationError("VALIDATION_FAILED", "bad field").WithRetry(2 * time.Second))
+	multi.Append(NewSecurityError("SECURITY_VIOLATION", "blocked").WithRetry(5 * time.Second))
+
+	if multi.Len() != 2 {
+		t.Fatalf("expected 2 errors, got %d", multi.Len())
+	}
+	if GetSeverity(multi) != SeverityCritical {
+		t.Fatalf("expected roll-up severity SeverityCritical (the SecurityError's), got %v", GetSeverity(multi))
+	}
+	if !IsRetryable(multi) {
+		t.Fatalf("expected all-retryable children to roll up to retryable")
+	}
+	after := GetRetryAfter(multi)
+	if after == nil || *after != 5*time.Second {
+		t.Fatalf("expected retry_after to roll up to the longest delay, got %v", after)
+	}
+}
+
+func TestMultiErrorNotRetryableIfAnyChildIsnt(t *testing.T) {
+	multi := NewMultiError()
+	multi.Append(NewValidationError("VALIDATION_FAILED", "bad field").WithRetry(time.Second))
+	multi.Append(NewStateError("STATE_INVALID", "bad state"))
+
+	if IsRetryable(multi) {
+		t.Fatalf("expected not retryable when one child isn't retryable")
+	}
+}
+
+func TestMultiErrorFilterAndGroupByType(t *testing.T) {
+	multi := NewMultiError()
+	multi.Append(NewAgentError(ErrorTypeRateLimit, "rate limited", "agent-1"))
+	multi.Append(NewAgentError(ErrorTypeValidation, "bad input", "agent-1"))
+	multi.Append(NewStateError("STATE_INVALID", "bad state"))
+
+	rateLimited := multi.Filter(func(err error) bool {
+		var agentErr *AgentError
+		return As(err, &agentErr) && agentErr.Type == ErrorTypeRateLimit
+	})
+	if rateLimited.Len() != 1 {
+		t.Fatalf("expected 1 rate-limited error, got %d", rateLimited.Len())
+	}
+
+	groups := multi.GroupByType()
+	if len(groups[ErrorTypeRateLimit]) != 1 || len(groups[ErrorTypeValidation]) != 1 {
+		t.Fatalf("expected one error per AgentError type, got %+v", groups)
+	}
+	if len(groups[ErrorType("")]) != 1 {
+		t.Fatalf("expected the StateError grouped under the empty ErrorType, got %+v", groups)
+	}
+}
+
+func TestValidationErrorToMultiError(t *testing.T) {
+	original := NewValidationError("VALIDATION_FAILED", "multiple fields invalid")
+	original.AddFieldError("email", "must be a valid email")
+	original.AddFieldError("age", "must be non-negative")
+
+	multi := original.ToMultiError()
+	if multi.Len() != 2 {
+		t.Fatalf("expected 2 field errors, got %d", multi.Len())
+	}
+}
@@ -0,0 +1,669 @@
+package errors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	errcode "github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/errors/code"
+)
+
+// errorEnvelope carries the fields common to every error type in this
+// package across a MarshalJSON/UnmarshalJSON round trip, plus the "type"
+// discriminator FromJSON switches on to reconstruct the right concrete
+// type. Its field names mirror the TS/Python AG-UI SDKs' error shape so a
+// browser or agent client on the other side of the wire can decode it
+// without format-specific logic.
+type errorEnvelope struct {
+	Type        string                 `json:"type"`
+	Code        string                 `json:"code"`
+	Message     string                 `json:"message"`
+	Severity    string                 `json:"severity"`
+	Timestamp   time.Time              `json:"timestamp"`
+	NumericCode errcode.Code           `json:"numeric_code,omitempty"`
+	Details     map[string]interface{} `json:"details,omitempty"`
+	Retryable   bool                   `json:"retryable,omitempty"`
+	RetryAfter  string                 `json:"retry_after,omitempty"`
+	Cause       json.RawMessage        `json:"cause,omitempty"`
+}
+
+// ParseSeverity parses the string produced by Severity.String back into a
+// Severity, defaulting to SeverityError for an unrecognized value.
+func ParseSeverity(s string) Severity {
+	switch s {
+	case "DEBUG":
+		return SeverityDebug
+	case "INFO":
+		return SeverityInfo
+	case "WARNING":
+		return SeverityWarning
+	case "CRITICAL":
+		return SeverityCritical
+	case "FATAL":
+		return SeverityFatal
+	default:
+		return SeverityError
+	}
+}
+
+// toEnvelope builds the common envelope for e, tagged with errType.
+func (e *BaseError) toEnvelope(errType string) (*errorEnvelope, error) {
+	cause, err := marshalCause(e.Cause)
+	if err != nil {
+		return nil, fmt.Errorf("marshal cause: %w", err)
+	}
+
+	retryAfter := ""
+	if e.RetryAfter != nil {
+		retryAfter = e.RetryAfter.String()
+	}
+
+	return &errorEnvelope{
+		Type:        errType,
+		Code:        e.Code,
+		Message:     e.Message,
+		Severity:    e.Severity.String(),
+		Timestamp:   e.Timestamp,
+		NumericCode: e.NumericCode,
+		Details:     e.Details,
+		Retryable:   e.Retryable,
+		RetryAfter:  retryAfter,
+		Cause:       cause,
+	}, nil
+}
+
+// fromEnvelope populates e's BaseError fields from env.
+func (e *BaseError) fromEnvelope(env errorEnvelope) error {
+	e.Code = env.Code
+	e.Message = env.Message
+	e.Severity = ParseSeverity(env.Severity)
+	e.Timestamp = env.Timestamp
+	e.NumericCode = env.NumericCode
+	e.Details = env.Details
+	e.Retryable = env.Retryable
+
+	if env.RetryAfter != "" {
+		d, err := time.ParseDuration(env.RetryAfter)
+		if err != nil {
+			return fmt.Errorf("parse retry_after: %w", err)
+		}
+		e.RetryAfter = &d
+	}
+
+	if len(env.Cause) > 0 && string(env.Cause) != "null" {
+		e.Cause = FromJSON(env.Cause)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (e *BaseError) MarshalJSON() ([]byte, error) {
+	env, err := e.toEnvelope("base_error")
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(env)
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (e *BaseError) UnmarshalJSON(data []byte) error {
+	var env errorEnvelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return err
+	}
+	return e.fromEnvelope(env)
+}
+
+// ToProto serializes the error to a minimal protobuf envelope carrying its
+// JSON form as field 1 (bytes); see proto/errors.proto.
+func (e *BaseError) ToProto() ([]byte, error) {
+	return toProto(e.MarshalJSON)
+}
+
+// FromProto deserializes a payload written by ToProto.
+func (e *BaseError) FromProto(data []byte) error {
+	return fromProto(data, e.UnmarshalJSON)
+}
+
+// MarshalJSON implements json.Marshaler
+func (e *StateError) MarshalJSON() ([]byte, error) {
+	env, err := e.BaseError.toEnvelope("state_error")
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		*errorEnvelope
+		StateID       string      `json:"state_id,omitempty"`
+		CurrentState  interface{} `json:"current_state,omitempty"`
+		ExpectedState interface{} `json:"expected_state,omitempty"`
+		Transition    string      `json:"transition,omitempty"`
+	}{
+		errorEnvelope: env,
+		StateID:       e.StateID,
+		CurrentState:  e.CurrentState,
+		ExpectedState: e.ExpectedState,
+		Transition:    e.Transition,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (e *StateError) UnmarshalJSON(data []byte) error {
+	var payload struct {
+		errorEnvelope
+		StateID       string      `json:"state_id,omitempty"`
+		CurrentState  interface{} `json:"current_state,omitempty"`
+		ExpectedState interface{} `json:"expected_state,omitempty"`
+		Transition    string      `json:"transition,omitempty"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+	if e.BaseError == nil {
+		e.BaseError = &BaseError{}
+	}
+	if err := e.BaseError.fromEnvelope(payload.errorEnvelope); err != nil {
+		return err
+	}
+	e.StateID = payload.StateID
+	e.CurrentState = payload.CurrentState
+	e.ExpectedState = payload.ExpectedState
+	e.Transition = payload.Transition
+	return nil
+}
+
+// ToProto serializes the error to a minimal protobuf envelope; see
+// proto/errors.proto.
+func (e *StateError) ToProto() ([]byte, error) { return toProto(e.MarshalJSON) }
+
+// FromProto deserializes a payload written by ToProto.
+func (e *StateError) FromProto(data []byte) error { return fromProto(data, e.UnmarshalJSON) }
+
+// MarshalJSON implements json.Marshaler
+func (e *ValidationError) MarshalJSON() ([]byte, error) {
+	env, err := e.BaseError.toEnvelope("validation_error")
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		*errorEnvelope
+		Field       string              `json:"field,omitempty"`
+		Value       interface{}         `json:"value,omitempty"`
+		Rule        string              `json:"rule,omitempty"`
+		FieldErrors map[string][]string `json:"field_errors,omitempty"`
+	}{
+		errorEnvelope: env,
+		Field:         e.Field,
+		Value:         e.Value,
+		Rule:          e.Rule,
+		FieldErrors:   e.FieldErrors,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (e *ValidationError) UnmarshalJSON(data []byte) error {
+	var payload struct {
+		errorEnvelope
+		Field       string              `json:"field,omitempty"`
+		Value       interface{}         `json:"value,omitempty"`
+		Rule        string              `json:"rule,omitempty"`
+		FieldErrors map[string][]string `json:"field_errors,omitempty"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+	if e.BaseError == nil {
+		e.BaseError = &BaseError{}
+	}
+	if err := e.BaseError.fromEnvelope(payload.errorEnvelope); err != nil {
+		return err
+	}
+	e.Field = payload.Field
+	e.Value = payload.Value
+	e.Rule = payload.Rule
+	e.FieldErrors = payload.FieldErrors
+	return nil
+}
+
+// ToProto serializes the error to a minimal protobuf envelope; see
+// proto/errors.proto.
+func (e *ValidationError) ToProto() ([]byte, error) { return toProto(e.MarshalJSON) }
+
+// FromProto deserializes a payload written by ToProto.
+func (e *ValidationError) FromProto(data []byte) error { return fromProto(data, e.UnmarshalJSON) }
+
+// MarshalJSON implements json.Marshaler
+func (e *ConflictError) MarshalJSON() ([]byte, error) {
+	env, err := e.BaseError.toEnvelope("conflict_error")
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		*errorEnvelope
+		ResourceID           string `json:"resource_id,omitempty"`
+		ResourceType         string `json:"resource_type,omitempty"`
+		ConflictingOperation string `json:"conflicting_operation,omitempty"`
+		ResolutionStrategy   string `json:"resolution_strategy,omitempty"`
+	}{
+		errorEnvelope:        env,
+		ResourceID:           e.ResourceID,
+		ResourceType:         e.ResourceType,
+		ConflictingOperation: e.ConflictingOperation,
+		ResolutionStrategy:   e.ResolutionStrategy,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (e *ConflictError) UnmarshalJSON(data []byte) error {
+	var payload struct {
+		errorEnvelope
+		ResourceID           string `json:"resource_id,omitempty"`
+		ResourceType         string `json:"resource_type,omitempty"`
+		ConflictingOperation string `json:"conflicting_operation,omitempty"`
+		ResolutionStrategy   string `json:"resolution_strategy,omitempty"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+	if e.BaseError == nil {
+		e.BaseError = &BaseError{}
+	}
+	if err := e.BaseError.fromEnvelope(payload.errorEnvelope); err != nil {
+		return err
+	}
+	e.ResourceID = payload.ResourceID
+	e.ResourceType = payload.ResourceType
+	e.ConflictingOperation = payload.ConflictingOperation
+	e.ResolutionStrategy = payload.ResolutionStrategy
+	return nil
+}
+
+// ToProto serializes the error to a minimal protobuf envelope; see
+// proto/errors.proto.
+func (e *ConflictError) ToProto() ([]byte, error) { return toProto(e.MarshalJSON) }
+
+// FromProto deserializes a payload written by ToProto.
+func (e *ConflictError) FromProto(data []byte) error { return fromProto(data, e.UnmarshalJSON) }
+
+// MarshalJSON implements json.Marshaler
+func (e *EncodingError) MarshalJSON() ([]byte, error) {
+	env, err := e.BaseError.toEnvelope("encoding_error")
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		*errorEnvelope
+		Format    string      `json:"format,omitempty"`
+		Operation string      `json:"operation,omitempty"`
+		Data      interface{} `json:"data,omitempty"`
+		Position  int64       `json:"position,omitempty"`
+		MimeType  string      `json:"mime_type,omitempty"`
+	}{
+		errorEnvelope: env,
+		Format:        e.EncodingFormat,
+		Operation:     e.Operation,
+		Data:          e.Data,
+		Position:      e.Position,
+		MimeType:      e.MimeType,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (e *EncodingError) UnmarshalJSON(data []byte) error {
+	var payload struct {
+		errorEnvelope
+		Format    string      `json:"format,omitempty"`
+		Operation string      `json:"operation,omitempty"`
+		Data      interface{} `json:"data,omitempty"`
+		Position  int64       `json:"position,omitempty"`
+		MimeType  string      `json:"mime_type,omitempty"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+	if e.BaseError == nil {
+		e.BaseError = &BaseError{}
+	}
+	if err := e.BaseError.fromEnvelope(payload.errorEnvelope); err != nil {
+		return err
+	}
+	e.EncodingFormat = payload.Format
+	e.Operation = payload.Operation
+	e.Data = payload.Data
+	e.Position = payload.Position
+	e.MimeType = payload.MimeType
+	return nil
+}
+
+// ToProto serializes the error to a minimal protobuf envelope; see
+// proto/errors.proto.
+func (e *EncodingError) ToProto() ([]byte, error) { return toProto(e.MarshalJSON) }
+
+// FromProto deserializes a payload written by ToProto.
+func (e *EncodingError) FromProto(data []byte) error { return fromProto(data, e.UnmarshalJSON) }
+
+// MarshalJSON implements json.Marshaler
+func (e *SecurityError) MarshalJSON() ([]byte, error) {
+	env, err := e.BaseError.toEnvelope("security_error")
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		*errorEnvelope
+		ViolationType string `json:"violation_type,omitempty"`
+		Pattern       string `json:"pattern,omitempty"`
+		Location      string `json:"location,omitempty"`
+		RiskLevel     string `json:"risk_level,omitempty"`
+	}{
+		errorEnvelope: env,
+		ViolationType: e.ViolationType,
+		Pattern:       e.Pattern,
+		Location:      e.Location,
+		RiskLevel:     e.RiskLevel,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (e *SecurityError) UnmarshalJSON(data []byte) error {
+	var payload struct {
+		errorEnvelope
+		ViolationType string `json:"violation_type,omitempty"`
+		Pattern       string `json:"pattern,omitempty"`
+		Location      string `json:"location,omitempty"`
+		RiskLevel     string `json:"risk_level,omitempty"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+	if e.BaseError == nil {
+		e.BaseError = &BaseError{}
+	}
+	if err := e.BaseError.fromEnvelope(payload.errorEnvelope); err != nil {
+		return err
+	}
+	e.ViolationType = payload.ViolationType
+	e.Pattern = payload.Pattern
+	e.Location = payload.Location
+	e.RiskLevel = payload.RiskLevel
+	return nil
+}
+
+// ToProto serializes the error to a minimal protobuf envelope; see
+// proto/errors.proto.
+func (e *SecurityError) ToProto() ([]byte, error) { return toProto(e.MarshalJSON) }
+
+// FromProto deserializes a payload written by ToProto.
+func (e *SecurityError) FromProto(data []byte) error { return fromProto(data, e.UnmarshalJSON) }
+
+// MarshalJSON implements json.Marshaler
+func (e *AgentError) MarshalJSON() ([]byte, error) {
+	env, err := e.BaseError.toEnvelope("agent_error")
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		*errorEnvelope
+		ErrorType string `json:"error_type,omitempty"`
+		Agent     string `json:"agent,omitempty"`
+		EventID   string `json:"event_id,omitempty"`
+	}{
+		errorEnvelope: env,
+		ErrorType:     string(e.Type),
+		Agent:         e.Agent,
+		EventID:       e.EventID,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (e *AgentError) UnmarshalJSON(data []byte) error {
+	var payload struct {
+		errorEnvelope
+		ErrorType string `json:"error_type,omitempty"`
+		Agent     string `json:"agent,omitempty"`
+		EventID   string `json:"event_id,omitempty"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+	if e.BaseError == nil {
+		e.BaseError = &BaseError{}
+	}
+	if err := e.BaseError.fromEnvelope(payload.errorEnvelope); err != nil {
+		return err
+	}
+	e.Type = ErrorType(payload.ErrorType)
+	e.Agent = payload.Agent
+	e.EventID = payload.EventID
+	return nil
+}
+
+// ToProto serializes the error to a minimal protobuf envelope; see
+// proto/errors.proto.
+func (e *AgentError) ToProto() ([]byte, error) { return toProto(e.MarshalJSON) }
+
+// FromProto deserializes a payload written by ToProto.
+func (e *AgentError) FromProto(data []byte) error { return fromProto(data, e.UnmarshalJSON) }
+
+// MarshalJSON implements json.Marshaler
+func (e *OperationError) MarshalJSON() ([]byte, error) {
+	cause, err := marshalCause(e.Err)
+	if err != nil {
+		return nil, fmt.Errorf("marshal cause: %w", err)
+	}
+
+	return json.Marshal(struct {
+		Type    string                 `json:"type"`
+		Op      string                 `json:"op"`
+		Target  string                 `json:"target"`
+		Code    string                 `json:"code,omitempty"`
+		Time    time.Time              `json:"timestamp"`
+		Details map[string]interface{} `json:"details,omitempty"`
+		Cause   json.RawMessage        `json:"cause,omitempty"`
+	}{
+		Type:    "operation_error",
+		Op:      e.Op,
+		Target:  e.Target,
+		Code:    e.Code,
+		Time:    e.Time,
+		Details: e.Details,
+		Cause:   cause,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (e *OperationError) UnmarshalJSON(data []byte) error {
+	var payload struct {
+		Op      string                 `json:"op"`
+		Target  string                 `json:"target"`
+		Code    string                 `json:"code,omitempty"`
+		Time    time.Time              `json:"timestamp"`
+		Details map[string]interface{} `json:"details,omitempty"`
+		Cause   json.RawMessage        `json:"cause,omitempty"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+	e.Op = payload.Op
+	e.Target = payload.Target
+	e.Code = payload.Code
+	e.Time = payload.Time
+	e.Details = payload.Details
+	if len(payload.Cause) > 0 && string(payload.Cause) != "null" {
+		e.Err = FromJSON(payload.Cause)
+	}
+	return nil
+}
+
+// ToProto serializes the error to a minimal protobuf envelope; see
+// proto/errors.proto.
+func (e *OperationError) ToProto() ([]byte, error) { return toProto(e.MarshalJSON) }
+
+// FromProto deserializes a payload written by ToProto.
+func (e *OperationError) FromProto(data []byte) error { return fromProto(data, e.UnmarshalJSON) }
+
+// marshalCause encodes err for the "cause" field: its own MarshalJSON if it
+// implements json.Marshaler (every error type in this package does), or a
+// minimal {"type":"generic_error","message":"..."} envelope otherwise.
+func marshalCause(err error) (json.RawMessage, error) {
+	if err == nil {
+		return nil, nil
+	}
+	if jm, ok := err.(json.Marshaler); ok {
+		return jm.MarshalJSON()
+	}
+	return json.Marshal(struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	}{Type: "generic_error", Message: err.Error()})
+}
+
+// FromJSON reconstructs the concrete error type that produced data (as
+// written by its MarshalJSON), selecting by the "type" discriminator, so a
+// remote agent can errors.As against the result instead of parsing strings.
+// An error whose type is unrecognized is reconstructed as a plain error
+// carrying its "message" field.
+func FromJSON(data []byte) error {
+	var probe struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return fmt.Errorf("errors.FromJSON: %w", err)
+	}
+
+	switch probe.Type {
+	case "base_error":
+		e := &BaseError{}
+		if err := e.UnmarshalJSON(data); err != nil {
+			return fmt.Errorf("errors.FromJSON: %w", err)
+		}
+		return e
+	case "state_error":
+		e := &StateError{}
+		if err := e.UnmarshalJSON(data); err != nil {
+			return fmt.Errorf("errors.FromJSON: %w", err)
+		}
+		return e
+	case "validation_error":
+		e := &ValidationError{}
+		if err := e.UnmarshalJSON(data); err != nil {
+			return fmt.Errorf("errors.FromJSON: %w", err)
+		}
+		return e
+	case "conflict_error":
+		e := &ConflictError{}
+		if err := e.UnmarshalJSON(data); err != nil {
+			return fmt.Errorf("errors.FromJSON: %w", err)
+		}
+		return e
+	case "encoding_error":
+		e := &EncodingError{}
+		if err := e.UnmarshalJSON(data); err != nil {
+			return fmt.Errorf("errors.FromJSON: %w", err)
+		}
+		return e
+	case "security_error":
+		e := &SecurityError{}
+		if err := e.UnmarshalJSON(data); err != nil {
+			return fmt.Errorf("errors.FromJSON: %w", err)
+		}
+		return e
+	case "agent_error":
+		e := &AgentError{}
+		if err := e.UnmarshalJSON(data); err != nil {
+			return fmt.Errorf("errors.FromJSON: %w", err)
+		}
+		return e
+	case "operation_error":
+		e := &OperationError{}
+		if err := e.UnmarshalJSON(data); err != nil {
+			return fmt.Errorf("errors.FromJSON: %w", err)
+		}
+		return e
+	default:
+		var generic struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(data, &generic); err == nil && generic.Message != "" {
+			return errors.New(generic.Message)
+		}
+		return fmt.Errorf("errors.FromJSON: unknown error type %q", probe.Type)
+	}
+}
+
+// toProto wraps the JSON produced by marshalJSON in a minimal protobuf
+// envelope (a single length-delimited field 1), mirroring
+// events.ToProtoEnvelope so the wire format is consistent across the SDK;
+// see proto/errors.proto.
+func toProto(marshalJSON func() ([]byte, error)) ([]byte, error) {
+	data, err := marshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return encodeProtoBytesField(1, data), nil
+}
+
+// fromProto unwraps a payload written by toProto and hands the JSON back to
+// unmarshalJSON.
+func fromProto(data []byte, unmarshalJSON func([]byte) error) error {
+	jsonData, err := decodeProtoBytesField(1, data)
+	if err != nil {
+		return err
+	}
+	return unmarshalJSON(jsonData)
+}
+
+// encodeProtoBytesField encodes a single length-delimited (wire type 2)
+// protobuf field.
+func encodeProtoBytesField(fieldNum int, value []byte) []byte {
+	tag := byte(fieldNum<<3) | 2
+	buf := []byte{tag}
+	buf = appendProtoVarint(buf, uint64(len(value)))
+	return append(buf, value...)
+}
+
+// appendProtoVarint appends v to buf in protobuf base-128 varint encoding.
+func appendProtoVarint(buf []byte, v uint64) []byte {
+	for v >= 0x80 {
+		buf = append(buf, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(buf, byte(v))
+}
+
+// decodeProtoBytesField reads back the length-delimited field encoded by
+// encodeProtoBytesField.
+func decodeProtoBytesField(fieldNum int, data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("decode proto field %d: empty payload", fieldNum)
+	}
+	wantTag := byte(fieldNum<<3) | 2
+	if data[0] != wantTag {
+		return nil, fmt.Errorf("decode proto field %d: unexpected tag %d", fieldNum, data[0])
+	}
+	length, n := readProtoVarint(data[1:])
+	if n == 0 {
+		return nil, fmt.Errorf("decode proto field %d: malformed length", fieldNum)
+	}
+	start := 1 + n
+	end := start + int(length)
+	if end > len(data) {
+		return nil, fmt.Errorf("decode proto field %d: truncated payload", fieldNum)
+	}
+	return data[start:end], nil
+}
+
+// readProtoVarint decodes a base-128 varint from the start of data,
+// returning the value and the number of bytes consumed (0 if malformed).
+func readProtoVarint(data []byte) (uint64, int) {
+	var v uint64
+	var shift uint
+	for i, b := range data {
+		v |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return v, i + 1
+		}
+		shift += 7
+	}
+	return 0, 0
+}
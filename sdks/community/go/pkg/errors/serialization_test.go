@@ -0,0 +1,165 @@
+package errors
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBaseErrorJSONRoundTrip(t *testing.T) {
+	retryAfter := 5 * time.Second
+	original := NewBaseError("SOMETHING_FAILED", "something failed").
+		WithDetail("attempt", 3).
+		WithCause(errors.New("root cause")).
+		WithRetry(retryAfter)
+	original.Timestamp = time.Date(2026, 7, 26, 12, 0, 0, 0, time.UTC)
+
+	data, err := original.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	restored, ok := FromJSON(data).(*BaseError)
+	if !ok {
+		t.Fatalf("FromJSON did not return a *BaseError: %v", FromJSON(data))
+	}
+
+	if restored.Code != original.Code || restored.Message != original.Message {
+		t.Fatalf("code/message mismatch: got %+v, want %+v", restored, original)
+	}
+	if !restored.Timestamp.Equal(original.Timestamp) {
+		t.Fatalf("timestamp mismatch: got %v, want %v", restored.Timestamp, original.Timestamp)
+	}
+	if restored.RetryAfter == nil || *restored.RetryAfter != retryAfter {
+		t.Fatalf("retry_after mismatch: got %v, want %v", restored.RetryAfter, retryAfter)
+	}
+	if restored.Cause == nil || restored.Cause.Error() != "root cause" {
+		t.Fatalf("cause not preserved: got %v", restored.Cause)
+	}
+}
+
+func TestValidationErrorJSONRoundTrip(t *testing.T) {
+	original := NewValidationError("VALIDATION_FAILED", "field is invalid").
+		WithField("email", "not-an-email").
+		WithRule("format")
+	original.AddFieldError("email", "must be a valid email address")
+
+	data, err := original.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	restoredErr := FromJSON(data)
+	restored, ok := restoredErr.(*ValidationError)
+	if !ok {
+		t.Fatalf("FromJSON did not return a *ValidationError: %v", restoredErr)
+	}
+
+	if restored.Field != "email" || restored.Rule != "format" {
+		t.Fatalf("field/rule mismatch: got %+v", restored)
+	}
+	if len(restored.FieldErrors["email"]) != 1 {
+		t.Fatalf("field_errors not preserved: got %+v", restored.FieldErrors)
+	}
+}
+
+func TestEncodingErrorCauseChainPreserved(t *testing.T) {
+	inner := NewStateError("STATE_INVALID", "bad state").WithStateID("s1")
+	outer := NewEncodingError("ENCODING_FAILED", "could not encode").
+		WithFormat("json").
+		WithOperation("encode").
+		WithMimeType("application/json").
+		WithCause(inner)
+
+	data, err := outer.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	restoredErr := FromJSON(data)
+	restored, ok := restoredErr.(*EncodingError)
+	if !ok {
+		t.Fatalf("FromJSON did not return a *EncodingError: %v", restoredErr)
+	}
+	if restored.EncodingFormat != "json" || restored.Operation != "encode" || restored.MimeType != "application/json" {
+		t.Fatalf("encoding-specific fields not preserved: got %+v", restored)
+	}
+
+	cause, ok := restored.Cause.(*StateError)
+	if !ok {
+		t.Fatalf("cause chain not preserved as *StateError: %v", restored.Cause)
+	}
+	if cause.StateID != "s1" {
+		t.Fatalf("nested cause fields not preserved: got %+v", cause)
+	}
+}
+
+func TestOperationErrorJSONRoundTrip(t *testing.T) {
+	original := NewOperationError("config.Load", "config.yaml", errors.New("file not found")).
+		WithCode("NOT_FOUND").
+		WithDetail("attempt", 1)
+
+	data, err := original.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	restoredErr := FromJSON(data)
+	restored, ok := restoredErr.(*OperationError)
+	if !ok {
+		t.Fatalf("FromJSON did not return a *OperationError: %v", restoredErr)
+	}
+	if restored.Op != original.Op || restored.Target != original.Target || restored.Code != original.Code {
+		t.Fatalf("operation error fields not preserved: got %+v", restored)
+	}
+	if restored.Err == nil || restored.Err.Error() != "file not found" {
+		t.Fatalf("cause not preserved: got %v", restored.Err)
+	}
+}
+
+// TestCrossLanguageFieldNames checks the wire JSON uses the snake_case
+// field names the TS/Python AG-UI SDKs expect, so remote agents decode it
+// without format-specific translation.
+func TestCrossLanguageFieldNames(t *testing.T) {
+	original := NewSecurityError("SECURITY_VIOLATION", "blocked").
+		WithViolationType("xss").
+		WithRiskLevel("high").
+		WithRetry(2 * time.Second)
+
+	data, err := original.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	for _, key := range []string{"type", "code", "message", "severity", "timestamp", "retry_after", "violation_type", "risk_level"} {
+		if _, ok := fields[key]; !ok {
+			t.Errorf("expected JSON field %q, got keys %v", key, fields)
+		}
+	}
+}
+
+func TestProtoRoundTrip(t *testing.T) {
+	original := NewConflictError("CONFLICT", "resource busy").
+		WithResource("document", "doc-1").
+		WithOperation("update")
+
+	data, err := original.ToProto()
+	if err != nil {
+		t.Fatalf("ToProto: %v", err)
+	}
+
+	restored := &ConflictError{}
+	if err := restored.FromProto(data); err != nil {
+		t.Fatalf("FromProto: %v", err)
+	}
+
+	if restored.ResourceID != "doc-1" || restored.ResourceType != "document" || restored.ConflictingOperation != "update" {
+		t.Fatalf("conflict fields not preserved over proto round trip: got %+v", restored)
+	}
+}
@@ -0,0 +1,67 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// TypeError describes a single JSON type mismatch encountered during a
+// best-effort decode (see encoding.DecodingOptions.AccumulateTypeErrors):
+// the value at Path didn't match the Go field's Expected type, so it was
+// left at its zero (or existing, for decode-into) value instead of
+// aborting the decode.
+type TypeError struct {
+	// Path is the JSON pointer (RFC 6901) path of the mismatched value,
+	// e.g. "/data/count".
+	Path string
+
+	// Expected is the Go type the field requires, e.g. "int64".
+	Expected string
+
+	// Found is the JSON value kind actually present, e.g. "string".
+	Found string
+}
+
+// Error returns a human-readable description of the mismatch.
+func (e *TypeError) Error() string {
+	return fmt.Sprintf("type mismatch at %s: expected %s, found %s", e.Path, e.Expected, e.Found)
+}
+
+// MultiTypeError collects every TypeError encountered during a single
+// best-effort decode, so a caller can inspect all of them via errors.As
+// instead of only the first.
+type MultiTypeError struct {
+	Errors []*TypeError
+}
+
+// Error returns the first mismatch's message, noting how many more were
+// found so the summary doesn't silently hide the rest.
+func (e *MultiTypeError) Error() string {
+	switch len(e.Errors) {
+	case 0:
+		return ""
+	case 1:
+		return e.Errors[0].Error()
+	default:
+		return fmt.Sprintf("%s (and %d more type error%s)", e.Errors[0].Error(), len(e.Errors)-1, plural(len(e.Errors)-1))
+	}
+}
+
+// Unwrap exposes every mismatch after the first as a ChainedError, so
+// errors.Is/As can walk into them too.
+func (e *MultiTypeError) Unwrap() error {
+	if len(e.Errors) <= 1 {
+		return nil
+	}
+	rest := make([]error, len(e.Errors)-1)
+	for i, te := range e.Errors[1:] {
+		rest[i] = te
+	}
+	return &ChainedError{errors: rest}
+}
+
+func plural(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
@@ -0,0 +1,57 @@
+package log
+
+import "context"
+
+// correlationKeyType is an unexported type so Correlation's context key
+// can't collide with keys set by other packages using context.WithValue.
+type correlationKeyType struct{}
+
+var correlationKey = correlationKeyType{}
+
+// Correlation holds the identifiers FromContext attaches to every log
+// line it produces: the inbound request's ID, the W3C trace/span IDs
+// carried by a "traceparent" header (if any) plus its opaque "tracestate"
+// companion (if any), and the thread/run IDs of whatever agentic run is in
+// flight, if any. All fields are optional; FromContext omits whichever are
+// empty.
+type Correlation struct {
+	RequestID  string
+	TraceID    string
+	SpanID     string
+	TraceState string
+	ThreadID   string
+	RunID      string
+}
+
+// WithCorrelation returns a context carrying corr, replacing whatever
+// Correlation ctx already carried. Request-level middleware calls this
+// once per request with the request/trace IDs; code deeper in a run adds
+// to it with WithThreadRun rather than needing to know those fields.
+func WithCorrelation(ctx context.Context, corr Correlation) context.Context {
+	return context.WithValue(ctx, correlationKey, corr)
+}
+
+// WithThreadRun returns a context whose Correlation - starting from
+// whatever ctx already carried, or an empty one - has ThreadID/RunID set,
+// leaving every other field untouched. This lets code inside an agentic
+// run (which knows the thread/run IDs but not the originating request's
+// ID) annotate a context without clobbering what request-level middleware
+// already attached.
+func WithThreadRun(ctx context.Context, threadID, runID string) context.Context {
+	corr := correlationFrom(ctx)
+	corr.ThreadID = threadID
+	corr.RunID = runID
+	return WithCorrelation(ctx, corr)
+}
+
+// correlationFrom reads ctx's Correlation, returning the zero value if it
+// doesn't carry one (including when ctx is nil).
+func correlationFrom(ctx context.Context) Correlation {
+	if ctx == nil {
+		return Correlation{}
+	}
+	if corr, ok := ctx.Value(correlationKey).(Correlation); ok {
+		return corr
+	}
+	return Correlation{}
+}
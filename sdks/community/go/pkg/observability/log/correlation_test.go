@@ -0,0 +1,48 @@
+package log
+
+import (
+	"context"
+	"testing"
+)
+
+func TestParseTraceParent(t *testing.T) {
+	t.Run("valid header", func(t *testing.T) {
+		traceID, spanID, ok := ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		if !ok {
+			t.Fatalf("expected ok=true")
+		}
+		if traceID != "4bf92f3577b34da6a3ce929d0e0e4736" {
+			t.Errorf("unexpected trace ID: %s", traceID)
+		}
+		if spanID != "00f067aa0ba902b7" {
+			t.Errorf("unexpected span ID: %s", spanID)
+		}
+	})
+
+	t.Run("malformed header", func(t *testing.T) {
+		for _, header := range []string{"", "not-a-traceparent", "00-tooshort-00f067aa0ba902b7-01"} {
+			if _, _, ok := ParseTraceParent(header); ok {
+				t.Errorf("expected ok=false for %q", header)
+			}
+		}
+	})
+}
+
+func TestWithThreadRunPreservesRequestFields(t *testing.T) {
+	ctx := WithCorrelation(context.Background(), Correlation{RequestID: "req-1", TraceID: "trace-1"})
+	ctx = WithThreadRun(ctx, "thread-1", "run-1")
+
+	corr := correlationFrom(ctx)
+	if corr.RequestID != "req-1" || corr.TraceID != "trace-1" {
+		t.Errorf("expected request-level fields preserved, got %+v", corr)
+	}
+	if corr.ThreadID != "thread-1" || corr.RunID != "run-1" {
+		t.Errorf("expected thread/run fields set, got %+v", corr)
+	}
+}
+
+func TestCorrelationFromNilContext(t *testing.T) {
+	if corr := correlationFrom(nil); corr != (Correlation{}) {
+		t.Errorf("expected zero value for nil context, got %+v", corr)
+	}
+}
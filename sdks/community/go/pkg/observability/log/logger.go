@@ -0,0 +1,65 @@
+// Package log provides a context-propagating structured logger built on
+// zap: FromContext(ctx) returns a logger that automatically carries
+// whatever Correlation (request ID, OTel trace/span ID, thread/run ID)
+// ctx accumulated, so a log line emitted deep inside encoder or state code
+// - anywhere holding the same ctx a request handler derived - correlates
+// with that request's top-level log line without the callee needing to
+// know any of those IDs itself.
+package log
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+var (
+	baseMu sync.RWMutex
+	base   = zap.NewNop()
+)
+
+// SetBase replaces the process-wide zap.Logger every FromContext call
+// derives its fields from. main.go calls this once at startup (e.g. with
+// zap.NewProduction() or zap.NewDevelopment()); tests may call it to
+// capture output. The zero value (before any SetBase call) is a no-op
+// logger, so packages that import log but run outside main's startup path
+// (unit tests, tools) don't need their own setup to avoid panicking.
+func SetBase(l *zap.Logger) {
+	baseMu.Lock()
+	defer baseMu.Unlock()
+	base = l
+}
+
+// FromContext returns a *zap.SugaredLogger with every non-empty
+// Correlation field ctx carries (see WithCorrelation, WithThreadRun)
+// attached as structured fields.
+func FromContext(ctx context.Context) *zap.SugaredLogger {
+	baseMu.RLock()
+	logger := base
+	baseMu.RUnlock()
+
+	corr := correlationFrom(ctx)
+
+	fields := make([]interface{}, 0, 10)
+	if corr.RequestID != "" {
+		fields = append(fields, "request_id", corr.RequestID)
+	}
+	if corr.TraceID != "" {
+		fields = append(fields, "trace_id", corr.TraceID)
+	}
+	if corr.SpanID != "" {
+		fields = append(fields, "span_id", corr.SpanID)
+	}
+	if corr.TraceState != "" {
+		fields = append(fields, "trace_state", corr.TraceState)
+	}
+	if corr.ThreadID != "" {
+		fields = append(fields, "thread_id", corr.ThreadID)
+	}
+	if corr.RunID != "" {
+		fields = append(fields, "run_id", corr.RunID)
+	}
+
+	return logger.Sugar().With(fields...)
+}
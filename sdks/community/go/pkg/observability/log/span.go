@@ -0,0 +1,63 @@
+package log
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// Span is a lightweight, logger-backed stand-in for a distributed tracing
+// span: a named interval with its own trace/span ID pair, logged as
+// structured start/end lines through the same zap logger FromContext uses,
+// rather than exported to a collector. There's no OpenTelemetry (or other)
+// tracing SDK wired into this repo; StartSpan/End give call sites - content
+// negotiation, encoding, SSE emission - a narrow, stable surface that a real
+// tracer could slot behind later without every call site changing.
+type Span struct {
+	ctx   context.Context
+	name  string
+	start time.Time
+}
+
+// StartSpan begins a span named name as a child of whatever span ctx
+// already carries, generating a fresh TraceID if ctx carries none (e.g. a
+// run with no inbound "traceparent"). attrs are logged alongside name as
+// alternating key/value pairs, the same convention FromContext's
+// *zap.SugaredLogger uses. It returns a context carrying the new span's
+// Correlation, so a nested StartSpan call parents itself to this one
+// instead of starting an unrelated trace, and the Span itself.
+func StartSpan(ctx context.Context, name string, attrs ...interface{}) (context.Context, *Span) {
+	corr := correlationFrom(ctx)
+	if corr.TraceID == "" {
+		corr.TraceID = newHexID(16)
+	}
+	corr.SpanID = newHexID(8)
+
+	spanCtx := WithCorrelation(ctx, corr)
+
+	fields := append([]interface{}{"span.name", name}, attrs...)
+	FromContext(spanCtx).Debugw("span start", fields...)
+
+	return spanCtx, &Span{ctx: spanCtx, name: name, start: time.Now()}
+}
+
+// End logs the span's duration and any additional attrs, closing it. attrs
+// follows the same key/value convention as StartSpan, e.g. for a result
+// only known once the span's work completes (the selected content type, the
+// decoded event's type).
+func (s *Span) End(attrs ...interface{}) {
+	fields := append([]interface{}{
+		"span.name", s.name,
+		"span.duration_ms", time.Since(s.start).Milliseconds(),
+	}, attrs...)
+	FromContext(s.ctx).Debugw("span end", fields...)
+}
+
+// newHexID returns a random n-byte ID hex-encoded, for a fresh TraceID or
+// SpanID when ctx doesn't already carry one.
+func newHexID(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
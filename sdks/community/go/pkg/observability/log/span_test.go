@@ -0,0 +1,37 @@
+package log
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStartSpanGeneratesATraceIDWhenNoneIsCarried(t *testing.T) {
+	ctx, span := StartSpan(context.Background(), "test.span")
+
+	corr := correlationFrom(ctx)
+	if corr.TraceID == "" {
+		t.Fatal("expected a fresh TraceID to be generated")
+	}
+	if corr.SpanID == "" {
+		t.Fatal("expected a fresh SpanID to be generated")
+	}
+
+	span.End()
+}
+
+func TestStartSpanChildInheritsParentTraceID(t *testing.T) {
+	parentCtx := WithCorrelation(context.Background(), Correlation{TraceID: "trace-1"})
+
+	parentCtx, parentSpan := StartSpan(parentCtx, "parent")
+	childCtx, childSpan := StartSpan(parentCtx, "child")
+
+	if corr := correlationFrom(childCtx); corr.TraceID != "trace-1" {
+		t.Errorf("expected child span to inherit TraceID, got %q", corr.TraceID)
+	}
+	if correlationFrom(childCtx).SpanID == correlationFrom(parentCtx).SpanID {
+		t.Error("expected child span to get its own SpanID")
+	}
+
+	childSpan.End()
+	parentSpan.End()
+}
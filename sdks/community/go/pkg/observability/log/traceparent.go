@@ -0,0 +1,22 @@
+package log
+
+import "strings"
+
+// ParseTraceParent extracts the trace and span IDs from a W3C Trace
+// Context "traceparent" header value
+// (https://www.w3.org/TR/trace-context/#traceparent-header), e.g.
+// "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01". It reports
+// ok=false for anything that isn't the expected
+// version-trace_id-parent_id-flags shape rather than returning an error,
+// since a missing or malformed header should just mean no trace
+// correlation for this request, not a failure.
+func ParseTraceParent(header string) (traceID, spanID string, ok bool) {
+	parts := strings.Split(header, "-")
+	if len(parts) != 4 {
+		return "", "", false
+	}
+	if len(parts[1]) != 32 || len(parts[2]) != 16 {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
@@ -0,0 +1,85 @@
+// Command agui-replay diffs two recorded SSE traces (as written by
+// pkg/recording.Recorder) event-by-event, so a decoder or server change can
+// be checked for regressions against a previously captured session without
+// a live backend.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/codec"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/recording"
+)
+
+func loadEntries(path string) ([]recording.TraceEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	replayer, err := recording.NewReplayer(codec.NewJSONCodec(), f, 1)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return replayer.Entries(), nil
+}
+
+// diff reports every index where want and got disagree on event type or
+// frame size, the two signals a trace-diff can check without re-running a
+// decoder neither trace was recorded with.
+func diff(want, got []recording.TraceEntry) []string {
+	var mismatches []string
+	n := len(want)
+	if len(got) > n {
+		n = len(got)
+	}
+	for i := 0; i < n; i++ {
+		switch {
+		case i >= len(want):
+			mismatches = append(mismatches, fmt.Sprintf("entry %d: unexpected extra event %s", i, got[i].EventType))
+		case i >= len(got):
+			mismatches = append(mismatches, fmt.Sprintf("entry %d: missing event %s", i, want[i].EventType))
+		case want[i].EventType != got[i].EventType:
+			mismatches = append(mismatches, fmt.Sprintf("entry %d: event type mismatch: want %s, got %s", i, want[i].EventType, got[i].EventType))
+		case want[i].Size != got[i].Size:
+			mismatches = append(mismatches, fmt.Sprintf("entry %d (%s): frame size mismatch: want %d bytes, got %d bytes", i, want[i].EventType, want[i].Size, got[i].Size))
+		}
+	}
+	return mismatches
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s <want.jsonl> <got.jsonl>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	want, err := loadEntries(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	got, err := loadEntries(flag.Arg(1))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	mismatches := diff(want, got)
+	if len(mismatches) == 0 {
+		fmt.Println("traces match")
+		return
+	}
+
+	for _, m := range mismatches {
+		fmt.Println(m)
+	}
+	os.Exit(1)
+}
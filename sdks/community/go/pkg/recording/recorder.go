@@ -0,0 +1,90 @@
+// Package recording captures a live SSE session's event stream to a JSONL
+// trace file and replays it later, so decoder bugs can be reproduced
+// against a recorded trace without a live backend.
+package recording
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/codec"
+)
+
+// TraceEntry is one recorded frame: the raw wire bytes alongside what they
+// decoded to (or were encoded from), so a trace can be replayed without
+// re-running a decoder to know each frame's type or arrival time.
+type TraceEntry struct {
+	RawFrame  []byte           `json:"raw_frame"`
+	EventType events.EventType `json:"event_type"`
+	Timestamp time.Time        `json:"timestamp"`
+	Size      int              `json:"size"`
+}
+
+// Recorder appends one TraceEntry per frame to a JSONL trace file. It is
+// safe for concurrent use, since a single recorded session commonly spans
+// multiple goroutines (e.g. one per SSE connection).
+type Recorder struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewRecorder returns a Recorder writing JSONL trace entries to w.
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{enc: json.NewEncoder(w)}
+}
+
+// Record appends a TraceEntry built from rawFrame and the Event it
+// represents.
+func (r *Recorder) Record(rawFrame []byte, event events.Event) error {
+	entry := TraceEntry{
+		RawFrame:  append([]byte(nil), rawFrame...),
+		EventType: event.Type(),
+		Timestamp: time.Now(),
+		Size:      len(rawFrame),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.enc.Encode(entry); err != nil {
+		return fmt.Errorf("recording: write trace entry: %w", err)
+	}
+	return nil
+}
+
+// DecodingRecorder wraps a codec.Codec's Decode so a client, which only
+// has the raw frame until it decodes it, can record in the same step it
+// already performs - Decode, then Record, then return the Event as usual.
+// It satisfies codec.Codec's Decode signature, so it can replace a
+// sse.Client's codec transparently.
+type DecodingRecorder struct {
+	Codec    codec.Codec
+	Recorder *Recorder
+}
+
+// Decode decodes raw via the wrapped Codec, records the frame, and
+// returns the decoded Event.
+func (d *DecodingRecorder) Decode(raw []byte) (events.Event, error) {
+	event, err := d.Codec.Decode(raw)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Recorder.Record(raw, event); err != nil {
+		return event, err
+	}
+	return event, nil
+}
+
+// Encode delegates to the wrapped Codec, so DecodingRecorder satisfies
+// codec.Codec and can replace a Client's codec transparently.
+func (d *DecodingRecorder) Encode(event events.Event) ([]byte, error) {
+	return d.Codec.Encode(event)
+}
+
+// ContentType delegates to the wrapped Codec.
+func (d *DecodingRecorder) ContentType() string {
+	return d.Codec.ContentType()
+}
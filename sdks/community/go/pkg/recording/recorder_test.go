@@ -0,0 +1,84 @@
+package recording
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/codec"
+)
+
+func TestRecorderReplayerRoundTrip(t *testing.T) {
+	c := codec.NewJSONCodec()
+	started := events.NewRunStartedEvent("thread-1", "run-1")
+	finished := events.NewRunFinishedEvent("thread-1", "run-1")
+
+	var buf bytes.Buffer
+	recorder := NewRecorder(&buf)
+	for _, event := range []events.Event{started, finished} {
+		raw, err := c.Encode(event)
+		if err != nil {
+			t.Fatalf("encode: %v", err)
+		}
+		if err := recorder.Record(raw, event); err != nil {
+			t.Fatalf("record: %v", err)
+		}
+	}
+
+	replayer, err := NewReplayer(c, &buf, 100)
+	if err != nil {
+		t.Fatalf("NewReplayer: %v", err)
+	}
+
+	entries := replayer.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].EventType != events.EventTypeRunStarted || entries[1].EventType != events.EventTypeRunFinished {
+		t.Fatalf("unexpected event types: %v, %v", entries[0].EventType, entries[1].EventType)
+	}
+
+	out := make(chan events.Event, 2)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := replayer.Replay(ctx, out); err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+
+	first := <-out
+	second := <-out
+	if first.Type() != events.EventTypeRunStarted || second.Type() != events.EventTypeRunFinished {
+		t.Fatalf("unexpected replayed event order: %v, %v", first.Type(), second.Type())
+	}
+	if _, ok := <-out; ok {
+		t.Fatal("expected channel to be closed after replay")
+	}
+}
+
+func TestDecodingRecorder(t *testing.T) {
+	c := codec.NewJSONCodec()
+	event := events.NewRunStartedEvent("thread-1", "run-1")
+	raw, err := c.Encode(event)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+
+	var buf bytes.Buffer
+	dr := &DecodingRecorder{Codec: c, Recorder: NewRecorder(&buf)}
+
+	decoded, err := dr.Decode(raw)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if decoded.Type() != events.EventTypeRunStarted {
+		t.Fatalf("unexpected decoded event type: %v", decoded.Type())
+	}
+	if buf.Len() == 0 {
+		t.Fatal("expected Decode to also record a trace entry")
+	}
+	if dr.ContentType() != c.ContentType() {
+		t.Fatalf("ContentType did not delegate to wrapped codec")
+	}
+}
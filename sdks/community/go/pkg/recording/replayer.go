@@ -0,0 +1,93 @@
+package recording
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/codec"
+)
+
+// Replayer re-emits a previously recorded trace's events at (a scaled
+// multiple of) their original inter-arrival timing, so a captured session
+// can be replayed without a live backend.
+type Replayer struct {
+	codec   codec.Codec
+	entries []TraceEntry
+	speedup float64
+}
+
+// NewReplayer reads every TraceEntry from trace (as written by Recorder)
+// and returns a Replayer that decodes them with c. speedup scales
+// playback - 2 replays twice as fast, 0.5 half as fast; 0 or negative is
+// treated as 1 (original timing).
+func NewReplayer(c codec.Codec, trace io.Reader, speedup float64) (*Replayer, error) {
+	if speedup <= 0 {
+		speedup = 1
+	}
+
+	var entries []TraceEntry
+	scanner := bufio.NewScanner(trace)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var entry TraceEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, fmt.Errorf("recording: parse trace entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("recording: read trace: %w", err)
+	}
+
+	return &Replayer{codec: c, entries: entries, speedup: speedup}, nil
+}
+
+// Entries returns the trace's raw entries, for tools (e.g. a trace-diffing
+// CLI) that need to compare traces rather than play them back live.
+func (r *Replayer) Entries() []TraceEntry {
+	return r.entries
+}
+
+// Replay decodes each trace entry in order and sends it on out, pausing
+// between sends to approximate the recorded inter-arrival gaps (scaled by
+// speedup). It closes out and returns nil once the trace is exhausted, or
+// returns ctx.Err() if ctx is canceled first.
+func (r *Replayer) Replay(ctx context.Context, out chan<- events.Event) error {
+	defer close(out)
+
+	var prev time.Time
+	for i, entry := range r.entries {
+		if i > 0 {
+			if gap := entry.Timestamp.Sub(prev); gap > 0 {
+				select {
+				case <-time.After(time.Duration(float64(gap) / r.speedup)):
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+		prev = entry.Timestamp
+
+		event, err := r.codec.Decode(entry.RawFrame)
+		if err != nil {
+			return fmt.Errorf("recording: decode trace entry %d: %w", i, err)
+		}
+
+		select {
+		case out <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package state
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// terminalOp mutates container (a map[string]any or []any) at key, returning
+// the container's new value - a plain map mutates in place and returns
+// itself, but an array insert/remove changes length and so must return a
+// different slice header for the caller to write back into the parent.
+type terminalOp func(container any, key string) (any, error)
+
+func addTerminal(value any) terminalOp {
+	return func(container any, key string) (any, error) {
+		switch v := container.(type) {
+		case map[string]any:
+			v[key] = value
+			return v, nil
+		case []any:
+			idx, err := arrayIndex(key, len(v), true)
+			if err != nil {
+				return nil, err
+			}
+			grown := append(v, nil)
+			copy(grown[idx+1:], grown[idx:len(grown)-1])
+			grown[idx] = value
+			return grown, nil
+		default:
+			return nil, fmt.Errorf("cannot add into %T", container)
+		}
+	}
+}
+
+func removeTerminal() terminalOp {
+	return func(container any, key string) (any, error) {
+		switch v := container.(type) {
+		case map[string]any:
+			if _, ok := v[key]; !ok {
+				return nil, fmt.Errorf("no such member %q to remove", key)
+			}
+			delete(v, key)
+			return v, nil
+		case []any:
+			idx, err := arrayIndex(key, len(v), false)
+			if err != nil {
+				return nil, err
+			}
+			return append(v[:idx], v[idx+1:]...), nil
+		default:
+			return nil, fmt.Errorf("cannot remove from %T", container)
+		}
+	}
+}
+
+func replaceTerminal(value any) terminalOp {
+	return func(container any, key string) (any, error) {
+		switch v := container.(type) {
+		case map[string]any:
+			if _, ok := v[key]; !ok {
+				return nil, fmt.Errorf("no such member %q to replace", key)
+			}
+			v[key] = value
+			return v, nil
+		case []any:
+			idx, err := arrayIndex(key, len(v), false)
+			if err != nil {
+				return nil, err
+			}
+			v[idx] = value
+			return v, nil
+		default:
+			return nil, fmt.Errorf("cannot replace within %T", container)
+		}
+	}
+}
+
+// walkAndApply descends doc along tokens, applies op at the final segment,
+// and threads the (possibly new) child value back up into its parent so
+// that array inserts/removes - which change a slice's length and therefore
+// its header - are visible all the way back to the root.
+func walkAndApply(doc any, tokens []string, op terminalOp) (any, error) {
+	if len(tokens) == 1 {
+		return op(doc, tokens[0])
+	}
+
+	tok := tokens[0]
+	switch v := doc.(type) {
+	case map[string]any:
+		child, ok := v[tok]
+		if !ok {
+			return nil, fmt.Errorf("no such member %q", tok)
+		}
+		newChild, err := walkAndApply(child, tokens[1:], op)
+		if err != nil {
+			return nil, err
+		}
+		v[tok] = newChild
+		return v, nil
+	case []any:
+		idx, err := arrayIndex(tok, len(v), false)
+		if err != nil {
+			return nil, err
+		}
+		newChild, err := walkAndApply(v[idx], tokens[1:], op)
+		if err != nil {
+			return nil, err
+		}
+		v[idx] = newChild
+		return v, nil
+	default:
+		return nil, fmt.Errorf("cannot descend into %T with %q", doc, tok)
+	}
+}
+
+// applyOp applies a single JSON Patch operation to doc, returning the new
+// document. doc is mutated in place where possible (maps) and replaced
+// where not (arrays that grow or shrink); callers that need rollback on
+// failure must operate on a deepCopy, not the live store document.
+func applyOp(doc any, op events.JSONPatchOperation) (any, error) {
+	tokens, err := splitPointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "add":
+		return walkAndApply(doc, tokens, addTerminal(op.Value))
+	case "remove":
+		return walkAndApply(doc, tokens, removeTerminal())
+	case "replace":
+		return walkAndApply(doc, tokens, replaceTerminal(op.Value))
+	case "move":
+		fromTokens, err := splitPointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		value, err := getByPointer(doc, fromTokens)
+		if err != nil {
+			return nil, fmt.Errorf("move from %q: %w", op.From, err)
+		}
+		doc, err = walkAndApply(doc, fromTokens, removeTerminal())
+		if err != nil {
+			return nil, fmt.Errorf("move from %q: %w", op.From, err)
+		}
+		return walkAndApply(doc, tokens, addTerminal(deepCopy(value)))
+	case "copy":
+		fromTokens, err := splitPointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		value, err := getByPointer(doc, fromTokens)
+		if err != nil {
+			return nil, fmt.Errorf("copy from %q: %w", op.From, err)
+		}
+		return walkAndApply(doc, tokens, addTerminal(deepCopy(value)))
+	case "test":
+		value, err := getByPointer(doc, tokens)
+		if err != nil {
+			return nil, fmt.Errorf("test %q: %w", op.Path, err)
+		}
+		if !reflect.DeepEqual(value, op.Value) {
+			return nil, fmt.Errorf("test %q failed: value does not match", op.Path)
+		}
+		return doc, nil
+	default:
+		return nil, fmt.Errorf("unsupported op %q", op.Op)
+	}
+}
+
+// deepCopy clones a document built from map[string]any, []any, and JSON
+// scalars - the shapes encoding/json produces when unmarshaling into any -
+// so a batch of patch ops can be applied to a scratch copy and only
+// committed back to the store once every op in the batch succeeds.
+func deepCopy(doc any) any {
+	switch v := doc.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, val := range v {
+			out[k] = deepCopy(val)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, val := range v {
+			out[i] = deepCopy(val)
+		}
+		return out
+	default:
+		return v
+	}
+}
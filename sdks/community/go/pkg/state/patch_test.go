@@ -0,0 +1,100 @@
+package state
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+func op(opName, path string, value any, from string) events.JSONPatchOperation {
+	return events.JSONPatchOperation{Op: opName, Path: path, Value: value, From: from}
+}
+
+func TestApplyOpAddReplaceRemove(t *testing.T) {
+	doc := any(map[string]any{"name": "ada"})
+
+	doc, err := applyOp(doc, op("add", "/age", float64(30), ""))
+	if err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	doc, err = applyOp(doc, op("replace", "/name", "grace", ""))
+	if err != nil {
+		t.Fatalf("replace: %v", err)
+	}
+	doc, err = applyOp(doc, op("remove", "/age", nil, ""))
+	if err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+
+	want := map[string]any{"name": "grace"}
+	if !reflect.DeepEqual(doc, want) {
+		t.Fatalf("got %#v, want %#v", doc, want)
+	}
+}
+
+func TestApplyOpArrayAppendAndInsert(t *testing.T) {
+	doc := any(map[string]any{"items": []any{"a", "c"}})
+
+	doc, err := applyOp(doc, op("add", "/items/1", "b", ""))
+	if err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+	doc, err = applyOp(doc, op("add", "/items/-", "d", ""))
+	if err != nil {
+		t.Fatalf("append: %v", err)
+	}
+
+	want := map[string]any{"items": []any{"a", "b", "c", "d"}}
+	if !reflect.DeepEqual(doc, want) {
+		t.Fatalf("got %#v, want %#v", doc, want)
+	}
+}
+
+func TestApplyOpMoveAndCopy(t *testing.T) {
+	doc := any(map[string]any{
+		"a": map[string]any{"value": "x"},
+		"b": map[string]any{},
+	})
+
+	doc, err := applyOp(doc, op("copy", "/b/value", nil, "/a/value"))
+	if err != nil {
+		t.Fatalf("copy: %v", err)
+	}
+	doc, err = applyOp(doc, op("move", "/c", nil, "/a"))
+	if err != nil {
+		t.Fatalf("move: %v", err)
+	}
+
+	want := map[string]any{
+		"b": map[string]any{"value": "x"},
+		"c": map[string]any{"value": "x"},
+	}
+	if !reflect.DeepEqual(doc, want) {
+		t.Fatalf("got %#v, want %#v", doc, want)
+	}
+}
+
+func TestApplyOpTestFailureReturnsError(t *testing.T) {
+	doc := any(map[string]any{"status": "open"})
+	if _, err := applyOp(doc, op("test", "/status", "closed", "")); err == nil {
+		t.Fatalf("expected test op to fail on mismatched value")
+	}
+}
+
+func TestSplitPointerUnescapesTildeAndSlash(t *testing.T) {
+	tokens, err := splitPointer("/a~1b/c~0d")
+	if err != nil {
+		t.Fatalf("splitPointer: %v", err)
+	}
+	want := []string{"a/b", "c~d"}
+	if !reflect.DeepEqual(tokens, want) {
+		t.Fatalf("got %#v, want %#v", tokens, want)
+	}
+}
+
+func TestSplitPointerRequiresLeadingSlash(t *testing.T) {
+	if _, err := splitPointer("status"); err == nil {
+		t.Fatalf("expected error for a path with no leading slash")
+	}
+}
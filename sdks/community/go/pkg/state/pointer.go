@@ -0,0 +1,74 @@
+package state
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// splitPointer tokenizes an RFC 6901 JSON Pointer into its path segments,
+// unescaping "~1" to "/" and "~0" to "~" in that order (the reverse of the
+// encoding order, so an already-escaped "~01" decodes to "~1" and not "/").
+// path must start with "/"; validateJSONPatchOperation already rejects the
+// empty string, so the bare root pointer is never something Apply has to
+// handle.
+func splitPointer(path string) ([]string, error) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("path must start with '/', got %q", path)
+	}
+
+	raw := strings.Split(path[1:], "/")
+	tokens := make([]string, len(raw))
+	for i, tok := range raw {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(tok, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// arrayIndex resolves tok against an array of length n. When forInsert is
+// true, tok == "-" (append) is accepted and the valid range extends to n
+// (one past the last element); otherwise tok must name an existing element.
+func arrayIndex(tok string, n int, forInsert bool) (int, error) {
+	if forInsert && tok == "-" {
+		return n, nil
+	}
+
+	idx, err := strconv.Atoi(tok)
+	if err != nil {
+		return 0, fmt.Errorf("array index %q is not a valid integer", tok)
+	}
+
+	max := n - 1
+	if forInsert {
+		max = n
+	}
+	if idx < 0 || idx > max {
+		return 0, fmt.Errorf("array index %d out of range [0,%d]", idx, max)
+	}
+	return idx, nil
+}
+
+// getByPointer resolves tokens against doc, returning the value at that
+// path or an error if any segment doesn't exist.
+func getByPointer(doc any, tokens []string) (any, error) {
+	cur := doc
+	for _, tok := range tokens {
+		switch v := cur.(type) {
+		case map[string]any:
+			val, ok := v[tok]
+			if !ok {
+				return nil, fmt.Errorf("no such member %q", tok)
+			}
+			cur = val
+		case []any:
+			idx, err := arrayIndex(tok, len(v), false)
+			if err != nil {
+				return nil, err
+			}
+			cur = v[idx]
+		default:
+			return nil, fmt.Errorf("cannot descend into %T with %q", cur, tok)
+		}
+	}
+	return cur, nil
+}
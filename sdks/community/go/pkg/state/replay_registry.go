@@ -0,0 +1,45 @@
+package state
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+// ReplayRegistry hands out one events.ReplayBuffer per thread, so threads
+// don't bleed events into each other's Last-Event-ID (or gRPC resume)
+// resumption the way a single shared buffer would. A ReplayRegistry is
+// safe to share across every transport a thread may reconnect through -
+// HTTP/SSE and gRPC alike - so a client that switches transports between
+// reconnects still resumes from the same sequence.
+type ReplayRegistry struct {
+	mu       sync.Mutex
+	buffers  map[string]*events.ReplayBuffer
+	maxCount int
+	ttl      time.Duration
+}
+
+// NewReplayRegistry creates a ReplayRegistry whose buffers are each sized
+// per the given maxCount/ttl; see config.Config.SSEReplayBufferSize and
+// config.Config.SSEReplayTTL.
+func NewReplayRegistry(maxCount int, ttl time.Duration) *ReplayRegistry {
+	return &ReplayRegistry{
+		buffers:  make(map[string]*events.ReplayBuffer),
+		maxCount: maxCount,
+		ttl:      ttl,
+	}
+}
+
+// ForThread returns threadID's replay buffer, creating it on first use.
+func (r *ReplayRegistry) ForThread(threadID string) *events.ReplayBuffer {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	buf, ok := r.buffers[threadID]
+	if !ok {
+		buf = events.NewReplayBuffer(r.maxCount, r.ttl, nil)
+		r.buffers[threadID] = buf
+	}
+	return buf
+}
@@ -0,0 +1,99 @@
+// Package state applies the JSON Patch (RFC 6902) operations carried by
+// events.StateDeltaEvent against a server-held document per thread, so a
+// StateDeltaEvent isn't just validated and forwarded but actually mutates
+// state a client can reconcile against after a reconnect via Store.Snapshot.
+package state
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/sse"
+)
+
+// Store maintains one JSON document per thread and applies RFC 6902 patch
+// batches to it atomically.
+type Store struct {
+	mu      sync.Mutex
+	docs    map[string]any
+	brokers map[string]*sse.Broker
+}
+
+// NewStore creates an empty Store.
+func NewStore() *Store {
+	return &Store{
+		docs:    make(map[string]any),
+		brokers: make(map[string]*sse.Broker),
+	}
+}
+
+// Apply applies delta to threadID's document as a single atomic batch: it
+// works against a deepCopy of the current document, and only commits the
+// copy back - and publishes the delta to Subscribe'd channels - once every
+// operation in the batch succeeds. A failed "test" (or any other op)
+// aborts the whole batch with no partial mutation visible.
+func (s *Store) Apply(threadID string, delta []events.JSONPatchOperation) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	doc, ok := s.docs[threadID]
+	if !ok {
+		doc = map[string]any{}
+	}
+	working := deepCopy(doc)
+	for _, op := range delta {
+		var err error
+		working, err = applyOp(working, op)
+		if err != nil {
+			return nil, fmt.Errorf("apply patch op %q at %q: %w", op.Op, op.Path, err)
+		}
+	}
+
+	s.docs[threadID] = working
+	s.broker(threadID).Publish(context.Background(), events.NewStateDeltaEvent(delta))
+	return deepCopy(working), nil
+}
+
+// Snapshot returns a deep copy of threadID's current document, or nil if
+// nothing has been applied to that thread yet.
+func (s *Store) Snapshot(threadID string) any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return deepCopy(s.docs[threadID])
+}
+
+// EmitSnapshot publishes a events.StateSnapshotEvent of threadID's current
+// document to its subscribers, for a caller that wants to reconcile
+// reconnecting clients on a timer rather than waiting for the next delta.
+func (s *Store) EmitSnapshot(threadID string) error {
+	snapshot := s.Snapshot(threadID)
+	if snapshot == nil {
+		return fmt.Errorf("no state recorded for thread %q", threadID)
+	}
+	return s.broker(threadID).Publish(context.Background(), events.NewStateSnapshotEvent(snapshot))
+}
+
+// Subscribe returns a channel that receives every events.StateDeltaEvent
+// Apply publishes for threadID, plus any events.StateSnapshotEvent from
+// EmitSnapshot. The channel is dropped, not closed, once the Store itself
+// is discarded; callers that need early unsubscription should use the
+// underlying per-thread Broker directly.
+func (s *Store) Subscribe(threadID string) <-chan events.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ch, _ := s.broker(threadID).Subscribe(context.Background())
+	return ch
+}
+
+// broker returns threadID's Broker, creating it on first use. Callers must
+// hold s.mu.
+func (s *Store) broker(threadID string) *sse.Broker {
+	b, ok := s.brokers[threadID]
+	if !ok {
+		b = sse.NewBroker(sse.BrokerOptions{})
+		s.brokers[threadID] = b
+	}
+	return b
+}
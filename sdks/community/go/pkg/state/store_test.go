@@ -0,0 +1,93 @@
+package state
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+)
+
+func TestStoreApplyAndSnapshot(t *testing.T) {
+	s := NewStore()
+
+	if _, err := s.Apply("thread-1", []events.JSONPatchOperation{
+		op("add", "/count", float64(1), ""),
+	}); err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+
+	snap, err := s.Apply("thread-1", []events.JSONPatchOperation{
+		op("replace", "/count", float64(2), ""),
+	})
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+
+	want := map[string]any{"count": float64(2)}
+	if !reflect.DeepEqual(snap, want) {
+		t.Fatalf("got %#v, want %#v", snap, want)
+	}
+	if got := s.Snapshot("thread-1"); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Snapshot got %#v, want %#v", got, want)
+	}
+}
+
+func TestStoreApplyRollsBackOnFailedTest(t *testing.T) {
+	s := NewStore()
+
+	if _, err := s.Apply("thread-1", []events.JSONPatchOperation{
+		op("add", "/status", "open", ""),
+	}); err != nil {
+		t.Fatalf("seed apply: %v", err)
+	}
+
+	_, err := s.Apply("thread-1", []events.JSONPatchOperation{
+		op("replace", "/status", "closed", ""),
+		op("test", "/status", "never-matches", ""),
+	})
+	if err == nil {
+		t.Fatalf("expected the batch to fail")
+	}
+
+	if got := s.Snapshot("thread-1"); !reflect.DeepEqual(got, map[string]any{"status": "open"}) {
+		t.Fatalf("expected no partial mutation after rollback, got %#v", got)
+	}
+}
+
+func TestStoreSubscribeReceivesDeltaAndSnapshotEvents(t *testing.T) {
+	s := NewStore()
+	ch := s.Subscribe("thread-1")
+
+	if _, err := s.Apply("thread-1", []events.JSONPatchOperation{
+		op("add", "/ready", true, ""),
+	}); err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+
+	select {
+	case evt := <-ch:
+		if _, ok := evt.(*events.StateDeltaEvent); !ok {
+			t.Fatalf("expected a *events.StateDeltaEvent, got %T", evt)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("timed out waiting for the delta event")
+	}
+
+	if err := s.EmitSnapshot("thread-1"); err != nil {
+		t.Fatalf("EmitSnapshot: %v", err)
+	}
+
+	select {
+	case evt := <-ch:
+		snapshot, ok := evt.(*events.StateSnapshotEvent)
+		if !ok {
+			t.Fatalf("expected a *events.StateSnapshotEvent, got %T", evt)
+		}
+		if !reflect.DeepEqual(snapshot.Snapshot, map[string]any{"ready": true}) {
+			t.Fatalf("unexpected snapshot payload: %#v", snapshot.Snapshot)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("timed out waiting for the snapshot event")
+	}
+}
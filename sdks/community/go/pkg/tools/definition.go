@@ -0,0 +1,47 @@
+// Package tools defines a transport-agnostic tool schema shared by every
+// surface that registers or validates tool calls in this SDK - today that
+// is the example MCP server (example/server/internal/mcp) and AG-UI event
+// dispatchers that emit ToolCallArgs/ToolCallEnd. Both read the same
+// Definition instead of each hand-rolling its own argument checks.
+package tools
+
+import "reflect"
+
+// Definition describes one callable tool: its name, human-readable
+// purpose, JSON Schema (draft-2020-12) describing its arguments, and the
+// Go type those arguments unmarshal into. ToMCP and Validate both read
+// from the same Definition, so an MCP-registered tool and an AG-UI
+// ToolCall for the same tool name are checked against identical rules.
+type Definition struct {
+	Name        string
+	Description string
+
+	// Schema is a JSON Schema document describing Args. At minimum it
+	// should set "type": "object" with "properties" and "required".
+	Schema map[string]interface{}
+
+	// Args is the Go struct type arguments unmarshal into. Optional - a
+	// Definition built directly from a hand-written Schema (rather than
+	// via DefinitionFor) may leave it nil.
+	Args reflect.Type
+}
+
+// DefinitionFor derives a Definition for T from its exported fields via
+// reflection: a `jsonschema:"description=..."` tag supplies a field's
+// schema description, and a `json:",omitempty"` field is excluded from
+// the schema's "required" list. See SchemaFromType for the full field
+// mapping rules.
+func DefinitionFor[T any](name, description string) Definition {
+	var zero T
+	t := reflect.TypeOf(zero)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return Definition{
+		Name:        name,
+		Description: description,
+		Schema:      SchemaFromType(t),
+		Args:        t,
+	}
+}
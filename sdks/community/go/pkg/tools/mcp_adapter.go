@@ -0,0 +1,54 @@
+package tools
+
+import (
+	"sort"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ToMCP builds an mcp.Tool from def, so a Definition registered once (and
+// validated via Validate) can also be handed to mcp.Server.AddTool without
+// a second, independent schema translation. It walks def.Schema's
+// "properties"/"required" rather than def.Args, so a Definition built by
+// hand (no Args) registers exactly like one built via DefinitionFor.
+func ToMCP(def Definition) mcp.Tool {
+	opts := []mcp.ToolOption{mcp.WithDescription(def.Description)}
+
+	properties, _ := def.Schema["properties"].(map[string]interface{})
+	required := make(map[string]bool, len(requiredOf(def.Schema)))
+	for _, name := range requiredOf(def.Schema) {
+		required[name] = true
+	}
+
+	// Sorted so ToMCP is deterministic across calls with the same def -
+	// map iteration order would otherwise make the generated mcp.Tool's
+	// option order (and thus any schema serialization depending on it)
+	// flaky between runs.
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		propSchema, _ := properties[name].(map[string]interface{})
+		desc, _ := propSchema["description"].(string)
+		propType, _ := propSchema["type"].(string)
+
+		propOpts := []mcp.PropertyOption{mcp.Description(desc)}
+		if required[name] {
+			propOpts = append(propOpts, mcp.Required())
+		}
+
+		switch propType {
+		case "boolean":
+			opts = append(opts, mcp.WithBoolean(name, propOpts...))
+		case "integer", "number":
+			opts = append(opts, mcp.WithNumber(name, propOpts...))
+		default:
+			opts = append(opts, mcp.WithString(name, propOpts...))
+		}
+	}
+
+	return mcp.NewTool(def.Name, opts...)
+}
@@ -0,0 +1,90 @@
+package tools
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Registry holds Definitions by name so that independent entry points -
+// mcp.Server.AddTool on one side, an AG-UI event dispatcher on the other -
+// validate and describe the same tool the same way. Its zero value is not
+// usable; construct one with NewRegistry.
+type Registry struct {
+	mu    sync.RWMutex
+	tools map[string]Definition
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{tools: make(map[string]Definition)}
+}
+
+// Register adds def to the registry, returning an error if a Definition
+// with the same name is already registered.
+func (r *Registry) Register(def Definition) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.tools[def.Name]; exists {
+		return fmt.Errorf("tools: definition %q already registered", def.Name)
+	}
+	r.tools[def.Name] = def
+	return nil
+}
+
+// Get returns the Definition registered under name, if any.
+func (r *Registry) Get(name string) (Definition, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	def, ok := r.tools[name]
+	return def, ok
+}
+
+// List returns every registered Definition, in no particular order.
+func (r *Registry) List() []Definition {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	defs := make([]Definition, 0, len(r.tools))
+	for _, def := range r.tools {
+		defs = append(defs, def)
+	}
+	return defs
+}
+
+// Unregister removes name from the registry, if present.
+func (r *Registry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.tools, name)
+}
+
+// defaultRegistry is the package-level Registry consulted by callers that
+// don't need an isolated instance of their own, mirroring the events
+// package's default-ID-generator idiom.
+var defaultRegistry = NewRegistry()
+
+// DefaultRegistry returns the package-level default Registry.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// Register adds def to the default Registry.
+func Register(def Definition) error {
+	return defaultRegistry.Register(def)
+}
+
+// Get returns the Definition registered under name in the default Registry.
+func Get(name string) (Definition, bool) {
+	return defaultRegistry.Get(name)
+}
+
+// List returns every Definition registered in the default Registry.
+func List() []Definition {
+	return defaultRegistry.List()
+}
+
+// Unregister removes name from the default Registry, if present.
+func Unregister(name string) {
+	defaultRegistry.Unregister(name)
+}
@@ -0,0 +1,106 @@
+package tools
+
+import (
+	"reflect"
+	"strings"
+)
+
+// SchemaFromType builds a JSON Schema object document from t's exported
+// fields via reflection, so a Definition can be derived from a Go struct
+// instead of hand-written field by field. Supported field kinds are
+// string, bool, and the numeric kinds; anything else (slices, maps,
+// nested structs) is skipped - a Definition needing those should build
+// its Schema by hand instead.
+//
+// A field's schema description comes from a `jsonschema:"description=..."`
+// struct tag (falling back to the field name); its property name and
+// required-ness follow encoding/json's own tag rules via jsonFieldName, so
+// a struct already marshaled elsewhere with encoding/json gets a matching
+// schema for free.
+func SchemaFromType(t reflect.Type) map[string]interface{} {
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{},
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return schema
+	}
+
+	properties := schema["properties"].(map[string]interface{})
+	var required []string
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fieldName, isRequired := jsonFieldName(field)
+		properties[fieldName] = map[string]interface{}{
+			"type":        jsonSchemaType(field.Type),
+			"description": fieldDescription(field),
+		}
+		if isRequired {
+			required = append(required, fieldName)
+		}
+	}
+
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// jsonSchemaType maps a Go field kind to its JSON Schema "type" keyword.
+// Kinds with no natural JSON Schema equivalent (func, chan, ...) map to
+// "string" as a conservative fallback rather than being rejected outright.
+func jsonSchemaType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	default:
+		return "string"
+	}
+}
+
+// jsonFieldName reports the schema property name and required-ness field
+// would have under encoding/json's own tag rules.
+func jsonFieldName(field reflect.StructField) (name string, required bool) {
+	tag := field.Tag.Get("json")
+	parts := strings.Split(tag, ",")
+
+	name = field.Name
+	if parts[0] != "" && parts[0] != "-" {
+		name = parts[0]
+	}
+
+	required = true
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			required = false
+		}
+	}
+	return name, required
+}
+
+// fieldDescription reads a `jsonschema:"description=..."` struct tag,
+// falling back to the field's Go name when absent.
+func fieldDescription(field reflect.StructField) string {
+	if tag, ok := field.Tag.Lookup("jsonschema"); ok {
+		for _, part := range strings.Split(tag, ",") {
+			if desc, found := strings.CutPrefix(part, "description="); found {
+				return desc
+			}
+		}
+	}
+	return field.Name
+}
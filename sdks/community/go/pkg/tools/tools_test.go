@@ -0,0 +1,105 @@
+package tools
+
+import (
+	"testing"
+)
+
+type sampleArgs struct {
+	Name string `json:"name" jsonschema:"description=The item name"`
+	Qty  int    `json:"qty,omitempty" jsonschema:"description=How many"`
+}
+
+func TestSchemaFromType(t *testing.T) {
+	def := DefinitionFor[sampleArgs]("sample", "a sample tool")
+
+	if def.Name != "sample" || def.Description != "a sample tool" {
+		t.Fatalf("unexpected Definition: %+v", def)
+	}
+
+	properties, ok := def.Schema["properties"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("schema missing properties: %+v", def.Schema)
+	}
+	if _, ok := properties["name"]; !ok {
+		t.Fatalf("schema missing name property: %+v", properties)
+	}
+	if _, ok := properties["qty"]; !ok {
+		t.Fatalf("schema missing qty property: %+v", properties)
+	}
+
+	required, _ := def.Schema["required"].([]string)
+	if len(required) != 1 || required[0] != "name" {
+		t.Fatalf("expected only name to be required, got %v", required)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	def := DefinitionFor[sampleArgs]("sample", "a sample tool")
+
+	t.Run("valid", func(t *testing.T) {
+		if err := Validate(def, []byte(`{"name":"widget","qty":3}`)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("missing required field", func(t *testing.T) {
+		err := Validate(def, []byte(`{"qty":3}`))
+		if err == nil {
+			t.Fatal("expected an error for a missing required field")
+		}
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		err := Validate(def, []byte(`{"name":"widget","qty":"not a number"}`))
+		if err == nil {
+			t.Fatal("expected an error for a wrong-typed field")
+		}
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		if err := Validate(def, []byte(`{not json`)); err == nil {
+			t.Fatal("expected an error for invalid JSON")
+		}
+	})
+
+	t.Run("empty schema always validates", func(t *testing.T) {
+		if err := Validate(Definition{Name: "anything"}, []byte(`{"whatever":1}`)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	def := Definition{Name: "sample"}
+
+	if err := r.Register(def); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := r.Register(def); err == nil {
+		t.Fatal("expected an error registering a duplicate name")
+	}
+
+	got, ok := r.Get("sample")
+	if !ok || got.Name != "sample" {
+		t.Fatalf("Get returned %+v, %v", got, ok)
+	}
+
+	if len(r.List()) != 1 {
+		t.Fatalf("expected 1 registered definition, got %d", len(r.List()))
+	}
+
+	r.Unregister("sample")
+	if _, ok := r.Get("sample"); ok {
+		t.Fatal("expected sample to be unregistered")
+	}
+}
+
+func TestToMCP(t *testing.T) {
+	def := DefinitionFor[sampleArgs]("sample", "a sample tool")
+	tool := ToMCP(def)
+
+	if tool.Name != "sample" {
+		t.Fatalf("expected tool name %q, got %q", "sample", tool.Name)
+	}
+}
@@ -0,0 +1,192 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+
+	perrors "github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/errors"
+)
+
+// Validate checks rawArgs (a JSON object) against def.Schema, the same
+// check ToMCP's registered tool and an AG-UI dispatcher handling a
+// ToolCall for def.Name should both run before acting on the arguments.
+// It returns a *pkg/errors.ValidationError with one FieldError per schema
+// violation, or nil if rawArgs satisfies the schema. A Definition with a
+// nil or empty Schema always validates.
+func Validate(def Definition, rawArgs []byte) error {
+	if len(def.Schema) == 0 {
+		return nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(rawArgs, &value); err != nil {
+		return perrors.NewValidationError("invalid_json", fmt.Sprintf("arguments for %q are not valid JSON", def.Name)).
+			WithCause(err)
+	}
+
+	verr := perrors.NewValidationError("schema_violation", fmt.Sprintf("arguments for %q failed schema validation", def.Name))
+	validateValue("", def.Schema, value, verr)
+
+	if verr.HasFieldErrors() {
+		return verr
+	}
+	return nil
+}
+
+// validateValue checks value against schema, recording one FieldError per
+// violation onto verr under path (the empty string at the document root).
+// It covers the subset of JSON Schema this package's adapters emit and
+// that DefinitionFor-derived schemas need: type, required, properties,
+// enum, minimum/maximum, minLength/maxLength, and array items.
+func validateValue(path string, schema map[string]interface{}, value interface{}, verr *perrors.ValidationError) {
+	if schemaType, ok := schema["type"].(string); ok {
+		if !matchesType(schemaType, value) {
+			verr.AddFieldError(fieldPath(path), fmt.Sprintf("expected type %q, got %T", schemaType, value))
+			return
+		}
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && len(enum) > 0 {
+		if !containsValue(enum, value) {
+			verr.AddFieldError(fieldPath(path), fmt.Sprintf("value %v is not one of %v", value, enum))
+		}
+	}
+
+	switch typed := value.(type) {
+	case string:
+		validateStringConstraints(path, schema, typed, verr)
+	case float64:
+		validateNumberConstraints(path, schema, typed, verr)
+	case map[string]interface{}:
+		validateObjectConstraints(path, schema, typed, verr)
+	case []interface{}:
+		validateArrayConstraints(path, schema, typed, verr)
+	}
+}
+
+func validateStringConstraints(path string, schema map[string]interface{}, value string, verr *perrors.ValidationError) {
+	if minLen, ok := numberOf(schema["minLength"]); ok && float64(len(value)) < minLen {
+		verr.AddFieldError(fieldPath(path), fmt.Sprintf("length %d is less than minLength %v", len(value), minLen))
+	}
+	if maxLen, ok := numberOf(schema["maxLength"]); ok && float64(len(value)) > maxLen {
+		verr.AddFieldError(fieldPath(path), fmt.Sprintf("length %d is greater than maxLength %v", len(value), maxLen))
+	}
+}
+
+func validateNumberConstraints(path string, schema map[string]interface{}, value float64, verr *perrors.ValidationError) {
+	if min, ok := numberOf(schema["minimum"]); ok && value < min {
+		verr.AddFieldError(fieldPath(path), fmt.Sprintf("value %v is less than minimum %v", value, min))
+	}
+	if max, ok := numberOf(schema["maximum"]); ok && value > max {
+		verr.AddFieldError(fieldPath(path), fmt.Sprintf("value %v is greater than maximum %v", value, max))
+	}
+}
+
+func validateObjectConstraints(path string, schema map[string]interface{}, value map[string]interface{}, verr *perrors.ValidationError) {
+	for _, name := range requiredOf(schema) {
+		if _, present := value[name]; !present {
+			verr.AddFieldError(fieldPath(joinPath(path, name)), "required property is missing")
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	for name, propValue := range value {
+		propSchema, ok := properties[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		validateValue(joinPath(path, name), propSchema, propValue, verr)
+	}
+}
+
+func validateArrayConstraints(path string, schema map[string]interface{}, value []interface{}, verr *perrors.ValidationError) {
+	itemSchema, ok := schema["items"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for i, item := range value {
+		validateValue(fmt.Sprintf("%s[%d]", path, i), itemSchema, item, verr)
+	}
+}
+
+func requiredOf(schema map[string]interface{}) []string {
+	switch required := schema["required"].(type) {
+	case []string:
+		return required
+	case []interface{}:
+		names := make([]string, 0, len(required))
+		for _, r := range required {
+			if s, ok := r.(string); ok {
+				names = append(names, s)
+			}
+		}
+		return names
+	default:
+		return nil
+	}
+}
+
+// matchesType reports whether value's runtime JSON type (as produced by
+// encoding/json's default decoding into interface{}) satisfies schemaType.
+func matchesType(schemaType string, value interface{}) bool {
+	switch schemaType {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "integer":
+		n, ok := value.(float64)
+		return ok && n == float64(int64(n))
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "null":
+		return value == nil
+	default:
+		return true
+	}
+}
+
+func containsValue(candidates []interface{}, value interface{}) bool {
+	for _, candidate := range candidates {
+		if fmt.Sprint(candidate) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func numberOf(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// fieldPath returns path, or "(root)" for the document root, so an empty
+// FieldErrors key is never produced.
+func fieldPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
@@ -0,0 +1,18 @@
+package grpc
+
+import "encoding/json"
+
+// jsonCodec stands in for the protoc-gen-go-generated protobuf Marshal/
+// Unmarshal a real build of eventstream.proto would provide, for the same
+// reason documented on grpcstream.Codec: this sandbox has no protoc
+// toolchain. It is installed server-wide via grpc.ForceServerCodec in
+// NewServer, so every SendMsg/RecvMsg on the Agentic stream - ClientMessage
+// inbound, *grpcstream.Event outbound - marshals as JSON on the wire
+// instead of protobuf.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) { return json.Marshal(v) }
+
+func (jsonCodec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+
+func (jsonCodec) Name() string { return "json" }
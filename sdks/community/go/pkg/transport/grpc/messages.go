@@ -0,0 +1,20 @@
+// Package grpc serves AG-UI events over a gRPC bidirectional stream,
+// alongside the Fiber/SSE transport. Like pkg/encoding/grpcstream, whose
+// wire types and ToProto/FromProto conversions it reuses for the
+// server-to-client direction, this package is written against a
+// hand-maintained service description rather than protoc-gen-go-grpc
+// output, since this sandbox has no protoc toolchain; service.go documents
+// the specific stand-ins. Once a real build pipeline can run protoc, this
+// package should be rebased onto the generated client/server stubs.
+package grpc
+
+// ClientMessage is what a client sends into the Agentic bidi stream to
+// start or continue a run. Unlike the server-to-client direction - which
+// reuses grpcstream.Event's oneof of AG-UI event shapes - the client side
+// carries run input, which has no equivalent among those shapes, so it is
+// its own message rather than a repurposed Event.
+type ClientMessage struct {
+	ThreadID string
+	RunID    string
+	Content  string
+}
@@ -0,0 +1,126 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+
+	"google.golang.org/grpc"
+
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/core/events"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/encoding/grpcstream"
+	"github.com/ag-ui-protocol/ag-ui/sdks/community/go/pkg/state"
+)
+
+// RunHandler drives one agentic run for msg, writing every resulting
+// events.Event to sink until the run completes or ctx is cancelled; it must
+// close neither sink nor return until the run is fully done emitting. This
+// plays the same role for the gRPC transport that
+// example/server/internal/agentic.ProcessInput plays for HTTP/SSE, so a
+// caller can wire both transports to the same underlying agent logic.
+type RunHandler func(ctx context.Context, msg ClientMessage, sink chan<- events.Event) error
+
+// Server serves the Agentic bidi streaming RPC. Construct it with the same
+// state.Store and state.ReplayRegistry passed to
+// routes.AgenticHandler, so a client reconnecting over either transport
+// observes one shared per-thread history.
+type Server struct {
+	grpcServer *grpc.Server
+	port       int
+	replay     *state.ReplayRegistry
+	run        RunHandler
+}
+
+var _ AgenticServer = (*Server)(nil)
+
+// NewServer creates a Server bound to port, ready for Start. run supplies
+// the actual agent behavior; replay is consulted so every event this
+// server emits is also recorded for Last-Event-ID-style resumption,
+// shared with whatever HTTP/SSE handlers were constructed against the
+// same instance.
+func NewServer(port int, replay *state.ReplayRegistry, run RunHandler) *Server {
+	s := &Server{
+		port:       port,
+		replay:     replay,
+		run:        run,
+		grpcServer: grpc.NewServer(grpc.ForceServerCodec(jsonCodec{})),
+	}
+	RegisterAgenticServer(s.grpcServer, s)
+	return s
+}
+
+// Start listens on s.port and blocks serving RPCs until Shutdown or a
+// fatal accept error.
+func (s *Server) Start() error {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
+	if err != nil {
+		return fmt.Errorf("grpc: listen on port %d: %w", s.port, err)
+	}
+	return s.grpcServer.Serve(lis)
+}
+
+// Shutdown gracefully drains in-flight RPCs via grpc.Server.GracefulStop,
+// falling back to an immediate Stop if ctx is cancelled first.
+func (s *Server) Shutdown(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+		return ctx.Err()
+	}
+}
+
+// Agentic implements AgenticServer. It reads each ClientMessage off stream,
+// drives it through s.run, and writes every resulting event back as a
+// *grpcstream.Event, recording it in the thread's replay buffer first so a
+// later reconnect - over gRPC or HTTP - resumes from the same sequence.
+func (s *Server) Agentic(stream grpc.ServerStream) error {
+	ctx := stream.Context()
+
+	for {
+		var msg ClientMessage
+		if err := stream.RecvMsg(&msg); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		threadID := msg.ThreadID
+		if threadID == "" {
+			threadID = events.GenerateThreadID()
+		}
+		buf := s.replay.ForThread(threadID)
+
+		sink := make(chan events.Event)
+		runErr := make(chan error, 1)
+		go func() {
+			defer close(sink)
+			runErr <- s.run(ctx, msg, sink)
+		}()
+
+		for event := range sink {
+			buf.Add(event)
+
+			wire, err := grpcstream.ToProto(event)
+			if err != nil {
+				return fmt.Errorf("grpc: convert event to wire message: %w", err)
+			}
+			if err := stream.SendMsg(wire); err != nil {
+				return fmt.Errorf("grpc: send event: %w", err)
+			}
+		}
+
+		if err := <-runErr; err != nil {
+			return err
+		}
+	}
+}
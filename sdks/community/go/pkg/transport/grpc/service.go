@@ -0,0 +1,45 @@
+package grpc
+
+import "google.golang.org/grpc"
+
+// AgenticServer is implemented by a type that can drive the Agentic RPC's
+// single bidirectional stream end to end. Server implements it; Agentic
+// reads ClientMessages off stream and writes *grpcstream.Event values back
+// until the stream closes.
+//
+// This mirrors the HandlerType a protoc-gen-go-grpc-generated
+// AgenticServer interface would declare, but is hand-written - see the
+// package doc comment for why.
+type AgenticServer interface {
+	Agentic(stream grpc.ServerStream) error
+}
+
+// agenticServiceDesc mirrors the grpc.ServiceDesc protoc-gen-go-grpc would
+// generate for a service declaring one bidi streaming RPC:
+//
+//	service Agentic {
+//	  rpc Agentic(stream ClientMessage) returns (stream ServerEvent);
+//	}
+var agenticServiceDesc = grpc.ServiceDesc{
+	ServiceName: "aguigrpc.Agentic",
+	HandlerType: (*AgenticServer)(nil),
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Agentic",
+			Handler:       agenticStreamHandler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "eventstream.proto",
+}
+
+func agenticStreamHandler(srv any, stream grpc.ServerStream) error {
+	return srv.(AgenticServer).Agentic(stream)
+}
+
+// RegisterAgenticServer registers srv as the implementation of the
+// Agentic bidi streaming RPC on s.
+func RegisterAgenticServer(s *grpc.Server, srv AgenticServer) {
+	s.RegisterService(&agenticServiceDesc, srv)
+}